@@ -16,11 +16,13 @@ import (
 )
 
 var (
-	md_Decision        protoreflect.MessageDescriptor
-	fd_Decision_from   protoreflect.FieldDescriptor
-	fd_Decision_to     protoreflect.FieldDescriptor
-	fd_Decision_amount protoreflect.FieldDescriptor
-	fd_Decision_valid  protoreflect.FieldDescriptor
+	md_Decision                   protoreflect.MessageDescriptor
+	fd_Decision_from              protoreflect.FieldDescriptor
+	fd_Decision_to                protoreflect.FieldDescriptor
+	fd_Decision_amount            protoreflect.FieldDescriptor
+	fd_Decision_valid             protoreflect.FieldDescriptor
+	fd_Decision_sender_blocked    protoreflect.FieldDescriptor
+	fd_Decision_recipient_blocked protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -30,6 +32,8 @@ func init() {
 	fd_Decision_to = md_Decision.Fields().ByName("to")
 	fd_Decision_amount = md_Decision.Fields().ByName("amount")
 	fd_Decision_valid = md_Decision.Fields().ByName("valid")
+	fd_Decision_sender_blocked = md_Decision.Fields().ByName("sender_blocked")
+	fd_Decision_recipient_blocked = md_Decision.Fields().ByName("recipient_blocked")
 }
 
 var _ protoreflect.Message = (*fastReflection_Decision)(nil)
@@ -121,6 +125,18 @@ func (x *fastReflection_Decision) Range(f func(protoreflect.FieldDescriptor, pro
 			return
 		}
 	}
+	if x.SenderBlocked != false {
+		value := protoreflect.ValueOfBool(x.SenderBlocked)
+		if !f(fd_Decision_sender_blocked, value) {
+			return
+		}
+	}
+	if x.RecipientBlocked != false {
+		value := protoreflect.ValueOfBool(x.RecipientBlocked)
+		if !f(fd_Decision_recipient_blocked, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -144,6 +160,10 @@ func (x *fastReflection_Decision) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.Amount != ""
 	case "florin.blacklist.v1.Decision.valid":
 		return x.Valid != false
+	case "florin.blacklist.v1.Decision.sender_blocked":
+		return x.SenderBlocked != false
+	case "florin.blacklist.v1.Decision.recipient_blocked":
+		return x.RecipientBlocked != false
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.Decision"))
@@ -168,6 +188,10 @@ func (x *fastReflection_Decision) Clear(fd protoreflect.FieldDescriptor) {
 		x.Amount = ""
 	case "florin.blacklist.v1.Decision.valid":
 		x.Valid = false
+	case "florin.blacklist.v1.Decision.sender_blocked":
+		x.SenderBlocked = false
+	case "florin.blacklist.v1.Decision.recipient_blocked":
+		x.RecipientBlocked = false
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.Decision"))
@@ -196,6 +220,12 @@ func (x *fastReflection_Decision) Get(descriptor protoreflect.FieldDescriptor) p
 	case "florin.blacklist.v1.Decision.valid":
 		value := x.Valid
 		return protoreflect.ValueOfBool(value)
+	case "florin.blacklist.v1.Decision.sender_blocked":
+		value := x.SenderBlocked
+		return protoreflect.ValueOfBool(value)
+	case "florin.blacklist.v1.Decision.recipient_blocked":
+		value := x.RecipientBlocked
+		return protoreflect.ValueOfBool(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.Decision"))
@@ -224,6 +254,10 @@ func (x *fastReflection_Decision) Set(fd protoreflect.FieldDescriptor, value pro
 		x.Amount = value.Interface().(string)
 	case "florin.blacklist.v1.Decision.valid":
 		x.Valid = value.Bool()
+	case "florin.blacklist.v1.Decision.sender_blocked":
+		x.SenderBlocked = value.Bool()
+	case "florin.blacklist.v1.Decision.recipient_blocked":
+		x.RecipientBlocked = value.Bool()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.Decision"))
@@ -252,6 +286,10 @@ func (x *fastReflection_Decision) Mutable(fd protoreflect.FieldDescriptor) proto
 		panic(fmt.Errorf("field amount of message florin.blacklist.v1.Decision is not mutable"))
 	case "florin.blacklist.v1.Decision.valid":
 		panic(fmt.Errorf("field valid of message florin.blacklist.v1.Decision is not mutable"))
+	case "florin.blacklist.v1.Decision.sender_blocked":
+		panic(fmt.Errorf("field sender_blocked of message florin.blacklist.v1.Decision is not mutable"))
+	case "florin.blacklist.v1.Decision.recipient_blocked":
+		panic(fmt.Errorf("field recipient_blocked of message florin.blacklist.v1.Decision is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.Decision"))
@@ -273,6 +311,10 @@ func (x *fastReflection_Decision) NewField(fd protoreflect.FieldDescriptor) prot
 		return protoreflect.ValueOfString("")
 	case "florin.blacklist.v1.Decision.valid":
 		return protoreflect.ValueOfBool(false)
+	case "florin.blacklist.v1.Decision.sender_blocked":
+		return protoreflect.ValueOfBool(false)
+	case "florin.blacklist.v1.Decision.recipient_blocked":
+		return protoreflect.ValueOfBool(false)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.Decision"))
@@ -357,6 +399,12 @@ func (x *fastReflection_Decision) ProtoMethods() *protoiface.Methods {
 		if x.Valid {
 			n += 2
 		}
+		if x.SenderBlocked {
+			n += 2
+		}
+		if x.RecipientBlocked {
+			n += 2
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -386,6 +434,26 @@ func (x *fastReflection_Decision) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.RecipientBlocked {
+			i--
+			if x.RecipientBlocked {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.SenderBlocked {
+			i--
+			if x.SenderBlocked {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x28
+		}
 		if x.Valid {
 			i--
 			if x.Valid {
@@ -582,6 +650,46 @@ func (x *fastReflection_Decision) ProtoMethods() *protoiface.Methods {
 					}
 				}
 				x.Valid = bool(v != 0)
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SenderBlocked", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.SenderBlocked = bool(v != 0)
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RecipientBlocked", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.RecipientBlocked = bool(v != 0)
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -618,14 +726,24 @@ func (x *fastReflection_Decision) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_Ban           protoreflect.MessageDescriptor
-	fd_Ban_adversary protoreflect.FieldDescriptor
+	md_Ban               protoreflect.MessageDescriptor
+	fd_Ban_adversary     protoreflect.FieldDescriptor
+	fd_Ban_denom         protoreflect.FieldDescriptor
+	fd_Ban_expiry_height protoreflect.FieldDescriptor
+	fd_Ban_expiry_time   protoreflect.FieldDescriptor
+	fd_Ban_reason        protoreflect.FieldDescriptor
+	fd_Ban_case_id       protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_florin_blacklist_v1_events_proto_init()
 	md_Ban = File_florin_blacklist_v1_events_proto.Messages().ByName("Ban")
 	fd_Ban_adversary = md_Ban.Fields().ByName("adversary")
+	fd_Ban_denom = md_Ban.Fields().ByName("denom")
+	fd_Ban_expiry_height = md_Ban.Fields().ByName("expiry_height")
+	fd_Ban_expiry_time = md_Ban.Fields().ByName("expiry_time")
+	fd_Ban_reason = md_Ban.Fields().ByName("reason")
+	fd_Ban_case_id = md_Ban.Fields().ByName("case_id")
 }
 
 var _ protoreflect.Message = (*fastReflection_Ban)(nil)
@@ -699,6 +817,36 @@ func (x *fastReflection_Ban) Range(f func(protoreflect.FieldDescriptor, protoref
 			return
 		}
 	}
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_Ban_denom, value) {
+			return
+		}
+	}
+	if x.ExpiryHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ExpiryHeight)
+		if !f(fd_Ban_expiry_height, value) {
+			return
+		}
+	}
+	if x.ExpiryTime != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ExpiryTime)
+		if !f(fd_Ban_expiry_time, value) {
+			return
+		}
+	}
+	if x.Reason != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Reason))
+		if !f(fd_Ban_reason, value) {
+			return
+		}
+	}
+	if x.CaseId != "" {
+		value := protoreflect.ValueOfString(x.CaseId)
+		if !f(fd_Ban_case_id, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -716,6 +864,16 @@ func (x *fastReflection_Ban) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
 	case "florin.blacklist.v1.Ban.adversary":
 		return x.Adversary != ""
+	case "florin.blacklist.v1.Ban.denom":
+		return x.Denom != ""
+	case "florin.blacklist.v1.Ban.expiry_height":
+		return x.ExpiryHeight != int64(0)
+	case "florin.blacklist.v1.Ban.expiry_time":
+		return x.ExpiryTime != int64(0)
+	case "florin.blacklist.v1.Ban.reason":
+		return x.Reason != 0
+	case "florin.blacklist.v1.Ban.case_id":
+		return x.CaseId != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.Ban"))
@@ -734,6 +892,16 @@ func (x *fastReflection_Ban) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
 	case "florin.blacklist.v1.Ban.adversary":
 		x.Adversary = ""
+	case "florin.blacklist.v1.Ban.denom":
+		x.Denom = ""
+	case "florin.blacklist.v1.Ban.expiry_height":
+		x.ExpiryHeight = int64(0)
+	case "florin.blacklist.v1.Ban.expiry_time":
+		x.ExpiryTime = int64(0)
+	case "florin.blacklist.v1.Ban.reason":
+		x.Reason = 0
+	case "florin.blacklist.v1.Ban.case_id":
+		x.CaseId = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.Ban"))
@@ -753,6 +921,21 @@ func (x *fastReflection_Ban) Get(descriptor protoreflect.FieldDescriptor) protor
 	case "florin.blacklist.v1.Ban.adversary":
 		value := x.Adversary
 		return protoreflect.ValueOfString(value)
+	case "florin.blacklist.v1.Ban.denom":
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
+	case "florin.blacklist.v1.Ban.expiry_height":
+		value := x.ExpiryHeight
+		return protoreflect.ValueOfInt64(value)
+	case "florin.blacklist.v1.Ban.expiry_time":
+		value := x.ExpiryTime
+		return protoreflect.ValueOfInt64(value)
+	case "florin.blacklist.v1.Ban.reason":
+		value := x.Reason
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "florin.blacklist.v1.Ban.case_id":
+		value := x.CaseId
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.Ban"))
@@ -775,6 +958,16 @@ func (x *fastReflection_Ban) Set(fd protoreflect.FieldDescriptor, value protoref
 	switch fd.FullName() {
 	case "florin.blacklist.v1.Ban.adversary":
 		x.Adversary = value.Interface().(string)
+	case "florin.blacklist.v1.Ban.denom":
+		x.Denom = value.Interface().(string)
+	case "florin.blacklist.v1.Ban.expiry_height":
+		x.ExpiryHeight = value.Int()
+	case "florin.blacklist.v1.Ban.expiry_time":
+		x.ExpiryTime = value.Int()
+	case "florin.blacklist.v1.Ban.reason":
+		x.Reason = (BanReason)(value.Enum())
+	case "florin.blacklist.v1.Ban.case_id":
+		x.CaseId = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.Ban"))
@@ -797,6 +990,16 @@ func (x *fastReflection_Ban) Mutable(fd protoreflect.FieldDescriptor) protorefle
 	switch fd.FullName() {
 	case "florin.blacklist.v1.Ban.adversary":
 		panic(fmt.Errorf("field adversary of message florin.blacklist.v1.Ban is not mutable"))
+	case "florin.blacklist.v1.Ban.denom":
+		panic(fmt.Errorf("field denom of message florin.blacklist.v1.Ban is not mutable"))
+	case "florin.blacklist.v1.Ban.expiry_height":
+		panic(fmt.Errorf("field expiry_height of message florin.blacklist.v1.Ban is not mutable"))
+	case "florin.blacklist.v1.Ban.expiry_time":
+		panic(fmt.Errorf("field expiry_time of message florin.blacklist.v1.Ban is not mutable"))
+	case "florin.blacklist.v1.Ban.reason":
+		panic(fmt.Errorf("field reason of message florin.blacklist.v1.Ban is not mutable"))
+	case "florin.blacklist.v1.Ban.case_id":
+		panic(fmt.Errorf("field case_id of message florin.blacklist.v1.Ban is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.Ban"))
@@ -812,6 +1015,16 @@ func (x *fastReflection_Ban) NewField(fd protoreflect.FieldDescriptor) protorefl
 	switch fd.FullName() {
 	case "florin.blacklist.v1.Ban.adversary":
 		return protoreflect.ValueOfString("")
+	case "florin.blacklist.v1.Ban.denom":
+		return protoreflect.ValueOfString("")
+	case "florin.blacklist.v1.Ban.expiry_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "florin.blacklist.v1.Ban.expiry_time":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "florin.blacklist.v1.Ban.reason":
+		return protoreflect.ValueOfEnum(0)
+	case "florin.blacklist.v1.Ban.case_id":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.Ban"))
@@ -885,6 +1098,23 @@ func (x *fastReflection_Ban) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		l = len(x.Denom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ExpiryHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExpiryHeight))
+		}
+		if x.ExpiryTime != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExpiryTime))
+		}
+		if x.Reason != 0 {
+			n += 1 + runtime.Sov(uint64(x.Reason))
+		}
+		l = len(x.CaseId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -914,6 +1144,35 @@ func (x *fastReflection_Ban) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.CaseId) > 0 {
+			i -= len(x.CaseId)
+			copy(dAtA[i:], x.CaseId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CaseId)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.Reason != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Reason))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.ExpiryTime != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExpiryTime))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.ExpiryHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExpiryHeight))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+			i--
+			dAtA[i] = 0x12
+		}
 		if len(x.Adversary) > 0 {
 			i -= len(x.Adversary)
 			copy(dAtA[i:], x.Adversary)
@@ -972,7 +1231,612 @@ func (x *fastReflection_Ban) ProtoMethods() *protoiface.Methods {
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Adversary", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Adversary", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Adversary = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Denom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+				}
+				x.ExpiryHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ExpiryHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpiryTime", wireType)
+				}
+				x.ExpiryTime = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ExpiryTime |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				}
+				x.Reason = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Reason |= BanReason(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CaseId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CaseId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_BanExpired           protoreflect.MessageDescriptor
+	fd_BanExpired_adversary protoreflect.FieldDescriptor
+	fd_BanExpired_denom     protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_florin_blacklist_v1_events_proto_init()
+	md_BanExpired = File_florin_blacklist_v1_events_proto.Messages().ByName("BanExpired")
+	fd_BanExpired_adversary = md_BanExpired.Fields().ByName("adversary")
+	fd_BanExpired_denom = md_BanExpired.Fields().ByName("denom")
+}
+
+var _ protoreflect.Message = (*fastReflection_BanExpired)(nil)
+
+type fastReflection_BanExpired BanExpired
+
+func (x *BanExpired) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_BanExpired)(x)
+}
+
+func (x *BanExpired) slowProtoReflect() protoreflect.Message {
+	mi := &file_florin_blacklist_v1_events_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_BanExpired_messageType fastReflection_BanExpired_messageType
+var _ protoreflect.MessageType = fastReflection_BanExpired_messageType{}
+
+type fastReflection_BanExpired_messageType struct{}
+
+func (x fastReflection_BanExpired_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_BanExpired)(nil)
+}
+func (x fastReflection_BanExpired_messageType) New() protoreflect.Message {
+	return new(fastReflection_BanExpired)
+}
+func (x fastReflection_BanExpired_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_BanExpired
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_BanExpired) Descriptor() protoreflect.MessageDescriptor {
+	return md_BanExpired
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_BanExpired) Type() protoreflect.MessageType {
+	return _fastReflection_BanExpired_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_BanExpired) New() protoreflect.Message {
+	return new(fastReflection_BanExpired)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_BanExpired) Interface() protoreflect.ProtoMessage {
+	return (*BanExpired)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_BanExpired) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Adversary != "" {
+		value := protoreflect.ValueOfString(x.Adversary)
+		if !f(fd_BanExpired_adversary, value) {
+			return
+		}
+	}
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_BanExpired_denom, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_BanExpired) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "florin.blacklist.v1.BanExpired.adversary":
+		return x.Adversary != ""
+	case "florin.blacklist.v1.BanExpired.denom":
+		return x.Denom != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.BanExpired"))
+		}
+		panic(fmt.Errorf("message florin.blacklist.v1.BanExpired does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BanExpired) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "florin.blacklist.v1.BanExpired.adversary":
+		x.Adversary = ""
+	case "florin.blacklist.v1.BanExpired.denom":
+		x.Denom = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.BanExpired"))
+		}
+		panic(fmt.Errorf("message florin.blacklist.v1.BanExpired does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_BanExpired) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "florin.blacklist.v1.BanExpired.adversary":
+		value := x.Adversary
+		return protoreflect.ValueOfString(value)
+	case "florin.blacklist.v1.BanExpired.denom":
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.BanExpired"))
+		}
+		panic(fmt.Errorf("message florin.blacklist.v1.BanExpired does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BanExpired) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "florin.blacklist.v1.BanExpired.adversary":
+		x.Adversary = value.Interface().(string)
+	case "florin.blacklist.v1.BanExpired.denom":
+		x.Denom = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.BanExpired"))
+		}
+		panic(fmt.Errorf("message florin.blacklist.v1.BanExpired does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BanExpired) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "florin.blacklist.v1.BanExpired.adversary":
+		panic(fmt.Errorf("field adversary of message florin.blacklist.v1.BanExpired is not mutable"))
+	case "florin.blacklist.v1.BanExpired.denom":
+		panic(fmt.Errorf("field denom of message florin.blacklist.v1.BanExpired is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.BanExpired"))
+		}
+		panic(fmt.Errorf("message florin.blacklist.v1.BanExpired does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_BanExpired) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "florin.blacklist.v1.BanExpired.adversary":
+		return protoreflect.ValueOfString("")
+	case "florin.blacklist.v1.BanExpired.denom":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.BanExpired"))
+		}
+		panic(fmt.Errorf("message florin.blacklist.v1.BanExpired does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_BanExpired) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in florin.blacklist.v1.BanExpired", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_BanExpired) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BanExpired) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_BanExpired) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_BanExpired) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*BanExpired)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Adversary)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Denom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*BanExpired)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Adversary) > 0 {
+			i -= len(x.Adversary)
+			copy(dAtA[i:], x.Adversary)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Adversary)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*BanExpired)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BanExpired: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BanExpired: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Adversary", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Adversary = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -1000,7 +1864,7 @@ func (x *fastReflection_Ban) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Adversary = string(dAtA[iNdEx:postIndex])
+				x.Denom = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -1040,12 +1904,14 @@ func (x *fastReflection_Ban) ProtoMethods() *protoiface.Methods {
 var (
 	md_Unban        protoreflect.MessageDescriptor
 	fd_Unban_friend protoreflect.FieldDescriptor
+	fd_Unban_denom  protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_florin_blacklist_v1_events_proto_init()
 	md_Unban = File_florin_blacklist_v1_events_proto.Messages().ByName("Unban")
 	fd_Unban_friend = md_Unban.Fields().ByName("friend")
+	fd_Unban_denom = md_Unban.Fields().ByName("denom")
 }
 
 var _ protoreflect.Message = (*fastReflection_Unban)(nil)
@@ -1057,7 +1923,7 @@ func (x *Unban) ProtoReflect() protoreflect.Message {
 }
 
 func (x *Unban) slowProtoReflect() protoreflect.Message {
-	mi := &file_florin_blacklist_v1_events_proto_msgTypes[2]
+	mi := &file_florin_blacklist_v1_events_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1119,6 +1985,12 @@ func (x *fastReflection_Unban) Range(f func(protoreflect.FieldDescriptor, protor
 			return
 		}
 	}
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_Unban_denom, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -1136,6 +2008,8 @@ func (x *fastReflection_Unban) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
 	case "florin.blacklist.v1.Unban.friend":
 		return x.Friend != ""
+	case "florin.blacklist.v1.Unban.denom":
+		return x.Denom != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.Unban"))
@@ -1154,6 +2028,8 @@ func (x *fastReflection_Unban) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
 	case "florin.blacklist.v1.Unban.friend":
 		x.Friend = ""
+	case "florin.blacklist.v1.Unban.denom":
+		x.Denom = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.Unban"))
@@ -1173,6 +2049,9 @@ func (x *fastReflection_Unban) Get(descriptor protoreflect.FieldDescriptor) prot
 	case "florin.blacklist.v1.Unban.friend":
 		value := x.Friend
 		return protoreflect.ValueOfString(value)
+	case "florin.blacklist.v1.Unban.denom":
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.Unban"))
@@ -1195,6 +2074,8 @@ func (x *fastReflection_Unban) Set(fd protoreflect.FieldDescriptor, value protor
 	switch fd.FullName() {
 	case "florin.blacklist.v1.Unban.friend":
 		x.Friend = value.Interface().(string)
+	case "florin.blacklist.v1.Unban.denom":
+		x.Denom = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.Unban"))
@@ -1217,6 +2098,8 @@ func (x *fastReflection_Unban) Mutable(fd protoreflect.FieldDescriptor) protoref
 	switch fd.FullName() {
 	case "florin.blacklist.v1.Unban.friend":
 		panic(fmt.Errorf("field friend of message florin.blacklist.v1.Unban is not mutable"))
+	case "florin.blacklist.v1.Unban.denom":
+		panic(fmt.Errorf("field denom of message florin.blacklist.v1.Unban is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.Unban"))
@@ -1232,6 +2115,8 @@ func (x *fastReflection_Unban) NewField(fd protoreflect.FieldDescriptor) protore
 	switch fd.FullName() {
 	case "florin.blacklist.v1.Unban.friend":
 		return protoreflect.ValueOfString("")
+	case "florin.blacklist.v1.Unban.denom":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.Unban"))
@@ -1305,6 +2190,10 @@ func (x *fastReflection_Unban) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		l = len(x.Denom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1334,6 +2223,13 @@ func (x *fastReflection_Unban) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+			i--
+			dAtA[i] = 0x12
+		}
 		if len(x.Friend) > 0 {
 			i -= len(x.Friend)
 			copy(dAtA[i:], x.Friend)
@@ -1422,6 +2318,38 @@ func (x *fastReflection_Unban) ProtoMethods() *protoiface.Methods {
 				}
 				x.Friend = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Denom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1460,12 +2388,14 @@ func (x *fastReflection_Unban) ProtoMethods() *protoiface.Methods {
 var (
 	md_AdminAccountAdded         protoreflect.MessageDescriptor
 	fd_AdminAccountAdded_account protoreflect.FieldDescriptor
+	fd_AdminAccountAdded_denom   protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_florin_blacklist_v1_events_proto_init()
 	md_AdminAccountAdded = File_florin_blacklist_v1_events_proto.Messages().ByName("AdminAccountAdded")
 	fd_AdminAccountAdded_account = md_AdminAccountAdded.Fields().ByName("account")
+	fd_AdminAccountAdded_denom = md_AdminAccountAdded.Fields().ByName("denom")
 }
 
 var _ protoreflect.Message = (*fastReflection_AdminAccountAdded)(nil)
@@ -1477,7 +2407,7 @@ func (x *AdminAccountAdded) ProtoReflect() protoreflect.Message {
 }
 
 func (x *AdminAccountAdded) slowProtoReflect() protoreflect.Message {
-	mi := &file_florin_blacklist_v1_events_proto_msgTypes[3]
+	mi := &file_florin_blacklist_v1_events_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1539,6 +2469,12 @@ func (x *fastReflection_AdminAccountAdded) Range(f func(protoreflect.FieldDescri
 			return
 		}
 	}
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_AdminAccountAdded_denom, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -1556,6 +2492,8 @@ func (x *fastReflection_AdminAccountAdded) Has(fd protoreflect.FieldDescriptor)
 	switch fd.FullName() {
 	case "florin.blacklist.v1.AdminAccountAdded.account":
 		return x.Account != ""
+	case "florin.blacklist.v1.AdminAccountAdded.denom":
+		return x.Denom != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.AdminAccountAdded"))
@@ -1574,6 +2512,8 @@ func (x *fastReflection_AdminAccountAdded) Clear(fd protoreflect.FieldDescriptor
 	switch fd.FullName() {
 	case "florin.blacklist.v1.AdminAccountAdded.account":
 		x.Account = ""
+	case "florin.blacklist.v1.AdminAccountAdded.denom":
+		x.Denom = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.AdminAccountAdded"))
@@ -1593,6 +2533,9 @@ func (x *fastReflection_AdminAccountAdded) Get(descriptor protoreflect.FieldDesc
 	case "florin.blacklist.v1.AdminAccountAdded.account":
 		value := x.Account
 		return protoreflect.ValueOfString(value)
+	case "florin.blacklist.v1.AdminAccountAdded.denom":
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.AdminAccountAdded"))
@@ -1615,6 +2558,8 @@ func (x *fastReflection_AdminAccountAdded) Set(fd protoreflect.FieldDescriptor,
 	switch fd.FullName() {
 	case "florin.blacklist.v1.AdminAccountAdded.account":
 		x.Account = value.Interface().(string)
+	case "florin.blacklist.v1.AdminAccountAdded.denom":
+		x.Denom = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.AdminAccountAdded"))
@@ -1637,6 +2582,8 @@ func (x *fastReflection_AdminAccountAdded) Mutable(fd protoreflect.FieldDescript
 	switch fd.FullName() {
 	case "florin.blacklist.v1.AdminAccountAdded.account":
 		panic(fmt.Errorf("field account of message florin.blacklist.v1.AdminAccountAdded is not mutable"))
+	case "florin.blacklist.v1.AdminAccountAdded.denom":
+		panic(fmt.Errorf("field denom of message florin.blacklist.v1.AdminAccountAdded is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.AdminAccountAdded"))
@@ -1652,6 +2599,8 @@ func (x *fastReflection_AdminAccountAdded) NewField(fd protoreflect.FieldDescrip
 	switch fd.FullName() {
 	case "florin.blacklist.v1.AdminAccountAdded.account":
 		return protoreflect.ValueOfString("")
+	case "florin.blacklist.v1.AdminAccountAdded.denom":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.AdminAccountAdded"))
@@ -1725,6 +2674,10 @@ func (x *fastReflection_AdminAccountAdded) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		l = len(x.Denom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1754,6 +2707,13 @@ func (x *fastReflection_AdminAccountAdded) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+			i--
+			dAtA[i] = 0x12
+		}
 		if len(x.Account) > 0 {
 			i -= len(x.Account)
 			copy(dAtA[i:], x.Account)
@@ -1842,6 +2802,38 @@ func (x *fastReflection_AdminAccountAdded) ProtoMethods() *protoiface.Methods {
 				}
 				x.Account = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Denom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1880,12 +2872,14 @@ func (x *fastReflection_AdminAccountAdded) ProtoMethods() *protoiface.Methods {
 var (
 	md_AdminAccountRemoved         protoreflect.MessageDescriptor
 	fd_AdminAccountRemoved_account protoreflect.FieldDescriptor
+	fd_AdminAccountRemoved_denom   protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_florin_blacklist_v1_events_proto_init()
 	md_AdminAccountRemoved = File_florin_blacklist_v1_events_proto.Messages().ByName("AdminAccountRemoved")
 	fd_AdminAccountRemoved_account = md_AdminAccountRemoved.Fields().ByName("account")
+	fd_AdminAccountRemoved_denom = md_AdminAccountRemoved.Fields().ByName("denom")
 }
 
 var _ protoreflect.Message = (*fastReflection_AdminAccountRemoved)(nil)
@@ -1897,7 +2891,7 @@ func (x *AdminAccountRemoved) ProtoReflect() protoreflect.Message {
 }
 
 func (x *AdminAccountRemoved) slowProtoReflect() protoreflect.Message {
-	mi := &file_florin_blacklist_v1_events_proto_msgTypes[4]
+	mi := &file_florin_blacklist_v1_events_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1959,6 +2953,12 @@ func (x *fastReflection_AdminAccountRemoved) Range(f func(protoreflect.FieldDesc
 			return
 		}
 	}
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_AdminAccountRemoved_denom, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -1976,6 +2976,8 @@ func (x *fastReflection_AdminAccountRemoved) Has(fd protoreflect.FieldDescriptor
 	switch fd.FullName() {
 	case "florin.blacklist.v1.AdminAccountRemoved.account":
 		return x.Account != ""
+	case "florin.blacklist.v1.AdminAccountRemoved.denom":
+		return x.Denom != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.AdminAccountRemoved"))
@@ -1994,6 +2996,8 @@ func (x *fastReflection_AdminAccountRemoved) Clear(fd protoreflect.FieldDescript
 	switch fd.FullName() {
 	case "florin.blacklist.v1.AdminAccountRemoved.account":
 		x.Account = ""
+	case "florin.blacklist.v1.AdminAccountRemoved.denom":
+		x.Denom = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.AdminAccountRemoved"))
@@ -2013,6 +3017,9 @@ func (x *fastReflection_AdminAccountRemoved) Get(descriptor protoreflect.FieldDe
 	case "florin.blacklist.v1.AdminAccountRemoved.account":
 		value := x.Account
 		return protoreflect.ValueOfString(value)
+	case "florin.blacklist.v1.AdminAccountRemoved.denom":
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.AdminAccountRemoved"))
@@ -2035,6 +3042,8 @@ func (x *fastReflection_AdminAccountRemoved) Set(fd protoreflect.FieldDescriptor
 	switch fd.FullName() {
 	case "florin.blacklist.v1.AdminAccountRemoved.account":
 		x.Account = value.Interface().(string)
+	case "florin.blacklist.v1.AdminAccountRemoved.denom":
+		x.Denom = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.AdminAccountRemoved"))
@@ -2057,6 +3066,8 @@ func (x *fastReflection_AdminAccountRemoved) Mutable(fd protoreflect.FieldDescri
 	switch fd.FullName() {
 	case "florin.blacklist.v1.AdminAccountRemoved.account":
 		panic(fmt.Errorf("field account of message florin.blacklist.v1.AdminAccountRemoved is not mutable"))
+	case "florin.blacklist.v1.AdminAccountRemoved.denom":
+		panic(fmt.Errorf("field denom of message florin.blacklist.v1.AdminAccountRemoved is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.AdminAccountRemoved"))
@@ -2072,6 +3083,8 @@ func (x *fastReflection_AdminAccountRemoved) NewField(fd protoreflect.FieldDescr
 	switch fd.FullName() {
 	case "florin.blacklist.v1.AdminAccountRemoved.account":
 		return protoreflect.ValueOfString("")
+	case "florin.blacklist.v1.AdminAccountRemoved.denom":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.AdminAccountRemoved"))
@@ -2145,6 +3158,10 @@ func (x *fastReflection_AdminAccountRemoved) ProtoMethods() *protoiface.Methods
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		l = len(x.Denom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -2174,6 +3191,13 @@ func (x *fastReflection_AdminAccountRemoved) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+			i--
+			dAtA[i] = 0x12
+		}
 		if len(x.Account) > 0 {
 			i -= len(x.Account)
 			copy(dAtA[i:], x.Account)
@@ -2262,6 +3286,38 @@ func (x *fastReflection_AdminAccountRemoved) ProtoMethods() *protoiface.Methods
 				}
 				x.Account = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Denom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2319,7 +3375,7 @@ func (x *OwnershipTransferStarted) ProtoReflect() protoreflect.Message {
 }
 
 func (x *OwnershipTransferStarted) slowProtoReflect() protoreflect.Message {
-	mi := &file_florin_blacklist_v1_events_proto_msgTypes[5]
+	mi := &file_florin_blacklist_v1_events_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2803,7 +3859,7 @@ func (x *OwnershipTransferred) ProtoReflect() protoreflect.Message {
 }
 
 func (x *OwnershipTransferred) slowProtoReflect() protoreflect.Message {
-	mi := &file_florin_blacklist_v1_events_proto_msgTypes[6]
+	mi := &file_florin_blacklist_v1_events_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3292,6 +4348,10 @@ type Decision struct {
 	Amount string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
 	// valid is true if transfer approved, false if rejected.
 	Valid bool `protobuf:"varint,4,opt,name=valid,proto3" json:"valid,omitempty"`
+	// sender_blocked is true if the sender is an adversary.
+	SenderBlocked bool `protobuf:"varint,5,opt,name=sender_blocked,json=senderBlocked,proto3" json:"sender_blocked,omitempty"`
+	// recipient_blocked is true if the recipient is an adversary.
+	RecipientBlocked bool `protobuf:"varint,6,opt,name=recipient_blocked,json=recipientBlocked,proto3" json:"recipient_blocked,omitempty"`
 }
 
 func (x *Decision) Reset() {
@@ -3342,6 +4402,20 @@ func (x *Decision) GetValid() bool {
 	return false
 }
 
+func (x *Decision) GetSenderBlocked() bool {
+	if x != nil {
+		return x.SenderBlocked
+	}
+	return false
+}
+
+func (x *Decision) GetRecipientBlocked() bool {
+	if x != nil {
+		return x.RecipientBlocked
+	}
+	return false
+}
+
 // Emitted when an address is added to the blacklist.
 type Ban struct {
 	state         protoimpl.MessageState
@@ -3350,6 +4424,16 @@ type Ban struct {
 
 	// adversary is the address that was added.
 	Adversary string `protobuf:"bytes,1,opt,name=adversary,proto3" json:"adversary,omitempty"`
+	// denom is the denom the ban is scoped to, empty meaning global.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// expiry_height is the block height the ban expires at, zero meaning never.
+	ExpiryHeight int64 `protobuf:"varint,3,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty"`
+	// expiry_time is the unix time the ban expires at, zero meaning never.
+	ExpiryTime int64 `protobuf:"varint,4,opt,name=expiry_time,json=expiryTime,proto3" json:"expiry_time,omitempty"`
+	// reason is the reason the address was banned for.
+	Reason BanReason `protobuf:"varint,5,opt,name=reason,proto3,enum=florin.blacklist.v1.BanReason" json:"reason,omitempty"`
+	// case_id is the external case the ban relates to.
+	CaseId string `protobuf:"bytes,6,opt,name=case_id,json=caseId,proto3" json:"case_id,omitempty"`
 }
 
 func (x *Ban) Reset() {
@@ -3379,6 +4463,87 @@ func (x *Ban) GetAdversary() string {
 	return ""
 }
 
+func (x *Ban) GetDenom() string {
+	if x != nil {
+		return x.Denom
+	}
+	return ""
+}
+
+func (x *Ban) GetExpiryHeight() int64 {
+	if x != nil {
+		return x.ExpiryHeight
+	}
+	return 0
+}
+
+func (x *Ban) GetExpiryTime() int64 {
+	if x != nil {
+		return x.ExpiryTime
+	}
+	return 0
+}
+
+func (x *Ban) GetReason() BanReason {
+	if x != nil {
+		return x.Reason
+	}
+	return BanReason_BAN_REASON_UNSPECIFIED
+}
+
+func (x *Ban) GetCaseId() string {
+	if x != nil {
+		return x.CaseId
+	}
+	return ""
+}
+
+// Emitted when a time-bounded ban expires and is pruned.
+type BanExpired struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// adversary is the address whose ban expired.
+	Adversary string `protobuf:"bytes,1,opt,name=adversary,proto3" json:"adversary,omitempty"`
+	// denom is the denom the ban was scoped to, empty meaning global.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (x *BanExpired) Reset() {
+	*x = BanExpired{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_florin_blacklist_v1_events_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BanExpired) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BanExpired) ProtoMessage() {}
+
+// Deprecated: Use BanExpired.ProtoReflect.Descriptor instead.
+func (*BanExpired) Descriptor() ([]byte, []int) {
+	return file_florin_blacklist_v1_events_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *BanExpired) GetAdversary() string {
+	if x != nil {
+		return x.Adversary
+	}
+	return ""
+}
+
+func (x *BanExpired) GetDenom() string {
+	if x != nil {
+		return x.Denom
+	}
+	return ""
+}
+
 // Emitted when an address is removed from the blacklist.
 type Unban struct {
 	state         protoimpl.MessageState
@@ -3387,12 +4552,14 @@ type Unban struct {
 
 	// friend is the address that was removed.
 	Friend string `protobuf:"bytes,1,opt,name=friend,proto3" json:"friend,omitempty"`
+	// denom is the denom the ban is scoped to, empty meaning global.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
 func (x *Unban) Reset() {
 	*x = Unban{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_florin_blacklist_v1_events_proto_msgTypes[2]
+		mi := &file_florin_blacklist_v1_events_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3406,7 +4573,7 @@ func (*Unban) ProtoMessage() {}
 
 // Deprecated: Use Unban.ProtoReflect.Descriptor instead.
 func (*Unban) Descriptor() ([]byte, []int) {
-	return file_florin_blacklist_v1_events_proto_rawDescGZIP(), []int{2}
+	return file_florin_blacklist_v1_events_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *Unban) GetFriend() string {
@@ -3416,6 +4583,13 @@ func (x *Unban) GetFriend() string {
 	return ""
 }
 
+func (x *Unban) GetDenom() string {
+	if x != nil {
+		return x.Denom
+	}
+	return ""
+}
+
 // Emitted when admin account is added.
 type AdminAccountAdded struct {
 	state         protoimpl.MessageState
@@ -3424,12 +4598,14 @@ type AdminAccountAdded struct {
 
 	// account is the address that was added.
 	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// denom is the denom the admin is scoped to, empty meaning global.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
 func (x *AdminAccountAdded) Reset() {
 	*x = AdminAccountAdded{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_florin_blacklist_v1_events_proto_msgTypes[3]
+		mi := &file_florin_blacklist_v1_events_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3443,7 +4619,7 @@ func (*AdminAccountAdded) ProtoMessage() {}
 
 // Deprecated: Use AdminAccountAdded.ProtoReflect.Descriptor instead.
 func (*AdminAccountAdded) Descriptor() ([]byte, []int) {
-	return file_florin_blacklist_v1_events_proto_rawDescGZIP(), []int{3}
+	return file_florin_blacklist_v1_events_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *AdminAccountAdded) GetAccount() string {
@@ -3453,6 +4629,13 @@ func (x *AdminAccountAdded) GetAccount() string {
 	return ""
 }
 
+func (x *AdminAccountAdded) GetDenom() string {
+	if x != nil {
+		return x.Denom
+	}
+	return ""
+}
+
 // Emitted when admin account is removed.
 type AdminAccountRemoved struct {
 	state         protoimpl.MessageState
@@ -3461,12 +4644,14 @@ type AdminAccountRemoved struct {
 
 	// account is the address that was removed.
 	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// denom is the denom the admin is scoped to, empty meaning global.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
 func (x *AdminAccountRemoved) Reset() {
 	*x = AdminAccountRemoved{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_florin_blacklist_v1_events_proto_msgTypes[4]
+		mi := &file_florin_blacklist_v1_events_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3480,7 +4665,7 @@ func (*AdminAccountRemoved) ProtoMessage() {}
 
 // Deprecated: Use AdminAccountRemoved.ProtoReflect.Descriptor instead.
 func (*AdminAccountRemoved) Descriptor() ([]byte, []int) {
-	return file_florin_blacklist_v1_events_proto_rawDescGZIP(), []int{4}
+	return file_florin_blacklist_v1_events_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *AdminAccountRemoved) GetAccount() string {
@@ -3490,6 +4675,13 @@ func (x *AdminAccountRemoved) GetAccount() string {
 	return ""
 }
 
+func (x *AdminAccountRemoved) GetDenom() string {
+	if x != nil {
+		return x.Denom
+	}
+	return ""
+}
+
 // Emitted when an ownership transfer is started.
 type OwnershipTransferStarted struct {
 	state         protoimpl.MessageState
@@ -3505,7 +4697,7 @@ type OwnershipTransferStarted struct {
 func (x *OwnershipTransferStarted) Reset() {
 	*x = OwnershipTransferStarted{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_florin_blacklist_v1_events_proto_msgTypes[5]
+		mi := &file_florin_blacklist_v1_events_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3519,7 +4711,7 @@ func (*OwnershipTransferStarted) ProtoMessage() {}
 
 // Deprecated: Use OwnershipTransferStarted.ProtoReflect.Descriptor instead.
 func (*OwnershipTransferStarted) Descriptor() ([]byte, []int) {
-	return file_florin_blacklist_v1_events_proto_rawDescGZIP(), []int{5}
+	return file_florin_blacklist_v1_events_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *OwnershipTransferStarted) GetPreviousOwner() string {
@@ -3551,7 +4743,7 @@ type OwnershipTransferred struct {
 func (x *OwnershipTransferred) Reset() {
 	*x = OwnershipTransferred{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_florin_blacklist_v1_events_proto_msgTypes[6]
+		mi := &file_florin_blacklist_v1_events_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3565,7 +4757,7 @@ func (*OwnershipTransferred) ProtoMessage() {}
 
 // Deprecated: Use OwnershipTransferred.ProtoReflect.Descriptor instead.
 func (*OwnershipTransferred) Descriptor() ([]byte, []int) {
-	return file_florin_blacklist_v1_events_proto_rawDescGZIP(), []int{6}
+	return file_florin_blacklist_v1_events_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *OwnershipTransferred) GetPreviousOwner() string {
@@ -3591,54 +4783,80 @@ var file_florin_blacklist_v1_events_proto_rawDesc = []byte{
 	0x6c, 0x69, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2f, 0x61,
 	0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d,
 	0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x8e, 0x01, 0x0a, 0x08,
-	0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02,
-	0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x48, 0x0a, 0x06,
-	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xc8, 0xde,
-	0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
-	0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06,
-	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x22, 0x23, 0x0a, 0x03,
-	0x42, 0x61, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x64, 0x76, 0x65, 0x72, 0x73, 0x61, 0x72, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x64, 0x76, 0x65, 0x72, 0x73, 0x61, 0x72,
-	0x79, 0x22, 0x1f, 0x0a, 0x05, 0x55, 0x6e, 0x62, 0x61, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x72,
-	0x69, 0x65, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x72, 0x69, 0x65,
-	0x6e, 0x64, 0x22, 0x2d, 0x0a, 0x11, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x41, 0x64, 0x64, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x22, 0x2f, 0x0a, 0x13, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x22, 0x5e, 0x0a, 0x18, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x54,
-	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x12, 0x25,
-	0x0a, 0x0e, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73,
-	0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x65, 0x77, 0x5f, 0x6f, 0x77, 0x6e,
-	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x65, 0x77, 0x4f, 0x77, 0x6e,
-	0x65, 0x72, 0x22, 0x5a, 0x0a, 0x14, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x54,
-	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x72,
-	0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0d, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x4f, 0x77, 0x6e, 0x65,
-	0x72, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x65, 0x77, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x65, 0x77, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x42, 0xdd,
-	0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x66, 0x6c, 0x6f, 0x72, 0x69, 0x6e, 0x2e, 0x62, 0x6c,
-	0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x45, 0x76, 0x65, 0x6e,
-	0x74, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x47, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6d, 0x6f, 0x6e, 0x65, 0x72, 0x69, 0x75, 0x6d, 0x2f, 0x6d,
-	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2d, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2f, 0x76, 0x32, 0x2f, 0x61,
-	0x70, 0x69, 0x2f, 0x66, 0x6c, 0x6f, 0x72, 0x69, 0x6e, 0x2f, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6c,
-	0x69, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x3b, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74,
-	0x76, 0x31, 0xa2, 0x02, 0x03, 0x46, 0x42, 0x58, 0xaa, 0x02, 0x13, 0x46, 0x6c, 0x6f, 0x72, 0x69,
-	0x6e, 0x2e, 0x42, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x2e, 0x56, 0x31, 0xca, 0x02,
-	0x13, 0x46, 0x6c, 0x6f, 0x72, 0x69, 0x6e, 0x5c, 0x42, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73,
-	0x74, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1f, 0x46, 0x6c, 0x6f, 0x72, 0x69, 0x6e, 0x5c, 0x42, 0x6c,
-	0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x15, 0x46, 0x6c, 0x6f, 0x72, 0x69, 0x6e, 0x3a,
-	0x3a, 0x42, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x21, 0x66, 0x6c, 0x6f, 0x72, 0x69, 0x6e, 0x2f, 0x62, 0x6c,
+	0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x73,
+	0x69, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe2,
+	0x01, 0x0a, 0x08, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x66,
+	0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12,
+	0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x12,
+	0x48, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
+	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4,
+	0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a,
+	0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x12,
+	0x25, 0x0a, 0x0e, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69,
+	0x65, 0x6e, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x10, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x65, 0x64, 0x22, 0xd0, 0x01, 0x0a, 0x03, 0x42, 0x61, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x61,
+	0x64, 0x76, 0x65, 0x72, 0x73, 0x61, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x61, 0x64, 0x76, 0x65, 0x72, 0x73, 0x61, 0x72, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e,
+	0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12,
+	0x23, 0x0a, 0x0d, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x48, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x79, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x36, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x66, 0x6c, 0x6f, 0x72, 0x69, 0x6e, 0x2e, 0x62,
+	0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x6e, 0x52,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x17, 0x0a,
+	0x07, 0x63, 0x61, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x63, 0x61, 0x73, 0x65, 0x49, 0x64, 0x22, 0x40, 0x0a, 0x0a, 0x42, 0x61, 0x6e, 0x45, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x64, 0x76, 0x65, 0x72, 0x73, 0x61, 0x72,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x64, 0x76, 0x65, 0x72, 0x73, 0x61,
+	0x72, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x22, 0x35, 0x0a, 0x05, 0x55, 0x6e, 0x62, 0x61,
+	0x6e, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e,
+	0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x22,
+	0x43, 0x0a, 0x11, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x41,
+	0x64, 0x64, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64,
+	0x65, 0x6e, 0x6f, 0x6d, 0x22, 0x45, 0x0a, 0x13, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x22, 0x5e, 0x0a, 0x18, 0x4f,
+	0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
+	0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x72, 0x65, 0x76, 0x69,
+	0x6f, 0x75, 0x73, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x1b,
+	0x0a, 0x09, 0x6e, 0x65, 0x77, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x6e, 0x65, 0x77, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x22, 0x5a, 0x0a, 0x14, 0x4f,
+	0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
+	0x72, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f,
+	0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x72, 0x65,
+	0x76, 0x69, 0x6f, 0x75, 0x73, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x65,
+	0x77, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e,
+	0x65, 0x77, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x42, 0xdd, 0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e,
+	0x66, 0x6c, 0x6f, 0x72, 0x69, 0x6e, 0x2e, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74,
+	0x2e, 0x76, 0x31, 0x42, 0x0b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x50, 0x01, 0x5a, 0x47, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6d,
+	0x6f, 0x6e, 0x65, 0x72, 0x69, 0x75, 0x6d, 0x2f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2d, 0x6e,
+	0x6f, 0x62, 0x6c, 0x65, 0x2f, 0x76, 0x32, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66, 0x6c, 0x6f, 0x72,
+	0x69, 0x6e, 0x2f, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x3b,
+	0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x46, 0x42,
+	0x58, 0xaa, 0x02, 0x13, 0x46, 0x6c, 0x6f, 0x72, 0x69, 0x6e, 0x2e, 0x42, 0x6c, 0x61, 0x63, 0x6b,
+	0x6c, 0x69, 0x73, 0x74, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x13, 0x46, 0x6c, 0x6f, 0x72, 0x69, 0x6e,
+	0x5c, 0x42, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1f,
+	0x46, 0x6c, 0x6f, 0x72, 0x69, 0x6e, 0x5c, 0x42, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74,
+	0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea,
+	0x02, 0x15, 0x46, 0x6c, 0x6f, 0x72, 0x69, 0x6e, 0x3a, 0x3a, 0x42, 0x6c, 0x61, 0x63, 0x6b, 0x6c,
+	0x69, 0x73, 0x74, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -3653,22 +4871,25 @@ func file_florin_blacklist_v1_events_proto_rawDescGZIP() []byte {
 	return file_florin_blacklist_v1_events_proto_rawDescData
 }
 
-var file_florin_blacklist_v1_events_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_florin_blacklist_v1_events_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_florin_blacklist_v1_events_proto_goTypes = []interface{}{
 	(*Decision)(nil),                 // 0: florin.blacklist.v1.Decision
 	(*Ban)(nil),                      // 1: florin.blacklist.v1.Ban
-	(*Unban)(nil),                    // 2: florin.blacklist.v1.Unban
-	(*AdminAccountAdded)(nil),        // 3: florin.blacklist.v1.AdminAccountAdded
-	(*AdminAccountRemoved)(nil),      // 4: florin.blacklist.v1.AdminAccountRemoved
-	(*OwnershipTransferStarted)(nil), // 5: florin.blacklist.v1.OwnershipTransferStarted
-	(*OwnershipTransferred)(nil),     // 6: florin.blacklist.v1.OwnershipTransferred
+	(*BanExpired)(nil),               // 2: florin.blacklist.v1.BanExpired
+	(*Unban)(nil),                    // 3: florin.blacklist.v1.Unban
+	(*AdminAccountAdded)(nil),        // 4: florin.blacklist.v1.AdminAccountAdded
+	(*AdminAccountRemoved)(nil),      // 5: florin.blacklist.v1.AdminAccountRemoved
+	(*OwnershipTransferStarted)(nil), // 6: florin.blacklist.v1.OwnershipTransferStarted
+	(*OwnershipTransferred)(nil),     // 7: florin.blacklist.v1.OwnershipTransferred
+	(BanReason)(0),                   // 8: florin.blacklist.v1.BanReason
 }
 var file_florin_blacklist_v1_events_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	8, // 0: florin.blacklist.v1.Ban.reason:type_name -> florin.blacklist.v1.BanReason
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
 }
 
 func init() { file_florin_blacklist_v1_events_proto_init() }
@@ -3676,6 +4897,7 @@ func file_florin_blacklist_v1_events_proto_init() {
 	if File_florin_blacklist_v1_events_proto != nil {
 		return
 	}
+	file_florin_blacklist_v1_genesis_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_florin_blacklist_v1_events_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Decision); i {
@@ -3702,7 +4924,7 @@ func file_florin_blacklist_v1_events_proto_init() {
 			}
 		}
 		file_florin_blacklist_v1_events_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Unban); i {
+			switch v := v.(*BanExpired); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3714,7 +4936,7 @@ func file_florin_blacklist_v1_events_proto_init() {
 			}
 		}
 		file_florin_blacklist_v1_events_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminAccountAdded); i {
+			switch v := v.(*Unban); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3726,7 +4948,7 @@ func file_florin_blacklist_v1_events_proto_init() {
 			}
 		}
 		file_florin_blacklist_v1_events_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminAccountRemoved); i {
+			switch v := v.(*AdminAccountAdded); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3738,7 +4960,7 @@ func file_florin_blacklist_v1_events_proto_init() {
 			}
 		}
 		file_florin_blacklist_v1_events_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OwnershipTransferStarted); i {
+			switch v := v.(*AdminAccountRemoved); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3750,6 +4972,18 @@ func file_florin_blacklist_v1_events_proto_init() {
 			}
 		}
 		file_florin_blacklist_v1_events_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OwnershipTransferStarted); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_florin_blacklist_v1_events_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*OwnershipTransferred); i {
 			case 0:
 				return &v.state
@@ -3768,7 +5002,7 @@ func file_florin_blacklist_v1_events_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_florin_blacklist_v1_events_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   7,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -2,9 +2,11 @@
 package blacklistv1
 
 import (
+	v1beta1 "cosmossdk.io/api/cosmos/base/query/v1beta1"
 	_ "cosmossdk.io/api/cosmos/query/v1"
 	fmt "fmt"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoiface "google.golang.org/protobuf/runtime/protoiface"
@@ -855,12 +857,14 @@ func (x *fastReflection_QueryOwnerResponse) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_QueryAdmins protoreflect.MessageDescriptor
+	md_QueryAdmins       protoreflect.MessageDescriptor
+	fd_QueryAdmins_denom protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_florin_blacklist_v1_query_proto_init()
 	md_QueryAdmins = File_florin_blacklist_v1_query_proto.Messages().ByName("QueryAdmins")
+	fd_QueryAdmins_denom = md_QueryAdmins.Fields().ByName("denom")
 }
 
 var _ protoreflect.Message = (*fastReflection_QueryAdmins)(nil)
@@ -928,6 +932,12 @@ func (x *fastReflection_QueryAdmins) Interface() protoreflect.ProtoMessage {
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
 func (x *fastReflection_QueryAdmins) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_QueryAdmins_denom, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -943,6 +953,8 @@ func (x *fastReflection_QueryAdmins) Range(f func(protoreflect.FieldDescriptor,
 // a repeated field is populated if it is non-empty.
 func (x *fastReflection_QueryAdmins) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
+	case "florin.blacklist.v1.QueryAdmins.denom":
+		return x.Denom != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.QueryAdmins"))
@@ -959,6 +971,8 @@ func (x *fastReflection_QueryAdmins) Has(fd protoreflect.FieldDescriptor) bool {
 // Clear is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_QueryAdmins) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
+	case "florin.blacklist.v1.QueryAdmins.denom":
+		x.Denom = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.QueryAdmins"))
@@ -975,6 +989,9 @@ func (x *fastReflection_QueryAdmins) Clear(fd protoreflect.FieldDescriptor) {
 // of the value; to obtain a mutable reference, use Mutable.
 func (x *fastReflection_QueryAdmins) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
+	case "florin.blacklist.v1.QueryAdmins.denom":
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.QueryAdmins"))
@@ -995,6 +1012,8 @@ func (x *fastReflection_QueryAdmins) Get(descriptor protoreflect.FieldDescriptor
 // Set is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_QueryAdmins) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
+	case "florin.blacklist.v1.QueryAdmins.denom":
+		x.Denom = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.QueryAdmins"))
@@ -1015,6 +1034,8 @@ func (x *fastReflection_QueryAdmins) Set(fd protoreflect.FieldDescriptor, value
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_QueryAdmins) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "florin.blacklist.v1.QueryAdmins.denom":
+		panic(fmt.Errorf("field denom of message florin.blacklist.v1.QueryAdmins is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.QueryAdmins"))
@@ -1028,6 +1049,8 @@ func (x *fastReflection_QueryAdmins) Mutable(fd protoreflect.FieldDescriptor) pr
 // For lists, maps, and messages, this returns a new, empty, mutable value.
 func (x *fastReflection_QueryAdmins) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "florin.blacklist.v1.QueryAdmins.denom":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.QueryAdmins"))
@@ -1097,6 +1120,10 @@ func (x *fastReflection_QueryAdmins) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
+		l = len(x.Denom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1126,6 +1153,13 @@ func (x *fastReflection_QueryAdmins) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+			i--
+			dAtA[i] = 0xa
+		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -1175,6 +1209,38 @@ func (x *fastReflection_QueryAdmins) ProtoMethods() *protoiface.Methods {
 				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAdmins: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Denom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1256,15 +1322,68 @@ func (x *_QueryAdminsResponse_1_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_QueryAdminsResponse_2_list)(nil)
+
+type _QueryAdminsResponse_2_list struct {
+	list *[]*Account
+}
+
+func (x *_QueryAdminsResponse_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryAdminsResponse_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryAdminsResponse_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Account)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryAdminsResponse_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Account)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryAdminsResponse_2_list) AppendMutable() protoreflect.Value {
+	v := new(Account)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryAdminsResponse_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryAdminsResponse_2_list) NewElement() protoreflect.Value {
+	v := new(Account)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryAdminsResponse_2_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_QueryAdminsResponse        protoreflect.MessageDescriptor
-	fd_QueryAdminsResponse_admins protoreflect.FieldDescriptor
+	md_QueryAdminsResponse              protoreflect.MessageDescriptor
+	fd_QueryAdminsResponse_admins       protoreflect.FieldDescriptor
+	fd_QueryAdminsResponse_denom_admins protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_florin_blacklist_v1_query_proto_init()
 	md_QueryAdminsResponse = File_florin_blacklist_v1_query_proto.Messages().ByName("QueryAdminsResponse")
 	fd_QueryAdminsResponse_admins = md_QueryAdminsResponse.Fields().ByName("admins")
+	fd_QueryAdminsResponse_denom_admins = md_QueryAdminsResponse.Fields().ByName("denom_admins")
 }
 
 var _ protoreflect.Message = (*fastReflection_QueryAdminsResponse)(nil)
@@ -1338,6 +1457,12 @@ func (x *fastReflection_QueryAdminsResponse) Range(f func(protoreflect.FieldDesc
 			return
 		}
 	}
+	if len(x.DenomAdmins) != 0 {
+		value := protoreflect.ValueOfList(&_QueryAdminsResponse_2_list{list: &x.DenomAdmins})
+		if !f(fd_QueryAdminsResponse_denom_admins, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -1355,6 +1480,8 @@ func (x *fastReflection_QueryAdminsResponse) Has(fd protoreflect.FieldDescriptor
 	switch fd.FullName() {
 	case "florin.blacklist.v1.QueryAdminsResponse.admins":
 		return len(x.Admins) != 0
+	case "florin.blacklist.v1.QueryAdminsResponse.denom_admins":
+		return len(x.DenomAdmins) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.QueryAdminsResponse"))
@@ -1373,6 +1500,8 @@ func (x *fastReflection_QueryAdminsResponse) Clear(fd protoreflect.FieldDescript
 	switch fd.FullName() {
 	case "florin.blacklist.v1.QueryAdminsResponse.admins":
 		x.Admins = nil
+	case "florin.blacklist.v1.QueryAdminsResponse.denom_admins":
+		x.DenomAdmins = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.QueryAdminsResponse"))
@@ -1395,6 +1524,12 @@ func (x *fastReflection_QueryAdminsResponse) Get(descriptor protoreflect.FieldDe
 		}
 		listValue := &_QueryAdminsResponse_1_list{list: &x.Admins}
 		return protoreflect.ValueOfList(listValue)
+	case "florin.blacklist.v1.QueryAdminsResponse.denom_admins":
+		if len(x.DenomAdmins) == 0 {
+			return protoreflect.ValueOfList(&_QueryAdminsResponse_2_list{})
+		}
+		listValue := &_QueryAdminsResponse_2_list{list: &x.DenomAdmins}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.QueryAdminsResponse"))
@@ -1419,6 +1554,10 @@ func (x *fastReflection_QueryAdminsResponse) Set(fd protoreflect.FieldDescriptor
 		lv := value.List()
 		clv := lv.(*_QueryAdminsResponse_1_list)
 		x.Admins = *clv.list
+	case "florin.blacklist.v1.QueryAdminsResponse.denom_admins":
+		lv := value.List()
+		clv := lv.(*_QueryAdminsResponse_2_list)
+		x.DenomAdmins = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.QueryAdminsResponse"))
@@ -1445,6 +1584,12 @@ func (x *fastReflection_QueryAdminsResponse) Mutable(fd protoreflect.FieldDescri
 		}
 		value := &_QueryAdminsResponse_1_list{list: &x.Admins}
 		return protoreflect.ValueOfList(value)
+	case "florin.blacklist.v1.QueryAdminsResponse.denom_admins":
+		if x.DenomAdmins == nil {
+			x.DenomAdmins = []*Account{}
+		}
+		value := &_QueryAdminsResponse_2_list{list: &x.DenomAdmins}
+		return protoreflect.ValueOfList(value)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.QueryAdminsResponse"))
@@ -1461,6 +1606,9 @@ func (x *fastReflection_QueryAdminsResponse) NewField(fd protoreflect.FieldDescr
 	case "florin.blacklist.v1.QueryAdminsResponse.admins":
 		list := []string{}
 		return protoreflect.ValueOfList(&_QueryAdminsResponse_1_list{list: &list})
+	case "florin.blacklist.v1.QueryAdminsResponse.denom_admins":
+		list := []*Account{}
+		return protoreflect.ValueOfList(&_QueryAdminsResponse_2_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.QueryAdminsResponse"))
@@ -1536,6 +1684,12 @@ func (x *fastReflection_QueryAdminsResponse) ProtoMethods() *protoiface.Methods
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		if len(x.DenomAdmins) > 0 {
+			for _, e := range x.DenomAdmins {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1565,6 +1719,22 @@ func (x *fastReflection_QueryAdminsResponse) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.DenomAdmins) > 0 {
+			for iNdEx := len(x.DenomAdmins) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.DenomAdmins[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
 		if len(x.Admins) > 0 {
 			for iNdEx := len(x.Admins) - 1; iNdEx >= 0; iNdEx-- {
 				i -= len(x.Admins[iNdEx])
@@ -1655,6 +1825,40 @@ func (x *fastReflection_QueryAdminsResponse) ProtoMethods() *protoiface.Methods
 				}
 				x.Admins = append(x.Admins, string(dAtA[iNdEx:postIndex]))
 				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DenomAdmins", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DenomAdmins = append(x.DenomAdmins, &Account{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DenomAdmins[len(x.DenomAdmins)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1691,12 +1895,18 @@ func (x *fastReflection_QueryAdminsResponse) ProtoMethods() *protoiface.Methods
 }
 
 var (
-	md_QueryAdversaries protoreflect.MessageDescriptor
+	md_QueryAdversaries                  protoreflect.MessageDescriptor
+	fd_QueryAdversaries_denom            protoreflect.FieldDescriptor
+	fd_QueryAdversaries_pagination       protoreflect.FieldDescriptor
+	fd_QueryAdversaries_denom_pagination protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_florin_blacklist_v1_query_proto_init()
 	md_QueryAdversaries = File_florin_blacklist_v1_query_proto.Messages().ByName("QueryAdversaries")
+	fd_QueryAdversaries_denom = md_QueryAdversaries.Fields().ByName("denom")
+	fd_QueryAdversaries_pagination = md_QueryAdversaries.Fields().ByName("pagination")
+	fd_QueryAdversaries_denom_pagination = md_QueryAdversaries.Fields().ByName("denom_pagination")
 }
 
 var _ protoreflect.Message = (*fastReflection_QueryAdversaries)(nil)
@@ -1764,6 +1974,24 @@ func (x *fastReflection_QueryAdversaries) Interface() protoreflect.ProtoMessage
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
 func (x *fastReflection_QueryAdversaries) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_QueryAdversaries_denom, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryAdversaries_pagination, value) {
+			return
+		}
+	}
+	if x.DenomPagination != nil {
+		value := protoreflect.ValueOfMessage(x.DenomPagination.ProtoReflect())
+		if !f(fd_QueryAdversaries_denom_pagination, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -1779,6 +2007,12 @@ func (x *fastReflection_QueryAdversaries) Range(f func(protoreflect.FieldDescrip
 // a repeated field is populated if it is non-empty.
 func (x *fastReflection_QueryAdversaries) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
+	case "florin.blacklist.v1.QueryAdversaries.denom":
+		return x.Denom != ""
+	case "florin.blacklist.v1.QueryAdversaries.pagination":
+		return x.Pagination != nil
+	case "florin.blacklist.v1.QueryAdversaries.denom_pagination":
+		return x.DenomPagination != nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.QueryAdversaries"))
@@ -1795,6 +2029,12 @@ func (x *fastReflection_QueryAdversaries) Has(fd protoreflect.FieldDescriptor) b
 // Clear is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_QueryAdversaries) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
+	case "florin.blacklist.v1.QueryAdversaries.denom":
+		x.Denom = ""
+	case "florin.blacklist.v1.QueryAdversaries.pagination":
+		x.Pagination = nil
+	case "florin.blacklist.v1.QueryAdversaries.denom_pagination":
+		x.DenomPagination = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.QueryAdversaries"))
@@ -1811,6 +2051,15 @@ func (x *fastReflection_QueryAdversaries) Clear(fd protoreflect.FieldDescriptor)
 // of the value; to obtain a mutable reference, use Mutable.
 func (x *fastReflection_QueryAdversaries) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
+	case "florin.blacklist.v1.QueryAdversaries.denom":
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
+	case "florin.blacklist.v1.QueryAdversaries.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "florin.blacklist.v1.QueryAdversaries.denom_pagination":
+		value := x.DenomPagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.QueryAdversaries"))
@@ -1831,6 +2080,12 @@ func (x *fastReflection_QueryAdversaries) Get(descriptor protoreflect.FieldDescr
 // Set is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_QueryAdversaries) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
+	case "florin.blacklist.v1.QueryAdversaries.denom":
+		x.Denom = value.Interface().(string)
+	case "florin.blacklist.v1.QueryAdversaries.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
+	case "florin.blacklist.v1.QueryAdversaries.denom_pagination":
+		x.DenomPagination = value.Message().Interface().(*v1beta1.PageRequest)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.QueryAdversaries"))
@@ -1851,6 +2106,18 @@ func (x *fastReflection_QueryAdversaries) Set(fd protoreflect.FieldDescriptor, v
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_QueryAdversaries) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "florin.blacklist.v1.QueryAdversaries.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageRequest)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	case "florin.blacklist.v1.QueryAdversaries.denom_pagination":
+		if x.DenomPagination == nil {
+			x.DenomPagination = new(v1beta1.PageRequest)
+		}
+		return protoreflect.ValueOfMessage(x.DenomPagination.ProtoReflect())
+	case "florin.blacklist.v1.QueryAdversaries.denom":
+		panic(fmt.Errorf("field denom of message florin.blacklist.v1.QueryAdversaries is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.QueryAdversaries"))
@@ -1864,6 +2131,14 @@ func (x *fastReflection_QueryAdversaries) Mutable(fd protoreflect.FieldDescripto
 // For lists, maps, and messages, this returns a new, empty, mutable value.
 func (x *fastReflection_QueryAdversaries) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "florin.blacklist.v1.QueryAdversaries.denom":
+		return protoreflect.ValueOfString("")
+	case "florin.blacklist.v1.QueryAdversaries.pagination":
+		m := new(v1beta1.PageRequest)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "florin.blacklist.v1.QueryAdversaries.denom_pagination":
+		m := new(v1beta1.PageRequest)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.QueryAdversaries"))
@@ -1933,6 +2208,18 @@ func (x *fastReflection_QueryAdversaries) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
+		l = len(x.Denom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.DenomPagination != nil {
+			l = options.Size(x.DenomPagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1962,6 +2249,41 @@ func (x *fastReflection_QueryAdversaries) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.DenomPagination != nil {
+			encoded, err := options.Marshal(x.DenomPagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+			i--
+			dAtA[i] = 0xa
+		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -2011,6 +2333,110 @@ func (x *fastReflection_QueryAdversaries) ProtoMethods() *protoiface.Methods {
 				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAdversaries: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Denom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageRequest{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DenomPagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.DenomPagination == nil {
+					x.DenomPagination = &v1beta1.PageRequest{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DenomPagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2092,74 +2518,1413 @@ func (x *_QueryAdversariesResponse_1_list) IsValid() bool {
 	return x.list != nil
 }
 
-var (
-	md_QueryAdversariesResponse             protoreflect.MessageDescriptor
-	fd_QueryAdversariesResponse_adversaries protoreflect.FieldDescriptor
-)
+var _ protoreflect.List = (*_QueryAdversariesResponse_2_list)(nil)
 
-func init() {
-	file_florin_blacklist_v1_query_proto_init()
-	md_QueryAdversariesResponse = File_florin_blacklist_v1_query_proto.Messages().ByName("QueryAdversariesResponse")
-	fd_QueryAdversariesResponse_adversaries = md_QueryAdversariesResponse.Fields().ByName("adversaries")
+type _QueryAdversariesResponse_2_list struct {
+	list *[]*Account
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryAdversariesResponse)(nil)
+func (x *_QueryAdversariesResponse_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
 
-type fastReflection_QueryAdversariesResponse QueryAdversariesResponse
+func (x *_QueryAdversariesResponse_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
 
-func (x *QueryAdversariesResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryAdversariesResponse)(x)
+func (x *_QueryAdversariesResponse_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Account)
+	(*x.list)[i] = concreteValue
 }
 
-func (x *QueryAdversariesResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_florin_blacklist_v1_query_proto_msgTypes[5]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (x *_QueryAdversariesResponse_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Account)
+	*x.list = append(*x.list, concreteValue)
 }
 
-var _fastReflection_QueryAdversariesResponse_messageType fastReflection_QueryAdversariesResponse_messageType
+func (x *_QueryAdversariesResponse_2_list) AppendMutable() protoreflect.Value {
+	v := new(Account)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryAdversariesResponse_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryAdversariesResponse_2_list) NewElement() protoreflect.Value {
+	v := new(Account)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryAdversariesResponse_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_QueryAdversariesResponse_5_list)(nil)
+
+type _QueryAdversariesResponse_5_list struct {
+	list *[]*BanRecord
+}
+
+func (x *_QueryAdversariesResponse_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryAdversariesResponse_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryAdversariesResponse_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*BanRecord)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryAdversariesResponse_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*BanRecord)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryAdversariesResponse_5_list) AppendMutable() protoreflect.Value {
+	v := new(BanRecord)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryAdversariesResponse_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryAdversariesResponse_5_list) NewElement() protoreflect.Value {
+	v := new(BanRecord)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryAdversariesResponse_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryAdversariesResponse                   protoreflect.MessageDescriptor
+	fd_QueryAdversariesResponse_adversaries       protoreflect.FieldDescriptor
+	fd_QueryAdversariesResponse_denom_adversaries protoreflect.FieldDescriptor
+	fd_QueryAdversariesResponse_pagination        protoreflect.FieldDescriptor
+	fd_QueryAdversariesResponse_denom_pagination  protoreflect.FieldDescriptor
+	fd_QueryAdversariesResponse_ban_records       protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_florin_blacklist_v1_query_proto_init()
+	md_QueryAdversariesResponse = File_florin_blacklist_v1_query_proto.Messages().ByName("QueryAdversariesResponse")
+	fd_QueryAdversariesResponse_adversaries = md_QueryAdversariesResponse.Fields().ByName("adversaries")
+	fd_QueryAdversariesResponse_denom_adversaries = md_QueryAdversariesResponse.Fields().ByName("denom_adversaries")
+	fd_QueryAdversariesResponse_pagination = md_QueryAdversariesResponse.Fields().ByName("pagination")
+	fd_QueryAdversariesResponse_denom_pagination = md_QueryAdversariesResponse.Fields().ByName("denom_pagination")
+	fd_QueryAdversariesResponse_ban_records = md_QueryAdversariesResponse.Fields().ByName("ban_records")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryAdversariesResponse)(nil)
+
+type fastReflection_QueryAdversariesResponse QueryAdversariesResponse
+
+func (x *QueryAdversariesResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryAdversariesResponse)(x)
+}
+
+func (x *QueryAdversariesResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_florin_blacklist_v1_query_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryAdversariesResponse_messageType fastReflection_QueryAdversariesResponse_messageType
 var _ protoreflect.MessageType = fastReflection_QueryAdversariesResponse_messageType{}
 
-type fastReflection_QueryAdversariesResponse_messageType struct{}
+type fastReflection_QueryAdversariesResponse_messageType struct{}
+
+func (x fastReflection_QueryAdversariesResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryAdversariesResponse)(nil)
+}
+func (x fastReflection_QueryAdversariesResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryAdversariesResponse)
+}
+func (x fastReflection_QueryAdversariesResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryAdversariesResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryAdversariesResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryAdversariesResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryAdversariesResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryAdversariesResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryAdversariesResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryAdversariesResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryAdversariesResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryAdversariesResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryAdversariesResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Adversaries) != 0 {
+		value := protoreflect.ValueOfList(&_QueryAdversariesResponse_1_list{list: &x.Adversaries})
+		if !f(fd_QueryAdversariesResponse_adversaries, value) {
+			return
+		}
+	}
+	if len(x.DenomAdversaries) != 0 {
+		value := protoreflect.ValueOfList(&_QueryAdversariesResponse_2_list{list: &x.DenomAdversaries})
+		if !f(fd_QueryAdversariesResponse_denom_adversaries, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryAdversariesResponse_pagination, value) {
+			return
+		}
+	}
+	if x.DenomPagination != nil {
+		value := protoreflect.ValueOfMessage(x.DenomPagination.ProtoReflect())
+		if !f(fd_QueryAdversariesResponse_denom_pagination, value) {
+			return
+		}
+	}
+	if len(x.BanRecords) != 0 {
+		value := protoreflect.ValueOfList(&_QueryAdversariesResponse_5_list{list: &x.BanRecords})
+		if !f(fd_QueryAdversariesResponse_ban_records, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryAdversariesResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "florin.blacklist.v1.QueryAdversariesResponse.adversaries":
+		return len(x.Adversaries) != 0
+	case "florin.blacklist.v1.QueryAdversariesResponse.denom_adversaries":
+		return len(x.DenomAdversaries) != 0
+	case "florin.blacklist.v1.QueryAdversariesResponse.pagination":
+		return x.Pagination != nil
+	case "florin.blacklist.v1.QueryAdversariesResponse.denom_pagination":
+		return x.DenomPagination != nil
+	case "florin.blacklist.v1.QueryAdversariesResponse.ban_records":
+		return len(x.BanRecords) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.QueryAdversariesResponse"))
+		}
+		panic(fmt.Errorf("message florin.blacklist.v1.QueryAdversariesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAdversariesResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "florin.blacklist.v1.QueryAdversariesResponse.adversaries":
+		x.Adversaries = nil
+	case "florin.blacklist.v1.QueryAdversariesResponse.denom_adversaries":
+		x.DenomAdversaries = nil
+	case "florin.blacklist.v1.QueryAdversariesResponse.pagination":
+		x.Pagination = nil
+	case "florin.blacklist.v1.QueryAdversariesResponse.denom_pagination":
+		x.DenomPagination = nil
+	case "florin.blacklist.v1.QueryAdversariesResponse.ban_records":
+		x.BanRecords = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.QueryAdversariesResponse"))
+		}
+		panic(fmt.Errorf("message florin.blacklist.v1.QueryAdversariesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryAdversariesResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "florin.blacklist.v1.QueryAdversariesResponse.adversaries":
+		if len(x.Adversaries) == 0 {
+			return protoreflect.ValueOfList(&_QueryAdversariesResponse_1_list{})
+		}
+		listValue := &_QueryAdversariesResponse_1_list{list: &x.Adversaries}
+		return protoreflect.ValueOfList(listValue)
+	case "florin.blacklist.v1.QueryAdversariesResponse.denom_adversaries":
+		if len(x.DenomAdversaries) == 0 {
+			return protoreflect.ValueOfList(&_QueryAdversariesResponse_2_list{})
+		}
+		listValue := &_QueryAdversariesResponse_2_list{list: &x.DenomAdversaries}
+		return protoreflect.ValueOfList(listValue)
+	case "florin.blacklist.v1.QueryAdversariesResponse.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "florin.blacklist.v1.QueryAdversariesResponse.denom_pagination":
+		value := x.DenomPagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "florin.blacklist.v1.QueryAdversariesResponse.ban_records":
+		if len(x.BanRecords) == 0 {
+			return protoreflect.ValueOfList(&_QueryAdversariesResponse_5_list{})
+		}
+		listValue := &_QueryAdversariesResponse_5_list{list: &x.BanRecords}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.QueryAdversariesResponse"))
+		}
+		panic(fmt.Errorf("message florin.blacklist.v1.QueryAdversariesResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAdversariesResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "florin.blacklist.v1.QueryAdversariesResponse.adversaries":
+		lv := value.List()
+		clv := lv.(*_QueryAdversariesResponse_1_list)
+		x.Adversaries = *clv.list
+	case "florin.blacklist.v1.QueryAdversariesResponse.denom_adversaries":
+		lv := value.List()
+		clv := lv.(*_QueryAdversariesResponse_2_list)
+		x.DenomAdversaries = *clv.list
+	case "florin.blacklist.v1.QueryAdversariesResponse.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
+	case "florin.blacklist.v1.QueryAdversariesResponse.denom_pagination":
+		x.DenomPagination = value.Message().Interface().(*v1beta1.PageResponse)
+	case "florin.blacklist.v1.QueryAdversariesResponse.ban_records":
+		lv := value.List()
+		clv := lv.(*_QueryAdversariesResponse_5_list)
+		x.BanRecords = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.QueryAdversariesResponse"))
+		}
+		panic(fmt.Errorf("message florin.blacklist.v1.QueryAdversariesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAdversariesResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "florin.blacklist.v1.QueryAdversariesResponse.adversaries":
+		if x.Adversaries == nil {
+			x.Adversaries = []string{}
+		}
+		value := &_QueryAdversariesResponse_1_list{list: &x.Adversaries}
+		return protoreflect.ValueOfList(value)
+	case "florin.blacklist.v1.QueryAdversariesResponse.denom_adversaries":
+		if x.DenomAdversaries == nil {
+			x.DenomAdversaries = []*Account{}
+		}
+		value := &_QueryAdversariesResponse_2_list{list: &x.DenomAdversaries}
+		return protoreflect.ValueOfList(value)
+	case "florin.blacklist.v1.QueryAdversariesResponse.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageResponse)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	case "florin.blacklist.v1.QueryAdversariesResponse.denom_pagination":
+		if x.DenomPagination == nil {
+			x.DenomPagination = new(v1beta1.PageResponse)
+		}
+		return protoreflect.ValueOfMessage(x.DenomPagination.ProtoReflect())
+	case "florin.blacklist.v1.QueryAdversariesResponse.ban_records":
+		if x.BanRecords == nil {
+			x.BanRecords = []*BanRecord{}
+		}
+		value := &_QueryAdversariesResponse_5_list{list: &x.BanRecords}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.QueryAdversariesResponse"))
+		}
+		panic(fmt.Errorf("message florin.blacklist.v1.QueryAdversariesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryAdversariesResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "florin.blacklist.v1.QueryAdversariesResponse.adversaries":
+		list := []string{}
+		return protoreflect.ValueOfList(&_QueryAdversariesResponse_1_list{list: &list})
+	case "florin.blacklist.v1.QueryAdversariesResponse.denom_adversaries":
+		list := []*Account{}
+		return protoreflect.ValueOfList(&_QueryAdversariesResponse_2_list{list: &list})
+	case "florin.blacklist.v1.QueryAdversariesResponse.pagination":
+		m := new(v1beta1.PageResponse)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "florin.blacklist.v1.QueryAdversariesResponse.denom_pagination":
+		m := new(v1beta1.PageResponse)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "florin.blacklist.v1.QueryAdversariesResponse.ban_records":
+		list := []*BanRecord{}
+		return protoreflect.ValueOfList(&_QueryAdversariesResponse_5_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.QueryAdversariesResponse"))
+		}
+		panic(fmt.Errorf("message florin.blacklist.v1.QueryAdversariesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryAdversariesResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in florin.blacklist.v1.QueryAdversariesResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryAdversariesResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAdversariesResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryAdversariesResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryAdversariesResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryAdversariesResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Adversaries) > 0 {
+			for _, s := range x.Adversaries {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.DenomAdversaries) > 0 {
+			for _, e := range x.DenomAdversaries {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.DenomPagination != nil {
+			l = options.Size(x.DenomPagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.BanRecords) > 0 {
+			for _, e := range x.BanRecords {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryAdversariesResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.BanRecords) > 0 {
+			for iNdEx := len(x.BanRecords) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.BanRecords[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if x.DenomPagination != nil {
+			encoded, err := options.Marshal(x.DenomPagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.DenomAdversaries) > 0 {
+			for iNdEx := len(x.DenomAdversaries) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.DenomAdversaries[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.Adversaries) > 0 {
+			for iNdEx := len(x.Adversaries) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Adversaries[iNdEx])
+				copy(dAtA[i:], x.Adversaries[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Adversaries[iNdEx])))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryAdversariesResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAdversariesResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAdversariesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Adversaries", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Adversaries = append(x.Adversaries, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DenomAdversaries", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DenomAdversaries = append(x.DenomAdversaries, &Account{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DenomAdversaries[len(x.DenomAdversaries)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageResponse{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DenomPagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.DenomPagination == nil {
+					x.DenomPagination = &v1beta1.PageResponse{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DenomPagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BanRecords", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BanRecords = append(x.BanRecords, &BanRecord{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BanRecords[len(x.BanRecords)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryAdversary         protoreflect.MessageDescriptor
+	fd_QueryAdversary_address protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_florin_blacklist_v1_query_proto_init()
+	md_QueryAdversary = File_florin_blacklist_v1_query_proto.Messages().ByName("QueryAdversary")
+	fd_QueryAdversary_address = md_QueryAdversary.Fields().ByName("address")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryAdversary)(nil)
+
+type fastReflection_QueryAdversary QueryAdversary
+
+func (x *QueryAdversary) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryAdversary)(x)
+}
+
+func (x *QueryAdversary) slowProtoReflect() protoreflect.Message {
+	mi := &file_florin_blacklist_v1_query_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryAdversary_messageType fastReflection_QueryAdversary_messageType
+var _ protoreflect.MessageType = fastReflection_QueryAdversary_messageType{}
+
+type fastReflection_QueryAdversary_messageType struct{}
+
+func (x fastReflection_QueryAdversary_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryAdversary)(nil)
+}
+func (x fastReflection_QueryAdversary_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryAdversary)
+}
+func (x fastReflection_QueryAdversary_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryAdversary
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryAdversary) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryAdversary
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryAdversary) Type() protoreflect.MessageType {
+	return _fastReflection_QueryAdversary_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryAdversary) New() protoreflect.Message {
+	return new(fastReflection_QueryAdversary)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryAdversary) Interface() protoreflect.ProtoMessage {
+	return (*QueryAdversary)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryAdversary) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_QueryAdversary_address, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryAdversary) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "florin.blacklist.v1.QueryAdversary.address":
+		return x.Address != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.QueryAdversary"))
+		}
+		panic(fmt.Errorf("message florin.blacklist.v1.QueryAdversary does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAdversary) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "florin.blacklist.v1.QueryAdversary.address":
+		x.Address = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.QueryAdversary"))
+		}
+		panic(fmt.Errorf("message florin.blacklist.v1.QueryAdversary does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryAdversary) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "florin.blacklist.v1.QueryAdversary.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.QueryAdversary"))
+		}
+		panic(fmt.Errorf("message florin.blacklist.v1.QueryAdversary does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAdversary) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "florin.blacklist.v1.QueryAdversary.address":
+		x.Address = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.QueryAdversary"))
+		}
+		panic(fmt.Errorf("message florin.blacklist.v1.QueryAdversary does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAdversary) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "florin.blacklist.v1.QueryAdversary.address":
+		panic(fmt.Errorf("field address of message florin.blacklist.v1.QueryAdversary is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.QueryAdversary"))
+		}
+		panic(fmt.Errorf("message florin.blacklist.v1.QueryAdversary does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryAdversary) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "florin.blacklist.v1.QueryAdversary.address":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.QueryAdversary"))
+		}
+		panic(fmt.Errorf("message florin.blacklist.v1.QueryAdversary does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryAdversary) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in florin.blacklist.v1.QueryAdversary", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryAdversary) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAdversary) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryAdversary) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryAdversary) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryAdversary)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryAdversary)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryAdversary)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAdversary: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAdversary: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryAdversaryResponse_1_list)(nil)
 
-func (x fastReflection_QueryAdversariesResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryAdversariesResponse)(nil)
+type _QueryAdversaryResponse_1_list struct {
+	list *[]*BanRecord
 }
-func (x fastReflection_QueryAdversariesResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryAdversariesResponse)
+
+func (x *_QueryAdversaryResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
 }
-func (x fastReflection_QueryAdversariesResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryAdversariesResponse
+
+func (x *_QueryAdversaryResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryAdversaryResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*BanRecord)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryAdversaryResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*BanRecord)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryAdversaryResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(BanRecord)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryAdversaryResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryAdversaryResponse_1_list) NewElement() protoreflect.Value {
+	v := new(BanRecord)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryAdversaryResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryAdversaryResponse      protoreflect.MessageDescriptor
+	fd_QueryAdversaryResponse_bans protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_florin_blacklist_v1_query_proto_init()
+	md_QueryAdversaryResponse = File_florin_blacklist_v1_query_proto.Messages().ByName("QueryAdversaryResponse")
+	fd_QueryAdversaryResponse_bans = md_QueryAdversaryResponse.Fields().ByName("bans")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryAdversaryResponse)(nil)
+
+type fastReflection_QueryAdversaryResponse QueryAdversaryResponse
+
+func (x *QueryAdversaryResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryAdversaryResponse)(x)
+}
+
+func (x *QueryAdversaryResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_florin_blacklist_v1_query_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryAdversaryResponse_messageType fastReflection_QueryAdversaryResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryAdversaryResponse_messageType{}
+
+type fastReflection_QueryAdversaryResponse_messageType struct{}
+
+func (x fastReflection_QueryAdversaryResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryAdversaryResponse)(nil)
+}
+func (x fastReflection_QueryAdversaryResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryAdversaryResponse)
+}
+func (x fastReflection_QueryAdversaryResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryAdversaryResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryAdversariesResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryAdversariesResponse
+func (x *fastReflection_QueryAdversaryResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryAdversaryResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryAdversariesResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryAdversariesResponse_messageType
+func (x *fastReflection_QueryAdversaryResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryAdversaryResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryAdversariesResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryAdversariesResponse)
+func (x *fastReflection_QueryAdversaryResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryAdversaryResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryAdversariesResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryAdversariesResponse)(x)
+func (x *fastReflection_QueryAdversaryResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryAdversaryResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2167,10 +3932,10 @@ func (x *fastReflection_QueryAdversariesResponse) Interface() protoreflect.Proto
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryAdversariesResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Adversaries) != 0 {
-		value := protoreflect.ValueOfList(&_QueryAdversariesResponse_1_list{list: &x.Adversaries})
-		if !f(fd_QueryAdversariesResponse_adversaries, value) {
+func (x *fastReflection_QueryAdversaryResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Bans) != 0 {
+		value := protoreflect.ValueOfList(&_QueryAdversaryResponse_1_list{list: &x.Bans})
+		if !f(fd_QueryAdversaryResponse_bans, value) {
 			return
 		}
 	}
@@ -2187,15 +3952,15 @@ func (x *fastReflection_QueryAdversariesResponse) Range(f func(protoreflect.Fiel
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryAdversariesResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryAdversaryResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "florin.blacklist.v1.QueryAdversariesResponse.adversaries":
-		return len(x.Adversaries) != 0
+	case "florin.blacklist.v1.QueryAdversaryResponse.bans":
+		return len(x.Bans) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.QueryAdversariesResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.QueryAdversaryResponse"))
 		}
-		panic(fmt.Errorf("message florin.blacklist.v1.QueryAdversariesResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message florin.blacklist.v1.QueryAdversaryResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2205,15 +3970,15 @@ func (x *fastReflection_QueryAdversariesResponse) Has(fd protoreflect.FieldDescr
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAdversariesResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryAdversaryResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "florin.blacklist.v1.QueryAdversariesResponse.adversaries":
-		x.Adversaries = nil
+	case "florin.blacklist.v1.QueryAdversaryResponse.bans":
+		x.Bans = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.QueryAdversariesResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.QueryAdversaryResponse"))
 		}
-		panic(fmt.Errorf("message florin.blacklist.v1.QueryAdversariesResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message florin.blacklist.v1.QueryAdversaryResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2223,19 +3988,19 @@ func (x *fastReflection_QueryAdversariesResponse) Clear(fd protoreflect.FieldDes
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryAdversariesResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryAdversaryResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "florin.blacklist.v1.QueryAdversariesResponse.adversaries":
-		if len(x.Adversaries) == 0 {
-			return protoreflect.ValueOfList(&_QueryAdversariesResponse_1_list{})
+	case "florin.blacklist.v1.QueryAdversaryResponse.bans":
+		if len(x.Bans) == 0 {
+			return protoreflect.ValueOfList(&_QueryAdversaryResponse_1_list{})
 		}
-		listValue := &_QueryAdversariesResponse_1_list{list: &x.Adversaries}
+		listValue := &_QueryAdversaryResponse_1_list{list: &x.Bans}
 		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.QueryAdversariesResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.QueryAdversaryResponse"))
 		}
-		panic(fmt.Errorf("message florin.blacklist.v1.QueryAdversariesResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message florin.blacklist.v1.QueryAdversaryResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2249,17 +4014,17 @@ func (x *fastReflection_QueryAdversariesResponse) Get(descriptor protoreflect.Fi
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAdversariesResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryAdversaryResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "florin.blacklist.v1.QueryAdversariesResponse.adversaries":
+	case "florin.blacklist.v1.QueryAdversaryResponse.bans":
 		lv := value.List()
-		clv := lv.(*_QueryAdversariesResponse_1_list)
-		x.Adversaries = *clv.list
+		clv := lv.(*_QueryAdversaryResponse_1_list)
+		x.Bans = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.QueryAdversariesResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.QueryAdversaryResponse"))
 		}
-		panic(fmt.Errorf("message florin.blacklist.v1.QueryAdversariesResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message florin.blacklist.v1.QueryAdversaryResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2273,45 +4038,45 @@ func (x *fastReflection_QueryAdversariesResponse) Set(fd protoreflect.FieldDescr
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAdversariesResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryAdversaryResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "florin.blacklist.v1.QueryAdversariesResponse.adversaries":
-		if x.Adversaries == nil {
-			x.Adversaries = []string{}
+	case "florin.blacklist.v1.QueryAdversaryResponse.bans":
+		if x.Bans == nil {
+			x.Bans = []*BanRecord{}
 		}
-		value := &_QueryAdversariesResponse_1_list{list: &x.Adversaries}
+		value := &_QueryAdversaryResponse_1_list{list: &x.Bans}
 		return protoreflect.ValueOfList(value)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.QueryAdversariesResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.QueryAdversaryResponse"))
 		}
-		panic(fmt.Errorf("message florin.blacklist.v1.QueryAdversariesResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message florin.blacklist.v1.QueryAdversaryResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryAdversariesResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryAdversaryResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "florin.blacklist.v1.QueryAdversariesResponse.adversaries":
-		list := []string{}
-		return protoreflect.ValueOfList(&_QueryAdversariesResponse_1_list{list: &list})
+	case "florin.blacklist.v1.QueryAdversaryResponse.bans":
+		list := []*BanRecord{}
+		return protoreflect.ValueOfList(&_QueryAdversaryResponse_1_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.QueryAdversariesResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.QueryAdversaryResponse"))
 		}
-		panic(fmt.Errorf("message florin.blacklist.v1.QueryAdversariesResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message florin.blacklist.v1.QueryAdversaryResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryAdversariesResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryAdversaryResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in florin.blacklist.v1.QueryAdversariesResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in florin.blacklist.v1.QueryAdversaryResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2319,7 +4084,7 @@ func (x *fastReflection_QueryAdversariesResponse) WhichOneof(d protoreflect.Oneo
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryAdversariesResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryAdversaryResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2330,7 +4095,7 @@ func (x *fastReflection_QueryAdversariesResponse) GetUnknown() protoreflect.RawF
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAdversariesResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryAdversaryResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2342,7 +4107,7 @@ func (x *fastReflection_QueryAdversariesResponse) SetUnknown(fields protoreflect
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryAdversariesResponse) IsValid() bool {
+func (x *fastReflection_QueryAdversaryResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -2352,9 +4117,9 @@ func (x *fastReflection_QueryAdversariesResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryAdversariesResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryAdversaryResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryAdversariesResponse)
+		x := input.Message.Interface().(*QueryAdversaryResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2366,9 +4131,9 @@ func (x *fastReflection_QueryAdversariesResponse) ProtoMethods() *protoiface.Met
 		var n int
 		var l int
 		_ = l
-		if len(x.Adversaries) > 0 {
-			for _, s := range x.Adversaries {
-				l = len(s)
+		if len(x.Bans) > 0 {
+			for _, e := range x.Bans {
+				l = options.Size(e)
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
@@ -2382,7 +4147,7 @@ func (x *fastReflection_QueryAdversariesResponse) ProtoMethods() *protoiface.Met
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryAdversariesResponse)
+		x := input.Message.Interface().(*QueryAdversaryResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2401,11 +4166,18 @@ func (x *fastReflection_QueryAdversariesResponse) ProtoMethods() *protoiface.Met
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Adversaries) > 0 {
-			for iNdEx := len(x.Adversaries) - 1; iNdEx >= 0; iNdEx-- {
-				i -= len(x.Adversaries[iNdEx])
-				copy(dAtA[i:], x.Adversaries[iNdEx])
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Adversaries[iNdEx])))
+		if len(x.Bans) > 0 {
+			for iNdEx := len(x.Bans) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Bans[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 				i--
 				dAtA[i] = 0xa
 			}
@@ -2421,7 +4193,7 @@ func (x *fastReflection_QueryAdversariesResponse) ProtoMethods() *protoiface.Met
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryAdversariesResponse)
+		x := input.Message.Interface().(*QueryAdversaryResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2453,17 +4225,17 @@ func (x *fastReflection_QueryAdversariesResponse) ProtoMethods() *protoiface.Met
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAdversariesResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAdversaryResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAdversariesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAdversaryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Adversaries", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Bans", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2473,23 +4245,25 @@ func (x *fastReflection_QueryAdversariesResponse) ProtoMethods() *protoiface.Met
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Adversaries = append(x.Adversaries, string(dAtA[iNdEx:postIndex]))
+				x.Bans = append(x.Bans, &BanRecord{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Bans[len(x.Bans)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -2612,6 +4386,8 @@ type QueryAdmins struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
 func (x *QueryAdmins) Reset() {
@@ -2634,12 +4410,20 @@ func (*QueryAdmins) Descriptor() ([]byte, []int) {
 	return file_florin_blacklist_v1_query_proto_rawDescGZIP(), []int{2}
 }
 
+func (x *QueryAdmins) GetDenom() string {
+	if x != nil {
+		return x.Denom
+	}
+	return ""
+}
+
 type QueryAdminsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Admins []string `protobuf:"bytes,1,rep,name=admins,proto3" json:"admins,omitempty"`
+	Admins      []string   `protobuf:"bytes,1,rep,name=admins,proto3" json:"admins,omitempty"`
+	DenomAdmins []*Account `protobuf:"bytes,2,rep,name=denom_admins,json=denomAdmins,proto3" json:"denom_admins,omitempty"`
 }
 
 func (x *QueryAdminsResponse) Reset() {
@@ -2669,10 +4453,21 @@ func (x *QueryAdminsResponse) GetAdmins() []string {
 	return nil
 }
 
+func (x *QueryAdminsResponse) GetDenomAdmins() []*Account {
+	if x != nil {
+		return x.DenomAdmins
+	}
+	return nil
+}
+
 type QueryAdversaries struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Denom           string               `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Pagination      *v1beta1.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	DenomPagination *v1beta1.PageRequest `protobuf:"bytes,3,opt,name=denom_pagination,json=denomPagination,proto3" json:"denom_pagination,omitempty"`
 }
 
 func (x *QueryAdversaries) Reset() {
@@ -2695,12 +4490,37 @@ func (*QueryAdversaries) Descriptor() ([]byte, []int) {
 	return file_florin_blacklist_v1_query_proto_rawDescGZIP(), []int{4}
 }
 
+func (x *QueryAdversaries) GetDenom() string {
+	if x != nil {
+		return x.Denom
+	}
+	return ""
+}
+
+func (x *QueryAdversaries) GetPagination() *v1beta1.PageRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *QueryAdversaries) GetDenomPagination() *v1beta1.PageRequest {
+	if x != nil {
+		return x.DenomPagination
+	}
+	return nil
+}
+
 type QueryAdversariesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Adversaries []string `protobuf:"bytes,1,rep,name=adversaries,proto3" json:"adversaries,omitempty"`
+	Adversaries      []string              `protobuf:"bytes,1,rep,name=adversaries,proto3" json:"adversaries,omitempty"`
+	DenomAdversaries []*Account            `protobuf:"bytes,2,rep,name=denom_adversaries,json=denomAdversaries,proto3" json:"denom_adversaries,omitempty"`
+	Pagination       *v1beta1.PageResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	DenomPagination  *v1beta1.PageResponse `protobuf:"bytes,4,opt,name=denom_pagination,json=denomPagination,proto3" json:"denom_pagination,omitempty"`
+	BanRecords       []*BanRecord          `protobuf:"bytes,5,rep,name=ban_records,json=banRecords,proto3" json:"ban_records,omitempty"`
 }
 
 func (x *QueryAdversariesResponse) Reset() {
@@ -2730,72 +4550,230 @@ func (x *QueryAdversariesResponse) GetAdversaries() []string {
 	return nil
 }
 
+func (x *QueryAdversariesResponse) GetDenomAdversaries() []*Account {
+	if x != nil {
+		return x.DenomAdversaries
+	}
+	return nil
+}
+
+func (x *QueryAdversariesResponse) GetPagination() *v1beta1.PageResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *QueryAdversariesResponse) GetDenomPagination() *v1beta1.PageResponse {
+	if x != nil {
+		return x.DenomPagination
+	}
+	return nil
+}
+
+func (x *QueryAdversariesResponse) GetBanRecords() []*BanRecord {
+	if x != nil {
+		return x.BanRecords
+	}
+	return nil
+}
+
+type QueryAdversary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (x *QueryAdversary) Reset() {
+	*x = QueryAdversary{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_florin_blacklist_v1_query_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryAdversary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryAdversary) ProtoMessage() {}
+
+// Deprecated: Use QueryAdversary.ProtoReflect.Descriptor instead.
+func (*QueryAdversary) Descriptor() ([]byte, []int) {
+	return file_florin_blacklist_v1_query_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *QueryAdversary) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type QueryAdversaryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Bans []*BanRecord `protobuf:"bytes,1,rep,name=bans,proto3" json:"bans,omitempty"`
+}
+
+func (x *QueryAdversaryResponse) Reset() {
+	*x = QueryAdversaryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_florin_blacklist_v1_query_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryAdversaryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryAdversaryResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryAdversaryResponse.ProtoReflect.Descriptor instead.
+func (*QueryAdversaryResponse) Descriptor() ([]byte, []int) {
+	return file_florin_blacklist_v1_query_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *QueryAdversaryResponse) GetBans() []*BanRecord {
+	if x != nil {
+		return x.Bans
+	}
+	return nil
+}
+
 var File_florin_blacklist_v1_query_proto protoreflect.FileDescriptor
 
 var file_florin_blacklist_v1_query_proto_rawDesc = []byte{
 	0x0a, 0x1f, 0x66, 0x6c, 0x6f, 0x72, 0x69, 0x6e, 0x2f, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69,
 	0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x12, 0x13, 0x66, 0x6c, 0x6f, 0x72, 0x69, 0x6e, 0x2e, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6c,
-	0x69, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x1a, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x71,
-	0x75, 0x65, 0x72, 0x79, 0x2f, 0x76, 0x31, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-	0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x22, 0x0c, 0x0a, 0x0a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x22,
-	0x4f, 0x0a, 0x12, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x70,
-	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0c, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4f, 0x77, 0x6e, 0x65, 0x72,
-	0x22, 0x0d, 0x0a, 0x0b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x73, 0x22,
-	0x2d, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x73, 0x22, 0x12,
-	0x0a, 0x10, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x64, 0x76, 0x65, 0x72, 0x73, 0x61, 0x72, 0x69,
-	0x65, 0x73, 0x22, 0x3c, 0x0a, 0x18, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x64, 0x76, 0x65, 0x72,
-	0x73, 0x61, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20,
-	0x0a, 0x0b, 0x61, 0x64, 0x76, 0x65, 0x72, 0x73, 0x61, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x64, 0x76, 0x65, 0x72, 0x73, 0x61, 0x72, 0x69, 0x65, 0x73,
-	0x32, 0x98, 0x03, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x7a, 0x0a, 0x05, 0x4f, 0x77,
-	0x6e, 0x65, 0x72, 0x12, 0x1f, 0x2e, 0x66, 0x6c, 0x6f, 0x72, 0x69, 0x6e, 0x2e, 0x62, 0x6c, 0x61,
-	0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f,
-	0x77, 0x6e, 0x65, 0x72, 0x1a, 0x27, 0x2e, 0x66, 0x6c, 0x6f, 0x72, 0x69, 0x6e, 0x2e, 0x62, 0x6c,
-	0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x4f, 0x77, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27, 0x88,
-	0xe7, 0xb0, 0x2a, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x12, 0x1a, 0x2f, 0x66, 0x6c, 0x6f,
-	0x72, 0x69, 0x6e, 0x2f, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x2f, 0x76, 0x31,
-	0x2f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x7e, 0x0a, 0x06, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x73,
-	0x12, 0x20, 0x2e, 0x66, 0x6c, 0x6f, 0x72, 0x69, 0x6e, 0x2e, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6c,
-	0x69, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x64, 0x6d, 0x69,
-	0x6e, 0x73, 0x1a, 0x28, 0x2e, 0x66, 0x6c, 0x6f, 0x72, 0x69, 0x6e, 0x2e, 0x62, 0x6c, 0x61, 0x63,
-	0x6b, 0x6c, 0x69, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x64,
-	0x6d, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x28, 0x88, 0xe7,
-	0xb0, 0x2a, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x66, 0x6c, 0x6f, 0x72,
-	0x69, 0x6e, 0x2f, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f,
-	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x73, 0x12, 0x92, 0x01, 0x0a, 0x0b, 0x41, 0x64, 0x76, 0x65, 0x72,
-	0x73, 0x61, 0x72, 0x69, 0x65, 0x73, 0x12, 0x25, 0x2e, 0x66, 0x6c, 0x6f, 0x72, 0x69, 0x6e, 0x2e,
-	0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x41, 0x64, 0x76, 0x65, 0x72, 0x73, 0x61, 0x72, 0x69, 0x65, 0x73, 0x1a, 0x2d, 0x2e,
+	0x69, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x1a, 0x2a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62,
+	0x61, 0x73, 0x65, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2f, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79,
+	0x2f, 0x76, 0x31, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x21, 0x66, 0x6c, 0x6f, 0x72, 0x69, 0x6e, 0x2f, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73,
+	0x74, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f,
+	0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x0c, 0x0a, 0x0a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f,
+	0x77, 0x6e, 0x65, 0x72, 0x22, 0x4f, 0x0a, 0x12, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x77, 0x6e,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77,
+	0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72,
+	0x12, 0x23, 0x0a, 0x0d, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x6f, 0x77, 0x6e, 0x65,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x4f, 0x77, 0x6e, 0x65, 0x72, 0x22, 0x23, 0x0a, 0x0b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x22, 0x74, 0x0a, 0x13, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x06, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x73, 0x12, 0x45, 0x0a, 0x0c, 0x64, 0x65, 0x6e,
+	0x6f, 0x6d, 0x5f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x66, 0x6c, 0x6f, 0x72, 0x69, 0x6e, 0x2e, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69,
+	0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x04, 0xc8,
+	0xde, 0x1f, 0x00, 0x52, 0x0b, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x73,
+	0x22, 0xc3, 0x01, 0x0a, 0x10, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x64, 0x76, 0x65, 0x72, 0x73,
+	0x61, 0x72, 0x69, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x46, 0x0a, 0x0a, 0x70,
+	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75,
+	0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x51, 0x0a, 0x10, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x5f, 0x70, 0x61, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72,
+	0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x50, 0x61, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xf1, 0x02, 0x0a, 0x18, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x41, 0x64, 0x76, 0x65, 0x72, 0x73, 0x61, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x64, 0x76, 0x65, 0x72, 0x73, 0x61, 0x72, 0x69,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x64, 0x76, 0x65, 0x72, 0x73,
+	0x61, 0x72, 0x69, 0x65, 0x73, 0x12, 0x4f, 0x0a, 0x11, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x5f, 0x61,
+	0x64, 0x76, 0x65, 0x72, 0x73, 0x61, 0x72, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x66, 0x6c, 0x6f, 0x72, 0x69, 0x6e, 0x2e, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6c,
+	0x69, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x04,
+	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x10, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x41, 0x64, 0x76, 0x65, 0x72,
+	0x73, 0x61, 0x72, 0x69, 0x65, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x52, 0x0a, 0x10, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x5f, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x52, 0x0f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x45, 0x0a, 0x0b, 0x62, 0x61, 0x6e, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x66, 0x6c, 0x6f, 0x72, 0x69,
+	0x6e, 0x2e, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42,
+	0x61, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0a,
+	0x62, 0x61, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x22, 0x2a, 0x0a, 0x0e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x41, 0x64, 0x76, 0x65, 0x72, 0x73, 0x61, 0x72, 0x79, 0x12, 0x18, 0x0a, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x52, 0x0a, 0x16, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41,
+	0x64, 0x76, 0x65, 0x72, 0x73, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x38, 0x0a, 0x04, 0x62, 0x61, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e,
+	0x2e, 0x66, 0x6c, 0x6f, 0x72, 0x69, 0x6e, 0x2e, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x42, 0x04,
+	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x04, 0x62, 0x61, 0x6e, 0x73, 0x32, 0xaf, 0x04, 0x0a, 0x05, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x12, 0x7a, 0x0a, 0x05, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x1f, 0x2e,
 	0x66, 0x6c, 0x6f, 0x72, 0x69, 0x6e, 0x2e, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74,
-	0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x64, 0x76, 0x65, 0x72, 0x73, 0x61,
-	0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2d, 0x88, 0xe7,
-	0xb0, 0x2a, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x12, 0x20, 0x2f, 0x66, 0x6c, 0x6f, 0x72,
-	0x69, 0x6e, 0x2f, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f,
-	0x61, 0x64, 0x76, 0x65, 0x72, 0x73, 0x61, 0x72, 0x69, 0x65, 0x73, 0x42, 0xdc, 0x01, 0x0a, 0x17,
-	0x63, 0x6f, 0x6d, 0x2e, 0x66, 0x6c, 0x6f, 0x72, 0x69, 0x6e, 0x2e, 0x62, 0x6c, 0x61, 0x63, 0x6b,
-	0x6c, 0x69, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72,
-	0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x47, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2f, 0x6d, 0x6f, 0x6e, 0x65, 0x72, 0x69, 0x75, 0x6d, 0x2f, 0x6d, 0x6f, 0x64, 0x75, 0x6c,
-	0x65, 0x2d, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2f, 0x76, 0x32, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66,
-	0x6c, 0x6f, 0x72, 0x69, 0x6e, 0x2f, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x2f,
-	0x76, 0x31, 0x3b, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x76, 0x31, 0xa2, 0x02,
-	0x03, 0x46, 0x42, 0x58, 0xaa, 0x02, 0x13, 0x46, 0x6c, 0x6f, 0x72, 0x69, 0x6e, 0x2e, 0x42, 0x6c,
-	0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x13, 0x46, 0x6c, 0x6f,
-	0x72, 0x69, 0x6e, 0x5c, 0x42, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x5c, 0x56, 0x31,
-	0xe2, 0x02, 0x1f, 0x46, 0x6c, 0x6f, 0x72, 0x69, 0x6e, 0x5c, 0x42, 0x6c, 0x61, 0x63, 0x6b, 0x6c,
-	0x69, 0x73, 0x74, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0xea, 0x02, 0x15, 0x46, 0x6c, 0x6f, 0x72, 0x69, 0x6e, 0x3a, 0x3a, 0x42, 0x6c, 0x61,
-	0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x1a, 0x27,
+	0x2e, 0x66, 0x6c, 0x6f, 0x72, 0x69, 0x6e, 0x2e, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27, 0x88, 0xe7, 0xb0, 0x2a, 0x01, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x1c, 0x12, 0x1a, 0x2f, 0x66, 0x6c, 0x6f, 0x72, 0x69, 0x6e, 0x2f, 0x62, 0x6c,
+	0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x77, 0x6e, 0x65, 0x72,
+	0x12, 0x7e, 0x0a, 0x06, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x73, 0x12, 0x20, 0x2e, 0x66, 0x6c, 0x6f,
+	0x72, 0x69, 0x6e, 0x2e, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x73, 0x1a, 0x28, 0x2e, 0x66,
+	0x6c, 0x6f, 0x72, 0x69, 0x6e, 0x2e, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x28, 0x88, 0xe7, 0xb0, 0x2a, 0x01, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x66, 0x6c, 0x6f, 0x72, 0x69, 0x6e, 0x2f, 0x62, 0x6c, 0x61,
+	0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x73,
+	0x12, 0x92, 0x01, 0x0a, 0x0b, 0x41, 0x64, 0x76, 0x65, 0x72, 0x73, 0x61, 0x72, 0x69, 0x65, 0x73,
+	0x12, 0x25, 0x2e, 0x66, 0x6c, 0x6f, 0x72, 0x69, 0x6e, 0x2e, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6c,
+	0x69, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x64, 0x76, 0x65,
+	0x72, 0x73, 0x61, 0x72, 0x69, 0x65, 0x73, 0x1a, 0x2d, 0x2e, 0x66, 0x6c, 0x6f, 0x72, 0x69, 0x6e,
+	0x2e, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x41, 0x64, 0x76, 0x65, 0x72, 0x73, 0x61, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2d, 0x88, 0xe7, 0xb0, 0x2a, 0x01, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x22, 0x12, 0x20, 0x2f, 0x66, 0x6c, 0x6f, 0x72, 0x69, 0x6e, 0x2f, 0x62, 0x6c, 0x61,
+	0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x64, 0x76, 0x65, 0x72, 0x73,
+	0x61, 0x72, 0x69, 0x65, 0x73, 0x12, 0x94, 0x01, 0x0a, 0x09, 0x41, 0x64, 0x76, 0x65, 0x72, 0x73,
+	0x61, 0x72, 0x79, 0x12, 0x23, 0x2e, 0x66, 0x6c, 0x6f, 0x72, 0x69, 0x6e, 0x2e, 0x62, 0x6c, 0x61,
+	0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41,
+	0x64, 0x76, 0x65, 0x72, 0x73, 0x61, 0x72, 0x79, 0x1a, 0x2b, 0x2e, 0x66, 0x6c, 0x6f, 0x72, 0x69,
+	0x6e, 0x2e, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x41, 0x64, 0x76, 0x65, 0x72, 0x73, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x35, 0x88, 0xe7, 0xb0, 0x2a, 0x01, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x2a, 0x12, 0x28, 0x2f, 0x66, 0x6c, 0x6f, 0x72, 0x69, 0x6e, 0x2f, 0x62, 0x6c, 0x61, 0x63,
+	0x6b, 0x6c, 0x69, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x64, 0x76, 0x65, 0x72, 0x73, 0x61,
+	0x72, 0x79, 0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x42, 0xdc, 0x01, 0x0a,
+	0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x66, 0x6c, 0x6f, 0x72, 0x69, 0x6e, 0x2e, 0x62, 0x6c, 0x61, 0x63,
+	0x6b, 0x6c, 0x69, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x47, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x6d, 0x6f, 0x6e, 0x65, 0x72, 0x69, 0x75, 0x6d, 0x2f, 0x6d, 0x6f, 0x64, 0x75,
+	0x6c, 0x65, 0x2d, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2f, 0x76, 0x32, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x66, 0x6c, 0x6f, 0x72, 0x69, 0x6e, 0x2f, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74,
+	0x2f, 0x76, 0x31, 0x3b, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x76, 0x31, 0xa2,
+	0x02, 0x03, 0x46, 0x42, 0x58, 0xaa, 0x02, 0x13, 0x46, 0x6c, 0x6f, 0x72, 0x69, 0x6e, 0x2e, 0x42,
+	0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x13, 0x46, 0x6c,
+	0x6f, 0x72, 0x69, 0x6e, 0x5c, 0x42, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x5c, 0x56,
+	0x31, 0xe2, 0x02, 0x1f, 0x46, 0x6c, 0x6f, 0x72, 0x69, 0x6e, 0x5c, 0x42, 0x6c, 0x61, 0x63, 0x6b,
+	0x6c, 0x69, 0x73, 0x74, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0xea, 0x02, 0x15, 0x46, 0x6c, 0x6f, 0x72, 0x69, 0x6e, 0x3a, 0x3a, 0x42, 0x6c,
+	0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2810,7 +4788,7 @@ func file_florin_blacklist_v1_query_proto_rawDescGZIP() []byte {
 	return file_florin_blacklist_v1_query_proto_rawDescData
 }
 
-var file_florin_blacklist_v1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_florin_blacklist_v1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_florin_blacklist_v1_query_proto_goTypes = []interface{}{
 	(*QueryOwner)(nil),               // 0: florin.blacklist.v1.QueryOwner
 	(*QueryOwnerResponse)(nil),       // 1: florin.blacklist.v1.QueryOwnerResponse
@@ -2818,19 +4796,35 @@ var file_florin_blacklist_v1_query_proto_goTypes = []interface{}{
 	(*QueryAdminsResponse)(nil),      // 3: florin.blacklist.v1.QueryAdminsResponse
 	(*QueryAdversaries)(nil),         // 4: florin.blacklist.v1.QueryAdversaries
 	(*QueryAdversariesResponse)(nil), // 5: florin.blacklist.v1.QueryAdversariesResponse
+	(*QueryAdversary)(nil),           // 6: florin.blacklist.v1.QueryAdversary
+	(*QueryAdversaryResponse)(nil),   // 7: florin.blacklist.v1.QueryAdversaryResponse
+	(*Account)(nil),                  // 8: florin.blacklist.v1.Account
+	(*v1beta1.PageRequest)(nil),      // 9: cosmos.base.query.v1beta1.PageRequest
+	(*v1beta1.PageResponse)(nil),     // 10: cosmos.base.query.v1beta1.PageResponse
+	(*BanRecord)(nil),                // 11: florin.blacklist.v1.BanRecord
 }
 var file_florin_blacklist_v1_query_proto_depIdxs = []int32{
-	0, // 0: florin.blacklist.v1.Query.Owner:input_type -> florin.blacklist.v1.QueryOwner
-	2, // 1: florin.blacklist.v1.Query.Admins:input_type -> florin.blacklist.v1.QueryAdmins
-	4, // 2: florin.blacklist.v1.Query.Adversaries:input_type -> florin.blacklist.v1.QueryAdversaries
-	1, // 3: florin.blacklist.v1.Query.Owner:output_type -> florin.blacklist.v1.QueryOwnerResponse
-	3, // 4: florin.blacklist.v1.Query.Admins:output_type -> florin.blacklist.v1.QueryAdminsResponse
-	5, // 5: florin.blacklist.v1.Query.Adversaries:output_type -> florin.blacklist.v1.QueryAdversariesResponse
-	3, // [3:6] is the sub-list for method output_type
-	0, // [0:3] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	8,  // 0: florin.blacklist.v1.QueryAdminsResponse.denom_admins:type_name -> florin.blacklist.v1.Account
+	9,  // 1: florin.blacklist.v1.QueryAdversaries.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	9,  // 2: florin.blacklist.v1.QueryAdversaries.denom_pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	8,  // 3: florin.blacklist.v1.QueryAdversariesResponse.denom_adversaries:type_name -> florin.blacklist.v1.Account
+	10, // 4: florin.blacklist.v1.QueryAdversariesResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	10, // 5: florin.blacklist.v1.QueryAdversariesResponse.denom_pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	11, // 6: florin.blacklist.v1.QueryAdversariesResponse.ban_records:type_name -> florin.blacklist.v1.BanRecord
+	11, // 7: florin.blacklist.v1.QueryAdversaryResponse.bans:type_name -> florin.blacklist.v1.BanRecord
+	0,  // 8: florin.blacklist.v1.Query.Owner:input_type -> florin.blacklist.v1.QueryOwner
+	2,  // 9: florin.blacklist.v1.Query.Admins:input_type -> florin.blacklist.v1.QueryAdmins
+	4,  // 10: florin.blacklist.v1.Query.Adversaries:input_type -> florin.blacklist.v1.QueryAdversaries
+	6,  // 11: florin.blacklist.v1.Query.Adversary:input_type -> florin.blacklist.v1.QueryAdversary
+	1,  // 12: florin.blacklist.v1.Query.Owner:output_type -> florin.blacklist.v1.QueryOwnerResponse
+	3,  // 13: florin.blacklist.v1.Query.Admins:output_type -> florin.blacklist.v1.QueryAdminsResponse
+	5,  // 14: florin.blacklist.v1.Query.Adversaries:output_type -> florin.blacklist.v1.QueryAdversariesResponse
+	7,  // 15: florin.blacklist.v1.Query.Adversary:output_type -> florin.blacklist.v1.QueryAdversaryResponse
+	12, // [12:16] is the sub-list for method output_type
+	8,  // [8:12] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_florin_blacklist_v1_query_proto_init() }
@@ -2838,6 +4832,7 @@ func file_florin_blacklist_v1_query_proto_init() {
 	if File_florin_blacklist_v1_query_proto != nil {
 		return
 	}
+	file_florin_blacklist_v1_genesis_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_florin_blacklist_v1_query_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*QueryOwner); i {
@@ -2911,6 +4906,30 @@ func file_florin_blacklist_v1_query_proto_init() {
 				return nil
 			}
 		}
+		file_florin_blacklist_v1_query_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryAdversary); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_florin_blacklist_v1_query_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryAdversaryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -2918,7 +4937,7 @@ func file_florin_blacklist_v1_query_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_florin_blacklist_v1_query_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -22,6 +22,7 @@ const (
 	Query_Owner_FullMethodName       = "/florin.blacklist.v1.Query/Owner"
 	Query_Admins_FullMethodName      = "/florin.blacklist.v1.Query/Admins"
 	Query_Adversaries_FullMethodName = "/florin.blacklist.v1.Query/Adversaries"
+	Query_Adversary_FullMethodName   = "/florin.blacklist.v1.Query/Adversary"
 )
 
 // QueryClient is the client API for Query service.
@@ -31,6 +32,7 @@ type QueryClient interface {
 	Owner(ctx context.Context, in *QueryOwner, opts ...grpc.CallOption) (*QueryOwnerResponse, error)
 	Admins(ctx context.Context, in *QueryAdmins, opts ...grpc.CallOption) (*QueryAdminsResponse, error)
 	Adversaries(ctx context.Context, in *QueryAdversaries, opts ...grpc.CallOption) (*QueryAdversariesResponse, error)
+	Adversary(ctx context.Context, in *QueryAdversary, opts ...grpc.CallOption) (*QueryAdversaryResponse, error)
 }
 
 type queryClient struct {
@@ -71,6 +73,16 @@ func (c *queryClient) Adversaries(ctx context.Context, in *QueryAdversaries, opt
 	return out, nil
 }
 
+func (c *queryClient) Adversary(ctx context.Context, in *QueryAdversary, opts ...grpc.CallOption) (*QueryAdversaryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryAdversaryResponse)
+	err := c.cc.Invoke(ctx, Query_Adversary_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility.
@@ -78,6 +90,7 @@ type QueryServer interface {
 	Owner(context.Context, *QueryOwner) (*QueryOwnerResponse, error)
 	Admins(context.Context, *QueryAdmins) (*QueryAdminsResponse, error)
 	Adversaries(context.Context, *QueryAdversaries) (*QueryAdversariesResponse, error)
+	Adversary(context.Context, *QueryAdversary) (*QueryAdversaryResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -97,6 +110,9 @@ func (UnimplementedQueryServer) Admins(context.Context, *QueryAdmins) (*QueryAdm
 func (UnimplementedQueryServer) Adversaries(context.Context, *QueryAdversaries) (*QueryAdversariesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Adversaries not implemented")
 }
+func (UnimplementedQueryServer) Adversary(context.Context, *QueryAdversary) (*QueryAdversaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Adversary not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 func (UnimplementedQueryServer) testEmbeddedByValue()               {}
 
@@ -172,6 +188,24 @@ func _Query_Adversaries_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Adversary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAdversary)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Adversary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Adversary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Adversary(ctx, req.(*QueryAdversary))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -191,6 +225,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Adversaries",
 			Handler:    _Query_Adversaries_Handler,
 		},
+		{
+			MethodName: "Adversary",
+			Handler:    _Query_Adversary_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "florin/blacklist/v1/query.proto",
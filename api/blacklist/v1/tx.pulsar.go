@@ -796,6 +796,7 @@ var (
 	md_MsgAddAdminAccount         protoreflect.MessageDescriptor
 	fd_MsgAddAdminAccount_signer  protoreflect.FieldDescriptor
 	fd_MsgAddAdminAccount_account protoreflect.FieldDescriptor
+	fd_MsgAddAdminAccount_denom   protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -803,6 +804,7 @@ func init() {
 	md_MsgAddAdminAccount = File_florin_blacklist_v1_tx_proto.Messages().ByName("MsgAddAdminAccount")
 	fd_MsgAddAdminAccount_signer = md_MsgAddAdminAccount.Fields().ByName("signer")
 	fd_MsgAddAdminAccount_account = md_MsgAddAdminAccount.Fields().ByName("account")
+	fd_MsgAddAdminAccount_denom = md_MsgAddAdminAccount.Fields().ByName("denom")
 }
 
 var _ protoreflect.Message = (*fastReflection_MsgAddAdminAccount)(nil)
@@ -882,6 +884,12 @@ func (x *fastReflection_MsgAddAdminAccount) Range(f func(protoreflect.FieldDescr
 			return
 		}
 	}
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_MsgAddAdminAccount_denom, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -901,6 +909,8 @@ func (x *fastReflection_MsgAddAdminAccount) Has(fd protoreflect.FieldDescriptor)
 		return x.Signer != ""
 	case "florin.blacklist.v1.MsgAddAdminAccount.account":
 		return x.Account != ""
+	case "florin.blacklist.v1.MsgAddAdminAccount.denom":
+		return x.Denom != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.MsgAddAdminAccount"))
@@ -921,6 +931,8 @@ func (x *fastReflection_MsgAddAdminAccount) Clear(fd protoreflect.FieldDescripto
 		x.Signer = ""
 	case "florin.blacklist.v1.MsgAddAdminAccount.account":
 		x.Account = ""
+	case "florin.blacklist.v1.MsgAddAdminAccount.denom":
+		x.Denom = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.MsgAddAdminAccount"))
@@ -943,6 +955,9 @@ func (x *fastReflection_MsgAddAdminAccount) Get(descriptor protoreflect.FieldDes
 	case "florin.blacklist.v1.MsgAddAdminAccount.account":
 		value := x.Account
 		return protoreflect.ValueOfString(value)
+	case "florin.blacklist.v1.MsgAddAdminAccount.denom":
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.MsgAddAdminAccount"))
@@ -967,6 +982,8 @@ func (x *fastReflection_MsgAddAdminAccount) Set(fd protoreflect.FieldDescriptor,
 		x.Signer = value.Interface().(string)
 	case "florin.blacklist.v1.MsgAddAdminAccount.account":
 		x.Account = value.Interface().(string)
+	case "florin.blacklist.v1.MsgAddAdminAccount.denom":
+		x.Denom = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.MsgAddAdminAccount"))
@@ -991,6 +1008,8 @@ func (x *fastReflection_MsgAddAdminAccount) Mutable(fd protoreflect.FieldDescrip
 		panic(fmt.Errorf("field signer of message florin.blacklist.v1.MsgAddAdminAccount is not mutable"))
 	case "florin.blacklist.v1.MsgAddAdminAccount.account":
 		panic(fmt.Errorf("field account of message florin.blacklist.v1.MsgAddAdminAccount is not mutable"))
+	case "florin.blacklist.v1.MsgAddAdminAccount.denom":
+		panic(fmt.Errorf("field denom of message florin.blacklist.v1.MsgAddAdminAccount is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.MsgAddAdminAccount"))
@@ -1008,6 +1027,8 @@ func (x *fastReflection_MsgAddAdminAccount) NewField(fd protoreflect.FieldDescri
 		return protoreflect.ValueOfString("")
 	case "florin.blacklist.v1.MsgAddAdminAccount.account":
 		return protoreflect.ValueOfString("")
+	case "florin.blacklist.v1.MsgAddAdminAccount.denom":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.MsgAddAdminAccount"))
@@ -1085,6 +1106,10 @@ func (x *fastReflection_MsgAddAdminAccount) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		l = len(x.Denom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1114,6 +1139,13 @@ func (x *fastReflection_MsgAddAdminAccount) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+			i--
+			dAtA[i] = 0x1a
+		}
 		if len(x.Account) > 0 {
 			i -= len(x.Account)
 			copy(dAtA[i:], x.Account)
@@ -1241,6 +1273,38 @@ func (x *fastReflection_MsgAddAdminAccount) ProtoMethods() *protoiface.Methods {
 				}
 				x.Account = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Denom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1633,9 +1697,14 @@ func (x *fastReflection_MsgAddAdminAccountResponse) ProtoMethods() *protoiface.M
 }
 
 var (
-	md_MsgBan           protoreflect.MessageDescriptor
-	fd_MsgBan_signer    protoreflect.FieldDescriptor
-	fd_MsgBan_adversary protoreflect.FieldDescriptor
+	md_MsgBan               protoreflect.MessageDescriptor
+	fd_MsgBan_signer        protoreflect.FieldDescriptor
+	fd_MsgBan_adversary     protoreflect.FieldDescriptor
+	fd_MsgBan_denom         protoreflect.FieldDescriptor
+	fd_MsgBan_expiry_height protoreflect.FieldDescriptor
+	fd_MsgBan_expiry_time   protoreflect.FieldDescriptor
+	fd_MsgBan_reason        protoreflect.FieldDescriptor
+	fd_MsgBan_case_id       protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -1643,6 +1712,11 @@ func init() {
 	md_MsgBan = File_florin_blacklist_v1_tx_proto.Messages().ByName("MsgBan")
 	fd_MsgBan_signer = md_MsgBan.Fields().ByName("signer")
 	fd_MsgBan_adversary = md_MsgBan.Fields().ByName("adversary")
+	fd_MsgBan_denom = md_MsgBan.Fields().ByName("denom")
+	fd_MsgBan_expiry_height = md_MsgBan.Fields().ByName("expiry_height")
+	fd_MsgBan_expiry_time = md_MsgBan.Fields().ByName("expiry_time")
+	fd_MsgBan_reason = md_MsgBan.Fields().ByName("reason")
+	fd_MsgBan_case_id = md_MsgBan.Fields().ByName("case_id")
 }
 
 var _ protoreflect.Message = (*fastReflection_MsgBan)(nil)
@@ -1722,6 +1796,36 @@ func (x *fastReflection_MsgBan) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_MsgBan_denom, value) {
+			return
+		}
+	}
+	if x.ExpiryHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ExpiryHeight)
+		if !f(fd_MsgBan_expiry_height, value) {
+			return
+		}
+	}
+	if x.ExpiryTime != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ExpiryTime)
+		if !f(fd_MsgBan_expiry_time, value) {
+			return
+		}
+	}
+	if x.Reason != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Reason))
+		if !f(fd_MsgBan_reason, value) {
+			return
+		}
+	}
+	if x.CaseId != "" {
+		value := protoreflect.ValueOfString(x.CaseId)
+		if !f(fd_MsgBan_case_id, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -1741,6 +1845,16 @@ func (x *fastReflection_MsgBan) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.Signer != ""
 	case "florin.blacklist.v1.MsgBan.adversary":
 		return x.Adversary != ""
+	case "florin.blacklist.v1.MsgBan.denom":
+		return x.Denom != ""
+	case "florin.blacklist.v1.MsgBan.expiry_height":
+		return x.ExpiryHeight != int64(0)
+	case "florin.blacklist.v1.MsgBan.expiry_time":
+		return x.ExpiryTime != int64(0)
+	case "florin.blacklist.v1.MsgBan.reason":
+		return x.Reason != 0
+	case "florin.blacklist.v1.MsgBan.case_id":
+		return x.CaseId != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.MsgBan"))
@@ -1761,6 +1875,16 @@ func (x *fastReflection_MsgBan) Clear(fd protoreflect.FieldDescriptor) {
 		x.Signer = ""
 	case "florin.blacklist.v1.MsgBan.adversary":
 		x.Adversary = ""
+	case "florin.blacklist.v1.MsgBan.denom":
+		x.Denom = ""
+	case "florin.blacklist.v1.MsgBan.expiry_height":
+		x.ExpiryHeight = int64(0)
+	case "florin.blacklist.v1.MsgBan.expiry_time":
+		x.ExpiryTime = int64(0)
+	case "florin.blacklist.v1.MsgBan.reason":
+		x.Reason = 0
+	case "florin.blacklist.v1.MsgBan.case_id":
+		x.CaseId = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.MsgBan"))
@@ -1783,6 +1907,21 @@ func (x *fastReflection_MsgBan) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "florin.blacklist.v1.MsgBan.adversary":
 		value := x.Adversary
 		return protoreflect.ValueOfString(value)
+	case "florin.blacklist.v1.MsgBan.denom":
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
+	case "florin.blacklist.v1.MsgBan.expiry_height":
+		value := x.ExpiryHeight
+		return protoreflect.ValueOfInt64(value)
+	case "florin.blacklist.v1.MsgBan.expiry_time":
+		value := x.ExpiryTime
+		return protoreflect.ValueOfInt64(value)
+	case "florin.blacklist.v1.MsgBan.reason":
+		value := x.Reason
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "florin.blacklist.v1.MsgBan.case_id":
+		value := x.CaseId
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.MsgBan"))
@@ -1807,6 +1946,16 @@ func (x *fastReflection_MsgBan) Set(fd protoreflect.FieldDescriptor, value proto
 		x.Signer = value.Interface().(string)
 	case "florin.blacklist.v1.MsgBan.adversary":
 		x.Adversary = value.Interface().(string)
+	case "florin.blacklist.v1.MsgBan.denom":
+		x.Denom = value.Interface().(string)
+	case "florin.blacklist.v1.MsgBan.expiry_height":
+		x.ExpiryHeight = value.Int()
+	case "florin.blacklist.v1.MsgBan.expiry_time":
+		x.ExpiryTime = value.Int()
+	case "florin.blacklist.v1.MsgBan.reason":
+		x.Reason = (BanReason)(value.Enum())
+	case "florin.blacklist.v1.MsgBan.case_id":
+		x.CaseId = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.MsgBan"))
@@ -1831,6 +1980,16 @@ func (x *fastReflection_MsgBan) Mutable(fd protoreflect.FieldDescriptor) protore
 		panic(fmt.Errorf("field signer of message florin.blacklist.v1.MsgBan is not mutable"))
 	case "florin.blacklist.v1.MsgBan.adversary":
 		panic(fmt.Errorf("field adversary of message florin.blacklist.v1.MsgBan is not mutable"))
+	case "florin.blacklist.v1.MsgBan.denom":
+		panic(fmt.Errorf("field denom of message florin.blacklist.v1.MsgBan is not mutable"))
+	case "florin.blacklist.v1.MsgBan.expiry_height":
+		panic(fmt.Errorf("field expiry_height of message florin.blacklist.v1.MsgBan is not mutable"))
+	case "florin.blacklist.v1.MsgBan.expiry_time":
+		panic(fmt.Errorf("field expiry_time of message florin.blacklist.v1.MsgBan is not mutable"))
+	case "florin.blacklist.v1.MsgBan.reason":
+		panic(fmt.Errorf("field reason of message florin.blacklist.v1.MsgBan is not mutable"))
+	case "florin.blacklist.v1.MsgBan.case_id":
+		panic(fmt.Errorf("field case_id of message florin.blacklist.v1.MsgBan is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.MsgBan"))
@@ -1848,6 +2007,16 @@ func (x *fastReflection_MsgBan) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfString("")
 	case "florin.blacklist.v1.MsgBan.adversary":
 		return protoreflect.ValueOfString("")
+	case "florin.blacklist.v1.MsgBan.denom":
+		return protoreflect.ValueOfString("")
+	case "florin.blacklist.v1.MsgBan.expiry_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "florin.blacklist.v1.MsgBan.expiry_time":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "florin.blacklist.v1.MsgBan.reason":
+		return protoreflect.ValueOfEnum(0)
+	case "florin.blacklist.v1.MsgBan.case_id":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.MsgBan"))
@@ -1925,6 +2094,23 @@ func (x *fastReflection_MsgBan) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		l = len(x.Denom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ExpiryHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExpiryHeight))
+		}
+		if x.ExpiryTime != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExpiryTime))
+		}
+		if x.Reason != 0 {
+			n += 1 + runtime.Sov(uint64(x.Reason))
+		}
+		l = len(x.CaseId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1954,6 +2140,35 @@ func (x *fastReflection_MsgBan) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.CaseId) > 0 {
+			i -= len(x.CaseId)
+			copy(dAtA[i:], x.CaseId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CaseId)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if x.Reason != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Reason))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.ExpiryTime != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExpiryTime))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.ExpiryHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExpiryHeight))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+			i--
+			dAtA[i] = 0x1a
+		}
 		if len(x.Adversary) > 0 {
 			i -= len(x.Adversary)
 			copy(dAtA[i:], x.Adversary)
@@ -2081,6 +2296,127 @@ func (x *fastReflection_MsgBan) ProtoMethods() *protoiface.Methods {
 				}
 				x.Adversary = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Denom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+				}
+				x.ExpiryHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ExpiryHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpiryTime", wireType)
+				}
+				x.ExpiryTime = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ExpiryTime |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				}
+				x.Reason = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Reason |= BanReason(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CaseId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CaseId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2472,28 +2808,84 @@ func (x *fastReflection_MsgBanResponse) ProtoMethods() *protoiface.Methods {
 	}
 }
 
+var _ protoreflect.List = (*_MsgBanBatch_2_list)(nil)
+
+type _MsgBanBatch_2_list struct {
+	list *[]string
+}
+
+func (x *_MsgBanBatch_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgBanBatch_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_MsgBanBatch_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgBanBatch_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgBanBatch_2_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message MsgBanBatch at list field Adversaries as it is not of Message kind"))
+}
+
+func (x *_MsgBanBatch_2_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgBanBatch_2_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_MsgBanBatch_2_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_MsgRemoveAdminAccount         protoreflect.MessageDescriptor
-	fd_MsgRemoveAdminAccount_signer  protoreflect.FieldDescriptor
-	fd_MsgRemoveAdminAccount_account protoreflect.FieldDescriptor
+	md_MsgBanBatch               protoreflect.MessageDescriptor
+	fd_MsgBanBatch_signer        protoreflect.FieldDescriptor
+	fd_MsgBanBatch_adversaries   protoreflect.FieldDescriptor
+	fd_MsgBanBatch_denom         protoreflect.FieldDescriptor
+	fd_MsgBanBatch_expiry_height protoreflect.FieldDescriptor
+	fd_MsgBanBatch_expiry_time   protoreflect.FieldDescriptor
+	fd_MsgBanBatch_reason        protoreflect.FieldDescriptor
+	fd_MsgBanBatch_case_id       protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_florin_blacklist_v1_tx_proto_init()
-	md_MsgRemoveAdminAccount = File_florin_blacklist_v1_tx_proto.Messages().ByName("MsgRemoveAdminAccount")
-	fd_MsgRemoveAdminAccount_signer = md_MsgRemoveAdminAccount.Fields().ByName("signer")
-	fd_MsgRemoveAdminAccount_account = md_MsgRemoveAdminAccount.Fields().ByName("account")
+	md_MsgBanBatch = File_florin_blacklist_v1_tx_proto.Messages().ByName("MsgBanBatch")
+	fd_MsgBanBatch_signer = md_MsgBanBatch.Fields().ByName("signer")
+	fd_MsgBanBatch_adversaries = md_MsgBanBatch.Fields().ByName("adversaries")
+	fd_MsgBanBatch_denom = md_MsgBanBatch.Fields().ByName("denom")
+	fd_MsgBanBatch_expiry_height = md_MsgBanBatch.Fields().ByName("expiry_height")
+	fd_MsgBanBatch_expiry_time = md_MsgBanBatch.Fields().ByName("expiry_time")
+	fd_MsgBanBatch_reason = md_MsgBanBatch.Fields().ByName("reason")
+	fd_MsgBanBatch_case_id = md_MsgBanBatch.Fields().ByName("case_id")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgRemoveAdminAccount)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgBanBatch)(nil)
 
-type fastReflection_MsgRemoveAdminAccount MsgRemoveAdminAccount
+type fastReflection_MsgBanBatch MsgBanBatch
 
-func (x *MsgRemoveAdminAccount) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgRemoveAdminAccount)(x)
+func (x *MsgBanBatch) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgBanBatch)(x)
 }
 
-func (x *MsgRemoveAdminAccount) slowProtoReflect() protoreflect.Message {
+func (x *MsgBanBatch) slowProtoReflect() protoreflect.Message {
 	mi := &file_florin_blacklist_v1_tx_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2505,43 +2897,43 @@ func (x *MsgRemoveAdminAccount) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgRemoveAdminAccount_messageType fastReflection_MsgRemoveAdminAccount_messageType
-var _ protoreflect.MessageType = fastReflection_MsgRemoveAdminAccount_messageType{}
+var _fastReflection_MsgBanBatch_messageType fastReflection_MsgBanBatch_messageType
+var _ protoreflect.MessageType = fastReflection_MsgBanBatch_messageType{}
 
-type fastReflection_MsgRemoveAdminAccount_messageType struct{}
+type fastReflection_MsgBanBatch_messageType struct{}
 
-func (x fastReflection_MsgRemoveAdminAccount_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgRemoveAdminAccount)(nil)
+func (x fastReflection_MsgBanBatch_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgBanBatch)(nil)
 }
-func (x fastReflection_MsgRemoveAdminAccount_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgRemoveAdminAccount)
+func (x fastReflection_MsgBanBatch_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgBanBatch)
 }
-func (x fastReflection_MsgRemoveAdminAccount_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgRemoveAdminAccount
+func (x fastReflection_MsgBanBatch_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgBanBatch
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgRemoveAdminAccount) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgRemoveAdminAccount
+func (x *fastReflection_MsgBanBatch) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgBanBatch
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgRemoveAdminAccount) Type() protoreflect.MessageType {
-	return _fastReflection_MsgRemoveAdminAccount_messageType
+func (x *fastReflection_MsgBanBatch) Type() protoreflect.MessageType {
+	return _fastReflection_MsgBanBatch_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgRemoveAdminAccount) New() protoreflect.Message {
-	return new(fastReflection_MsgRemoveAdminAccount)
+func (x *fastReflection_MsgBanBatch) New() protoreflect.Message {
+	return new(fastReflection_MsgBanBatch)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgRemoveAdminAccount) Interface() protoreflect.ProtoMessage {
-	return (*MsgRemoveAdminAccount)(x)
+func (x *fastReflection_MsgBanBatch) Interface() protoreflect.ProtoMessage {
+	return (*MsgBanBatch)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2549,16 +2941,46 @@ func (x *fastReflection_MsgRemoveAdminAccount) Interface() protoreflect.ProtoMes
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgRemoveAdminAccount) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgBanBatch) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.Signer != "" {
 		value := protoreflect.ValueOfString(x.Signer)
-		if !f(fd_MsgRemoveAdminAccount_signer, value) {
+		if !f(fd_MsgBanBatch_signer, value) {
 			return
 		}
 	}
-	if x.Account != "" {
-		value := protoreflect.ValueOfString(x.Account)
-		if !f(fd_MsgRemoveAdminAccount_account, value) {
+	if len(x.Adversaries) != 0 {
+		value := protoreflect.ValueOfList(&_MsgBanBatch_2_list{list: &x.Adversaries})
+		if !f(fd_MsgBanBatch_adversaries, value) {
+			return
+		}
+	}
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_MsgBanBatch_denom, value) {
+			return
+		}
+	}
+	if x.ExpiryHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ExpiryHeight)
+		if !f(fd_MsgBanBatch_expiry_height, value) {
+			return
+		}
+	}
+	if x.ExpiryTime != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ExpiryTime)
+		if !f(fd_MsgBanBatch_expiry_time, value) {
+			return
+		}
+	}
+	if x.Reason != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Reason))
+		if !f(fd_MsgBanBatch_reason, value) {
+			return
+		}
+	}
+	if x.CaseId != "" {
+		value := protoreflect.ValueOfString(x.CaseId)
+		if !f(fd_MsgBanBatch_case_id, value) {
 			return
 		}
 	}
@@ -2575,17 +2997,27 @@ func (x *fastReflection_MsgRemoveAdminAccount) Range(f func(protoreflect.FieldDe
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgRemoveAdminAccount) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgBanBatch) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "florin.blacklist.v1.MsgRemoveAdminAccount.signer":
+	case "florin.blacklist.v1.MsgBanBatch.signer":
 		return x.Signer != ""
-	case "florin.blacklist.v1.MsgRemoveAdminAccount.account":
-		return x.Account != ""
+	case "florin.blacklist.v1.MsgBanBatch.adversaries":
+		return len(x.Adversaries) != 0
+	case "florin.blacklist.v1.MsgBanBatch.denom":
+		return x.Denom != ""
+	case "florin.blacklist.v1.MsgBanBatch.expiry_height":
+		return x.ExpiryHeight != int64(0)
+	case "florin.blacklist.v1.MsgBanBatch.expiry_time":
+		return x.ExpiryTime != int64(0)
+	case "florin.blacklist.v1.MsgBanBatch.reason":
+		return x.Reason != 0
+	case "florin.blacklist.v1.MsgBanBatch.case_id":
+		return x.CaseId != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.MsgRemoveAdminAccount"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.MsgBanBatch"))
 		}
-		panic(fmt.Errorf("message florin.blacklist.v1.MsgRemoveAdminAccount does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message florin.blacklist.v1.MsgBanBatch does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2595,17 +3027,27 @@ func (x *fastReflection_MsgRemoveAdminAccount) Has(fd protoreflect.FieldDescript
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgRemoveAdminAccount) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgBanBatch) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "florin.blacklist.v1.MsgRemoveAdminAccount.signer":
+	case "florin.blacklist.v1.MsgBanBatch.signer":
 		x.Signer = ""
-	case "florin.blacklist.v1.MsgRemoveAdminAccount.account":
-		x.Account = ""
+	case "florin.blacklist.v1.MsgBanBatch.adversaries":
+		x.Adversaries = nil
+	case "florin.blacklist.v1.MsgBanBatch.denom":
+		x.Denom = ""
+	case "florin.blacklist.v1.MsgBanBatch.expiry_height":
+		x.ExpiryHeight = int64(0)
+	case "florin.blacklist.v1.MsgBanBatch.expiry_time":
+		x.ExpiryTime = int64(0)
+	case "florin.blacklist.v1.MsgBanBatch.reason":
+		x.Reason = 0
+	case "florin.blacklist.v1.MsgBanBatch.case_id":
+		x.CaseId = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.MsgRemoveAdminAccount"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.MsgBanBatch"))
 		}
-		panic(fmt.Errorf("message florin.blacklist.v1.MsgRemoveAdminAccount does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message florin.blacklist.v1.MsgBanBatch does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2615,19 +3057,37 @@ func (x *fastReflection_MsgRemoveAdminAccount) Clear(fd protoreflect.FieldDescri
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgRemoveAdminAccount) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgBanBatch) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "florin.blacklist.v1.MsgRemoveAdminAccount.signer":
+	case "florin.blacklist.v1.MsgBanBatch.signer":
 		value := x.Signer
 		return protoreflect.ValueOfString(value)
-	case "florin.blacklist.v1.MsgRemoveAdminAccount.account":
-		value := x.Account
+	case "florin.blacklist.v1.MsgBanBatch.adversaries":
+		if len(x.Adversaries) == 0 {
+			return protoreflect.ValueOfList(&_MsgBanBatch_2_list{})
+		}
+		listValue := &_MsgBanBatch_2_list{list: &x.Adversaries}
+		return protoreflect.ValueOfList(listValue)
+	case "florin.blacklist.v1.MsgBanBatch.denom":
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
+	case "florin.blacklist.v1.MsgBanBatch.expiry_height":
+		value := x.ExpiryHeight
+		return protoreflect.ValueOfInt64(value)
+	case "florin.blacklist.v1.MsgBanBatch.expiry_time":
+		value := x.ExpiryTime
+		return protoreflect.ValueOfInt64(value)
+	case "florin.blacklist.v1.MsgBanBatch.reason":
+		value := x.Reason
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "florin.blacklist.v1.MsgBanBatch.case_id":
+		value := x.CaseId
 		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.MsgRemoveAdminAccount"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.MsgBanBatch"))
 		}
-		panic(fmt.Errorf("message florin.blacklist.v1.MsgRemoveAdminAccount does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message florin.blacklist.v1.MsgBanBatch does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2641,17 +3101,29 @@ func (x *fastReflection_MsgRemoveAdminAccount) Get(descriptor protoreflect.Field
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgRemoveAdminAccount) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgBanBatch) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "florin.blacklist.v1.MsgRemoveAdminAccount.signer":
+	case "florin.blacklist.v1.MsgBanBatch.signer":
 		x.Signer = value.Interface().(string)
-	case "florin.blacklist.v1.MsgRemoveAdminAccount.account":
-		x.Account = value.Interface().(string)
+	case "florin.blacklist.v1.MsgBanBatch.adversaries":
+		lv := value.List()
+		clv := lv.(*_MsgBanBatch_2_list)
+		x.Adversaries = *clv.list
+	case "florin.blacklist.v1.MsgBanBatch.denom":
+		x.Denom = value.Interface().(string)
+	case "florin.blacklist.v1.MsgBanBatch.expiry_height":
+		x.ExpiryHeight = value.Int()
+	case "florin.blacklist.v1.MsgBanBatch.expiry_time":
+		x.ExpiryTime = value.Int()
+	case "florin.blacklist.v1.MsgBanBatch.reason":
+		x.Reason = (BanReason)(value.Enum())
+	case "florin.blacklist.v1.MsgBanBatch.case_id":
+		x.CaseId = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.MsgRemoveAdminAccount"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.MsgBanBatch"))
 		}
-		panic(fmt.Errorf("message florin.blacklist.v1.MsgRemoveAdminAccount does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message florin.blacklist.v1.MsgBanBatch does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2665,44 +3137,69 @@ func (x *fastReflection_MsgRemoveAdminAccount) Set(fd protoreflect.FieldDescript
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgRemoveAdminAccount) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgBanBatch) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "florin.blacklist.v1.MsgRemoveAdminAccount.signer":
-		panic(fmt.Errorf("field signer of message florin.blacklist.v1.MsgRemoveAdminAccount is not mutable"))
-	case "florin.blacklist.v1.MsgRemoveAdminAccount.account":
-		panic(fmt.Errorf("field account of message florin.blacklist.v1.MsgRemoveAdminAccount is not mutable"))
+	case "florin.blacklist.v1.MsgBanBatch.adversaries":
+		if x.Adversaries == nil {
+			x.Adversaries = []string{}
+		}
+		value := &_MsgBanBatch_2_list{list: &x.Adversaries}
+		return protoreflect.ValueOfList(value)
+	case "florin.blacklist.v1.MsgBanBatch.signer":
+		panic(fmt.Errorf("field signer of message florin.blacklist.v1.MsgBanBatch is not mutable"))
+	case "florin.blacklist.v1.MsgBanBatch.denom":
+		panic(fmt.Errorf("field denom of message florin.blacklist.v1.MsgBanBatch is not mutable"))
+	case "florin.blacklist.v1.MsgBanBatch.expiry_height":
+		panic(fmt.Errorf("field expiry_height of message florin.blacklist.v1.MsgBanBatch is not mutable"))
+	case "florin.blacklist.v1.MsgBanBatch.expiry_time":
+		panic(fmt.Errorf("field expiry_time of message florin.blacklist.v1.MsgBanBatch is not mutable"))
+	case "florin.blacklist.v1.MsgBanBatch.reason":
+		panic(fmt.Errorf("field reason of message florin.blacklist.v1.MsgBanBatch is not mutable"))
+	case "florin.blacklist.v1.MsgBanBatch.case_id":
+		panic(fmt.Errorf("field case_id of message florin.blacklist.v1.MsgBanBatch is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.MsgRemoveAdminAccount"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.MsgBanBatch"))
 		}
-		panic(fmt.Errorf("message florin.blacklist.v1.MsgRemoveAdminAccount does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message florin.blacklist.v1.MsgBanBatch does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgRemoveAdminAccount) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgBanBatch) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "florin.blacklist.v1.MsgRemoveAdminAccount.signer":
+	case "florin.blacklist.v1.MsgBanBatch.signer":
 		return protoreflect.ValueOfString("")
-	case "florin.blacklist.v1.MsgRemoveAdminAccount.account":
+	case "florin.blacklist.v1.MsgBanBatch.adversaries":
+		list := []string{}
+		return protoreflect.ValueOfList(&_MsgBanBatch_2_list{list: &list})
+	case "florin.blacklist.v1.MsgBanBatch.denom":
+		return protoreflect.ValueOfString("")
+	case "florin.blacklist.v1.MsgBanBatch.expiry_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "florin.blacklist.v1.MsgBanBatch.expiry_time":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "florin.blacklist.v1.MsgBanBatch.reason":
+		return protoreflect.ValueOfEnum(0)
+	case "florin.blacklist.v1.MsgBanBatch.case_id":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.MsgRemoveAdminAccount"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.MsgBanBatch"))
 		}
-		panic(fmt.Errorf("message florin.blacklist.v1.MsgRemoveAdminAccount does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message florin.blacklist.v1.MsgBanBatch does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgRemoveAdminAccount) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgBanBatch) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in florin.blacklist.v1.MsgRemoveAdminAccount", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in florin.blacklist.v1.MsgBanBatch", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2710,7 +3207,7 @@ func (x *fastReflection_MsgRemoveAdminAccount) WhichOneof(d protoreflect.OneofDe
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgRemoveAdminAccount) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgBanBatch) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2721,7 +3218,7 @@ func (x *fastReflection_MsgRemoveAdminAccount) GetUnknown() protoreflect.RawFiel
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgRemoveAdminAccount) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgBanBatch) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2733,7 +3230,7 @@ func (x *fastReflection_MsgRemoveAdminAccount) SetUnknown(fields protoreflect.Ra
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgRemoveAdminAccount) IsValid() bool {
+func (x *fastReflection_MsgBanBatch) IsValid() bool {
 	return x != nil
 }
 
@@ -2743,9 +3240,9 @@ func (x *fastReflection_MsgRemoveAdminAccount) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgRemoveAdminAccount) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgBanBatch) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgRemoveAdminAccount)
+		x := input.Message.Interface().(*MsgBanBatch)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2761,7 +3258,26 @@ func (x *fastReflection_MsgRemoveAdminAccount) ProtoMethods() *protoiface.Method
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.Account)
+		if len(x.Adversaries) > 0 {
+			for _, s := range x.Adversaries {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.Denom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ExpiryHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExpiryHeight))
+		}
+		if x.ExpiryTime != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExpiryTime))
+		}
+		if x.Reason != 0 {
+			n += 1 + runtime.Sov(uint64(x.Reason))
+		}
+		l = len(x.CaseId)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -2775,7 +3291,7 @@ func (x *fastReflection_MsgRemoveAdminAccount) ProtoMethods() *protoiface.Method
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgRemoveAdminAccount)
+		x := input.Message.Interface().(*MsgBanBatch)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2794,12 +3310,43 @@ func (x *fastReflection_MsgRemoveAdminAccount) ProtoMethods() *protoiface.Method
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Account) > 0 {
-			i -= len(x.Account)
-			copy(dAtA[i:], x.Account)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Account)))
+		if len(x.CaseId) > 0 {
+			i -= len(x.CaseId)
+			copy(dAtA[i:], x.CaseId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CaseId)))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x3a
+		}
+		if x.Reason != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Reason))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.ExpiryTime != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExpiryTime))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.ExpiryHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExpiryHeight))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Adversaries) > 0 {
+			for iNdEx := len(x.Adversaries) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Adversaries[iNdEx])
+				copy(dAtA[i:], x.Adversaries[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Adversaries[iNdEx])))
+				i--
+				dAtA[i] = 0x12
+			}
 		}
 		if len(x.Signer) > 0 {
 			i -= len(x.Signer)
@@ -2819,7 +3366,7 @@ func (x *fastReflection_MsgRemoveAdminAccount) ProtoMethods() *protoiface.Method
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgRemoveAdminAccount)
+		x := input.Message.Interface().(*MsgBanBatch)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2851,10 +3398,10 @@ func (x *fastReflection_MsgRemoveAdminAccount) ProtoMethods() *protoiface.Method
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRemoveAdminAccount: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgBanBatch: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRemoveAdminAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgBanBatch: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -2891,7 +3438,7 @@ func (x *fastReflection_MsgRemoveAdminAccount) ProtoMethods() *protoiface.Method
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Adversaries", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -2919,7 +3466,128 @@ func (x *fastReflection_MsgRemoveAdminAccount) ProtoMethods() *protoiface.Method
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Account = string(dAtA[iNdEx:postIndex])
+				x.Adversaries = append(x.Adversaries, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Denom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+				}
+				x.ExpiryHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ExpiryHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpiryTime", wireType)
+				}
+				x.ExpiryTime = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ExpiryTime |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				}
+				x.Reason = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Reason |= BanReason(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CaseId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CaseId = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -2957,23 +3625,23 @@ func (x *fastReflection_MsgRemoveAdminAccount) ProtoMethods() *protoiface.Method
 }
 
 var (
-	md_MsgRemoveAdminAccountResponse protoreflect.MessageDescriptor
+	md_MsgBanBatchResponse protoreflect.MessageDescriptor
 )
 
 func init() {
 	file_florin_blacklist_v1_tx_proto_init()
-	md_MsgRemoveAdminAccountResponse = File_florin_blacklist_v1_tx_proto.Messages().ByName("MsgRemoveAdminAccountResponse")
+	md_MsgBanBatchResponse = File_florin_blacklist_v1_tx_proto.Messages().ByName("MsgBanBatchResponse")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgRemoveAdminAccountResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgBanBatchResponse)(nil)
 
-type fastReflection_MsgRemoveAdminAccountResponse MsgRemoveAdminAccountResponse
+type fastReflection_MsgBanBatchResponse MsgBanBatchResponse
 
-func (x *MsgRemoveAdminAccountResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgRemoveAdminAccountResponse)(x)
+func (x *MsgBanBatchResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgBanBatchResponse)(x)
 }
 
-func (x *MsgRemoveAdminAccountResponse) slowProtoReflect() protoreflect.Message {
+func (x *MsgBanBatchResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_florin_blacklist_v1_tx_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2985,43 +3653,43 @@ func (x *MsgRemoveAdminAccountResponse) slowProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgRemoveAdminAccountResponse_messageType fastReflection_MsgRemoveAdminAccountResponse_messageType
-var _ protoreflect.MessageType = fastReflection_MsgRemoveAdminAccountResponse_messageType{}
+var _fastReflection_MsgBanBatchResponse_messageType fastReflection_MsgBanBatchResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgBanBatchResponse_messageType{}
 
-type fastReflection_MsgRemoveAdminAccountResponse_messageType struct{}
+type fastReflection_MsgBanBatchResponse_messageType struct{}
 
-func (x fastReflection_MsgRemoveAdminAccountResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgRemoveAdminAccountResponse)(nil)
+func (x fastReflection_MsgBanBatchResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgBanBatchResponse)(nil)
 }
-func (x fastReflection_MsgRemoveAdminAccountResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgRemoveAdminAccountResponse)
+func (x fastReflection_MsgBanBatchResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgBanBatchResponse)
 }
-func (x fastReflection_MsgRemoveAdminAccountResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgRemoveAdminAccountResponse
+func (x fastReflection_MsgBanBatchResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgBanBatchResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgRemoveAdminAccountResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgRemoveAdminAccountResponse
+func (x *fastReflection_MsgBanBatchResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgBanBatchResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgRemoveAdminAccountResponse) Type() protoreflect.MessageType {
-	return _fastReflection_MsgRemoveAdminAccountResponse_messageType
+func (x *fastReflection_MsgBanBatchResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgBanBatchResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgRemoveAdminAccountResponse) New() protoreflect.Message {
-	return new(fastReflection_MsgRemoveAdminAccountResponse)
+func (x *fastReflection_MsgBanBatchResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgBanBatchResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgRemoveAdminAccountResponse) Interface() protoreflect.ProtoMessage {
-	return (*MsgRemoveAdminAccountResponse)(x)
+func (x *fastReflection_MsgBanBatchResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgBanBatchResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3029,7 +3697,7 @@ func (x *fastReflection_MsgRemoveAdminAccountResponse) Interface() protoreflect.
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgRemoveAdminAccountResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgBanBatchResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 }
 
 // Has reports whether a field is populated.
@@ -3043,13 +3711,13 @@ func (x *fastReflection_MsgRemoveAdminAccountResponse) Range(f func(protoreflect
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgRemoveAdminAccountResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgBanBatchResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.MsgRemoveAdminAccountResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.MsgBanBatchResponse"))
 		}
-		panic(fmt.Errorf("message florin.blacklist.v1.MsgRemoveAdminAccountResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message florin.blacklist.v1.MsgBanBatchResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3059,13 +3727,13 @@ func (x *fastReflection_MsgRemoveAdminAccountResponse) Has(fd protoreflect.Field
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgRemoveAdminAccountResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgBanBatchResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.MsgRemoveAdminAccountResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.MsgBanBatchResponse"))
 		}
-		panic(fmt.Errorf("message florin.blacklist.v1.MsgRemoveAdminAccountResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message florin.blacklist.v1.MsgBanBatchResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3075,13 +3743,13 @@ func (x *fastReflection_MsgRemoveAdminAccountResponse) Clear(fd protoreflect.Fie
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgRemoveAdminAccountResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgBanBatchResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.MsgRemoveAdminAccountResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.MsgBanBatchResponse"))
 		}
-		panic(fmt.Errorf("message florin.blacklist.v1.MsgRemoveAdminAccountResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message florin.blacklist.v1.MsgBanBatchResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3095,13 +3763,13 @@ func (x *fastReflection_MsgRemoveAdminAccountResponse) Get(descriptor protorefle
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgRemoveAdminAccountResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgBanBatchResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.MsgRemoveAdminAccountResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.MsgBanBatchResponse"))
 		}
-		panic(fmt.Errorf("message florin.blacklist.v1.MsgRemoveAdminAccountResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message florin.blacklist.v1.MsgBanBatchResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3115,36 +3783,36 @@ func (x *fastReflection_MsgRemoveAdminAccountResponse) Set(fd protoreflect.Field
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgRemoveAdminAccountResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgBanBatchResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.MsgRemoveAdminAccountResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.MsgBanBatchResponse"))
 		}
-		panic(fmt.Errorf("message florin.blacklist.v1.MsgRemoveAdminAccountResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message florin.blacklist.v1.MsgBanBatchResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgRemoveAdminAccountResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgBanBatchResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.MsgRemoveAdminAccountResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.MsgBanBatchResponse"))
 		}
-		panic(fmt.Errorf("message florin.blacklist.v1.MsgRemoveAdminAccountResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message florin.blacklist.v1.MsgBanBatchResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgRemoveAdminAccountResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgBanBatchResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in florin.blacklist.v1.MsgRemoveAdminAccountResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in florin.blacklist.v1.MsgBanBatchResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3152,7 +3820,7 @@ func (x *fastReflection_MsgRemoveAdminAccountResponse) WhichOneof(d protoreflect
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgRemoveAdminAccountResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgBanBatchResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3163,7 +3831,7 @@ func (x *fastReflection_MsgRemoveAdminAccountResponse) GetUnknown() protoreflect
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgRemoveAdminAccountResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgBanBatchResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3175,7 +3843,7 @@ func (x *fastReflection_MsgRemoveAdminAccountResponse) SetUnknown(fields protore
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgRemoveAdminAccountResponse) IsValid() bool {
+func (x *fastReflection_MsgBanBatchResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -3185,9 +3853,9 @@ func (x *fastReflection_MsgRemoveAdminAccountResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgRemoveAdminAccountResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgBanBatchResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgRemoveAdminAccountResponse)
+		x := input.Message.Interface().(*MsgBanBatchResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3209,7 +3877,7 @@ func (x *fastReflection_MsgRemoveAdminAccountResponse) ProtoMethods() *protoifac
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgRemoveAdminAccountResponse)
+		x := input.Message.Interface().(*MsgBanBatchResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3239,7 +3907,7 @@ func (x *fastReflection_MsgRemoveAdminAccountResponse) ProtoMethods() *protoifac
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgRemoveAdminAccountResponse)
+		x := input.Message.Interface().(*MsgBanBatchResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3271,10 +3939,10 @@ func (x *fastReflection_MsgRemoveAdminAccountResponse) ProtoMethods() *protoifac
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRemoveAdminAccountResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgBanBatchResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRemoveAdminAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgBanBatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			default:
@@ -3313,27 +3981,29 @@ func (x *fastReflection_MsgRemoveAdminAccountResponse) ProtoMethods() *protoifac
 }
 
 var (
-	md_MsgTransferOwnership           protoreflect.MessageDescriptor
-	fd_MsgTransferOwnership_signer    protoreflect.FieldDescriptor
-	fd_MsgTransferOwnership_new_owner protoreflect.FieldDescriptor
+	md_MsgRemoveAdminAccount         protoreflect.MessageDescriptor
+	fd_MsgRemoveAdminAccount_signer  protoreflect.FieldDescriptor
+	fd_MsgRemoveAdminAccount_account protoreflect.FieldDescriptor
+	fd_MsgRemoveAdminAccount_denom   protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_florin_blacklist_v1_tx_proto_init()
-	md_MsgTransferOwnership = File_florin_blacklist_v1_tx_proto.Messages().ByName("MsgTransferOwnership")
-	fd_MsgTransferOwnership_signer = md_MsgTransferOwnership.Fields().ByName("signer")
-	fd_MsgTransferOwnership_new_owner = md_MsgTransferOwnership.Fields().ByName("new_owner")
+	md_MsgRemoveAdminAccount = File_florin_blacklist_v1_tx_proto.Messages().ByName("MsgRemoveAdminAccount")
+	fd_MsgRemoveAdminAccount_signer = md_MsgRemoveAdminAccount.Fields().ByName("signer")
+	fd_MsgRemoveAdminAccount_account = md_MsgRemoveAdminAccount.Fields().ByName("account")
+	fd_MsgRemoveAdminAccount_denom = md_MsgRemoveAdminAccount.Fields().ByName("denom")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgTransferOwnership)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgRemoveAdminAccount)(nil)
 
-type fastReflection_MsgTransferOwnership MsgTransferOwnership
+type fastReflection_MsgRemoveAdminAccount MsgRemoveAdminAccount
 
-func (x *MsgTransferOwnership) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgTransferOwnership)(x)
+func (x *MsgRemoveAdminAccount) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgRemoveAdminAccount)(x)
 }
 
-func (x *MsgTransferOwnership) slowProtoReflect() protoreflect.Message {
+func (x *MsgRemoveAdminAccount) slowProtoReflect() protoreflect.Message {
 	mi := &file_florin_blacklist_v1_tx_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3345,43 +4015,43 @@ func (x *MsgTransferOwnership) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgTransferOwnership_messageType fastReflection_MsgTransferOwnership_messageType
-var _ protoreflect.MessageType = fastReflection_MsgTransferOwnership_messageType{}
+var _fastReflection_MsgRemoveAdminAccount_messageType fastReflection_MsgRemoveAdminAccount_messageType
+var _ protoreflect.MessageType = fastReflection_MsgRemoveAdminAccount_messageType{}
 
-type fastReflection_MsgTransferOwnership_messageType struct{}
+type fastReflection_MsgRemoveAdminAccount_messageType struct{}
 
-func (x fastReflection_MsgTransferOwnership_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgTransferOwnership)(nil)
-}
-func (x fastReflection_MsgTransferOwnership_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgTransferOwnership)
+func (x fastReflection_MsgRemoveAdminAccount_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgRemoveAdminAccount)(nil)
 }
-func (x fastReflection_MsgTransferOwnership_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgTransferOwnership
+func (x fastReflection_MsgRemoveAdminAccount_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgRemoveAdminAccount)
+}
+func (x fastReflection_MsgRemoveAdminAccount_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRemoveAdminAccount
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgTransferOwnership) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgTransferOwnership
+func (x *fastReflection_MsgRemoveAdminAccount) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRemoveAdminAccount
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgTransferOwnership) Type() protoreflect.MessageType {
-	return _fastReflection_MsgTransferOwnership_messageType
+func (x *fastReflection_MsgRemoveAdminAccount) Type() protoreflect.MessageType {
+	return _fastReflection_MsgRemoveAdminAccount_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgTransferOwnership) New() protoreflect.Message {
-	return new(fastReflection_MsgTransferOwnership)
+func (x *fastReflection_MsgRemoveAdminAccount) New() protoreflect.Message {
+	return new(fastReflection_MsgRemoveAdminAccount)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgTransferOwnership) Interface() protoreflect.ProtoMessage {
-	return (*MsgTransferOwnership)(x)
+func (x *fastReflection_MsgRemoveAdminAccount) Interface() protoreflect.ProtoMessage {
+	return (*MsgRemoveAdminAccount)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3389,16 +4059,22 @@ func (x *fastReflection_MsgTransferOwnership) Interface() protoreflect.ProtoMess
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgTransferOwnership) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgRemoveAdminAccount) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.Signer != "" {
 		value := protoreflect.ValueOfString(x.Signer)
-		if !f(fd_MsgTransferOwnership_signer, value) {
+		if !f(fd_MsgRemoveAdminAccount_signer, value) {
 			return
 		}
 	}
-	if x.NewOwner != "" {
-		value := protoreflect.ValueOfString(x.NewOwner)
-		if !f(fd_MsgTransferOwnership_new_owner, value) {
+	if x.Account != "" {
+		value := protoreflect.ValueOfString(x.Account)
+		if !f(fd_MsgRemoveAdminAccount_account, value) {
+			return
+		}
+	}
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_MsgRemoveAdminAccount_denom, value) {
 			return
 		}
 	}
@@ -3415,17 +4091,19 @@ func (x *fastReflection_MsgTransferOwnership) Range(f func(protoreflect.FieldDes
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgTransferOwnership) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgRemoveAdminAccount) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "florin.blacklist.v1.MsgTransferOwnership.signer":
+	case "florin.blacklist.v1.MsgRemoveAdminAccount.signer":
 		return x.Signer != ""
-	case "florin.blacklist.v1.MsgTransferOwnership.new_owner":
-		return x.NewOwner != ""
+	case "florin.blacklist.v1.MsgRemoveAdminAccount.account":
+		return x.Account != ""
+	case "florin.blacklist.v1.MsgRemoveAdminAccount.denom":
+		return x.Denom != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.MsgTransferOwnership"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.MsgRemoveAdminAccount"))
 		}
-		panic(fmt.Errorf("message florin.blacklist.v1.MsgTransferOwnership does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message florin.blacklist.v1.MsgRemoveAdminAccount does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3435,17 +4113,19 @@ func (x *fastReflection_MsgTransferOwnership) Has(fd protoreflect.FieldDescripto
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgTransferOwnership) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgRemoveAdminAccount) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "florin.blacklist.v1.MsgTransferOwnership.signer":
+	case "florin.blacklist.v1.MsgRemoveAdminAccount.signer":
 		x.Signer = ""
-	case "florin.blacklist.v1.MsgTransferOwnership.new_owner":
-		x.NewOwner = ""
+	case "florin.blacklist.v1.MsgRemoveAdminAccount.account":
+		x.Account = ""
+	case "florin.blacklist.v1.MsgRemoveAdminAccount.denom":
+		x.Denom = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.MsgTransferOwnership"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.MsgRemoveAdminAccount"))
 		}
-		panic(fmt.Errorf("message florin.blacklist.v1.MsgTransferOwnership does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message florin.blacklist.v1.MsgRemoveAdminAccount does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3455,19 +4135,22 @@ func (x *fastReflection_MsgTransferOwnership) Clear(fd protoreflect.FieldDescrip
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgTransferOwnership) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgRemoveAdminAccount) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "florin.blacklist.v1.MsgTransferOwnership.signer":
+	case "florin.blacklist.v1.MsgRemoveAdminAccount.signer":
 		value := x.Signer
 		return protoreflect.ValueOfString(value)
-	case "florin.blacklist.v1.MsgTransferOwnership.new_owner":
-		value := x.NewOwner
+	case "florin.blacklist.v1.MsgRemoveAdminAccount.account":
+		value := x.Account
+		return protoreflect.ValueOfString(value)
+	case "florin.blacklist.v1.MsgRemoveAdminAccount.denom":
+		value := x.Denom
 		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.MsgTransferOwnership"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.MsgRemoveAdminAccount"))
 		}
-		panic(fmt.Errorf("message florin.blacklist.v1.MsgTransferOwnership does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message florin.blacklist.v1.MsgRemoveAdminAccount does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3481,17 +4164,19 @@ func (x *fastReflection_MsgTransferOwnership) Get(descriptor protoreflect.FieldD
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgTransferOwnership) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgRemoveAdminAccount) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "florin.blacklist.v1.MsgTransferOwnership.signer":
+	case "florin.blacklist.v1.MsgRemoveAdminAccount.signer":
 		x.Signer = value.Interface().(string)
-	case "florin.blacklist.v1.MsgTransferOwnership.new_owner":
-		x.NewOwner = value.Interface().(string)
+	case "florin.blacklist.v1.MsgRemoveAdminAccount.account":
+		x.Account = value.Interface().(string)
+	case "florin.blacklist.v1.MsgRemoveAdminAccount.denom":
+		x.Denom = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.MsgTransferOwnership"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.MsgRemoveAdminAccount"))
 		}
-		panic(fmt.Errorf("message florin.blacklist.v1.MsgTransferOwnership does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message florin.blacklist.v1.MsgRemoveAdminAccount does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3505,44 +4190,48 @@ func (x *fastReflection_MsgTransferOwnership) Set(fd protoreflect.FieldDescripto
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgTransferOwnership) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgRemoveAdminAccount) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "florin.blacklist.v1.MsgTransferOwnership.signer":
-		panic(fmt.Errorf("field signer of message florin.blacklist.v1.MsgTransferOwnership is not mutable"))
-	case "florin.blacklist.v1.MsgTransferOwnership.new_owner":
-		panic(fmt.Errorf("field new_owner of message florin.blacklist.v1.MsgTransferOwnership is not mutable"))
+	case "florin.blacklist.v1.MsgRemoveAdminAccount.signer":
+		panic(fmt.Errorf("field signer of message florin.blacklist.v1.MsgRemoveAdminAccount is not mutable"))
+	case "florin.blacklist.v1.MsgRemoveAdminAccount.account":
+		panic(fmt.Errorf("field account of message florin.blacklist.v1.MsgRemoveAdminAccount is not mutable"))
+	case "florin.blacklist.v1.MsgRemoveAdminAccount.denom":
+		panic(fmt.Errorf("field denom of message florin.blacklist.v1.MsgRemoveAdminAccount is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.MsgTransferOwnership"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.MsgRemoveAdminAccount"))
 		}
-		panic(fmt.Errorf("message florin.blacklist.v1.MsgTransferOwnership does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message florin.blacklist.v1.MsgRemoveAdminAccount does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgTransferOwnership) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgRemoveAdminAccount) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "florin.blacklist.v1.MsgTransferOwnership.signer":
+	case "florin.blacklist.v1.MsgRemoveAdminAccount.signer":
 		return protoreflect.ValueOfString("")
-	case "florin.blacklist.v1.MsgTransferOwnership.new_owner":
+	case "florin.blacklist.v1.MsgRemoveAdminAccount.account":
+		return protoreflect.ValueOfString("")
+	case "florin.blacklist.v1.MsgRemoveAdminAccount.denom":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.MsgTransferOwnership"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.MsgRemoveAdminAccount"))
 		}
-		panic(fmt.Errorf("message florin.blacklist.v1.MsgTransferOwnership does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message florin.blacklist.v1.MsgRemoveAdminAccount does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgTransferOwnership) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgRemoveAdminAccount) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in florin.blacklist.v1.MsgTransferOwnership", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in florin.blacklist.v1.MsgRemoveAdminAccount", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3550,7 +4239,7 @@ func (x *fastReflection_MsgTransferOwnership) WhichOneof(d protoreflect.OneofDes
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgTransferOwnership) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgRemoveAdminAccount) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3561,7 +4250,7 @@ func (x *fastReflection_MsgTransferOwnership) GetUnknown() protoreflect.RawField
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgTransferOwnership) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgRemoveAdminAccount) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3573,7 +4262,7 @@ func (x *fastReflection_MsgTransferOwnership) SetUnknown(fields protoreflect.Raw
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgTransferOwnership) IsValid() bool {
+func (x *fastReflection_MsgRemoveAdminAccount) IsValid() bool {
 	return x != nil
 }
 
@@ -3583,9 +4272,9 @@ func (x *fastReflection_MsgTransferOwnership) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgTransferOwnership) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgRemoveAdminAccount) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgTransferOwnership)
+		x := input.Message.Interface().(*MsgRemoveAdminAccount)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3601,7 +4290,11 @@ func (x *fastReflection_MsgTransferOwnership) ProtoMethods() *protoiface.Methods
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.NewOwner)
+		l = len(x.Account)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Denom)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -3615,7 +4308,7 @@ func (x *fastReflection_MsgTransferOwnership) ProtoMethods() *protoiface.Methods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgTransferOwnership)
+		x := input.Message.Interface().(*MsgRemoveAdminAccount)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3634,10 +4327,17 @@ func (x *fastReflection_MsgTransferOwnership) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.NewOwner) > 0 {
-			i -= len(x.NewOwner)
-			copy(dAtA[i:], x.NewOwner)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NewOwner)))
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Account) > 0 {
+			i -= len(x.Account)
+			copy(dAtA[i:], x.Account)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Account)))
 			i--
 			dAtA[i] = 0x12
 		}
@@ -3659,7 +4359,7 @@ func (x *fastReflection_MsgTransferOwnership) ProtoMethods() *protoiface.Methods
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgTransferOwnership)
+		x := input.Message.Interface().(*MsgRemoveAdminAccount)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3691,10 +4391,10 @@ func (x *fastReflection_MsgTransferOwnership) ProtoMethods() *protoiface.Methods
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgTransferOwnership: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRemoveAdminAccount: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgTransferOwnership: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRemoveAdminAccount: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -3731,7 +4431,7 @@ func (x *fastReflection_MsgTransferOwnership) ProtoMethods() *protoiface.Methods
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -3759,7 +4459,39 @@ func (x *fastReflection_MsgTransferOwnership) ProtoMethods() *protoiface.Methods
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.NewOwner = string(dAtA[iNdEx:postIndex])
+				x.Account = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Denom = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -3797,23 +4529,23 @@ func (x *fastReflection_MsgTransferOwnership) ProtoMethods() *protoiface.Methods
 }
 
 var (
-	md_MsgTransferOwnershipResponse protoreflect.MessageDescriptor
+	md_MsgRemoveAdminAccountResponse protoreflect.MessageDescriptor
 )
 
 func init() {
 	file_florin_blacklist_v1_tx_proto_init()
-	md_MsgTransferOwnershipResponse = File_florin_blacklist_v1_tx_proto.Messages().ByName("MsgTransferOwnershipResponse")
+	md_MsgRemoveAdminAccountResponse = File_florin_blacklist_v1_tx_proto.Messages().ByName("MsgRemoveAdminAccountResponse")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgTransferOwnershipResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgRemoveAdminAccountResponse)(nil)
 
-type fastReflection_MsgTransferOwnershipResponse MsgTransferOwnershipResponse
+type fastReflection_MsgRemoveAdminAccountResponse MsgRemoveAdminAccountResponse
 
-func (x *MsgTransferOwnershipResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgTransferOwnershipResponse)(x)
+func (x *MsgRemoveAdminAccountResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgRemoveAdminAccountResponse)(x)
 }
 
-func (x *MsgTransferOwnershipResponse) slowProtoReflect() protoreflect.Message {
+func (x *MsgRemoveAdminAccountResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_florin_blacklist_v1_tx_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3825,43 +4557,1787 @@ func (x *MsgTransferOwnershipResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgTransferOwnershipResponse_messageType fastReflection_MsgTransferOwnershipResponse_messageType
-var _ protoreflect.MessageType = fastReflection_MsgTransferOwnershipResponse_messageType{}
+var _fastReflection_MsgRemoveAdminAccountResponse_messageType fastReflection_MsgRemoveAdminAccountResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgRemoveAdminAccountResponse_messageType{}
 
-type fastReflection_MsgTransferOwnershipResponse_messageType struct{}
+type fastReflection_MsgRemoveAdminAccountResponse_messageType struct{}
 
-func (x fastReflection_MsgTransferOwnershipResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgTransferOwnershipResponse)(nil)
+func (x fastReflection_MsgRemoveAdminAccountResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgRemoveAdminAccountResponse)(nil)
 }
-func (x fastReflection_MsgTransferOwnershipResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgTransferOwnershipResponse)
+func (x fastReflection_MsgRemoveAdminAccountResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgRemoveAdminAccountResponse)
 }
-func (x fastReflection_MsgTransferOwnershipResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgTransferOwnershipResponse
+func (x fastReflection_MsgRemoveAdminAccountResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRemoveAdminAccountResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgTransferOwnershipResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgTransferOwnershipResponse
+func (x *fastReflection_MsgRemoveAdminAccountResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRemoveAdminAccountResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgTransferOwnershipResponse) Type() protoreflect.MessageType {
-	return _fastReflection_MsgTransferOwnershipResponse_messageType
+func (x *fastReflection_MsgRemoveAdminAccountResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgRemoveAdminAccountResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgRemoveAdminAccountResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgRemoveAdminAccountResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgRemoveAdminAccountResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgRemoveAdminAccountResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgRemoveAdminAccountResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgRemoveAdminAccountResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.MsgRemoveAdminAccountResponse"))
+		}
+		panic(fmt.Errorf("message florin.blacklist.v1.MsgRemoveAdminAccountResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRemoveAdminAccountResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.MsgRemoveAdminAccountResponse"))
+		}
+		panic(fmt.Errorf("message florin.blacklist.v1.MsgRemoveAdminAccountResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgRemoveAdminAccountResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.MsgRemoveAdminAccountResponse"))
+		}
+		panic(fmt.Errorf("message florin.blacklist.v1.MsgRemoveAdminAccountResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRemoveAdminAccountResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.MsgRemoveAdminAccountResponse"))
+		}
+		panic(fmt.Errorf("message florin.blacklist.v1.MsgRemoveAdminAccountResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRemoveAdminAccountResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.MsgRemoveAdminAccountResponse"))
+		}
+		panic(fmt.Errorf("message florin.blacklist.v1.MsgRemoveAdminAccountResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgRemoveAdminAccountResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.MsgRemoveAdminAccountResponse"))
+		}
+		panic(fmt.Errorf("message florin.blacklist.v1.MsgRemoveAdminAccountResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgRemoveAdminAccountResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in florin.blacklist.v1.MsgRemoveAdminAccountResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgRemoveAdminAccountResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRemoveAdminAccountResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgRemoveAdminAccountResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgRemoveAdminAccountResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgRemoveAdminAccountResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRemoveAdminAccountResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRemoveAdminAccountResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRemoveAdminAccountResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRemoveAdminAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgTransferOwnership           protoreflect.MessageDescriptor
+	fd_MsgTransferOwnership_signer    protoreflect.FieldDescriptor
+	fd_MsgTransferOwnership_new_owner protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_florin_blacklist_v1_tx_proto_init()
+	md_MsgTransferOwnership = File_florin_blacklist_v1_tx_proto.Messages().ByName("MsgTransferOwnership")
+	fd_MsgTransferOwnership_signer = md_MsgTransferOwnership.Fields().ByName("signer")
+	fd_MsgTransferOwnership_new_owner = md_MsgTransferOwnership.Fields().ByName("new_owner")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgTransferOwnership)(nil)
+
+type fastReflection_MsgTransferOwnership MsgTransferOwnership
+
+func (x *MsgTransferOwnership) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgTransferOwnership)(x)
+}
+
+func (x *MsgTransferOwnership) slowProtoReflect() protoreflect.Message {
+	mi := &file_florin_blacklist_v1_tx_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgTransferOwnership_messageType fastReflection_MsgTransferOwnership_messageType
+var _ protoreflect.MessageType = fastReflection_MsgTransferOwnership_messageType{}
+
+type fastReflection_MsgTransferOwnership_messageType struct{}
+
+func (x fastReflection_MsgTransferOwnership_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgTransferOwnership)(nil)
+}
+func (x fastReflection_MsgTransferOwnership_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgTransferOwnership)
+}
+func (x fastReflection_MsgTransferOwnership_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgTransferOwnership
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgTransferOwnership) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgTransferOwnership
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgTransferOwnership) Type() protoreflect.MessageType {
+	return _fastReflection_MsgTransferOwnership_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgTransferOwnership) New() protoreflect.Message {
+	return new(fastReflection_MsgTransferOwnership)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgTransferOwnership) Interface() protoreflect.ProtoMessage {
+	return (*MsgTransferOwnership)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgTransferOwnership) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Signer != "" {
+		value := protoreflect.ValueOfString(x.Signer)
+		if !f(fd_MsgTransferOwnership_signer, value) {
+			return
+		}
+	}
+	if x.NewOwner != "" {
+		value := protoreflect.ValueOfString(x.NewOwner)
+		if !f(fd_MsgTransferOwnership_new_owner, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgTransferOwnership) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "florin.blacklist.v1.MsgTransferOwnership.signer":
+		return x.Signer != ""
+	case "florin.blacklist.v1.MsgTransferOwnership.new_owner":
+		return x.NewOwner != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.MsgTransferOwnership"))
+		}
+		panic(fmt.Errorf("message florin.blacklist.v1.MsgTransferOwnership does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgTransferOwnership) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "florin.blacklist.v1.MsgTransferOwnership.signer":
+		x.Signer = ""
+	case "florin.blacklist.v1.MsgTransferOwnership.new_owner":
+		x.NewOwner = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.MsgTransferOwnership"))
+		}
+		panic(fmt.Errorf("message florin.blacklist.v1.MsgTransferOwnership does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgTransferOwnership) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "florin.blacklist.v1.MsgTransferOwnership.signer":
+		value := x.Signer
+		return protoreflect.ValueOfString(value)
+	case "florin.blacklist.v1.MsgTransferOwnership.new_owner":
+		value := x.NewOwner
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.MsgTransferOwnership"))
+		}
+		panic(fmt.Errorf("message florin.blacklist.v1.MsgTransferOwnership does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgTransferOwnership) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "florin.blacklist.v1.MsgTransferOwnership.signer":
+		x.Signer = value.Interface().(string)
+	case "florin.blacklist.v1.MsgTransferOwnership.new_owner":
+		x.NewOwner = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.MsgTransferOwnership"))
+		}
+		panic(fmt.Errorf("message florin.blacklist.v1.MsgTransferOwnership does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgTransferOwnership) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "florin.blacklist.v1.MsgTransferOwnership.signer":
+		panic(fmt.Errorf("field signer of message florin.blacklist.v1.MsgTransferOwnership is not mutable"))
+	case "florin.blacklist.v1.MsgTransferOwnership.new_owner":
+		panic(fmt.Errorf("field new_owner of message florin.blacklist.v1.MsgTransferOwnership is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.MsgTransferOwnership"))
+		}
+		panic(fmt.Errorf("message florin.blacklist.v1.MsgTransferOwnership does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgTransferOwnership) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "florin.blacklist.v1.MsgTransferOwnership.signer":
+		return protoreflect.ValueOfString("")
+	case "florin.blacklist.v1.MsgTransferOwnership.new_owner":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.MsgTransferOwnership"))
+		}
+		panic(fmt.Errorf("message florin.blacklist.v1.MsgTransferOwnership does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgTransferOwnership) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in florin.blacklist.v1.MsgTransferOwnership", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgTransferOwnership) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgTransferOwnership) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgTransferOwnership) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgTransferOwnership) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgTransferOwnership)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Signer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.NewOwner)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgTransferOwnership)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.NewOwner) > 0 {
+			i -= len(x.NewOwner)
+			copy(dAtA[i:], x.NewOwner)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NewOwner)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Signer) > 0 {
+			i -= len(x.Signer)
+			copy(dAtA[i:], x.Signer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signer)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgTransferOwnership)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgTransferOwnership: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgTransferOwnership: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Signer = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.NewOwner = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgTransferOwnershipResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_florin_blacklist_v1_tx_proto_init()
+	md_MsgTransferOwnershipResponse = File_florin_blacklist_v1_tx_proto.Messages().ByName("MsgTransferOwnershipResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgTransferOwnershipResponse)(nil)
+
+type fastReflection_MsgTransferOwnershipResponse MsgTransferOwnershipResponse
+
+func (x *MsgTransferOwnershipResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgTransferOwnershipResponse)(x)
+}
+
+func (x *MsgTransferOwnershipResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_florin_blacklist_v1_tx_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgTransferOwnershipResponse_messageType fastReflection_MsgTransferOwnershipResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgTransferOwnershipResponse_messageType{}
+
+type fastReflection_MsgTransferOwnershipResponse_messageType struct{}
+
+func (x fastReflection_MsgTransferOwnershipResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgTransferOwnershipResponse)(nil)
+}
+func (x fastReflection_MsgTransferOwnershipResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgTransferOwnershipResponse)
+}
+func (x fastReflection_MsgTransferOwnershipResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgTransferOwnershipResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgTransferOwnershipResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgTransferOwnershipResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgTransferOwnershipResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgTransferOwnershipResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgTransferOwnershipResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgTransferOwnershipResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgTransferOwnershipResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgTransferOwnershipResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgTransferOwnershipResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgTransferOwnershipResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.MsgTransferOwnershipResponse"))
+		}
+		panic(fmt.Errorf("message florin.blacklist.v1.MsgTransferOwnershipResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgTransferOwnershipResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.MsgTransferOwnershipResponse"))
+		}
+		panic(fmt.Errorf("message florin.blacklist.v1.MsgTransferOwnershipResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgTransferOwnershipResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.MsgTransferOwnershipResponse"))
+		}
+		panic(fmt.Errorf("message florin.blacklist.v1.MsgTransferOwnershipResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgTransferOwnershipResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.MsgTransferOwnershipResponse"))
+		}
+		panic(fmt.Errorf("message florin.blacklist.v1.MsgTransferOwnershipResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgTransferOwnershipResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.MsgTransferOwnershipResponse"))
+		}
+		panic(fmt.Errorf("message florin.blacklist.v1.MsgTransferOwnershipResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgTransferOwnershipResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.MsgTransferOwnershipResponse"))
+		}
+		panic(fmt.Errorf("message florin.blacklist.v1.MsgTransferOwnershipResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgTransferOwnershipResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in florin.blacklist.v1.MsgTransferOwnershipResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgTransferOwnershipResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgTransferOwnershipResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgTransferOwnershipResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgTransferOwnershipResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgTransferOwnershipResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgTransferOwnershipResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgTransferOwnershipResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgTransferOwnershipResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgTransferOwnershipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgUnban        protoreflect.MessageDescriptor
+	fd_MsgUnban_signer protoreflect.FieldDescriptor
+	fd_MsgUnban_friend protoreflect.FieldDescriptor
+	fd_MsgUnban_denom  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_florin_blacklist_v1_tx_proto_init()
+	md_MsgUnban = File_florin_blacklist_v1_tx_proto.Messages().ByName("MsgUnban")
+	fd_MsgUnban_signer = md_MsgUnban.Fields().ByName("signer")
+	fd_MsgUnban_friend = md_MsgUnban.Fields().ByName("friend")
+	fd_MsgUnban_denom = md_MsgUnban.Fields().ByName("denom")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgUnban)(nil)
+
+type fastReflection_MsgUnban MsgUnban
+
+func (x *MsgUnban) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUnban)(x)
+}
+
+func (x *MsgUnban) slowProtoReflect() protoreflect.Message {
+	mi := &file_florin_blacklist_v1_tx_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgUnban_messageType fastReflection_MsgUnban_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUnban_messageType{}
+
+type fastReflection_MsgUnban_messageType struct{}
+
+func (x fastReflection_MsgUnban_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUnban)(nil)
+}
+func (x fastReflection_MsgUnban_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUnban)
+}
+func (x fastReflection_MsgUnban_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUnban
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgUnban) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUnban
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgUnban) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUnban_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgUnban) New() protoreflect.Message {
+	return new(fastReflection_MsgUnban)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgUnban) Interface() protoreflect.ProtoMessage {
+	return (*MsgUnban)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgUnban) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Signer != "" {
+		value := protoreflect.ValueOfString(x.Signer)
+		if !f(fd_MsgUnban_signer, value) {
+			return
+		}
+	}
+	if x.Friend != "" {
+		value := protoreflect.ValueOfString(x.Friend)
+		if !f(fd_MsgUnban_friend, value) {
+			return
+		}
+	}
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_MsgUnban_denom, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgUnban) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "florin.blacklist.v1.MsgUnban.signer":
+		return x.Signer != ""
+	case "florin.blacklist.v1.MsgUnban.friend":
+		return x.Friend != ""
+	case "florin.blacklist.v1.MsgUnban.denom":
+		return x.Denom != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.MsgUnban"))
+		}
+		panic(fmt.Errorf("message florin.blacklist.v1.MsgUnban does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUnban) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "florin.blacklist.v1.MsgUnban.signer":
+		x.Signer = ""
+	case "florin.blacklist.v1.MsgUnban.friend":
+		x.Friend = ""
+	case "florin.blacklist.v1.MsgUnban.denom":
+		x.Denom = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.MsgUnban"))
+		}
+		panic(fmt.Errorf("message florin.blacklist.v1.MsgUnban does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgUnban) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "florin.blacklist.v1.MsgUnban.signer":
+		value := x.Signer
+		return protoreflect.ValueOfString(value)
+	case "florin.blacklist.v1.MsgUnban.friend":
+		value := x.Friend
+		return protoreflect.ValueOfString(value)
+	case "florin.blacklist.v1.MsgUnban.denom":
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.MsgUnban"))
+		}
+		panic(fmt.Errorf("message florin.blacklist.v1.MsgUnban does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUnban) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "florin.blacklist.v1.MsgUnban.signer":
+		x.Signer = value.Interface().(string)
+	case "florin.blacklist.v1.MsgUnban.friend":
+		x.Friend = value.Interface().(string)
+	case "florin.blacklist.v1.MsgUnban.denom":
+		x.Denom = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.MsgUnban"))
+		}
+		panic(fmt.Errorf("message florin.blacklist.v1.MsgUnban does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUnban) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "florin.blacklist.v1.MsgUnban.signer":
+		panic(fmt.Errorf("field signer of message florin.blacklist.v1.MsgUnban is not mutable"))
+	case "florin.blacklist.v1.MsgUnban.friend":
+		panic(fmt.Errorf("field friend of message florin.blacklist.v1.MsgUnban is not mutable"))
+	case "florin.blacklist.v1.MsgUnban.denom":
+		panic(fmt.Errorf("field denom of message florin.blacklist.v1.MsgUnban is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.MsgUnban"))
+		}
+		panic(fmt.Errorf("message florin.blacklist.v1.MsgUnban does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgUnban) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "florin.blacklist.v1.MsgUnban.signer":
+		return protoreflect.ValueOfString("")
+	case "florin.blacklist.v1.MsgUnban.friend":
+		return protoreflect.ValueOfString("")
+	case "florin.blacklist.v1.MsgUnban.denom":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.MsgUnban"))
+		}
+		panic(fmt.Errorf("message florin.blacklist.v1.MsgUnban does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgUnban) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in florin.blacklist.v1.MsgUnban", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgUnban) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUnban) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgUnban) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgUnban) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgUnban)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Signer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Friend)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Denom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUnban)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Friend) > 0 {
+			i -= len(x.Friend)
+			copy(dAtA[i:], x.Friend)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Friend)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Signer) > 0 {
+			i -= len(x.Signer)
+			copy(dAtA[i:], x.Signer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signer)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUnban)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUnban: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUnban: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Signer = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Friend", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Friend = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Denom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgUnbanResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_florin_blacklist_v1_tx_proto_init()
+	md_MsgUnbanResponse = File_florin_blacklist_v1_tx_proto.Messages().ByName("MsgUnbanResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgUnbanResponse)(nil)
+
+type fastReflection_MsgUnbanResponse MsgUnbanResponse
+
+func (x *MsgUnbanResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUnbanResponse)(x)
+}
+
+func (x *MsgUnbanResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_florin_blacklist_v1_tx_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgUnbanResponse_messageType fastReflection_MsgUnbanResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUnbanResponse_messageType{}
+
+type fastReflection_MsgUnbanResponse_messageType struct{}
+
+func (x fastReflection_MsgUnbanResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUnbanResponse)(nil)
+}
+func (x fastReflection_MsgUnbanResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUnbanResponse)
+}
+func (x fastReflection_MsgUnbanResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUnbanResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgUnbanResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUnbanResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgUnbanResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUnbanResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgTransferOwnershipResponse) New() protoreflect.Message {
-	return new(fastReflection_MsgTransferOwnershipResponse)
+func (x *fastReflection_MsgUnbanResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgUnbanResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgTransferOwnershipResponse) Interface() protoreflect.ProtoMessage {
-	return (*MsgTransferOwnershipResponse)(x)
+func (x *fastReflection_MsgUnbanResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgUnbanResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3869,7 +6345,7 @@ func (x *fastReflection_MsgTransferOwnershipResponse) Interface() protoreflect.P
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgTransferOwnershipResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgUnbanResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 }
 
 // Has reports whether a field is populated.
@@ -3883,13 +6359,13 @@ func (x *fastReflection_MsgTransferOwnershipResponse) Range(f func(protoreflect.
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgTransferOwnershipResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgUnbanResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.MsgTransferOwnershipResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.MsgUnbanResponse"))
 		}
-		panic(fmt.Errorf("message florin.blacklist.v1.MsgTransferOwnershipResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message florin.blacklist.v1.MsgUnbanResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3899,13 +6375,13 @@ func (x *fastReflection_MsgTransferOwnershipResponse) Has(fd protoreflect.FieldD
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgTransferOwnershipResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgUnbanResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.MsgTransferOwnershipResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.MsgUnbanResponse"))
 		}
-		panic(fmt.Errorf("message florin.blacklist.v1.MsgTransferOwnershipResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message florin.blacklist.v1.MsgUnbanResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3915,13 +6391,13 @@ func (x *fastReflection_MsgTransferOwnershipResponse) Clear(fd protoreflect.Fiel
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgTransferOwnershipResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUnbanResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.MsgTransferOwnershipResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.MsgUnbanResponse"))
 		}
-		panic(fmt.Errorf("message florin.blacklist.v1.MsgTransferOwnershipResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message florin.blacklist.v1.MsgUnbanResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3935,13 +6411,13 @@ func (x *fastReflection_MsgTransferOwnershipResponse) Get(descriptor protoreflec
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgTransferOwnershipResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgUnbanResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.MsgTransferOwnershipResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.MsgUnbanResponse"))
 		}
-		panic(fmt.Errorf("message florin.blacklist.v1.MsgTransferOwnershipResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message florin.blacklist.v1.MsgUnbanResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3955,36 +6431,36 @@ func (x *fastReflection_MsgTransferOwnershipResponse) Set(fd protoreflect.FieldD
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgTransferOwnershipResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUnbanResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.MsgTransferOwnershipResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.MsgUnbanResponse"))
 		}
-		panic(fmt.Errorf("message florin.blacklist.v1.MsgTransferOwnershipResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message florin.blacklist.v1.MsgUnbanResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgTransferOwnershipResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUnbanResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.MsgTransferOwnershipResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.MsgUnbanResponse"))
 		}
-		panic(fmt.Errorf("message florin.blacklist.v1.MsgTransferOwnershipResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message florin.blacklist.v1.MsgUnbanResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgTransferOwnershipResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgUnbanResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in florin.blacklist.v1.MsgTransferOwnershipResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in florin.blacklist.v1.MsgUnbanResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3992,7 +6468,7 @@ func (x *fastReflection_MsgTransferOwnershipResponse) WhichOneof(d protoreflect.
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgTransferOwnershipResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgUnbanResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4003,7 +6479,7 @@ func (x *fastReflection_MsgTransferOwnershipResponse) GetUnknown() protoreflect.
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgTransferOwnershipResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgUnbanResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4015,7 +6491,7 @@ func (x *fastReflection_MsgTransferOwnershipResponse) SetUnknown(fields protoref
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgTransferOwnershipResponse) IsValid() bool {
+func (x *fastReflection_MsgUnbanResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -4025,9 +6501,9 @@ func (x *fastReflection_MsgTransferOwnershipResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgTransferOwnershipResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgUnbanResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgTransferOwnershipResponse)
+		x := input.Message.Interface().(*MsgUnbanResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4049,7 +6525,7 @@ func (x *fastReflection_MsgTransferOwnershipResponse) ProtoMethods() *protoiface
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgTransferOwnershipResponse)
+		x := input.Message.Interface().(*MsgUnbanResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4079,7 +6555,7 @@ func (x *fastReflection_MsgTransferOwnershipResponse) ProtoMethods() *protoiface
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgTransferOwnershipResponse)
+		x := input.Message.Interface().(*MsgUnbanResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4111,10 +6587,10 @@ func (x *fastReflection_MsgTransferOwnershipResponse) ProtoMethods() *protoiface
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgTransferOwnershipResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUnbanResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgTransferOwnershipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUnbanResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			default:
@@ -4152,29 +6628,77 @@ func (x *fastReflection_MsgTransferOwnershipResponse) ProtoMethods() *protoiface
 	}
 }
 
+var _ protoreflect.List = (*_MsgUnbanBatch_2_list)(nil)
+
+type _MsgUnbanBatch_2_list struct {
+	list *[]string
+}
+
+func (x *_MsgUnbanBatch_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgUnbanBatch_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_MsgUnbanBatch_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgUnbanBatch_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgUnbanBatch_2_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message MsgUnbanBatch at list field Friends as it is not of Message kind"))
+}
+
+func (x *_MsgUnbanBatch_2_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgUnbanBatch_2_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_MsgUnbanBatch_2_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_MsgUnban        protoreflect.MessageDescriptor
-	fd_MsgUnban_signer protoreflect.FieldDescriptor
-	fd_MsgUnban_friend protoreflect.FieldDescriptor
+	md_MsgUnbanBatch         protoreflect.MessageDescriptor
+	fd_MsgUnbanBatch_signer  protoreflect.FieldDescriptor
+	fd_MsgUnbanBatch_friends protoreflect.FieldDescriptor
+	fd_MsgUnbanBatch_denom   protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_florin_blacklist_v1_tx_proto_init()
-	md_MsgUnban = File_florin_blacklist_v1_tx_proto.Messages().ByName("MsgUnban")
-	fd_MsgUnban_signer = md_MsgUnban.Fields().ByName("signer")
-	fd_MsgUnban_friend = md_MsgUnban.Fields().ByName("friend")
+	md_MsgUnbanBatch = File_florin_blacklist_v1_tx_proto.Messages().ByName("MsgUnbanBatch")
+	fd_MsgUnbanBatch_signer = md_MsgUnbanBatch.Fields().ByName("signer")
+	fd_MsgUnbanBatch_friends = md_MsgUnbanBatch.Fields().ByName("friends")
+	fd_MsgUnbanBatch_denom = md_MsgUnbanBatch.Fields().ByName("denom")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgUnban)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgUnbanBatch)(nil)
 
-type fastReflection_MsgUnban MsgUnban
+type fastReflection_MsgUnbanBatch MsgUnbanBatch
 
-func (x *MsgUnban) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgUnban)(x)
+func (x *MsgUnbanBatch) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUnbanBatch)(x)
 }
 
-func (x *MsgUnban) slowProtoReflect() protoreflect.Message {
-	mi := &file_florin_blacklist_v1_tx_proto_msgTypes[10]
+func (x *MsgUnbanBatch) slowProtoReflect() protoreflect.Message {
+	mi := &file_florin_blacklist_v1_tx_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4185,43 +6709,43 @@ func (x *MsgUnban) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgUnban_messageType fastReflection_MsgUnban_messageType
-var _ protoreflect.MessageType = fastReflection_MsgUnban_messageType{}
+var _fastReflection_MsgUnbanBatch_messageType fastReflection_MsgUnbanBatch_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUnbanBatch_messageType{}
 
-type fastReflection_MsgUnban_messageType struct{}
+type fastReflection_MsgUnbanBatch_messageType struct{}
 
-func (x fastReflection_MsgUnban_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgUnban)(nil)
+func (x fastReflection_MsgUnbanBatch_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUnbanBatch)(nil)
 }
-func (x fastReflection_MsgUnban_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgUnban)
+func (x fastReflection_MsgUnbanBatch_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUnbanBatch)
 }
-func (x fastReflection_MsgUnban_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgUnban
+func (x fastReflection_MsgUnbanBatch_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUnbanBatch
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgUnban) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgUnban
+func (x *fastReflection_MsgUnbanBatch) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUnbanBatch
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgUnban) Type() protoreflect.MessageType {
-	return _fastReflection_MsgUnban_messageType
+func (x *fastReflection_MsgUnbanBatch) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUnbanBatch_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgUnban) New() protoreflect.Message {
-	return new(fastReflection_MsgUnban)
+func (x *fastReflection_MsgUnbanBatch) New() protoreflect.Message {
+	return new(fastReflection_MsgUnbanBatch)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgUnban) Interface() protoreflect.ProtoMessage {
-	return (*MsgUnban)(x)
+func (x *fastReflection_MsgUnbanBatch) Interface() protoreflect.ProtoMessage {
+	return (*MsgUnbanBatch)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4229,16 +6753,22 @@ func (x *fastReflection_MsgUnban) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgUnban) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgUnbanBatch) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.Signer != "" {
 		value := protoreflect.ValueOfString(x.Signer)
-		if !f(fd_MsgUnban_signer, value) {
+		if !f(fd_MsgUnbanBatch_signer, value) {
 			return
 		}
 	}
-	if x.Friend != "" {
-		value := protoreflect.ValueOfString(x.Friend)
-		if !f(fd_MsgUnban_friend, value) {
+	if len(x.Friends) != 0 {
+		value := protoreflect.ValueOfList(&_MsgUnbanBatch_2_list{list: &x.Friends})
+		if !f(fd_MsgUnbanBatch_friends, value) {
+			return
+		}
+	}
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_MsgUnbanBatch_denom, value) {
 			return
 		}
 	}
@@ -4255,17 +6785,19 @@ func (x *fastReflection_MsgUnban) Range(f func(protoreflect.FieldDescriptor, pro
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgUnban) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgUnbanBatch) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "florin.blacklist.v1.MsgUnban.signer":
+	case "florin.blacklist.v1.MsgUnbanBatch.signer":
 		return x.Signer != ""
-	case "florin.blacklist.v1.MsgUnban.friend":
-		return x.Friend != ""
+	case "florin.blacklist.v1.MsgUnbanBatch.friends":
+		return len(x.Friends) != 0
+	case "florin.blacklist.v1.MsgUnbanBatch.denom":
+		return x.Denom != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.MsgUnban"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.MsgUnbanBatch"))
 		}
-		panic(fmt.Errorf("message florin.blacklist.v1.MsgUnban does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message florin.blacklist.v1.MsgUnbanBatch does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4275,17 +6807,19 @@ func (x *fastReflection_MsgUnban) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUnban) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgUnbanBatch) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "florin.blacklist.v1.MsgUnban.signer":
+	case "florin.blacklist.v1.MsgUnbanBatch.signer":
 		x.Signer = ""
-	case "florin.blacklist.v1.MsgUnban.friend":
-		x.Friend = ""
+	case "florin.blacklist.v1.MsgUnbanBatch.friends":
+		x.Friends = nil
+	case "florin.blacklist.v1.MsgUnbanBatch.denom":
+		x.Denom = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.MsgUnban"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.MsgUnbanBatch"))
 		}
-		panic(fmt.Errorf("message florin.blacklist.v1.MsgUnban does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message florin.blacklist.v1.MsgUnbanBatch does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4295,19 +6829,25 @@ func (x *fastReflection_MsgUnban) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgUnban) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUnbanBatch) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "florin.blacklist.v1.MsgUnban.signer":
+	case "florin.blacklist.v1.MsgUnbanBatch.signer":
 		value := x.Signer
 		return protoreflect.ValueOfString(value)
-	case "florin.blacklist.v1.MsgUnban.friend":
-		value := x.Friend
+	case "florin.blacklist.v1.MsgUnbanBatch.friends":
+		if len(x.Friends) == 0 {
+			return protoreflect.ValueOfList(&_MsgUnbanBatch_2_list{})
+		}
+		listValue := &_MsgUnbanBatch_2_list{list: &x.Friends}
+		return protoreflect.ValueOfList(listValue)
+	case "florin.blacklist.v1.MsgUnbanBatch.denom":
+		value := x.Denom
 		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.MsgUnban"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.MsgUnbanBatch"))
 		}
-		panic(fmt.Errorf("message florin.blacklist.v1.MsgUnban does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message florin.blacklist.v1.MsgUnbanBatch does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4321,17 +6861,21 @@ func (x *fastReflection_MsgUnban) Get(descriptor protoreflect.FieldDescriptor) p
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUnban) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgUnbanBatch) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "florin.blacklist.v1.MsgUnban.signer":
+	case "florin.blacklist.v1.MsgUnbanBatch.signer":
 		x.Signer = value.Interface().(string)
-	case "florin.blacklist.v1.MsgUnban.friend":
-		x.Friend = value.Interface().(string)
+	case "florin.blacklist.v1.MsgUnbanBatch.friends":
+		lv := value.List()
+		clv := lv.(*_MsgUnbanBatch_2_list)
+		x.Friends = *clv.list
+	case "florin.blacklist.v1.MsgUnbanBatch.denom":
+		x.Denom = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.MsgUnban"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.MsgUnbanBatch"))
 		}
-		panic(fmt.Errorf("message florin.blacklist.v1.MsgUnban does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message florin.blacklist.v1.MsgUnbanBatch does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4345,44 +6889,53 @@ func (x *fastReflection_MsgUnban) Set(fd protoreflect.FieldDescriptor, value pro
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUnban) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUnbanBatch) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "florin.blacklist.v1.MsgUnban.signer":
-		panic(fmt.Errorf("field signer of message florin.blacklist.v1.MsgUnban is not mutable"))
-	case "florin.blacklist.v1.MsgUnban.friend":
-		panic(fmt.Errorf("field friend of message florin.blacklist.v1.MsgUnban is not mutable"))
+	case "florin.blacklist.v1.MsgUnbanBatch.friends":
+		if x.Friends == nil {
+			x.Friends = []string{}
+		}
+		value := &_MsgUnbanBatch_2_list{list: &x.Friends}
+		return protoreflect.ValueOfList(value)
+	case "florin.blacklist.v1.MsgUnbanBatch.signer":
+		panic(fmt.Errorf("field signer of message florin.blacklist.v1.MsgUnbanBatch is not mutable"))
+	case "florin.blacklist.v1.MsgUnbanBatch.denom":
+		panic(fmt.Errorf("field denom of message florin.blacklist.v1.MsgUnbanBatch is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.MsgUnban"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.MsgUnbanBatch"))
 		}
-		panic(fmt.Errorf("message florin.blacklist.v1.MsgUnban does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message florin.blacklist.v1.MsgUnbanBatch does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgUnban) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUnbanBatch) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "florin.blacklist.v1.MsgUnban.signer":
+	case "florin.blacklist.v1.MsgUnbanBatch.signer":
 		return protoreflect.ValueOfString("")
-	case "florin.blacklist.v1.MsgUnban.friend":
+	case "florin.blacklist.v1.MsgUnbanBatch.friends":
+		list := []string{}
+		return protoreflect.ValueOfList(&_MsgUnbanBatch_2_list{list: &list})
+	case "florin.blacklist.v1.MsgUnbanBatch.denom":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.MsgUnban"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.MsgUnbanBatch"))
 		}
-		panic(fmt.Errorf("message florin.blacklist.v1.MsgUnban does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message florin.blacklist.v1.MsgUnbanBatch does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgUnban) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgUnbanBatch) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in florin.blacklist.v1.MsgUnban", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in florin.blacklist.v1.MsgUnbanBatch", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4390,7 +6943,7 @@ func (x *fastReflection_MsgUnban) WhichOneof(d protoreflect.OneofDescriptor) pro
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgUnban) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgUnbanBatch) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4401,7 +6954,7 @@ func (x *fastReflection_MsgUnban) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUnban) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgUnbanBatch) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4413,7 +6966,7 @@ func (x *fastReflection_MsgUnban) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgUnban) IsValid() bool {
+func (x *fastReflection_MsgUnbanBatch) IsValid() bool {
 	return x != nil
 }
 
@@ -4423,9 +6976,9 @@ func (x *fastReflection_MsgUnban) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgUnban) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgUnbanBatch) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgUnban)
+		x := input.Message.Interface().(*MsgUnbanBatch)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4441,7 +6994,13 @@ func (x *fastReflection_MsgUnban) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.Friend)
+		if len(x.Friends) > 0 {
+			for _, s := range x.Friends {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.Denom)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -4455,7 +7014,7 @@ func (x *fastReflection_MsgUnban) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgUnban)
+		x := input.Message.Interface().(*MsgUnbanBatch)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4474,12 +7033,21 @@ func (x *fastReflection_MsgUnban) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Friend) > 0 {
-			i -= len(x.Friend)
-			copy(dAtA[i:], x.Friend)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Friend)))
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x1a
+		}
+		if len(x.Friends) > 0 {
+			for iNdEx := len(x.Friends) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Friends[iNdEx])
+				copy(dAtA[i:], x.Friends[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Friends[iNdEx])))
+				i--
+				dAtA[i] = 0x12
+			}
 		}
 		if len(x.Signer) > 0 {
 			i -= len(x.Signer)
@@ -4499,7 +7067,7 @@ func (x *fastReflection_MsgUnban) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgUnban)
+		x := input.Message.Interface().(*MsgUnbanBatch)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4531,10 +7099,10 @@ func (x *fastReflection_MsgUnban) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUnban: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUnbanBatch: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUnban: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUnbanBatch: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -4571,7 +7139,7 @@ func (x *fastReflection_MsgUnban) ProtoMethods() *protoiface.Methods {
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Friend", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Friends", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -4599,7 +7167,39 @@ func (x *fastReflection_MsgUnban) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Friend = string(dAtA[iNdEx:postIndex])
+				x.Friends = append(x.Friends, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Denom = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -4637,24 +7237,24 @@ func (x *fastReflection_MsgUnban) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_MsgUnbanResponse protoreflect.MessageDescriptor
+	md_MsgUnbanBatchResponse protoreflect.MessageDescriptor
 )
 
 func init() {
 	file_florin_blacklist_v1_tx_proto_init()
-	md_MsgUnbanResponse = File_florin_blacklist_v1_tx_proto.Messages().ByName("MsgUnbanResponse")
+	md_MsgUnbanBatchResponse = File_florin_blacklist_v1_tx_proto.Messages().ByName("MsgUnbanBatchResponse")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgUnbanResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgUnbanBatchResponse)(nil)
 
-type fastReflection_MsgUnbanResponse MsgUnbanResponse
+type fastReflection_MsgUnbanBatchResponse MsgUnbanBatchResponse
 
-func (x *MsgUnbanResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgUnbanResponse)(x)
+func (x *MsgUnbanBatchResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUnbanBatchResponse)(x)
 }
 
-func (x *MsgUnbanResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_florin_blacklist_v1_tx_proto_msgTypes[11]
+func (x *MsgUnbanBatchResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_florin_blacklist_v1_tx_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4665,43 +7265,43 @@ func (x *MsgUnbanResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgUnbanResponse_messageType fastReflection_MsgUnbanResponse_messageType
-var _ protoreflect.MessageType = fastReflection_MsgUnbanResponse_messageType{}
+var _fastReflection_MsgUnbanBatchResponse_messageType fastReflection_MsgUnbanBatchResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUnbanBatchResponse_messageType{}
 
-type fastReflection_MsgUnbanResponse_messageType struct{}
+type fastReflection_MsgUnbanBatchResponse_messageType struct{}
 
-func (x fastReflection_MsgUnbanResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgUnbanResponse)(nil)
+func (x fastReflection_MsgUnbanBatchResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUnbanBatchResponse)(nil)
 }
-func (x fastReflection_MsgUnbanResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgUnbanResponse)
+func (x fastReflection_MsgUnbanBatchResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUnbanBatchResponse)
 }
-func (x fastReflection_MsgUnbanResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgUnbanResponse
+func (x fastReflection_MsgUnbanBatchResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUnbanBatchResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgUnbanResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgUnbanResponse
+func (x *fastReflection_MsgUnbanBatchResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUnbanBatchResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgUnbanResponse) Type() protoreflect.MessageType {
-	return _fastReflection_MsgUnbanResponse_messageType
+func (x *fastReflection_MsgUnbanBatchResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUnbanBatchResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgUnbanResponse) New() protoreflect.Message {
-	return new(fastReflection_MsgUnbanResponse)
+func (x *fastReflection_MsgUnbanBatchResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgUnbanBatchResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgUnbanResponse) Interface() protoreflect.ProtoMessage {
-	return (*MsgUnbanResponse)(x)
+func (x *fastReflection_MsgUnbanBatchResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgUnbanBatchResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4709,7 +7309,7 @@ func (x *fastReflection_MsgUnbanResponse) Interface() protoreflect.ProtoMessage
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgUnbanResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgUnbanBatchResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 }
 
 // Has reports whether a field is populated.
@@ -4723,13 +7323,13 @@ func (x *fastReflection_MsgUnbanResponse) Range(f func(protoreflect.FieldDescrip
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgUnbanResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgUnbanBatchResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.MsgUnbanResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.MsgUnbanBatchResponse"))
 		}
-		panic(fmt.Errorf("message florin.blacklist.v1.MsgUnbanResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message florin.blacklist.v1.MsgUnbanBatchResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4739,13 +7339,13 @@ func (x *fastReflection_MsgUnbanResponse) Has(fd protoreflect.FieldDescriptor) b
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUnbanResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgUnbanBatchResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.MsgUnbanResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.MsgUnbanBatchResponse"))
 		}
-		panic(fmt.Errorf("message florin.blacklist.v1.MsgUnbanResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message florin.blacklist.v1.MsgUnbanBatchResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4755,13 +7355,13 @@ func (x *fastReflection_MsgUnbanResponse) Clear(fd protoreflect.FieldDescriptor)
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgUnbanResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUnbanBatchResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.MsgUnbanResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.MsgUnbanBatchResponse"))
 		}
-		panic(fmt.Errorf("message florin.blacklist.v1.MsgUnbanResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message florin.blacklist.v1.MsgUnbanBatchResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4775,13 +7375,13 @@ func (x *fastReflection_MsgUnbanResponse) Get(descriptor protoreflect.FieldDescr
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUnbanResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgUnbanBatchResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.MsgUnbanResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.MsgUnbanBatchResponse"))
 		}
-		panic(fmt.Errorf("message florin.blacklist.v1.MsgUnbanResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message florin.blacklist.v1.MsgUnbanBatchResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4795,36 +7395,36 @@ func (x *fastReflection_MsgUnbanResponse) Set(fd protoreflect.FieldDescriptor, v
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUnbanResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUnbanBatchResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.MsgUnbanResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.MsgUnbanBatchResponse"))
 		}
-		panic(fmt.Errorf("message florin.blacklist.v1.MsgUnbanResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message florin.blacklist.v1.MsgUnbanBatchResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgUnbanResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUnbanBatchResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.MsgUnbanResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: florin.blacklist.v1.MsgUnbanBatchResponse"))
 		}
-		panic(fmt.Errorf("message florin.blacklist.v1.MsgUnbanResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message florin.blacklist.v1.MsgUnbanBatchResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgUnbanResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgUnbanBatchResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in florin.blacklist.v1.MsgUnbanResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in florin.blacklist.v1.MsgUnbanBatchResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4832,7 +7432,7 @@ func (x *fastReflection_MsgUnbanResponse) WhichOneof(d protoreflect.OneofDescrip
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgUnbanResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgUnbanBatchResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4843,7 +7443,7 @@ func (x *fastReflection_MsgUnbanResponse) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUnbanResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgUnbanBatchResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4855,7 +7455,7 @@ func (x *fastReflection_MsgUnbanResponse) SetUnknown(fields protoreflect.RawFiel
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgUnbanResponse) IsValid() bool {
+func (x *fastReflection_MsgUnbanBatchResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -4865,9 +7465,9 @@ func (x *fastReflection_MsgUnbanResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgUnbanResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgUnbanBatchResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgUnbanResponse)
+		x := input.Message.Interface().(*MsgUnbanBatchResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4889,7 +7489,7 @@ func (x *fastReflection_MsgUnbanResponse) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgUnbanResponse)
+		x := input.Message.Interface().(*MsgUnbanBatchResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4919,7 +7519,7 @@ func (x *fastReflection_MsgUnbanResponse) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgUnbanResponse)
+		x := input.Message.Interface().(*MsgUnbanBatchResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4951,10 +7551,10 @@ func (x *fastReflection_MsgUnbanResponse) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUnbanResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUnbanBatchResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUnbanResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUnbanBatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			default:
@@ -5076,6 +7676,7 @@ type MsgAddAdminAccount struct {
 
 	Signer  string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
 	Account string `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	Denom   string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
 func (x *MsgAddAdminAccount) Reset() {
@@ -5112,6 +7713,13 @@ func (x *MsgAddAdminAccount) GetAccount() string {
 	return ""
 }
 
+func (x *MsgAddAdminAccount) GetDenom() string {
+	if x != nil {
+		return x.Denom
+	}
+	return ""
+}
+
 // MsgAddAdminAccountResponse is the response of the AddAminAccount action.
 type MsgAddAdminAccountResponse struct {
 	state         protoimpl.MessageState
@@ -5145,8 +7753,13 @@ type MsgBan struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Signer    string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
-	Adversary string `protobuf:"bytes,2,opt,name=adversary,proto3" json:"adversary,omitempty"`
+	Signer       string    `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Adversary    string    `protobuf:"bytes,2,opt,name=adversary,proto3" json:"adversary,omitempty"`
+	Denom        string    `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	ExpiryHeight int64     `protobuf:"varint,4,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty"`
+	ExpiryTime   int64     `protobuf:"varint,5,opt,name=expiry_time,json=expiryTime,proto3" json:"expiry_time,omitempty"`
+	Reason       BanReason `protobuf:"varint,6,opt,name=reason,proto3,enum=florin.blacklist.v1.BanReason" json:"reason,omitempty"`
+	CaseId       string    `protobuf:"bytes,7,opt,name=case_id,json=caseId,proto3" json:"case_id,omitempty"`
 }
 
 func (x *MsgBan) Reset() {
@@ -5183,6 +7796,41 @@ func (x *MsgBan) GetAdversary() string {
 	return ""
 }
 
+func (x *MsgBan) GetDenom() string {
+	if x != nil {
+		return x.Denom
+	}
+	return ""
+}
+
+func (x *MsgBan) GetExpiryHeight() int64 {
+	if x != nil {
+		return x.ExpiryHeight
+	}
+	return 0
+}
+
+func (x *MsgBan) GetExpiryTime() int64 {
+	if x != nil {
+		return x.ExpiryTime
+	}
+	return 0
+}
+
+func (x *MsgBan) GetReason() BanReason {
+	if x != nil {
+		return x.Reason
+	}
+	return BanReason_BAN_REASON_UNSPECIFIED
+}
+
+func (x *MsgBan) GetCaseId() string {
+	if x != nil {
+		return x.CaseId
+	}
+	return ""
+}
+
 // MsgBanResponse is the response of the Ban action.
 type MsgBanResponse struct {
 	state         protoimpl.MessageState
@@ -5210,6 +7858,117 @@ func (*MsgBanResponse) Descriptor() ([]byte, []int) {
 	return file_florin_blacklist_v1_tx_proto_rawDescGZIP(), []int{5}
 }
 
+// MsgBanBatch is the request of the BanBatch action.
+type MsgBanBatch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Signer       string    `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Adversaries  []string  `protobuf:"bytes,2,rep,name=adversaries,proto3" json:"adversaries,omitempty"`
+	Denom        string    `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	ExpiryHeight int64     `protobuf:"varint,4,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty"`
+	ExpiryTime   int64     `protobuf:"varint,5,opt,name=expiry_time,json=expiryTime,proto3" json:"expiry_time,omitempty"`
+	Reason       BanReason `protobuf:"varint,6,opt,name=reason,proto3,enum=florin.blacklist.v1.BanReason" json:"reason,omitempty"`
+	CaseId       string    `protobuf:"bytes,7,opt,name=case_id,json=caseId,proto3" json:"case_id,omitempty"`
+}
+
+func (x *MsgBanBatch) Reset() {
+	*x = MsgBanBatch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_florin_blacklist_v1_tx_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgBanBatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgBanBatch) ProtoMessage() {}
+
+// Deprecated: Use MsgBanBatch.ProtoReflect.Descriptor instead.
+func (*MsgBanBatch) Descriptor() ([]byte, []int) {
+	return file_florin_blacklist_v1_tx_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *MsgBanBatch) GetSigner() string {
+	if x != nil {
+		return x.Signer
+	}
+	return ""
+}
+
+func (x *MsgBanBatch) GetAdversaries() []string {
+	if x != nil {
+		return x.Adversaries
+	}
+	return nil
+}
+
+func (x *MsgBanBatch) GetDenom() string {
+	if x != nil {
+		return x.Denom
+	}
+	return ""
+}
+
+func (x *MsgBanBatch) GetExpiryHeight() int64 {
+	if x != nil {
+		return x.ExpiryHeight
+	}
+	return 0
+}
+
+func (x *MsgBanBatch) GetExpiryTime() int64 {
+	if x != nil {
+		return x.ExpiryTime
+	}
+	return 0
+}
+
+func (x *MsgBanBatch) GetReason() BanReason {
+	if x != nil {
+		return x.Reason
+	}
+	return BanReason_BAN_REASON_UNSPECIFIED
+}
+
+func (x *MsgBanBatch) GetCaseId() string {
+	if x != nil {
+		return x.CaseId
+	}
+	return ""
+}
+
+// MsgBanBatchResponse is the response of the BanBatch action.
+type MsgBanBatchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgBanBatchResponse) Reset() {
+	*x = MsgBanBatchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_florin_blacklist_v1_tx_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgBanBatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgBanBatchResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgBanBatchResponse.ProtoReflect.Descriptor instead.
+func (*MsgBanBatchResponse) Descriptor() ([]byte, []int) {
+	return file_florin_blacklist_v1_tx_proto_rawDescGZIP(), []int{7}
+}
+
 // MsgRemoveAdminAccount implements the removeAdminAccount (0x67a89a72) method.
 type MsgRemoveAdminAccount struct {
 	state         protoimpl.MessageState
@@ -5218,12 +7977,13 @@ type MsgRemoveAdminAccount struct {
 
 	Signer  string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
 	Account string `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	Denom   string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
 func (x *MsgRemoveAdminAccount) Reset() {
 	*x = MsgRemoveAdminAccount{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_florin_blacklist_v1_tx_proto_msgTypes[6]
+		mi := &file_florin_blacklist_v1_tx_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5237,7 +7997,7 @@ func (*MsgRemoveAdminAccount) ProtoMessage() {}
 
 // Deprecated: Use MsgRemoveAdminAccount.ProtoReflect.Descriptor instead.
 func (*MsgRemoveAdminAccount) Descriptor() ([]byte, []int) {
-	return file_florin_blacklist_v1_tx_proto_rawDescGZIP(), []int{6}
+	return file_florin_blacklist_v1_tx_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *MsgRemoveAdminAccount) GetSigner() string {
@@ -5254,6 +8014,13 @@ func (x *MsgRemoveAdminAccount) GetAccount() string {
 	return ""
 }
 
+func (x *MsgRemoveAdminAccount) GetDenom() string {
+	if x != nil {
+		return x.Denom
+	}
+	return ""
+}
+
 // MsgRemoveAdminAccountResponse is the response of the RemoveAdminAccount action.
 type MsgRemoveAdminAccountResponse struct {
 	state         protoimpl.MessageState
@@ -5264,7 +8031,7 @@ type MsgRemoveAdminAccountResponse struct {
 func (x *MsgRemoveAdminAccountResponse) Reset() {
 	*x = MsgRemoveAdminAccountResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_florin_blacklist_v1_tx_proto_msgTypes[7]
+		mi := &file_florin_blacklist_v1_tx_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5278,7 +8045,7 @@ func (*MsgRemoveAdminAccountResponse) ProtoMessage() {}
 
 // Deprecated: Use MsgRemoveAdminAccountResponse.ProtoReflect.Descriptor instead.
 func (*MsgRemoveAdminAccountResponse) Descriptor() ([]byte, []int) {
-	return file_florin_blacklist_v1_tx_proto_rawDescGZIP(), []int{7}
+	return file_florin_blacklist_v1_tx_proto_rawDescGZIP(), []int{9}
 }
 
 // MsgTransferOwnership implements the transferOwnership (0xf2fde38b) method.
@@ -5294,7 +8061,7 @@ type MsgTransferOwnership struct {
 func (x *MsgTransferOwnership) Reset() {
 	*x = MsgTransferOwnership{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_florin_blacklist_v1_tx_proto_msgTypes[8]
+		mi := &file_florin_blacklist_v1_tx_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5308,7 +8075,7 @@ func (*MsgTransferOwnership) ProtoMessage() {}
 
 // Deprecated: Use MsgTransferOwnership.ProtoReflect.Descriptor instead.
 func (*MsgTransferOwnership) Descriptor() ([]byte, []int) {
-	return file_florin_blacklist_v1_tx_proto_rawDescGZIP(), []int{8}
+	return file_florin_blacklist_v1_tx_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *MsgTransferOwnership) GetSigner() string {
@@ -5335,7 +8102,7 @@ type MsgTransferOwnershipResponse struct {
 func (x *MsgTransferOwnershipResponse) Reset() {
 	*x = MsgTransferOwnershipResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_florin_blacklist_v1_tx_proto_msgTypes[9]
+		mi := &file_florin_blacklist_v1_tx_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5349,7 +8116,7 @@ func (*MsgTransferOwnershipResponse) ProtoMessage() {}
 
 // Deprecated: Use MsgTransferOwnershipResponse.ProtoReflect.Descriptor instead.
 func (*MsgTransferOwnershipResponse) Descriptor() ([]byte, []int) {
-	return file_florin_blacklist_v1_tx_proto_rawDescGZIP(), []int{9}
+	return file_florin_blacklist_v1_tx_proto_rawDescGZIP(), []int{11}
 }
 
 // MsgUnban implements the unban (0xb9f14557) method.
@@ -5360,12 +8127,13 @@ type MsgUnban struct {
 
 	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
 	Friend string `protobuf:"bytes,2,opt,name=friend,proto3" json:"friend,omitempty"`
+	Denom  string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
 func (x *MsgUnban) Reset() {
 	*x = MsgUnban{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_florin_blacklist_v1_tx_proto_msgTypes[10]
+		mi := &file_florin_blacklist_v1_tx_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5379,7 +8147,7 @@ func (*MsgUnban) ProtoMessage() {}
 
 // Deprecated: Use MsgUnban.ProtoReflect.Descriptor instead.
 func (*MsgUnban) Descriptor() ([]byte, []int) {
-	return file_florin_blacklist_v1_tx_proto_rawDescGZIP(), []int{10}
+	return file_florin_blacklist_v1_tx_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *MsgUnban) GetSigner() string {
@@ -5396,6 +8164,13 @@ func (x *MsgUnban) GetFriend() string {
 	return ""
 }
 
+func (x *MsgUnban) GetDenom() string {
+	if x != nil {
+		return x.Denom
+	}
+	return ""
+}
+
 // MsgUnbanResponse is the response of the Unban action.
 type MsgUnbanResponse struct {
 	state         protoimpl.MessageState
@@ -5406,7 +8181,7 @@ type MsgUnbanResponse struct {
 func (x *MsgUnbanResponse) Reset() {
 	*x = MsgUnbanResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_florin_blacklist_v1_tx_proto_msgTypes[11]
+		mi := &file_florin_blacklist_v1_tx_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5420,7 +8195,86 @@ func (*MsgUnbanResponse) ProtoMessage() {}
 
 // Deprecated: Use MsgUnbanResponse.ProtoReflect.Descriptor instead.
 func (*MsgUnbanResponse) Descriptor() ([]byte, []int) {
-	return file_florin_blacklist_v1_tx_proto_rawDescGZIP(), []int{11}
+	return file_florin_blacklist_v1_tx_proto_rawDescGZIP(), []int{13}
+}
+
+// MsgUnbanBatch is the request of the UnbanBatch action.
+type MsgUnbanBatch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Signer  string   `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Friends []string `protobuf:"bytes,2,rep,name=friends,proto3" json:"friends,omitempty"`
+	Denom   string   `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (x *MsgUnbanBatch) Reset() {
+	*x = MsgUnbanBatch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_florin_blacklist_v1_tx_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgUnbanBatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgUnbanBatch) ProtoMessage() {}
+
+// Deprecated: Use MsgUnbanBatch.ProtoReflect.Descriptor instead.
+func (*MsgUnbanBatch) Descriptor() ([]byte, []int) {
+	return file_florin_blacklist_v1_tx_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *MsgUnbanBatch) GetSigner() string {
+	if x != nil {
+		return x.Signer
+	}
+	return ""
+}
+
+func (x *MsgUnbanBatch) GetFriends() []string {
+	if x != nil {
+		return x.Friends
+	}
+	return nil
+}
+
+func (x *MsgUnbanBatch) GetDenom() string {
+	if x != nil {
+		return x.Denom
+	}
+	return ""
+}
+
+// MsgUnbanBatchResponse is the response of the UnbanBatch action.
+type MsgUnbanBatchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgUnbanBatchResponse) Reset() {
+	*x = MsgUnbanBatchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_florin_blacklist_v1_tx_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgUnbanBatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgUnbanBatchResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgUnbanBatchResponse.ProtoReflect.Descriptor instead.
+func (*MsgUnbanBatchResponse) Descriptor() ([]byte, []int) {
+	return file_florin_blacklist_v1_tx_proto_rawDescGZIP(), []int{15}
 }
 
 var File_florin_blacklist_v1_tx_proto protoreflect.FileDescriptor
@@ -5433,135 +8287,201 @@ var file_florin_blacklist_v1_tx_proto_rawDesc = []byte{
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x6d,
 	0x73, 0x67, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x73, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
 	0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x22, 0x80, 0x01, 0x0a, 0x12, 0x4d, 0x73, 0x67, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x4f, 0x77,
-	0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65,
-	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x3a, 0x38, 0x88, 0xa0, 0x1f, 0x00, 0xe8,
-	0xa0, 0x1f, 0x00, 0x82, 0xe7, 0xb0, 0x2a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x8a, 0xe7,
-	0xb0, 0x2a, 0x20, 0x66, 0x6c, 0x6f, 0x72, 0x69, 0x6e, 0x2f, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6c,
-	0x69, 0x73, 0x74, 0x2f, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73,
-	0x68, 0x69, 0x70, 0x22, 0x1c, 0x0a, 0x1a, 0x4d, 0x73, 0x67, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74,
-	0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0xb3, 0x01, 0x0a, 0x12, 0x4d, 0x73, 0x67, 0x41, 0x64, 0x64, 0x41, 0x64, 0x6d, 0x69,
-	0x6e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e,
-	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x12, 0x32, 0x0a, 0x07, 0x61, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x21, 0x66, 0x6c, 0x6f, 0x72,
+	0x69, 0x6e, 0x2f, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x2f,
+	0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67,
+	0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0x80, 0x01, 0x0a, 0x12, 0x4d, 0x73, 0x67, 0x41, 0x63, 0x63, 0x65, 0x70,
+	0x74, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x69,
+	0x67, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x3a, 0x38, 0x88, 0xa0,
+	0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x82, 0xe7, 0xb0, 0x2a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65,
+	0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x20, 0x66, 0x6c, 0x6f, 0x72, 0x69, 0x6e, 0x2f, 0x62, 0x6c, 0x61,
+	0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x2f, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x4f, 0x77, 0x6e,
+	0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x22, 0x1c, 0x0a, 0x1a, 0x4d, 0x73, 0x67, 0x41, 0x63, 0x63,
+	0x65, 0x70, 0x74, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0xc9, 0x01, 0x0a, 0x12, 0x4d, 0x73, 0x67, 0x41, 0x64, 0x64, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x06, 0x73,
+	0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d,
 	0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x3a, 0x37,
-	0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x82, 0xe7, 0xb0, 0x2a, 0x06, 0x73, 0x69, 0x67,
-	0x6e, 0x65, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x1f, 0x66, 0x6c, 0x6f, 0x72, 0x69, 0x6e, 0x2f, 0x62,
-	0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x2f, 0x41, 0x64, 0x64, 0x41, 0x6d, 0x69, 0x6e,
-	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x1c, 0x0a, 0x1a, 0x4d, 0x73, 0x67, 0x41, 0x64,
-	0x64, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xa0, 0x01, 0x0a, 0x06, 0x4d, 0x73, 0x67, 0x42, 0x61, 0x6e,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x12, 0x32, 0x0a,
+	0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18,
+	0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x3a, 0x37, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f,
+	0x00, 0x82, 0xe7, 0xb0, 0x2a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x8a, 0xe7, 0xb0, 0x2a,
+	0x1f, 0x66, 0x6c, 0x6f, 0x72, 0x69, 0x6e, 0x2f, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73,
+	0x74, 0x2f, 0x41, 0x64, 0x64, 0x41, 0x6d, 0x69, 0x6e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x22, 0x1c, 0x0a, 0x1a, 0x4d, 0x73, 0x67, 0x41, 0x64, 0x64, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xcd,
+	0x02, 0x0a, 0x06, 0x4d, 0x73, 0x67, 0x42, 0x61, 0x6e, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x69, 0x67,
+	0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x12, 0x36, 0x0a, 0x09, 0x61,
+	0x64, 0x76, 0x65, 0x72, 0x73, 0x61, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18,
+	0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x61, 0x64, 0x76, 0x65, 0x72, 0x73,
+	0x61, 0x72, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x79, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0c, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1f,
+	0x0a, 0x0b, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x36, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1e, 0x2e, 0x66, 0x6c, 0x6f, 0x72, 0x69, 0x6e, 0x2e, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69,
+	0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x63, 0x61, 0x73, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x61, 0x73, 0x65, 0x49, 0x64,
+	0x3a, 0x2c, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x82, 0xe7, 0xb0, 0x2a, 0x06, 0x73,
+	0x69, 0x67, 0x6e, 0x65, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x14, 0x66, 0x6c, 0x6f, 0x72, 0x69, 0x6e,
+	0x2f, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x2f, 0x42, 0x61, 0x6e, 0x22, 0x10,
+	0x0a, 0x0e, 0x4d, 0x73, 0x67, 0x42, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0xdb, 0x02, 0x0a, 0x0b, 0x4d, 0x73, 0x67, 0x42, 0x61, 0x6e, 0x42, 0x61, 0x74, 0x63, 0x68,
 	0x12, 0x30, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
 	0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64,
 	0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e,
-	0x65, 0x72, 0x12, 0x36, 0x0a, 0x09, 0x61, 0x64, 0x76, 0x65, 0x72, 0x73, 0x61, 0x72, 0x79, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52,
-	0x09, 0x61, 0x64, 0x76, 0x65, 0x72, 0x73, 0x61, 0x72, 0x79, 0x3a, 0x2c, 0x88, 0xa0, 0x1f, 0x00,
-	0xe8, 0xa0, 0x1f, 0x00, 0x82, 0xe7, 0xb0, 0x2a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x8a,
-	0xe7, 0xb0, 0x2a, 0x14, 0x66, 0x6c, 0x6f, 0x72, 0x69, 0x6e, 0x2f, 0x62, 0x6c, 0x61, 0x63, 0x6b,
-	0x6c, 0x69, 0x73, 0x74, 0x2f, 0x42, 0x61, 0x6e, 0x22, 0x10, 0x0a, 0x0e, 0x4d, 0x73, 0x67, 0x42,
-	0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xba, 0x01, 0x0a, 0x15, 0x4d,
-	0x73, 0x67, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x01,
+	0x65, 0x72, 0x12, 0x3a, 0x0a, 0x0b, 0x61, 0x64, 0x76, 0x65, 0x72, 0x73, 0x61, 0x72, 0x69, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x52, 0x0b, 0x61, 0x64, 0x76, 0x65, 0x72, 0x73, 0x61, 0x72, 0x69, 0x65, 0x73, 0x12, 0x14,
+	0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64,
+	0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x5f, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x79, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x79, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x36, 0x0a, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x66, 0x6c, 0x6f,
+	0x72, 0x69, 0x6e, 0x2e, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x42, 0x61, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x63, 0x61, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x61, 0x73, 0x65, 0x49, 0x64, 0x3a, 0x31, 0x88, 0xa0, 0x1f,
+	0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x82, 0xe7, 0xb0, 0x2a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72,
+	0x8a, 0xe7, 0xb0, 0x2a, 0x19, 0x66, 0x6c, 0x6f, 0x72, 0x69, 0x6e, 0x2f, 0x62, 0x6c, 0x61, 0x63,
+	0x6b, 0x6c, 0x69, 0x73, 0x74, 0x2f, 0x42, 0x61, 0x6e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x22, 0x15,
+	0x0a, 0x13, 0x4d, 0x73, 0x67, 0x42, 0x61, 0x6e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xd0, 0x01, 0x0a, 0x15, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x30, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65,
+	0x72, 0x12, 0x32, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x3a, 0x3b, 0x88, 0xa0, 0x1f,
+	0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x82, 0xe7, 0xb0, 0x2a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72,
+	0x8a, 0xe7, 0xb0, 0x2a, 0x23, 0x66, 0x6c, 0x6f, 0x72, 0x69, 0x6e, 0x2f, 0x62, 0x6c, 0x61, 0x63,
+	0x6b, 0x6c, 0x69, 0x73, 0x74, 0x2f, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x1f, 0x0a, 0x1d, 0x4d, 0x73, 0x67, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xbb, 0x01, 0x0a, 0x14, 0x4d, 0x73,
+	0x67, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68,
+	0x69, 0x70, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x73, 0x69,
+	0x67, 0x6e, 0x65, 0x72, 0x12, 0x35, 0x0a, 0x09, 0x6e, 0x65, 0x77, 0x5f, 0x6f, 0x77, 0x6e, 0x65,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x52, 0x08, 0x6e, 0x65, 0x77, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x3a, 0x3a, 0x88, 0xa0, 0x1f,
+	0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x82, 0xe7, 0xb0, 0x2a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72,
+	0x8a, 0xe7, 0xb0, 0x2a, 0x22, 0x66, 0x6c, 0x6f, 0x72, 0x69, 0x6e, 0x2f, 0x62, 0x6c, 0x61, 0x63,
+	0x6b, 0x6c, 0x69, 0x73, 0x74, 0x2f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x4f, 0x77,
+	0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x22, 0x1e, 0x0a, 0x1c, 0x4d, 0x73, 0x67, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xb4, 0x01, 0x0a, 0x08, 0x4d, 0x73, 0x67, 0x55,
+	0x6e, 0x62, 0x61, 0x6e, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
 	0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x06,
-	0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x12, 0x32, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x3a, 0x3b, 0x88, 0xa0, 0x1f, 0x00,
-	0xe8, 0xa0, 0x1f, 0x00, 0x82, 0xe7, 0xb0, 0x2a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x8a,
-	0xe7, 0xb0, 0x2a, 0x23, 0x66, 0x6c, 0x6f, 0x72, 0x69, 0x6e, 0x2f, 0x62, 0x6c, 0x61, 0x63, 0x6b,
-	0x6c, 0x69, 0x73, 0x74, 0x2f, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x41, 0x64, 0x6d, 0x69, 0x6e,
-	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x1f, 0x0a, 0x1d, 0x4d, 0x73, 0x67, 0x52, 0x65,
-	0x6d, 0x6f, 0x76, 0x65, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xbb, 0x01, 0x0a, 0x14, 0x4d, 0x73, 0x67,
-	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69,
-	0x70, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x73, 0x69, 0x67,
-	0x6e, 0x65, 0x72, 0x12, 0x35, 0x0a, 0x09, 0x6e, 0x65, 0x77, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72,
+	0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x12, 0x30, 0x0a, 0x06, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64,
 	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d,
 	0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x52, 0x08, 0x6e, 0x65, 0x77, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x3a, 0x3a, 0x88, 0xa0, 0x1f, 0x00,
-	0xe8, 0xa0, 0x1f, 0x00, 0x82, 0xe7, 0xb0, 0x2a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x8a,
-	0xe7, 0xb0, 0x2a, 0x22, 0x66, 0x6c, 0x6f, 0x72, 0x69, 0x6e, 0x2f, 0x62, 0x6c, 0x61, 0x63, 0x6b,
-	0x6c, 0x69, 0x73, 0x74, 0x2f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x4f, 0x77, 0x6e,
-	0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x22, 0x1e, 0x0a, 0x1c, 0x4d, 0x73, 0x67, 0x54, 0x72, 0x61,
-	0x6e, 0x73, 0x66, 0x65, 0x72, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x9e, 0x01, 0x0a, 0x08, 0x4d, 0x73, 0x67, 0x55, 0x6e,
-	0x62, 0x61, 0x6e, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
-	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x73,
-	0x69, 0x67, 0x6e, 0x65, 0x72, 0x12, 0x30, 0x0a, 0x06, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52,
-	0x06, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x3a, 0x2e, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f,
-	0x00, 0x82, 0xe7, 0xb0, 0x2a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x8a, 0xe7, 0xb0, 0x2a,
-	0x16, 0x66, 0x6c, 0x6f, 0x72, 0x69, 0x6e, 0x2f, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73,
-	0x74, 0x2f, 0x55, 0x6e, 0x62, 0x61, 0x6e, 0x22, 0x12, 0x0a, 0x10, 0x4d, 0x73, 0x67, 0x55, 0x6e,
-	0x62, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xe7, 0x04, 0x0a, 0x03,
-	0x4d, 0x73, 0x67, 0x12, 0x6b, 0x0a, 0x0f, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x4f, 0x77, 0x6e,
-	0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x12, 0x27, 0x2e, 0x66, 0x6c, 0x6f, 0x72, 0x69, 0x6e, 0x2e,
+	0x52, 0x06, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f,
+	0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x3a, 0x2e,
+	0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x82, 0xe7, 0xb0, 0x2a, 0x06, 0x73, 0x69, 0x67,
+	0x6e, 0x65, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x16, 0x66, 0x6c, 0x6f, 0x72, 0x69, 0x6e, 0x2f, 0x62,
+	0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x2f, 0x55, 0x6e, 0x62, 0x61, 0x6e, 0x22, 0x12,
+	0x0a, 0x10, 0x4d, 0x73, 0x67, 0x55, 0x6e, 0x62, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0xc0, 0x01, 0x0a, 0x0d, 0x4d, 0x73, 0x67, 0x55, 0x6e, 0x62, 0x61, 0x6e, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x06,
+	0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x12, 0x32, 0x0a, 0x07, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x52, 0x07, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65,
+	0x6e, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d,
+	0x3a, 0x33, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x82, 0xe7, 0xb0, 0x2a, 0x06, 0x73,
+	0x69, 0x67, 0x6e, 0x65, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x1b, 0x66, 0x6c, 0x6f, 0x72, 0x69, 0x6e,
+	0x2f, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x2f, 0x55, 0x6e, 0x62, 0x61, 0x6e,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x22, 0x17, 0x0a, 0x15, 0x4d, 0x73, 0x67, 0x55, 0x6e, 0x62, 0x61,
+	0x6e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x9d,
+	0x06, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x6b, 0x0a, 0x0f, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74,
+	0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x12, 0x27, 0x2e, 0x66, 0x6c, 0x6f, 0x72,
+	0x69, 0x6e, 0x2e, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x73, 0x67, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68,
+	0x69, 0x70, 0x1a, 0x2f, 0x2e, 0x66, 0x6c, 0x6f, 0x72, 0x69, 0x6e, 0x2e, 0x62, 0x6c, 0x61, 0x63,
+	0x6b, 0x6c, 0x69, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x41, 0x63, 0x63, 0x65,
+	0x70, 0x74, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x6b, 0x0a, 0x0f, 0x41, 0x64, 0x64, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x27, 0x2e, 0x66, 0x6c, 0x6f, 0x72, 0x69, 0x6e, 0x2e,
 	0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67,
-	0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x1a,
+	0x41, 0x64, 0x64, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a,
 	0x2f, 0x2e, 0x66, 0x6c, 0x6f, 0x72, 0x69, 0x6e, 0x2e, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69,
-	0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x4f,
-	0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x6b, 0x0a, 0x0f, 0x41, 0x64, 0x64, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x12, 0x27, 0x2e, 0x66, 0x6c, 0x6f, 0x72, 0x69, 0x6e, 0x2e, 0x62, 0x6c, 0x61,
-	0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x41, 0x64, 0x64,
-	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x2f, 0x2e, 0x66,
-	0x6c, 0x6f, 0x72, 0x69, 0x6e, 0x2e, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x2e,
-	0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x41, 0x64, 0x64, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a,
-	0x03, 0x42, 0x61, 0x6e, 0x12, 0x1b, 0x2e, 0x66, 0x6c, 0x6f, 0x72, 0x69, 0x6e, 0x2e, 0x62, 0x6c,
+	0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x41, 0x64, 0x64, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x47, 0x0a, 0x03, 0x42, 0x61, 0x6e, 0x12, 0x1b, 0x2e, 0x66, 0x6c, 0x6f, 0x72, 0x69, 0x6e,
+	0x2e, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73,
+	0x67, 0x42, 0x61, 0x6e, 0x1a, 0x23, 0x2e, 0x66, 0x6c, 0x6f, 0x72, 0x69, 0x6e, 0x2e, 0x62, 0x6c,
 	0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x42, 0x61,
-	0x6e, 0x1a, 0x23, 0x2e, 0x66, 0x6c, 0x6f, 0x72, 0x69, 0x6e, 0x2e, 0x62, 0x6c, 0x61, 0x63, 0x6b,
-	0x6c, 0x69, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x42, 0x61, 0x6e, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x74, 0x0a, 0x12, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
-	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2a, 0x2e, 0x66,
-	0x6c, 0x6f, 0x72, 0x69, 0x6e, 0x2e, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x2e,
-	0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x41, 0x64, 0x6d, 0x69,
-	0x6e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x32, 0x2e, 0x66, 0x6c, 0x6f, 0x72, 0x69,
-	0x6e, 0x2e, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
-	0x73, 0x67, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x71, 0x0a, 0x11,
-	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69,
-	0x70, 0x12, 0x29, 0x2e, 0x66, 0x6c, 0x6f, 0x72, 0x69, 0x6e, 0x2e, 0x62, 0x6c, 0x61, 0x63, 0x6b,
-	0x6c, 0x69, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x54, 0x72, 0x61, 0x6e, 0x73,
-	0x66, 0x65, 0x72, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x1a, 0x31, 0x2e, 0x66,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x08, 0x42, 0x61, 0x6e,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x20, 0x2e, 0x66, 0x6c, 0x6f, 0x72, 0x69, 0x6e, 0x2e, 0x62,
+	0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x42,
+	0x61, 0x6e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x1a, 0x28, 0x2e, 0x66, 0x6c, 0x6f, 0x72, 0x69, 0x6e,
+	0x2e, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73,
+	0x67, 0x42, 0x61, 0x6e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x74, 0x0a, 0x12, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2a, 0x2e, 0x66, 0x6c, 0x6f, 0x72, 0x69, 0x6e,
+	0x2e, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73,
+	0x67, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x1a, 0x32, 0x2e, 0x66, 0x6c, 0x6f, 0x72, 0x69, 0x6e, 0x2e, 0x62, 0x6c, 0x61,
+	0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x71, 0x0a, 0x11, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x65, 0x72, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x12, 0x29, 0x2e, 0x66,
 	0x6c, 0x6f, 0x72, 0x69, 0x6e, 0x2e, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x2e,
 	0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x4f, 0x77,
-	0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x4d, 0x0a, 0x05, 0x55, 0x6e, 0x62, 0x61, 0x6e, 0x12, 0x1d, 0x2e, 0x66, 0x6c, 0x6f, 0x72, 0x69,
-	0x6e, 0x2e, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
-	0x73, 0x67, 0x55, 0x6e, 0x62, 0x61, 0x6e, 0x1a, 0x25, 0x2e, 0x66, 0x6c, 0x6f, 0x72, 0x69, 0x6e,
+	0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x1a, 0x31, 0x2e, 0x66, 0x6c, 0x6f, 0x72, 0x69, 0x6e,
+	0x2e, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73,
+	0x67, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68,
+	0x69, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x05, 0x55, 0x6e,
+	0x62, 0x61, 0x6e, 0x12, 0x1d, 0x2e, 0x66, 0x6c, 0x6f, 0x72, 0x69, 0x6e, 0x2e, 0x62, 0x6c, 0x61,
+	0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x6e, 0x62,
+	0x61, 0x6e, 0x1a, 0x25, 0x2e, 0x66, 0x6c, 0x6f, 0x72, 0x69, 0x6e, 0x2e, 0x62, 0x6c, 0x61, 0x63,
+	0x6b, 0x6c, 0x69, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x6e, 0x62, 0x61,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x0a, 0x55, 0x6e, 0x62,
+	0x61, 0x6e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x22, 0x2e, 0x66, 0x6c, 0x6f, 0x72, 0x69, 0x6e,
 	0x2e, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73,
-	0x67, 0x55, 0x6e, 0x62, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x1a, 0x05,
-	0x80, 0xe7, 0xb0, 0x2a, 0x01, 0x42, 0xd9, 0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x66, 0x6c,
+	0x67, 0x55, 0x6e, 0x62, 0x61, 0x6e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x1a, 0x2a, 0x2e, 0x66, 0x6c,
 	0x6f, 0x72, 0x69, 0x6e, 0x2e, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x2e, 0x76,
-	0x31, 0x42, 0x07, 0x54, 0x78, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x47, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6d, 0x6f, 0x6e, 0x65, 0x72, 0x69, 0x75,
-	0x6d, 0x2f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2d, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2f, 0x76,
-	0x32, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66, 0x6c, 0x6f, 0x72, 0x69, 0x6e, 0x2f, 0x62, 0x6c, 0x61,
-	0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x2f, 0x76, 0x31, 0x3b, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6c,
-	0x69, 0x73, 0x74, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x46, 0x42, 0x58, 0xaa, 0x02, 0x13, 0x46, 0x6c,
-	0x6f, 0x72, 0x69, 0x6e, 0x2e, 0x42, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x2e, 0x56,
-	0x31, 0xca, 0x02, 0x13, 0x46, 0x6c, 0x6f, 0x72, 0x69, 0x6e, 0x5c, 0x42, 0x6c, 0x61, 0x63, 0x6b,
-	0x6c, 0x69, 0x73, 0x74, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1f, 0x46, 0x6c, 0x6f, 0x72, 0x69, 0x6e,
-	0x5c, 0x42, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50,
-	0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x15, 0x46, 0x6c, 0x6f, 0x72,
-	0x69, 0x6e, 0x3a, 0x3a, 0x42, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x3a, 0x3a, 0x56,
-	0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x6e, 0x62, 0x61, 0x6e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x1a, 0x05, 0x80, 0xe7, 0xb0, 0x2a, 0x01, 0x42, 0xd9,
+	0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x66, 0x6c, 0x6f, 0x72, 0x69, 0x6e, 0x2e, 0x62, 0x6c,
+	0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x42, 0x07, 0x54, 0x78, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x47, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x6d, 0x6f, 0x6e, 0x65, 0x72, 0x69, 0x75, 0x6d, 0x2f, 0x6d, 0x6f, 0x64, 0x75, 0x6c,
+	0x65, 0x2d, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2f, 0x76, 0x32, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66,
+	0x6c, 0x6f, 0x72, 0x69, 0x6e, 0x2f, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x2f,
+	0x76, 0x31, 0x3b, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x76, 0x31, 0xa2, 0x02,
+	0x03, 0x46, 0x42, 0x58, 0xaa, 0x02, 0x13, 0x46, 0x6c, 0x6f, 0x72, 0x69, 0x6e, 0x2e, 0x42, 0x6c,
+	0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x13, 0x46, 0x6c, 0x6f,
+	0x72, 0x69, 0x6e, 0x5c, 0x42, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x5c, 0x56, 0x31,
+	0xe2, 0x02, 0x1f, 0x46, 0x6c, 0x6f, 0x72, 0x69, 0x6e, 0x5c, 0x42, 0x6c, 0x61, 0x63, 0x6b, 0x6c,
+	0x69, 0x73, 0x74, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0xea, 0x02, 0x15, 0x46, 0x6c, 0x6f, 0x72, 0x69, 0x6e, 0x3a, 0x3a, 0x42, 0x6c, 0x61,
+	0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -5576,7 +8496,7 @@ func file_florin_blacklist_v1_tx_proto_rawDescGZIP() []byte {
 	return file_florin_blacklist_v1_tx_proto_rawDescData
 }
 
-var file_florin_blacklist_v1_tx_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_florin_blacklist_v1_tx_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
 var file_florin_blacklist_v1_tx_proto_goTypes = []interface{}{
 	(*MsgAcceptOwnership)(nil),            // 0: florin.blacklist.v1.MsgAcceptOwnership
 	(*MsgAcceptOwnershipResponse)(nil),    // 1: florin.blacklist.v1.MsgAcceptOwnershipResponse
@@ -5584,31 +8504,42 @@ var file_florin_blacklist_v1_tx_proto_goTypes = []interface{}{
 	(*MsgAddAdminAccountResponse)(nil),    // 3: florin.blacklist.v1.MsgAddAdminAccountResponse
 	(*MsgBan)(nil),                        // 4: florin.blacklist.v1.MsgBan
 	(*MsgBanResponse)(nil),                // 5: florin.blacklist.v1.MsgBanResponse
-	(*MsgRemoveAdminAccount)(nil),         // 6: florin.blacklist.v1.MsgRemoveAdminAccount
-	(*MsgRemoveAdminAccountResponse)(nil), // 7: florin.blacklist.v1.MsgRemoveAdminAccountResponse
-	(*MsgTransferOwnership)(nil),          // 8: florin.blacklist.v1.MsgTransferOwnership
-	(*MsgTransferOwnershipResponse)(nil),  // 9: florin.blacklist.v1.MsgTransferOwnershipResponse
-	(*MsgUnban)(nil),                      // 10: florin.blacklist.v1.MsgUnban
-	(*MsgUnbanResponse)(nil),              // 11: florin.blacklist.v1.MsgUnbanResponse
+	(*MsgBanBatch)(nil),                   // 6: florin.blacklist.v1.MsgBanBatch
+	(*MsgBanBatchResponse)(nil),           // 7: florin.blacklist.v1.MsgBanBatchResponse
+	(*MsgRemoveAdminAccount)(nil),         // 8: florin.blacklist.v1.MsgRemoveAdminAccount
+	(*MsgRemoveAdminAccountResponse)(nil), // 9: florin.blacklist.v1.MsgRemoveAdminAccountResponse
+	(*MsgTransferOwnership)(nil),          // 10: florin.blacklist.v1.MsgTransferOwnership
+	(*MsgTransferOwnershipResponse)(nil),  // 11: florin.blacklist.v1.MsgTransferOwnershipResponse
+	(*MsgUnban)(nil),                      // 12: florin.blacklist.v1.MsgUnban
+	(*MsgUnbanResponse)(nil),              // 13: florin.blacklist.v1.MsgUnbanResponse
+	(*MsgUnbanBatch)(nil),                 // 14: florin.blacklist.v1.MsgUnbanBatch
+	(*MsgUnbanBatchResponse)(nil),         // 15: florin.blacklist.v1.MsgUnbanBatchResponse
+	(BanReason)(0),                        // 16: florin.blacklist.v1.BanReason
 }
 var file_florin_blacklist_v1_tx_proto_depIdxs = []int32{
-	0,  // 0: florin.blacklist.v1.Msg.AcceptOwnership:input_type -> florin.blacklist.v1.MsgAcceptOwnership
-	2,  // 1: florin.blacklist.v1.Msg.AddAdminAccount:input_type -> florin.blacklist.v1.MsgAddAdminAccount
-	4,  // 2: florin.blacklist.v1.Msg.Ban:input_type -> florin.blacklist.v1.MsgBan
-	6,  // 3: florin.blacklist.v1.Msg.RemoveAdminAccount:input_type -> florin.blacklist.v1.MsgRemoveAdminAccount
-	8,  // 4: florin.blacklist.v1.Msg.TransferOwnership:input_type -> florin.blacklist.v1.MsgTransferOwnership
-	10, // 5: florin.blacklist.v1.Msg.Unban:input_type -> florin.blacklist.v1.MsgUnban
-	1,  // 6: florin.blacklist.v1.Msg.AcceptOwnership:output_type -> florin.blacklist.v1.MsgAcceptOwnershipResponse
-	3,  // 7: florin.blacklist.v1.Msg.AddAdminAccount:output_type -> florin.blacklist.v1.MsgAddAdminAccountResponse
-	5,  // 8: florin.blacklist.v1.Msg.Ban:output_type -> florin.blacklist.v1.MsgBanResponse
-	7,  // 9: florin.blacklist.v1.Msg.RemoveAdminAccount:output_type -> florin.blacklist.v1.MsgRemoveAdminAccountResponse
-	9,  // 10: florin.blacklist.v1.Msg.TransferOwnership:output_type -> florin.blacklist.v1.MsgTransferOwnershipResponse
-	11, // 11: florin.blacklist.v1.Msg.Unban:output_type -> florin.blacklist.v1.MsgUnbanResponse
-	6,  // [6:12] is the sub-list for method output_type
-	0,  // [0:6] is the sub-list for method input_type
-	0,  // [0:0] is the sub-list for extension type_name
-	0,  // [0:0] is the sub-list for extension extendee
-	0,  // [0:0] is the sub-list for field type_name
+	16, // 0: florin.blacklist.v1.MsgBan.reason:type_name -> florin.blacklist.v1.BanReason
+	16, // 1: florin.blacklist.v1.MsgBanBatch.reason:type_name -> florin.blacklist.v1.BanReason
+	0,  // 2: florin.blacklist.v1.Msg.AcceptOwnership:input_type -> florin.blacklist.v1.MsgAcceptOwnership
+	2,  // 3: florin.blacklist.v1.Msg.AddAdminAccount:input_type -> florin.blacklist.v1.MsgAddAdminAccount
+	4,  // 4: florin.blacklist.v1.Msg.Ban:input_type -> florin.blacklist.v1.MsgBan
+	6,  // 5: florin.blacklist.v1.Msg.BanBatch:input_type -> florin.blacklist.v1.MsgBanBatch
+	8,  // 6: florin.blacklist.v1.Msg.RemoveAdminAccount:input_type -> florin.blacklist.v1.MsgRemoveAdminAccount
+	10, // 7: florin.blacklist.v1.Msg.TransferOwnership:input_type -> florin.blacklist.v1.MsgTransferOwnership
+	12, // 8: florin.blacklist.v1.Msg.Unban:input_type -> florin.blacklist.v1.MsgUnban
+	14, // 9: florin.blacklist.v1.Msg.UnbanBatch:input_type -> florin.blacklist.v1.MsgUnbanBatch
+	1,  // 10: florin.blacklist.v1.Msg.AcceptOwnership:output_type -> florin.blacklist.v1.MsgAcceptOwnershipResponse
+	3,  // 11: florin.blacklist.v1.Msg.AddAdminAccount:output_type -> florin.blacklist.v1.MsgAddAdminAccountResponse
+	5,  // 12: florin.blacklist.v1.Msg.Ban:output_type -> florin.blacklist.v1.MsgBanResponse
+	7,  // 13: florin.blacklist.v1.Msg.BanBatch:output_type -> florin.blacklist.v1.MsgBanBatchResponse
+	9,  // 14: florin.blacklist.v1.Msg.RemoveAdminAccount:output_type -> florin.blacklist.v1.MsgRemoveAdminAccountResponse
+	11, // 15: florin.blacklist.v1.Msg.TransferOwnership:output_type -> florin.blacklist.v1.MsgTransferOwnershipResponse
+	13, // 16: florin.blacklist.v1.Msg.Unban:output_type -> florin.blacklist.v1.MsgUnbanResponse
+	15, // 17: florin.blacklist.v1.Msg.UnbanBatch:output_type -> florin.blacklist.v1.MsgUnbanBatchResponse
+	10, // [10:18] is the sub-list for method output_type
+	2,  // [2:10] is the sub-list for method input_type
+	2,  // [2:2] is the sub-list for extension type_name
+	2,  // [2:2] is the sub-list for extension extendee
+	0,  // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_florin_blacklist_v1_tx_proto_init() }
@@ -5616,6 +8547,7 @@ func file_florin_blacklist_v1_tx_proto_init() {
 	if File_florin_blacklist_v1_tx_proto != nil {
 		return
 	}
+	file_florin_blacklist_v1_genesis_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_florin_blacklist_v1_tx_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MsgAcceptOwnership); i {
@@ -5690,7 +8622,7 @@ func file_florin_blacklist_v1_tx_proto_init() {
 			}
 		}
 		file_florin_blacklist_v1_tx_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgRemoveAdminAccount); i {
+			switch v := v.(*MsgBanBatch); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5702,7 +8634,7 @@ func file_florin_blacklist_v1_tx_proto_init() {
 			}
 		}
 		file_florin_blacklist_v1_tx_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgRemoveAdminAccountResponse); i {
+			switch v := v.(*MsgBanBatchResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5714,7 +8646,7 @@ func file_florin_blacklist_v1_tx_proto_init() {
 			}
 		}
 		file_florin_blacklist_v1_tx_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgTransferOwnership); i {
+			switch v := v.(*MsgRemoveAdminAccount); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5726,7 +8658,7 @@ func file_florin_blacklist_v1_tx_proto_init() {
 			}
 		}
 		file_florin_blacklist_v1_tx_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgTransferOwnershipResponse); i {
+			switch v := v.(*MsgRemoveAdminAccountResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5738,7 +8670,7 @@ func file_florin_blacklist_v1_tx_proto_init() {
 			}
 		}
 		file_florin_blacklist_v1_tx_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgUnban); i {
+			switch v := v.(*MsgTransferOwnership); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5750,6 +8682,30 @@ func file_florin_blacklist_v1_tx_proto_init() {
 			}
 		}
 		file_florin_blacklist_v1_tx_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgTransferOwnershipResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_florin_blacklist_v1_tx_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgUnban); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_florin_blacklist_v1_tx_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MsgUnbanResponse); i {
 			case 0:
 				return &v.state
@@ -5761,6 +8717,30 @@ func file_florin_blacklist_v1_tx_proto_init() {
 				return nil
 			}
 		}
+		file_florin_blacklist_v1_tx_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgUnbanBatch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_florin_blacklist_v1_tx_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgUnbanBatchResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -5768,7 +8748,7 @@ func file_florin_blacklist_v1_tx_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_florin_blacklist_v1_tx_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   12,
+			NumMessages:   16,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -22,9 +22,11 @@ const (
 	Msg_AcceptOwnership_FullMethodName    = "/florin.blacklist.v1.Msg/AcceptOwnership"
 	Msg_AddAdminAccount_FullMethodName    = "/florin.blacklist.v1.Msg/AddAdminAccount"
 	Msg_Ban_FullMethodName                = "/florin.blacklist.v1.Msg/Ban"
+	Msg_BanBatch_FullMethodName           = "/florin.blacklist.v1.Msg/BanBatch"
 	Msg_RemoveAdminAccount_FullMethodName = "/florin.blacklist.v1.Msg/RemoveAdminAccount"
 	Msg_TransferOwnership_FullMethodName  = "/florin.blacklist.v1.Msg/TransferOwnership"
 	Msg_Unban_FullMethodName              = "/florin.blacklist.v1.Msg/Unban"
+	Msg_UnbanBatch_FullMethodName         = "/florin.blacklist.v1.Msg/UnbanBatch"
 )
 
 // MsgClient is the client API for Msg service.
@@ -34,9 +36,11 @@ type MsgClient interface {
 	AcceptOwnership(ctx context.Context, in *MsgAcceptOwnership, opts ...grpc.CallOption) (*MsgAcceptOwnershipResponse, error)
 	AddAdminAccount(ctx context.Context, in *MsgAddAdminAccount, opts ...grpc.CallOption) (*MsgAddAdminAccountResponse, error)
 	Ban(ctx context.Context, in *MsgBan, opts ...grpc.CallOption) (*MsgBanResponse, error)
+	BanBatch(ctx context.Context, in *MsgBanBatch, opts ...grpc.CallOption) (*MsgBanBatchResponse, error)
 	RemoveAdminAccount(ctx context.Context, in *MsgRemoveAdminAccount, opts ...grpc.CallOption) (*MsgRemoveAdminAccountResponse, error)
 	TransferOwnership(ctx context.Context, in *MsgTransferOwnership, opts ...grpc.CallOption) (*MsgTransferOwnershipResponse, error)
 	Unban(ctx context.Context, in *MsgUnban, opts ...grpc.CallOption) (*MsgUnbanResponse, error)
+	UnbanBatch(ctx context.Context, in *MsgUnbanBatch, opts ...grpc.CallOption) (*MsgUnbanBatchResponse, error)
 }
 
 type msgClient struct {
@@ -77,6 +81,16 @@ func (c *msgClient) Ban(ctx context.Context, in *MsgBan, opts ...grpc.CallOption
 	return out, nil
 }
 
+func (c *msgClient) BanBatch(ctx context.Context, in *MsgBanBatch, opts ...grpc.CallOption) (*MsgBanBatchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgBanBatchResponse)
+	err := c.cc.Invoke(ctx, Msg_BanBatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) RemoveAdminAccount(ctx context.Context, in *MsgRemoveAdminAccount, opts ...grpc.CallOption) (*MsgRemoveAdminAccountResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(MsgRemoveAdminAccountResponse)
@@ -107,6 +121,16 @@ func (c *msgClient) Unban(ctx context.Context, in *MsgUnban, opts ...grpc.CallOp
 	return out, nil
 }
 
+func (c *msgClient) UnbanBatch(ctx context.Context, in *MsgUnbanBatch, opts ...grpc.CallOption) (*MsgUnbanBatchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgUnbanBatchResponse)
+	err := c.cc.Invoke(ctx, Msg_UnbanBatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility.
@@ -114,9 +138,11 @@ type MsgServer interface {
 	AcceptOwnership(context.Context, *MsgAcceptOwnership) (*MsgAcceptOwnershipResponse, error)
 	AddAdminAccount(context.Context, *MsgAddAdminAccount) (*MsgAddAdminAccountResponse, error)
 	Ban(context.Context, *MsgBan) (*MsgBanResponse, error)
+	BanBatch(context.Context, *MsgBanBatch) (*MsgBanBatchResponse, error)
 	RemoveAdminAccount(context.Context, *MsgRemoveAdminAccount) (*MsgRemoveAdminAccountResponse, error)
 	TransferOwnership(context.Context, *MsgTransferOwnership) (*MsgTransferOwnershipResponse, error)
 	Unban(context.Context, *MsgUnban) (*MsgUnbanResponse, error)
+	UnbanBatch(context.Context, *MsgUnbanBatch) (*MsgUnbanBatchResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -136,6 +162,9 @@ func (UnimplementedMsgServer) AddAdminAccount(context.Context, *MsgAddAdminAccou
 func (UnimplementedMsgServer) Ban(context.Context, *MsgBan) (*MsgBanResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Ban not implemented")
 }
+func (UnimplementedMsgServer) BanBatch(context.Context, *MsgBanBatch) (*MsgBanBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BanBatch not implemented")
+}
 func (UnimplementedMsgServer) RemoveAdminAccount(context.Context, *MsgRemoveAdminAccount) (*MsgRemoveAdminAccountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveAdminAccount not implemented")
 }
@@ -145,6 +174,9 @@ func (UnimplementedMsgServer) TransferOwnership(context.Context, *MsgTransferOwn
 func (UnimplementedMsgServer) Unban(context.Context, *MsgUnban) (*MsgUnbanResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Unban not implemented")
 }
+func (UnimplementedMsgServer) UnbanBatch(context.Context, *MsgUnbanBatch) (*MsgUnbanBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnbanBatch not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 func (UnimplementedMsgServer) testEmbeddedByValue()             {}
 
@@ -220,6 +252,24 @@ func _Msg_Ban_Handler(srv interface{}, ctx context.Context, dec func(interface{}
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_BanBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBanBatch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BanBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_BanBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BanBatch(ctx, req.(*MsgBanBatch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_RemoveAdminAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgRemoveAdminAccount)
 	if err := dec(in); err != nil {
@@ -274,6 +324,24 @@ func _Msg_Unban_Handler(srv interface{}, ctx context.Context, dec func(interface
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UnbanBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnbanBatch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnbanBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UnbanBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnbanBatch(ctx, req.(*MsgUnbanBatch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -293,6 +361,10 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Ban",
 			Handler:    _Msg_Ban_Handler,
 		},
+		{
+			MethodName: "BanBatch",
+			Handler:    _Msg_BanBatch_Handler,
+		},
 		{
 			MethodName: "RemoveAdminAccount",
 			Handler:    _Msg_RemoveAdminAccount_Handler,
@@ -305,6 +377,10 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Unban",
 			Handler:    _Msg_Unban_Handler,
 		},
+		{
+			MethodName: "UnbanBatch",
+			Handler:    _Msg_UnbanBatch_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "florin/blacklist/v1/tx.proto",
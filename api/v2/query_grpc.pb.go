@@ -19,18 +19,32 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Query_Authority_FullMethodName         = "/florin.v2.Query/Authority"
-	Query_AllowedDenoms_FullMethodName     = "/florin.v2.Query/AllowedDenoms"
-	Query_Owners_FullMethodName            = "/florin.v2.Query/Owners"
-	Query_Owner_FullMethodName             = "/florin.v2.Query/Owner"
-	Query_Systems_FullMethodName           = "/florin.v2.Query/Systems"
-	Query_SystemsByDenom_FullMethodName    = "/florin.v2.Query/SystemsByDenom"
-	Query_Admins_FullMethodName            = "/florin.v2.Query/Admins"
-	Query_AdminsByDenom_FullMethodName     = "/florin.v2.Query/AdminsByDenom"
-	Query_MaxMintAllowances_FullMethodName = "/florin.v2.Query/MaxMintAllowances"
-	Query_MaxMintAllowance_FullMethodName  = "/florin.v2.Query/MaxMintAllowance"
-	Query_MintAllowances_FullMethodName    = "/florin.v2.Query/MintAllowances"
-	Query_MintAllowance_FullMethodName     = "/florin.v2.Query/MintAllowance"
+	Query_Authority_FullMethodName          = "/florin.v2.Query/Authority"
+	Query_AllowedDenoms_FullMethodName      = "/florin.v2.Query/AllowedDenoms"
+	Query_Owners_FullMethodName             = "/florin.v2.Query/Owners"
+	Query_Owner_FullMethodName              = "/florin.v2.Query/Owner"
+	Query_Systems_FullMethodName            = "/florin.v2.Query/Systems"
+	Query_SystemsByDenom_FullMethodName     = "/florin.v2.Query/SystemsByDenom"
+	Query_Admins_FullMethodName             = "/florin.v2.Query/Admins"
+	Query_AdminsByDenom_FullMethodName      = "/florin.v2.Query/AdminsByDenom"
+	Query_MaxMintAllowances_FullMethodName  = "/florin.v2.Query/MaxMintAllowances"
+	Query_MaxMintAllowance_FullMethodName   = "/florin.v2.Query/MaxMintAllowance"
+	Query_MintAllowances_FullMethodName     = "/florin.v2.Query/MintAllowances"
+	Query_MintAllowance_FullMethodName      = "/florin.v2.Query/MintAllowance"
+	Query_Paused_FullMethodName             = "/florin.v2.Query/Paused"
+	Query_Seizer_FullMethodName             = "/florin.v2.Query/Seizer"
+	Query_Nonce_FullMethodName              = "/florin.v2.Query/Nonce"
+	Query_RateLimits_FullMethodName         = "/florin.v2.Query/RateLimits"
+	Query_SupplyCap_FullMethodName          = "/florin.v2.Query/SupplyCap"
+	Query_MintApprovalPolicy_FullMethodName = "/florin.v2.Query/MintApprovalPolicy"
+	Query_PendingMints_FullMethodName       = "/florin.v2.Query/PendingMints"
+	Query_PendingMint_FullMethodName        = "/florin.v2.Query/PendingMint"
+	Query_MintReference_FullMethodName      = "/florin.v2.Query/MintReference"
+	Query_Roles_FullMethodName              = "/florin.v2.Query/Roles"
+	Query_TransferPolicy_FullMethodName     = "/florin.v2.Query/TransferPolicy"
+	Query_AllowlistAdmins_FullMethodName    = "/florin.v2.Query/AllowlistAdmins"
+	Query_Allowlist_FullMethodName          = "/florin.v2.Query/Allowlist"
+	Query_AccountLimit_FullMethodName       = "/florin.v2.Query/AccountLimit"
 )
 
 // QueryClient is the client API for Query service.
@@ -49,6 +63,20 @@ type QueryClient interface {
 	MaxMintAllowance(ctx context.Context, in *QueryMaxMintAllowance, opts ...grpc.CallOption) (*QueryMaxMintAllowanceResponse, error)
 	MintAllowances(ctx context.Context, in *QueryMintAllowances, opts ...grpc.CallOption) (*QueryMintAllowancesResponse, error)
 	MintAllowance(ctx context.Context, in *QueryMintAllowance, opts ...grpc.CallOption) (*QueryMintAllowanceResponse, error)
+	Paused(ctx context.Context, in *QueryPaused, opts ...grpc.CallOption) (*QueryPausedResponse, error)
+	Seizer(ctx context.Context, in *QuerySeizer, opts ...grpc.CallOption) (*QuerySeizerResponse, error)
+	Nonce(ctx context.Context, in *QueryNonce, opts ...grpc.CallOption) (*QueryNonceResponse, error)
+	RateLimits(ctx context.Context, in *QueryRateLimits, opts ...grpc.CallOption) (*QueryRateLimitsResponse, error)
+	SupplyCap(ctx context.Context, in *QuerySupplyCap, opts ...grpc.CallOption) (*QuerySupplyCapResponse, error)
+	MintApprovalPolicy(ctx context.Context, in *QueryMintApprovalPolicy, opts ...grpc.CallOption) (*QueryMintApprovalPolicyResponse, error)
+	PendingMints(ctx context.Context, in *QueryPendingMints, opts ...grpc.CallOption) (*QueryPendingMintsResponse, error)
+	PendingMint(ctx context.Context, in *QueryPendingMint, opts ...grpc.CallOption) (*QueryPendingMintResponse, error)
+	MintReference(ctx context.Context, in *QueryMintReference, opts ...grpc.CallOption) (*QueryMintReferenceResponse, error)
+	Roles(ctx context.Context, in *QueryRoles, opts ...grpc.CallOption) (*QueryRolesResponse, error)
+	TransferPolicy(ctx context.Context, in *QueryTransferPolicy, opts ...grpc.CallOption) (*QueryTransferPolicyResponse, error)
+	AllowlistAdmins(ctx context.Context, in *QueryAllowlistAdmins, opts ...grpc.CallOption) (*QueryAllowlistAdminsResponse, error)
+	Allowlist(ctx context.Context, in *QueryAllowlist, opts ...grpc.CallOption) (*QueryAllowlistResponse, error)
+	AccountLimit(ctx context.Context, in *QueryAccountLimit, opts ...grpc.CallOption) (*QueryAccountLimitResponse, error)
 }
 
 type queryClient struct {
@@ -179,6 +207,146 @@ func (c *queryClient) MintAllowance(ctx context.Context, in *QueryMintAllowance,
 	return out, nil
 }
 
+func (c *queryClient) Paused(ctx context.Context, in *QueryPaused, opts ...grpc.CallOption) (*QueryPausedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryPausedResponse)
+	err := c.cc.Invoke(ctx, Query_Paused_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Seizer(ctx context.Context, in *QuerySeizer, opts ...grpc.CallOption) (*QuerySeizerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuerySeizerResponse)
+	err := c.cc.Invoke(ctx, Query_Seizer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Nonce(ctx context.Context, in *QueryNonce, opts ...grpc.CallOption) (*QueryNonceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryNonceResponse)
+	err := c.cc.Invoke(ctx, Query_Nonce_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RateLimits(ctx context.Context, in *QueryRateLimits, opts ...grpc.CallOption) (*QueryRateLimitsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryRateLimitsResponse)
+	err := c.cc.Invoke(ctx, Query_RateLimits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SupplyCap(ctx context.Context, in *QuerySupplyCap, opts ...grpc.CallOption) (*QuerySupplyCapResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuerySupplyCapResponse)
+	err := c.cc.Invoke(ctx, Query_SupplyCap_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MintApprovalPolicy(ctx context.Context, in *QueryMintApprovalPolicy, opts ...grpc.CallOption) (*QueryMintApprovalPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryMintApprovalPolicyResponse)
+	err := c.cc.Invoke(ctx, Query_MintApprovalPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingMints(ctx context.Context, in *QueryPendingMints, opts ...grpc.CallOption) (*QueryPendingMintsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryPendingMintsResponse)
+	err := c.cc.Invoke(ctx, Query_PendingMints_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingMint(ctx context.Context, in *QueryPendingMint, opts ...grpc.CallOption) (*QueryPendingMintResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryPendingMintResponse)
+	err := c.cc.Invoke(ctx, Query_PendingMint_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MintReference(ctx context.Context, in *QueryMintReference, opts ...grpc.CallOption) (*QueryMintReferenceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryMintReferenceResponse)
+	err := c.cc.Invoke(ctx, Query_MintReference_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Roles(ctx context.Context, in *QueryRoles, opts ...grpc.CallOption) (*QueryRolesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryRolesResponse)
+	err := c.cc.Invoke(ctx, Query_Roles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TransferPolicy(ctx context.Context, in *QueryTransferPolicy, opts ...grpc.CallOption) (*QueryTransferPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryTransferPolicyResponse)
+	err := c.cc.Invoke(ctx, Query_TransferPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllowlistAdmins(ctx context.Context, in *QueryAllowlistAdmins, opts ...grpc.CallOption) (*QueryAllowlistAdminsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryAllowlistAdminsResponse)
+	err := c.cc.Invoke(ctx, Query_AllowlistAdmins_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Allowlist(ctx context.Context, in *QueryAllowlist, opts ...grpc.CallOption) (*QueryAllowlistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryAllowlistResponse)
+	err := c.cc.Invoke(ctx, Query_Allowlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AccountLimit(ctx context.Context, in *QueryAccountLimit, opts ...grpc.CallOption) (*QueryAccountLimitResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryAccountLimitResponse)
+	err := c.cc.Invoke(ctx, Query_AccountLimit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility.
@@ -195,6 +363,20 @@ type QueryServer interface {
 	MaxMintAllowance(context.Context, *QueryMaxMintAllowance) (*QueryMaxMintAllowanceResponse, error)
 	MintAllowances(context.Context, *QueryMintAllowances) (*QueryMintAllowancesResponse, error)
 	MintAllowance(context.Context, *QueryMintAllowance) (*QueryMintAllowanceResponse, error)
+	Paused(context.Context, *QueryPaused) (*QueryPausedResponse, error)
+	Seizer(context.Context, *QuerySeizer) (*QuerySeizerResponse, error)
+	Nonce(context.Context, *QueryNonce) (*QueryNonceResponse, error)
+	RateLimits(context.Context, *QueryRateLimits) (*QueryRateLimitsResponse, error)
+	SupplyCap(context.Context, *QuerySupplyCap) (*QuerySupplyCapResponse, error)
+	MintApprovalPolicy(context.Context, *QueryMintApprovalPolicy) (*QueryMintApprovalPolicyResponse, error)
+	PendingMints(context.Context, *QueryPendingMints) (*QueryPendingMintsResponse, error)
+	PendingMint(context.Context, *QueryPendingMint) (*QueryPendingMintResponse, error)
+	MintReference(context.Context, *QueryMintReference) (*QueryMintReferenceResponse, error)
+	Roles(context.Context, *QueryRoles) (*QueryRolesResponse, error)
+	TransferPolicy(context.Context, *QueryTransferPolicy) (*QueryTransferPolicyResponse, error)
+	AllowlistAdmins(context.Context, *QueryAllowlistAdmins) (*QueryAllowlistAdminsResponse, error)
+	Allowlist(context.Context, *QueryAllowlist) (*QueryAllowlistResponse, error)
+	AccountLimit(context.Context, *QueryAccountLimit) (*QueryAccountLimitResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -241,6 +423,48 @@ func (UnimplementedQueryServer) MintAllowances(context.Context, *QueryMintAllowa
 func (UnimplementedQueryServer) MintAllowance(context.Context, *QueryMintAllowance) (*QueryMintAllowanceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MintAllowance not implemented")
 }
+func (UnimplementedQueryServer) Paused(context.Context, *QueryPaused) (*QueryPausedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Paused not implemented")
+}
+func (UnimplementedQueryServer) Seizer(context.Context, *QuerySeizer) (*QuerySeizerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Seizer not implemented")
+}
+func (UnimplementedQueryServer) Nonce(context.Context, *QueryNonce) (*QueryNonceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Nonce not implemented")
+}
+func (UnimplementedQueryServer) RateLimits(context.Context, *QueryRateLimits) (*QueryRateLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RateLimits not implemented")
+}
+func (UnimplementedQueryServer) SupplyCap(context.Context, *QuerySupplyCap) (*QuerySupplyCapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SupplyCap not implemented")
+}
+func (UnimplementedQueryServer) MintApprovalPolicy(context.Context, *QueryMintApprovalPolicy) (*QueryMintApprovalPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MintApprovalPolicy not implemented")
+}
+func (UnimplementedQueryServer) PendingMints(context.Context, *QueryPendingMints) (*QueryPendingMintsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingMints not implemented")
+}
+func (UnimplementedQueryServer) PendingMint(context.Context, *QueryPendingMint) (*QueryPendingMintResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingMint not implemented")
+}
+func (UnimplementedQueryServer) MintReference(context.Context, *QueryMintReference) (*QueryMintReferenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MintReference not implemented")
+}
+func (UnimplementedQueryServer) Roles(context.Context, *QueryRoles) (*QueryRolesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Roles not implemented")
+}
+func (UnimplementedQueryServer) TransferPolicy(context.Context, *QueryTransferPolicy) (*QueryTransferPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferPolicy not implemented")
+}
+func (UnimplementedQueryServer) AllowlistAdmins(context.Context, *QueryAllowlistAdmins) (*QueryAllowlistAdminsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllowlistAdmins not implemented")
+}
+func (UnimplementedQueryServer) Allowlist(context.Context, *QueryAllowlist) (*QueryAllowlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Allowlist not implemented")
+}
+func (UnimplementedQueryServer) AccountLimit(context.Context, *QueryAccountLimit) (*QueryAccountLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountLimit not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 func (UnimplementedQueryServer) testEmbeddedByValue()               {}
 
@@ -478,6 +702,258 @@ func _Query_MintAllowance_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Paused_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPaused)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Paused(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Paused_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Paused(ctx, req.(*QueryPaused))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Seizer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySeizer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Seizer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Seizer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Seizer(ctx, req.(*QuerySeizer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Nonce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNonce)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Nonce(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Nonce_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Nonce(ctx, req.(*QueryNonce))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RateLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRateLimits)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RateLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_RateLimits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RateLimits(ctx, req.(*QueryRateLimits))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SupplyCap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySupplyCap)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SupplyCap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SupplyCap_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SupplyCap(ctx, req.(*QuerySupplyCap))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MintApprovalPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMintApprovalPolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MintApprovalPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_MintApprovalPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MintApprovalPolicy(ctx, req.(*QueryMintApprovalPolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingMints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingMints)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingMints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PendingMints_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingMints(ctx, req.(*QueryPendingMints))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingMint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingMint)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingMint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PendingMint_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingMint(ctx, req.(*QueryPendingMint))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MintReference_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMintReference)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MintReference(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_MintReference_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MintReference(ctx, req.(*QueryMintReference))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Roles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRoles)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Roles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Roles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Roles(ctx, req.(*QueryRoles))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TransferPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTransferPolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TransferPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TransferPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TransferPolicy(ctx, req.(*QueryTransferPolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllowlistAdmins_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllowlistAdmins)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllowlistAdmins(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AllowlistAdmins_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllowlistAdmins(ctx, req.(*QueryAllowlistAdmins))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Allowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllowlist)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Allowlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Allowlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Allowlist(ctx, req.(*QueryAllowlist))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AccountLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountLimit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AccountLimit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountLimit(ctx, req.(*QueryAccountLimit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -533,6 +1009,62 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "MintAllowance",
 			Handler:    _Query_MintAllowance_Handler,
 		},
+		{
+			MethodName: "Paused",
+			Handler:    _Query_Paused_Handler,
+		},
+		{
+			MethodName: "Seizer",
+			Handler:    _Query_Seizer_Handler,
+		},
+		{
+			MethodName: "Nonce",
+			Handler:    _Query_Nonce_Handler,
+		},
+		{
+			MethodName: "RateLimits",
+			Handler:    _Query_RateLimits_Handler,
+		},
+		{
+			MethodName: "SupplyCap",
+			Handler:    _Query_SupplyCap_Handler,
+		},
+		{
+			MethodName: "MintApprovalPolicy",
+			Handler:    _Query_MintApprovalPolicy_Handler,
+		},
+		{
+			MethodName: "PendingMints",
+			Handler:    _Query_PendingMints_Handler,
+		},
+		{
+			MethodName: "PendingMint",
+			Handler:    _Query_PendingMint_Handler,
+		},
+		{
+			MethodName: "MintReference",
+			Handler:    _Query_MintReference_Handler,
+		},
+		{
+			MethodName: "Roles",
+			Handler:    _Query_Roles_Handler,
+		},
+		{
+			MethodName: "TransferPolicy",
+			Handler:    _Query_TransferPolicy_Handler,
+		},
+		{
+			MethodName: "AllowlistAdmins",
+			Handler:    _Query_AllowlistAdmins_Handler,
+		},
+		{
+			MethodName: "Allowlist",
+			Handler:    _Query_Allowlist_Handler,
+		},
+		{
+			MethodName: "AccountLimit",
+			Handler:    _Query_AccountLimit_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "florin/v2/query.proto",
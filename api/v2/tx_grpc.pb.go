@@ -19,18 +19,40 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Msg_AcceptOwnership_FullMethodName     = "/florin.v2.Msg/AcceptOwnership"
-	Msg_AddAdminAccount_FullMethodName     = "/florin.v2.Msg/AddAdminAccount"
-	Msg_AddSystemAccount_FullMethodName    = "/florin.v2.Msg/AddSystemAccount"
-	Msg_AllowDenom_FullMethodName          = "/florin.v2.Msg/AllowDenom"
-	Msg_Burn_FullMethodName                = "/florin.v2.Msg/Burn"
-	Msg_Mint_FullMethodName                = "/florin.v2.Msg/Mint"
-	Msg_Recover_FullMethodName             = "/florin.v2.Msg/Recover"
-	Msg_RemoveAdminAccount_FullMethodName  = "/florin.v2.Msg/RemoveAdminAccount"
-	Msg_RemoveSystemAccount_FullMethodName = "/florin.v2.Msg/RemoveSystemAccount"
-	Msg_SetMaxMintAllowance_FullMethodName = "/florin.v2.Msg/SetMaxMintAllowance"
-	Msg_SetMintAllowance_FullMethodName    = "/florin.v2.Msg/SetMintAllowance"
-	Msg_TransferOwnership_FullMethodName   = "/florin.v2.Msg/TransferOwnership"
+	Msg_AcceptOwnership_FullMethodName           = "/florin.v2.Msg/AcceptOwnership"
+	Msg_AddAdminAccount_FullMethodName           = "/florin.v2.Msg/AddAdminAccount"
+	Msg_AddAllowlistAdmin_FullMethodName         = "/florin.v2.Msg/AddAllowlistAdmin"
+	Msg_AddSystemAccount_FullMethodName          = "/florin.v2.Msg/AddSystemAccount"
+	Msg_AddToAllowlist_FullMethodName            = "/florin.v2.Msg/AddToAllowlist"
+	Msg_AllowDenom_FullMethodName                = "/florin.v2.Msg/AllowDenom"
+	Msg_ApproveMint_FullMethodName               = "/florin.v2.Msg/ApproveMint"
+	Msg_Burn_FullMethodName                      = "/florin.v2.Msg/Burn"
+	Msg_CancelMint_FullMethodName                = "/florin.v2.Msg/CancelMint"
+	Msg_DeprecateLegacySignatures_FullMethodName = "/florin.v2.Msg/DeprecateLegacySignatures"
+	Msg_Mint_FullMethodName                      = "/florin.v2.Msg/Mint"
+	Msg_MintBatch_FullMethodName                 = "/florin.v2.Msg/MintBatch"
+	Msg_Pause_FullMethodName                     = "/florin.v2.Msg/Pause"
+	Msg_Recover_FullMethodName                   = "/florin.v2.Msg/Recover"
+	Msg_Redeem_FullMethodName                    = "/florin.v2.Msg/Redeem"
+	Msg_RemoveAccountLimit_FullMethodName        = "/florin.v2.Msg/RemoveAccountLimit"
+	Msg_RemoveAdminAccount_FullMethodName        = "/florin.v2.Msg/RemoveAdminAccount"
+	Msg_RemoveAllowlistAdmin_FullMethodName      = "/florin.v2.Msg/RemoveAllowlistAdmin"
+	Msg_RemoveFromAllowlist_FullMethodName       = "/florin.v2.Msg/RemoveFromAllowlist"
+	Msg_RemoveRateLimit_FullMethodName           = "/florin.v2.Msg/RemoveRateLimit"
+	Msg_RemoveSystemAccount_FullMethodName       = "/florin.v2.Msg/RemoveSystemAccount"
+	Msg_Seize_FullMethodName                     = "/florin.v2.Msg/Seize"
+	Msg_SetAccountLimit_FullMethodName           = "/florin.v2.Msg/SetAccountLimit"
+	Msg_SetMaxMintAllowance_FullMethodName       = "/florin.v2.Msg/SetMaxMintAllowance"
+	Msg_SetMintAllowance_FullMethodName          = "/florin.v2.Msg/SetMintAllowance"
+	Msg_SetMintApprovalPolicy_FullMethodName     = "/florin.v2.Msg/SetMintApprovalPolicy"
+	Msg_SetPauser_FullMethodName                 = "/florin.v2.Msg/SetPauser"
+	Msg_SetRateLimit_FullMethodName              = "/florin.v2.Msg/SetRateLimit"
+	Msg_SetSeizer_FullMethodName                 = "/florin.v2.Msg/SetSeizer"
+	Msg_SetSupplyCap_FullMethodName              = "/florin.v2.Msg/SetSupplyCap"
+	Msg_SetTransferPolicy_FullMethodName         = "/florin.v2.Msg/SetTransferPolicy"
+	Msg_TransferOwnership_FullMethodName         = "/florin.v2.Msg/TransferOwnership"
+	Msg_Unpause_FullMethodName                   = "/florin.v2.Msg/Unpause"
+	Msg_Wipe_FullMethodName                      = "/florin.v2.Msg/Wipe"
 )
 
 // MsgClient is the client API for Msg service.
@@ -39,16 +61,38 @@ const (
 type MsgClient interface {
 	AcceptOwnership(ctx context.Context, in *MsgAcceptOwnership, opts ...grpc.CallOption) (*MsgAcceptOwnershipResponse, error)
 	AddAdminAccount(ctx context.Context, in *MsgAddAdminAccount, opts ...grpc.CallOption) (*MsgAddAdminAccountResponse, error)
+	AddAllowlistAdmin(ctx context.Context, in *MsgAddAllowlistAdmin, opts ...grpc.CallOption) (*MsgAddAllowlistAdminResponse, error)
 	AddSystemAccount(ctx context.Context, in *MsgAddSystemAccount, opts ...grpc.CallOption) (*MsgAddSystemAccountResponse, error)
+	AddToAllowlist(ctx context.Context, in *MsgAddToAllowlist, opts ...grpc.CallOption) (*MsgAddToAllowlistResponse, error)
 	AllowDenom(ctx context.Context, in *MsgAllowDenom, opts ...grpc.CallOption) (*MsgAllowDenomResponse, error)
+	ApproveMint(ctx context.Context, in *MsgApproveMint, opts ...grpc.CallOption) (*MsgApproveMintResponse, error)
 	Burn(ctx context.Context, in *MsgBurn, opts ...grpc.CallOption) (*MsgBurnResponse, error)
+	CancelMint(ctx context.Context, in *MsgCancelMint, opts ...grpc.CallOption) (*MsgCancelMintResponse, error)
+	DeprecateLegacySignatures(ctx context.Context, in *MsgDeprecateLegacySignatures, opts ...grpc.CallOption) (*MsgDeprecateLegacySignaturesResponse, error)
 	Mint(ctx context.Context, in *MsgMint, opts ...grpc.CallOption) (*MsgMintResponse, error)
+	MintBatch(ctx context.Context, in *MsgMintBatch, opts ...grpc.CallOption) (*MsgMintBatchResponse, error)
+	Pause(ctx context.Context, in *MsgPause, opts ...grpc.CallOption) (*MsgPauseResponse, error)
 	Recover(ctx context.Context, in *MsgRecover, opts ...grpc.CallOption) (*MsgRecoverResponse, error)
+	Redeem(ctx context.Context, in *MsgRedeem, opts ...grpc.CallOption) (*MsgRedeemResponse, error)
+	RemoveAccountLimit(ctx context.Context, in *MsgRemoveAccountLimit, opts ...grpc.CallOption) (*MsgRemoveAccountLimitResponse, error)
 	RemoveAdminAccount(ctx context.Context, in *MsgRemoveAdminAccount, opts ...grpc.CallOption) (*MsgRemoveAdminAccountResponse, error)
+	RemoveAllowlistAdmin(ctx context.Context, in *MsgRemoveAllowlistAdmin, opts ...grpc.CallOption) (*MsgRemoveAllowlistAdminResponse, error)
+	RemoveFromAllowlist(ctx context.Context, in *MsgRemoveFromAllowlist, opts ...grpc.CallOption) (*MsgRemoveFromAllowlistResponse, error)
+	RemoveRateLimit(ctx context.Context, in *MsgRemoveRateLimit, opts ...grpc.CallOption) (*MsgRemoveRateLimitResponse, error)
 	RemoveSystemAccount(ctx context.Context, in *MsgRemoveSystemAccount, opts ...grpc.CallOption) (*MsgRemoveSystemAccountResponse, error)
+	Seize(ctx context.Context, in *MsgSeize, opts ...grpc.CallOption) (*MsgSeizeResponse, error)
+	SetAccountLimit(ctx context.Context, in *MsgSetAccountLimit, opts ...grpc.CallOption) (*MsgSetAccountLimitResponse, error)
 	SetMaxMintAllowance(ctx context.Context, in *MsgSetMaxMintAllowance, opts ...grpc.CallOption) (*MsgSetMaxMintAllowanceResponse, error)
 	SetMintAllowance(ctx context.Context, in *MsgSetMintAllowance, opts ...grpc.CallOption) (*MsgSetMintAllowanceResponse, error)
+	SetMintApprovalPolicy(ctx context.Context, in *MsgSetMintApprovalPolicy, opts ...grpc.CallOption) (*MsgSetMintApprovalPolicyResponse, error)
+	SetPauser(ctx context.Context, in *MsgSetPauser, opts ...grpc.CallOption) (*MsgSetPauserResponse, error)
+	SetRateLimit(ctx context.Context, in *MsgSetRateLimit, opts ...grpc.CallOption) (*MsgSetRateLimitResponse, error)
+	SetSeizer(ctx context.Context, in *MsgSetSeizer, opts ...grpc.CallOption) (*MsgSetSeizerResponse, error)
+	SetSupplyCap(ctx context.Context, in *MsgSetSupplyCap, opts ...grpc.CallOption) (*MsgSetSupplyCapResponse, error)
+	SetTransferPolicy(ctx context.Context, in *MsgSetTransferPolicy, opts ...grpc.CallOption) (*MsgSetTransferPolicyResponse, error)
 	TransferOwnership(ctx context.Context, in *MsgTransferOwnership, opts ...grpc.CallOption) (*MsgTransferOwnershipResponse, error)
+	Unpause(ctx context.Context, in *MsgUnpause, opts ...grpc.CallOption) (*MsgUnpauseResponse, error)
+	Wipe(ctx context.Context, in *MsgWipe, opts ...grpc.CallOption) (*MsgWipeResponse, error)
 }
 
 type msgClient struct {
@@ -79,6 +123,16 @@ func (c *msgClient) AddAdminAccount(ctx context.Context, in *MsgAddAdminAccount,
 	return out, nil
 }
 
+func (c *msgClient) AddAllowlistAdmin(ctx context.Context, in *MsgAddAllowlistAdmin, opts ...grpc.CallOption) (*MsgAddAllowlistAdminResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgAddAllowlistAdminResponse)
+	err := c.cc.Invoke(ctx, Msg_AddAllowlistAdmin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) AddSystemAccount(ctx context.Context, in *MsgAddSystemAccount, opts ...grpc.CallOption) (*MsgAddSystemAccountResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(MsgAddSystemAccountResponse)
@@ -89,6 +143,16 @@ func (c *msgClient) AddSystemAccount(ctx context.Context, in *MsgAddSystemAccoun
 	return out, nil
 }
 
+func (c *msgClient) AddToAllowlist(ctx context.Context, in *MsgAddToAllowlist, opts ...grpc.CallOption) (*MsgAddToAllowlistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgAddToAllowlistResponse)
+	err := c.cc.Invoke(ctx, Msg_AddToAllowlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) AllowDenom(ctx context.Context, in *MsgAllowDenom, opts ...grpc.CallOption) (*MsgAllowDenomResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(MsgAllowDenomResponse)
@@ -99,6 +163,16 @@ func (c *msgClient) AllowDenom(ctx context.Context, in *MsgAllowDenom, opts ...g
 	return out, nil
 }
 
+func (c *msgClient) ApproveMint(ctx context.Context, in *MsgApproveMint, opts ...grpc.CallOption) (*MsgApproveMintResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgApproveMintResponse)
+	err := c.cc.Invoke(ctx, Msg_ApproveMint_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) Burn(ctx context.Context, in *MsgBurn, opts ...grpc.CallOption) (*MsgBurnResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(MsgBurnResponse)
@@ -109,6 +183,26 @@ func (c *msgClient) Burn(ctx context.Context, in *MsgBurn, opts ...grpc.CallOpti
 	return out, nil
 }
 
+func (c *msgClient) CancelMint(ctx context.Context, in *MsgCancelMint, opts ...grpc.CallOption) (*MsgCancelMintResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgCancelMintResponse)
+	err := c.cc.Invoke(ctx, Msg_CancelMint_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DeprecateLegacySignatures(ctx context.Context, in *MsgDeprecateLegacySignatures, opts ...grpc.CallOption) (*MsgDeprecateLegacySignaturesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgDeprecateLegacySignaturesResponse)
+	err := c.cc.Invoke(ctx, Msg_DeprecateLegacySignatures_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) Mint(ctx context.Context, in *MsgMint, opts ...grpc.CallOption) (*MsgMintResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(MsgMintResponse)
@@ -119,6 +213,26 @@ func (c *msgClient) Mint(ctx context.Context, in *MsgMint, opts ...grpc.CallOpti
 	return out, nil
 }
 
+func (c *msgClient) MintBatch(ctx context.Context, in *MsgMintBatch, opts ...grpc.CallOption) (*MsgMintBatchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgMintBatchResponse)
+	err := c.cc.Invoke(ctx, Msg_MintBatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Pause(ctx context.Context, in *MsgPause, opts ...grpc.CallOption) (*MsgPauseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgPauseResponse)
+	err := c.cc.Invoke(ctx, Msg_Pause_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) Recover(ctx context.Context, in *MsgRecover, opts ...grpc.CallOption) (*MsgRecoverResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(MsgRecoverResponse)
@@ -129,6 +243,26 @@ func (c *msgClient) Recover(ctx context.Context, in *MsgRecover, opts ...grpc.Ca
 	return out, nil
 }
 
+func (c *msgClient) Redeem(ctx context.Context, in *MsgRedeem, opts ...grpc.CallOption) (*MsgRedeemResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgRedeemResponse)
+	err := c.cc.Invoke(ctx, Msg_Redeem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveAccountLimit(ctx context.Context, in *MsgRemoveAccountLimit, opts ...grpc.CallOption) (*MsgRemoveAccountLimitResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgRemoveAccountLimitResponse)
+	err := c.cc.Invoke(ctx, Msg_RemoveAccountLimit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) RemoveAdminAccount(ctx context.Context, in *MsgRemoveAdminAccount, opts ...grpc.CallOption) (*MsgRemoveAdminAccountResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(MsgRemoveAdminAccountResponse)
@@ -139,6 +273,36 @@ func (c *msgClient) RemoveAdminAccount(ctx context.Context, in *MsgRemoveAdminAc
 	return out, nil
 }
 
+func (c *msgClient) RemoveAllowlistAdmin(ctx context.Context, in *MsgRemoveAllowlistAdmin, opts ...grpc.CallOption) (*MsgRemoveAllowlistAdminResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgRemoveAllowlistAdminResponse)
+	err := c.cc.Invoke(ctx, Msg_RemoveAllowlistAdmin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveFromAllowlist(ctx context.Context, in *MsgRemoveFromAllowlist, opts ...grpc.CallOption) (*MsgRemoveFromAllowlistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgRemoveFromAllowlistResponse)
+	err := c.cc.Invoke(ctx, Msg_RemoveFromAllowlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveRateLimit(ctx context.Context, in *MsgRemoveRateLimit, opts ...grpc.CallOption) (*MsgRemoveRateLimitResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgRemoveRateLimitResponse)
+	err := c.cc.Invoke(ctx, Msg_RemoveRateLimit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) RemoveSystemAccount(ctx context.Context, in *MsgRemoveSystemAccount, opts ...grpc.CallOption) (*MsgRemoveSystemAccountResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(MsgRemoveSystemAccountResponse)
@@ -149,6 +313,26 @@ func (c *msgClient) RemoveSystemAccount(ctx context.Context, in *MsgRemoveSystem
 	return out, nil
 }
 
+func (c *msgClient) Seize(ctx context.Context, in *MsgSeize, opts ...grpc.CallOption) (*MsgSeizeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSeizeResponse)
+	err := c.cc.Invoke(ctx, Msg_Seize_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetAccountLimit(ctx context.Context, in *MsgSetAccountLimit, opts ...grpc.CallOption) (*MsgSetAccountLimitResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetAccountLimitResponse)
+	err := c.cc.Invoke(ctx, Msg_SetAccountLimit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) SetMaxMintAllowance(ctx context.Context, in *MsgSetMaxMintAllowance, opts ...grpc.CallOption) (*MsgSetMaxMintAllowanceResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(MsgSetMaxMintAllowanceResponse)
@@ -169,6 +353,66 @@ func (c *msgClient) SetMintAllowance(ctx context.Context, in *MsgSetMintAllowanc
 	return out, nil
 }
 
+func (c *msgClient) SetMintApprovalPolicy(ctx context.Context, in *MsgSetMintApprovalPolicy, opts ...grpc.CallOption) (*MsgSetMintApprovalPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetMintApprovalPolicyResponse)
+	err := c.cc.Invoke(ctx, Msg_SetMintApprovalPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetPauser(ctx context.Context, in *MsgSetPauser, opts ...grpc.CallOption) (*MsgSetPauserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetPauserResponse)
+	err := c.cc.Invoke(ctx, Msg_SetPauser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetRateLimit(ctx context.Context, in *MsgSetRateLimit, opts ...grpc.CallOption) (*MsgSetRateLimitResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetRateLimitResponse)
+	err := c.cc.Invoke(ctx, Msg_SetRateLimit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetSeizer(ctx context.Context, in *MsgSetSeizer, opts ...grpc.CallOption) (*MsgSetSeizerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetSeizerResponse)
+	err := c.cc.Invoke(ctx, Msg_SetSeizer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetSupplyCap(ctx context.Context, in *MsgSetSupplyCap, opts ...grpc.CallOption) (*MsgSetSupplyCapResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetSupplyCapResponse)
+	err := c.cc.Invoke(ctx, Msg_SetSupplyCap_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetTransferPolicy(ctx context.Context, in *MsgSetTransferPolicy, opts ...grpc.CallOption) (*MsgSetTransferPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetTransferPolicyResponse)
+	err := c.cc.Invoke(ctx, Msg_SetTransferPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) TransferOwnership(ctx context.Context, in *MsgTransferOwnership, opts ...grpc.CallOption) (*MsgTransferOwnershipResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(MsgTransferOwnershipResponse)
@@ -179,22 +423,64 @@ func (c *msgClient) TransferOwnership(ctx context.Context, in *MsgTransferOwners
 	return out, nil
 }
 
+func (c *msgClient) Unpause(ctx context.Context, in *MsgUnpause, opts ...grpc.CallOption) (*MsgUnpauseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgUnpauseResponse)
+	err := c.cc.Invoke(ctx, Msg_Unpause_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Wipe(ctx context.Context, in *MsgWipe, opts ...grpc.CallOption) (*MsgWipeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgWipeResponse)
+	err := c.cc.Invoke(ctx, Msg_Wipe_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility.
 type MsgServer interface {
 	AcceptOwnership(context.Context, *MsgAcceptOwnership) (*MsgAcceptOwnershipResponse, error)
 	AddAdminAccount(context.Context, *MsgAddAdminAccount) (*MsgAddAdminAccountResponse, error)
+	AddAllowlistAdmin(context.Context, *MsgAddAllowlistAdmin) (*MsgAddAllowlistAdminResponse, error)
 	AddSystemAccount(context.Context, *MsgAddSystemAccount) (*MsgAddSystemAccountResponse, error)
+	AddToAllowlist(context.Context, *MsgAddToAllowlist) (*MsgAddToAllowlistResponse, error)
 	AllowDenom(context.Context, *MsgAllowDenom) (*MsgAllowDenomResponse, error)
+	ApproveMint(context.Context, *MsgApproveMint) (*MsgApproveMintResponse, error)
 	Burn(context.Context, *MsgBurn) (*MsgBurnResponse, error)
+	CancelMint(context.Context, *MsgCancelMint) (*MsgCancelMintResponse, error)
+	DeprecateLegacySignatures(context.Context, *MsgDeprecateLegacySignatures) (*MsgDeprecateLegacySignaturesResponse, error)
 	Mint(context.Context, *MsgMint) (*MsgMintResponse, error)
+	MintBatch(context.Context, *MsgMintBatch) (*MsgMintBatchResponse, error)
+	Pause(context.Context, *MsgPause) (*MsgPauseResponse, error)
 	Recover(context.Context, *MsgRecover) (*MsgRecoverResponse, error)
+	Redeem(context.Context, *MsgRedeem) (*MsgRedeemResponse, error)
+	RemoveAccountLimit(context.Context, *MsgRemoveAccountLimit) (*MsgRemoveAccountLimitResponse, error)
 	RemoveAdminAccount(context.Context, *MsgRemoveAdminAccount) (*MsgRemoveAdminAccountResponse, error)
+	RemoveAllowlistAdmin(context.Context, *MsgRemoveAllowlistAdmin) (*MsgRemoveAllowlistAdminResponse, error)
+	RemoveFromAllowlist(context.Context, *MsgRemoveFromAllowlist) (*MsgRemoveFromAllowlistResponse, error)
+	RemoveRateLimit(context.Context, *MsgRemoveRateLimit) (*MsgRemoveRateLimitResponse, error)
 	RemoveSystemAccount(context.Context, *MsgRemoveSystemAccount) (*MsgRemoveSystemAccountResponse, error)
+	Seize(context.Context, *MsgSeize) (*MsgSeizeResponse, error)
+	SetAccountLimit(context.Context, *MsgSetAccountLimit) (*MsgSetAccountLimitResponse, error)
 	SetMaxMintAllowance(context.Context, *MsgSetMaxMintAllowance) (*MsgSetMaxMintAllowanceResponse, error)
 	SetMintAllowance(context.Context, *MsgSetMintAllowance) (*MsgSetMintAllowanceResponse, error)
+	SetMintApprovalPolicy(context.Context, *MsgSetMintApprovalPolicy) (*MsgSetMintApprovalPolicyResponse, error)
+	SetPauser(context.Context, *MsgSetPauser) (*MsgSetPauserResponse, error)
+	SetRateLimit(context.Context, *MsgSetRateLimit) (*MsgSetRateLimitResponse, error)
+	SetSeizer(context.Context, *MsgSetSeizer) (*MsgSetSeizerResponse, error)
+	SetSupplyCap(context.Context, *MsgSetSupplyCap) (*MsgSetSupplyCapResponse, error)
+	SetTransferPolicy(context.Context, *MsgSetTransferPolicy) (*MsgSetTransferPolicyResponse, error)
 	TransferOwnership(context.Context, *MsgTransferOwnership) (*MsgTransferOwnershipResponse, error)
+	Unpause(context.Context, *MsgUnpause) (*MsgUnpauseResponse, error)
+	Wipe(context.Context, *MsgWipe) (*MsgWipeResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -211,36 +497,102 @@ func (UnimplementedMsgServer) AcceptOwnership(context.Context, *MsgAcceptOwnersh
 func (UnimplementedMsgServer) AddAdminAccount(context.Context, *MsgAddAdminAccount) (*MsgAddAdminAccountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddAdminAccount not implemented")
 }
+func (UnimplementedMsgServer) AddAllowlistAdmin(context.Context, *MsgAddAllowlistAdmin) (*MsgAddAllowlistAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddAllowlistAdmin not implemented")
+}
 func (UnimplementedMsgServer) AddSystemAccount(context.Context, *MsgAddSystemAccount) (*MsgAddSystemAccountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddSystemAccount not implemented")
 }
+func (UnimplementedMsgServer) AddToAllowlist(context.Context, *MsgAddToAllowlist) (*MsgAddToAllowlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddToAllowlist not implemented")
+}
 func (UnimplementedMsgServer) AllowDenom(context.Context, *MsgAllowDenom) (*MsgAllowDenomResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AllowDenom not implemented")
 }
+func (UnimplementedMsgServer) ApproveMint(context.Context, *MsgApproveMint) (*MsgApproveMintResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveMint not implemented")
+}
 func (UnimplementedMsgServer) Burn(context.Context, *MsgBurn) (*MsgBurnResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Burn not implemented")
 }
+func (UnimplementedMsgServer) CancelMint(context.Context, *MsgCancelMint) (*MsgCancelMintResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelMint not implemented")
+}
+func (UnimplementedMsgServer) DeprecateLegacySignatures(context.Context, *MsgDeprecateLegacySignatures) (*MsgDeprecateLegacySignaturesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeprecateLegacySignatures not implemented")
+}
 func (UnimplementedMsgServer) Mint(context.Context, *MsgMint) (*MsgMintResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Mint not implemented")
 }
+func (UnimplementedMsgServer) MintBatch(context.Context, *MsgMintBatch) (*MsgMintBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MintBatch not implemented")
+}
+func (UnimplementedMsgServer) Pause(context.Context, *MsgPause) (*MsgPauseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Pause not implemented")
+}
 func (UnimplementedMsgServer) Recover(context.Context, *MsgRecover) (*MsgRecoverResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Recover not implemented")
 }
+func (UnimplementedMsgServer) Redeem(context.Context, *MsgRedeem) (*MsgRedeemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Redeem not implemented")
+}
+func (UnimplementedMsgServer) RemoveAccountLimit(context.Context, *MsgRemoveAccountLimit) (*MsgRemoveAccountLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveAccountLimit not implemented")
+}
 func (UnimplementedMsgServer) RemoveAdminAccount(context.Context, *MsgRemoveAdminAccount) (*MsgRemoveAdminAccountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveAdminAccount not implemented")
 }
+func (UnimplementedMsgServer) RemoveAllowlistAdmin(context.Context, *MsgRemoveAllowlistAdmin) (*MsgRemoveAllowlistAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveAllowlistAdmin not implemented")
+}
+func (UnimplementedMsgServer) RemoveFromAllowlist(context.Context, *MsgRemoveFromAllowlist) (*MsgRemoveFromAllowlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFromAllowlist not implemented")
+}
+func (UnimplementedMsgServer) RemoveRateLimit(context.Context, *MsgRemoveRateLimit) (*MsgRemoveRateLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveRateLimit not implemented")
+}
 func (UnimplementedMsgServer) RemoveSystemAccount(context.Context, *MsgRemoveSystemAccount) (*MsgRemoveSystemAccountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveSystemAccount not implemented")
 }
+func (UnimplementedMsgServer) Seize(context.Context, *MsgSeize) (*MsgSeizeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Seize not implemented")
+}
+func (UnimplementedMsgServer) SetAccountLimit(context.Context, *MsgSetAccountLimit) (*MsgSetAccountLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAccountLimit not implemented")
+}
 func (UnimplementedMsgServer) SetMaxMintAllowance(context.Context, *MsgSetMaxMintAllowance) (*MsgSetMaxMintAllowanceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetMaxMintAllowance not implemented")
 }
 func (UnimplementedMsgServer) SetMintAllowance(context.Context, *MsgSetMintAllowance) (*MsgSetMintAllowanceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetMintAllowance not implemented")
 }
+func (UnimplementedMsgServer) SetMintApprovalPolicy(context.Context, *MsgSetMintApprovalPolicy) (*MsgSetMintApprovalPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMintApprovalPolicy not implemented")
+}
+func (UnimplementedMsgServer) SetPauser(context.Context, *MsgSetPauser) (*MsgSetPauserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPauser not implemented")
+}
+func (UnimplementedMsgServer) SetRateLimit(context.Context, *MsgSetRateLimit) (*MsgSetRateLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRateLimit not implemented")
+}
+func (UnimplementedMsgServer) SetSeizer(context.Context, *MsgSetSeizer) (*MsgSetSeizerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSeizer not implemented")
+}
+func (UnimplementedMsgServer) SetSupplyCap(context.Context, *MsgSetSupplyCap) (*MsgSetSupplyCapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSupplyCap not implemented")
+}
+func (UnimplementedMsgServer) SetTransferPolicy(context.Context, *MsgSetTransferPolicy) (*MsgSetTransferPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTransferPolicy not implemented")
+}
 func (UnimplementedMsgServer) TransferOwnership(context.Context, *MsgTransferOwnership) (*MsgTransferOwnershipResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TransferOwnership not implemented")
 }
+func (UnimplementedMsgServer) Unpause(context.Context, *MsgUnpause) (*MsgUnpauseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unpause not implemented")
+}
+func (UnimplementedMsgServer) Wipe(context.Context, *MsgWipe) (*MsgWipeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Wipe not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 func (UnimplementedMsgServer) testEmbeddedByValue()             {}
 
@@ -298,6 +650,24 @@ func _Msg_AddAdminAccount_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_AddAllowlistAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddAllowlistAdmin)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddAllowlistAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AddAllowlistAdmin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddAllowlistAdmin(ctx, req.(*MsgAddAllowlistAdmin))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_AddSystemAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgAddSystemAccount)
 	if err := dec(in); err != nil {
@@ -316,6 +686,24 @@ func _Msg_AddSystemAccount_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_AddToAllowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddToAllowlist)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddToAllowlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AddToAllowlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddToAllowlist(ctx, req.(*MsgAddToAllowlist))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_AllowDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgAllowDenom)
 	if err := dec(in); err != nil {
@@ -334,6 +722,24 @@ func _Msg_AllowDenom_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ApproveMint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgApproveMint)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ApproveMint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ApproveMint_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ApproveMint(ctx, req.(*MsgApproveMint))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_Burn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgBurn)
 	if err := dec(in); err != nil {
@@ -352,6 +758,42 @@ func _Msg_Burn_Handler(srv interface{}, ctx context.Context, dec func(interface{
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CancelMint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelMint)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelMint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CancelMint_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelMint(ctx, req.(*MsgCancelMint))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeprecateLegacySignatures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeprecateLegacySignatures)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeprecateLegacySignatures(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_DeprecateLegacySignatures_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeprecateLegacySignatures(ctx, req.(*MsgDeprecateLegacySignatures))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_Mint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgMint)
 	if err := dec(in); err != nil {
@@ -370,6 +812,42 @@ func _Msg_Mint_Handler(srv interface{}, ctx context.Context, dec func(interface{
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_MintBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMintBatch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MintBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_MintBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MintBatch(ctx, req.(*MsgMintBatch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Pause_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPause)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Pause(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_Pause_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Pause(ctx, req.(*MsgPause))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_Recover_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgRecover)
 	if err := dec(in); err != nil {
@@ -388,6 +866,42 @@ func _Msg_Recover_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_Redeem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRedeem)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Redeem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_Redeem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Redeem(ctx, req.(*MsgRedeem))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveAccountLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveAccountLimit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveAccountLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RemoveAccountLimit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveAccountLimit(ctx, req.(*MsgRemoveAccountLimit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_RemoveAdminAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgRemoveAdminAccount)
 	if err := dec(in); err != nil {
@@ -406,6 +920,60 @@ func _Msg_RemoveAdminAccount_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RemoveAllowlistAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveAllowlistAdmin)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveAllowlistAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RemoveAllowlistAdmin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveAllowlistAdmin(ctx, req.(*MsgRemoveAllowlistAdmin))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveFromAllowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveFromAllowlist)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveFromAllowlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RemoveFromAllowlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveFromAllowlist(ctx, req.(*MsgRemoveFromAllowlist))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveRateLimit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveRateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RemoveRateLimit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveRateLimit(ctx, req.(*MsgRemoveRateLimit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_RemoveSystemAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgRemoveSystemAccount)
 	if err := dec(in); err != nil {
@@ -424,6 +992,42 @@ func _Msg_RemoveSystemAccount_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_Seize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSeize)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Seize(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_Seize_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Seize(ctx, req.(*MsgSeize))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetAccountLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAccountLimit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAccountLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetAccountLimit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAccountLimit(ctx, req.(*MsgSetAccountLimit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_SetMaxMintAllowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgSetMaxMintAllowance)
 	if err := dec(in); err != nil {
@@ -460,6 +1064,114 @@ func _Msg_SetMintAllowance_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetMintApprovalPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetMintApprovalPolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetMintApprovalPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetMintApprovalPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetMintApprovalPolicy(ctx, req.(*MsgSetMintApprovalPolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetPauser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetPauser)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetPauser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetPauser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetPauser(ctx, req.(*MsgSetPauser))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetRateLimit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetRateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetRateLimit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetRateLimit(ctx, req.(*MsgSetRateLimit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetSeizer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetSeizer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetSeizer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetSeizer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetSeizer(ctx, req.(*MsgSetSeizer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetSupplyCap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetSupplyCap)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetSupplyCap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetSupplyCap_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetSupplyCap(ctx, req.(*MsgSetSupplyCap))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetTransferPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetTransferPolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetTransferPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetTransferPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetTransferPolicy(ctx, req.(*MsgSetTransferPolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_TransferOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgTransferOwnership)
 	if err := dec(in); err != nil {
@@ -478,6 +1190,42 @@ func _Msg_TransferOwnership_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_Unpause_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnpause)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Unpause(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_Unpause_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Unpause(ctx, req.(*MsgUnpause))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Wipe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWipe)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Wipe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_Wipe_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Wipe(ctx, req.(*MsgWipe))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -493,34 +1241,90 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AddAdminAccount",
 			Handler:    _Msg_AddAdminAccount_Handler,
 		},
+		{
+			MethodName: "AddAllowlistAdmin",
+			Handler:    _Msg_AddAllowlistAdmin_Handler,
+		},
 		{
 			MethodName: "AddSystemAccount",
 			Handler:    _Msg_AddSystemAccount_Handler,
 		},
+		{
+			MethodName: "AddToAllowlist",
+			Handler:    _Msg_AddToAllowlist_Handler,
+		},
 		{
 			MethodName: "AllowDenom",
 			Handler:    _Msg_AllowDenom_Handler,
 		},
+		{
+			MethodName: "ApproveMint",
+			Handler:    _Msg_ApproveMint_Handler,
+		},
 		{
 			MethodName: "Burn",
 			Handler:    _Msg_Burn_Handler,
 		},
+		{
+			MethodName: "CancelMint",
+			Handler:    _Msg_CancelMint_Handler,
+		},
+		{
+			MethodName: "DeprecateLegacySignatures",
+			Handler:    _Msg_DeprecateLegacySignatures_Handler,
+		},
 		{
 			MethodName: "Mint",
 			Handler:    _Msg_Mint_Handler,
 		},
+		{
+			MethodName: "MintBatch",
+			Handler:    _Msg_MintBatch_Handler,
+		},
+		{
+			MethodName: "Pause",
+			Handler:    _Msg_Pause_Handler,
+		},
 		{
 			MethodName: "Recover",
 			Handler:    _Msg_Recover_Handler,
 		},
+		{
+			MethodName: "Redeem",
+			Handler:    _Msg_Redeem_Handler,
+		},
+		{
+			MethodName: "RemoveAccountLimit",
+			Handler:    _Msg_RemoveAccountLimit_Handler,
+		},
 		{
 			MethodName: "RemoveAdminAccount",
 			Handler:    _Msg_RemoveAdminAccount_Handler,
 		},
+		{
+			MethodName: "RemoveAllowlistAdmin",
+			Handler:    _Msg_RemoveAllowlistAdmin_Handler,
+		},
+		{
+			MethodName: "RemoveFromAllowlist",
+			Handler:    _Msg_RemoveFromAllowlist_Handler,
+		},
+		{
+			MethodName: "RemoveRateLimit",
+			Handler:    _Msg_RemoveRateLimit_Handler,
+		},
 		{
 			MethodName: "RemoveSystemAccount",
 			Handler:    _Msg_RemoveSystemAccount_Handler,
 		},
+		{
+			MethodName: "Seize",
+			Handler:    _Msg_Seize_Handler,
+		},
+		{
+			MethodName: "SetAccountLimit",
+			Handler:    _Msg_SetAccountLimit_Handler,
+		},
 		{
 			MethodName: "SetMaxMintAllowance",
 			Handler:    _Msg_SetMaxMintAllowance_Handler,
@@ -529,10 +1333,42 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetMintAllowance",
 			Handler:    _Msg_SetMintAllowance_Handler,
 		},
+		{
+			MethodName: "SetMintApprovalPolicy",
+			Handler:    _Msg_SetMintApprovalPolicy_Handler,
+		},
+		{
+			MethodName: "SetPauser",
+			Handler:    _Msg_SetPauser_Handler,
+		},
+		{
+			MethodName: "SetRateLimit",
+			Handler:    _Msg_SetRateLimit_Handler,
+		},
+		{
+			MethodName: "SetSeizer",
+			Handler:    _Msg_SetSeizer_Handler,
+		},
+		{
+			MethodName: "SetSupplyCap",
+			Handler:    _Msg_SetSupplyCap_Handler,
+		},
+		{
+			MethodName: "SetTransferPolicy",
+			Handler:    _Msg_SetTransferPolicy_Handler,
+		},
 		{
 			MethodName: "TransferOwnership",
 			Handler:    _Msg_TransferOwnership_Handler,
 		},
+		{
+			MethodName: "Unpause",
+			Handler:    _Msg_Unpause_Handler,
+		},
+		{
+			MethodName: "Wipe",
+			Handler:    _Msg_Wipe_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "florin/v2/tx.proto",
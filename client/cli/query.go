@@ -16,7 +16,9 @@ package cli
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -44,6 +46,19 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(QueryMaxMintAllowances())
 	cmd.AddCommand(QueryMintAllowances())
 	cmd.AddCommand(QueryMintAllowance())
+	cmd.AddCommand(QueryPaused())
+	cmd.AddCommand(QuerySeizer())
+	cmd.AddCommand(QueryNonce())
+	cmd.AddCommand(QueryRateLimits())
+	cmd.AddCommand(QuerySupplyCap())
+	cmd.AddCommand(QueryMintApprovalPolicy())
+	cmd.AddCommand(QueryPendingMints())
+	cmd.AddCommand(QueryMintReference())
+	cmd.AddCommand(QueryRoles())
+	cmd.AddCommand(QueryTransferPolicy())
+	cmd.AddCommand(QueryAllowlistAdmins())
+	cmd.AddCommand(QueryAllowlist())
+	cmd.AddCommand(QueryAccountLimit())
 
 	return cmd
 }
@@ -109,7 +124,12 @@ func QueryOwners() *cobra.Command {
 			if len(args) == 1 {
 				res, err = queryClient.Owner(context.Background(), &types.QueryOwner{Denom: args[0]})
 			} else {
-				res, err = queryClient.Owners(context.Background(), &types.QueryOwners{})
+				pageReq, pageErr := client.ReadPageRequest(cmd.Flags())
+				if pageErr != nil {
+					return pageErr
+				}
+
+				res, err = queryClient.Owners(context.Background(), &types.QueryOwners{Pagination: pageReq})
 			}
 
 			if err != nil {
@@ -121,6 +141,7 @@ func QueryOwners() *cobra.Command {
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "owners")
 
 	return cmd
 }
@@ -140,7 +161,12 @@ func QuerySystems() *cobra.Command {
 			if len(args) == 1 {
 				res, err = queryClient.SystemsByDenom(context.Background(), &types.QuerySystemsByDenom{Denom: args[0]})
 			} else {
-				res, err = queryClient.Systems(context.Background(), &types.QuerySystems{})
+				pageReq, pageErr := client.ReadPageRequest(cmd.Flags())
+				if pageErr != nil {
+					return pageErr
+				}
+
+				res, err = queryClient.Systems(context.Background(), &types.QuerySystems{Pagination: pageReq})
 			}
 
 			if err != nil {
@@ -152,6 +178,7 @@ func QuerySystems() *cobra.Command {
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "systems")
 
 	return cmd
 }
@@ -171,7 +198,12 @@ func QueryAdmins() *cobra.Command {
 			if len(args) == 1 {
 				res, err = queryClient.AdminsByDenom(context.Background(), &types.QueryAdminsByDenom{Denom: args[0]})
 			} else {
-				res, err = queryClient.Admins(context.Background(), &types.QueryAdmins{})
+				pageReq, pageErr := client.ReadPageRequest(cmd.Flags())
+				if pageErr != nil {
+					return pageErr
+				}
+
+				res, err = queryClient.Admins(context.Background(), &types.QueryAdmins{Pagination: pageReq})
 			}
 
 			if err != nil {
@@ -183,6 +215,7 @@ func QueryAdmins() *cobra.Command {
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "admins")
 
 	return cmd
 }
@@ -227,8 +260,14 @@ func QueryMintAllowances() *cobra.Command {
 			clientCtx := client.GetClientContextFromCmd(cmd)
 			queryClient := types.NewQueryClient(clientCtx)
 
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
 			res, err := queryClient.MintAllowances(context.Background(), &types.QueryMintAllowances{
-				Denom: args[0],
+				Denom:      args[0],
+				Pagination: pageReq,
 			})
 			if err != nil {
 				return err
@@ -239,6 +278,7 @@ func QueryMintAllowances() *cobra.Command {
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "mint allowances")
 
 	return cmd
 }
@@ -268,3 +308,354 @@ func QueryMintAllowance() *cobra.Command {
 
 	return cmd
 }
+
+func QueryPaused() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "paused [denom]",
+		Short: "Query the paused state and pauser of a specific denom",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Paused(context.Background(), &types.QueryPaused{
+				Denom: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func QuerySeizer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "seizer [denom]",
+		Short: "Query the seizer of a specific denom",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Seizer(context.Background(), &types.QuerySeizer{
+				Denom: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func QueryNonce() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "nonce [address]",
+		Short: "Query the next burn and recover signature nonce of an address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Nonce(context.Background(), &types.QueryNonce{
+				Address: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func QueryRateLimits() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rate-limits [denom]",
+		Short: "Query the mint rate limits and their remaining capacity of a specific denom",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.RateLimits(context.Background(), &types.QueryRateLimits{
+				Denom: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func QuerySupplyCap() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "supply-cap [denom]",
+		Short: "Query the supply cap and remaining headroom of a specific denom",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.SupplyCap(context.Background(), &types.QuerySupplyCap{
+				Denom: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func QueryMintApprovalPolicy() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mint-approval-policy [denom]",
+		Short: "Query the mint approval policy of a specific denom",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.MintApprovalPolicy(context.Background(), &types.QueryMintApprovalPolicy{
+				Denom: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func QueryPendingMints() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-mints [denom] (id)",
+		Short: "Query a specific or all pending mints of a specific denom",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			var res proto.Message
+			var err error
+
+			if len(args) == 2 {
+				id, parseErr := strconv.ParseUint(args[1], 10, 64)
+				if parseErr != nil {
+					return errors.New("invalid id")
+				}
+
+				res, err = queryClient.PendingMint(context.Background(), &types.QueryPendingMint{Denom: args[0], Id: id})
+			} else {
+				pageReq, pageErr := client.ReadPageRequest(cmd.Flags())
+				if pageErr != nil {
+					return pageErr
+				}
+
+				res, err = queryClient.PendingMints(context.Background(), &types.QueryPendingMints{Denom: args[0], Pagination: pageReq})
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "pending mints")
+
+	return cmd
+}
+
+func QueryMintReference() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mint-reference [denom] [reference]",
+		Short: "Query the mint or burn that used an external reference of a specific denom",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.MintReference(context.Background(), &types.QueryMintReference{
+				Denom:     args[0],
+				Reference: args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func QueryRoles() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "roles [address]",
+		Short: "Query every role, mint allowance and blacklist status of an address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Roles(context.Background(), &types.QueryRoles{
+				Address: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func QueryTransferPolicy() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-policy [denom]",
+		Short: "Query the transfer policy of a specific denom",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.TransferPolicy(context.Background(), &types.QueryTransferPolicy{
+				Denom: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func QueryAllowlistAdmins() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "allowlist-admins [denom]",
+		Short: "Query the allowlist admin accounts of a specific denom",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.AllowlistAdmins(context.Background(), &types.QueryAllowlistAdmins{
+				Denom: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func QueryAllowlist() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "allowlist [denom]",
+		Short: "Query the allowlisted accounts of a specific denom",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Allowlist(context.Background(), &types.QueryAllowlist{
+				Denom:      args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "allowlist")
+
+	return cmd
+}
+
+func QueryAccountLimit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "account-limit [denom] [address]",
+		Short: "Query the holding and transfer limits and daily outflow of an address for a specific denom",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.AccountLimit(context.Background(), &types.QueryAccountLimit{
+				Denom:   args[0],
+				Address: args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -19,10 +19,19 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/monerium/module-noble/v2/types/blacklist"
 	"github.com/spf13/cobra"
 )
 
+const (
+	FlagDenomCountTotal = "denom-count-total"
+	FlagDenomLimit      = "denom-limit"
+	FlagDenomOffset     = "denom-offset"
+	FlagDenomPageKey    = "denom-page-key"
+	FlagDenomReverse    = "denom-reverse"
+)
+
 func GetBlacklistQueryCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:                        "blacklist",
@@ -35,6 +44,7 @@ func GetBlacklistQueryCmd() *cobra.Command {
 	cmd.AddCommand(QueryBlacklistOwner())
 	cmd.AddCommand(QueryBlacklistAdmins())
 	cmd.AddCommand(QueryAdversaries())
+	cmd.AddCommand(QueryAdversary())
 
 	return cmd
 }
@@ -64,14 +74,19 @@ func QueryBlacklistOwner() *cobra.Command {
 
 func QueryBlacklistAdmins() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "admins",
+		Use:   "admins [denom]",
 		Short: "Query the submodule's admin accounts",
-		Args:  cobra.NoArgs,
+		Args:  cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx := client.GetClientContextFromCmd(cmd)
 			queryClient := blacklist.NewQueryClient(clientCtx)
 
-			res, err := queryClient.Admins(context.Background(), &blacklist.QueryAdmins{})
+			req := &blacklist.QueryAdmins{}
+			if len(args) == 1 {
+				req.Denom = args[0]
+			}
+
+			res, err := queryClient.Admins(context.Background(), req)
 			if err != nil {
 				return err
 			}
@@ -87,14 +102,77 @@ func QueryBlacklistAdmins() *cobra.Command {
 
 func QueryAdversaries() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "adversaries",
+		Use:   "adversaries [denom]",
 		Short: "Query the banned adversary accounts",
-		Args:  cobra.NoArgs,
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := blacklist.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &blacklist.QueryAdversaries{
+				Pagination:      pageReq,
+				DenomPagination: readDenomPageRequest(cmd),
+			}
+			if len(args) == 1 {
+				req.Denom = args[0]
+			}
+
+			res, err := queryClient.Adversaries(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "global adversaries")
+	cmd.Flags().String(FlagDenomPageKey, "", "pagination page-key of denom adversaries to query for")
+	cmd.Flags().Uint64(FlagDenomOffset, 0, "pagination offset of denom adversaries to query for")
+	cmd.Flags().Uint64(FlagDenomLimit, 100, "pagination limit of denom adversaries to query for")
+	cmd.Flags().Bool(FlagDenomCountTotal, false, "count total number of records in denom adversaries to query for")
+	cmd.Flags().Bool(FlagDenomReverse, false, "results are sorted in descending order")
+
+	return cmd
+}
+
+// readDenomPageRequest reads the page request of the denom adversaries, which
+// is separate from the global adversaries as their page keys aren't compatible.
+func readDenomPageRequest(cmd *cobra.Command) *query.PageRequest {
+	flagSet := cmd.Flags()
+	pageKey, _ := flagSet.GetString(FlagDenomPageKey)
+	offset, _ := flagSet.GetUint64(FlagDenomOffset)
+	limit, _ := flagSet.GetUint64(FlagDenomLimit)
+	countTotal, _ := flagSet.GetBool(FlagDenomCountTotal)
+	reverse, _ := flagSet.GetBool(FlagDenomReverse)
+
+	return &query.PageRequest{
+		Key:        []byte(pageKey),
+		Offset:     offset,
+		Limit:      limit,
+		CountTotal: countTotal,
+		Reverse:    reverse,
+	}
+}
+
+func QueryAdversary() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "adversary [address]",
+		Short: "Query the active bans of an account",
+		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx := client.GetClientContextFromCmd(cmd)
 			queryClient := blacklist.NewQueryClient(clientCtx)
 
-			res, err := queryClient.Adversaries(context.Background(), &blacklist.QueryAdversaries{})
+			res, err := queryClient.Adversary(context.Background(), &blacklist.QueryAdversary{
+				Address: args[0],
+			})
 			if err != nil {
 				return err
 			}
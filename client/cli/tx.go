@@ -15,9 +15,16 @@
 package cli
 
 import (
+	"bytes"
 	"encoding/base64"
+	"encoding/csv"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
 
 	"cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/client"
@@ -29,6 +36,22 @@ import (
 	"github.com/spf13/cobra"
 )
 
+const (
+	FlagAmount             = "amount"
+	FlagCaseID             = "case-id"
+	FlagComplianceOverride = "compliance-override"
+	FlagExpiryHeight       = "expiry-height"
+	FlagExpiryTime         = "expiry-time"
+	FlagMaxBalance         = "max-balance"
+	FlagMaxDailyOutflow    = "max-daily-outflow"
+	FlagMaxTransfer        = "max-transfer"
+	FlagNonce              = "nonce"
+	FlagReason             = "reason"
+	FlagReference          = "reference"
+	FlagWindowBlocks       = "window-blocks"
+	FlagWindowSeconds      = "window-seconds"
+)
+
 func GetTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:                        types.ModuleName,
@@ -42,16 +65,40 @@ func GetTxCmd() *cobra.Command {
 
 	cmd.AddCommand(TxAcceptOwnership())
 	cmd.AddCommand(TxAddAdminAccount())
+	cmd.AddCommand(TxAddAllowlistAdmin())
 	cmd.AddCommand(TxAddSystemAccount())
+	cmd.AddCommand(TxAddToAllowlist())
 	cmd.AddCommand(TxAllowDenom())
+	cmd.AddCommand(TxApproveMint())
 	cmd.AddCommand(TxBurn())
+	cmd.AddCommand(TxCancelMint())
+	cmd.AddCommand(TxDeprecateLegacySignatures())
 	cmd.AddCommand(TxMint())
+	cmd.AddCommand(TxMintBatch())
+	cmd.AddCommand(TxPause())
 	cmd.AddCommand(TxRecover())
+	cmd.AddCommand(TxRedeem())
+	cmd.AddCommand(TxRemoveAccountLimit())
 	cmd.AddCommand(TxRemoveAdminAccount())
+	cmd.AddCommand(TxRemoveAllowlistAdmin())
+	cmd.AddCommand(TxRemoveFromAllowlist())
+	cmd.AddCommand(TxRemoveRateLimit())
 	cmd.AddCommand(TxRemoveSystemAccount())
+	cmd.AddCommand(TxSeize())
+	cmd.AddCommand(TxSetAccountLimit())
 	cmd.AddCommand(TxSetMaxMintAllowance())
 	cmd.AddCommand(TxSetMintAllowance())
+	cmd.AddCommand(TxSetMintApprovalPolicy())
+	cmd.AddCommand(TxSetPauser())
+	cmd.AddCommand(TxSetRateLimit())
+	cmd.AddCommand(TxSetSeizer())
+	cmd.AddCommand(TxSetSupplyCap())
+	cmd.AddCommand(TxSetTransferPolicy())
+	cmd.AddCommand(TxSignAuthorization())
 	cmd.AddCommand(TxTransferOwnership())
+	cmd.AddCommand(TxUnpause())
+	cmd.AddCommand(TxVerifyAuthorization())
+	cmd.AddCommand(TxWipe())
 
 	return cmd
 }
@@ -108,6 +155,32 @@ func TxAddAdminAccount() *cobra.Command {
 	return cmd
 }
 
+func TxAddAllowlistAdmin() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-allowlist-admin [denom] [account]",
+		Short: "Add an allowlist admin account for a specific denom",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgAddAllowlistAdmin{
+				Denom:   args[0],
+				Signer:  clientCtx.GetFromAddress().String(),
+				Account: args[1],
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func TxAddSystemAccount() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "add-system-account [denom] [account]",
@@ -134,6 +207,32 @@ func TxAddSystemAccount() *cobra.Command {
 	return cmd
 }
 
+func TxAddToAllowlist() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-to-allowlist [denom] [account]",
+		Short: "Add an account to the allowlist of a specific denom",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgAddToAllowlist{
+				Denom:   args[0],
+				Signer:  clientCtx.GetFromAddress().String(),
+				Account: args[1],
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func TxAllowDenom() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "allow-denom [denom] [owner]",
@@ -160,10 +259,42 @@ func TxAllowDenom() *cobra.Command {
 	return cmd
 }
 
+func TxApproveMint() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "approve-mint [denom] [id]",
+		Short: "Approve a pending mint of a specific denom",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return errors.New("invalid id")
+			}
+
+			msg := &types.MsgApproveMint{
+				Denom:  args[0],
+				Signer: clientCtx.GetFromAddress().String(),
+				Id:     id,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func TxBurn() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "burn [denom] [from] [amount] [signature] [pub_key]",
 		Short: "Transaction that burns a specific denom",
+		Long:  "Transaction that burns a specific denom. The signature must cover the burn sign-doc, unless no expiry height is provided, in which case the legacy constant message is used.",
 		Args:  cobra.ExactArgs(5),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -190,45 +321,57 @@ func TxBurn() *cobra.Command {
 				return err
 			}
 
+			expiryHeight, err := cmd.Flags().GetInt64(FlagExpiryHeight)
+			if err != nil {
+				return err
+			}
+			reference, err := cmd.Flags().GetString(FlagReference)
+			if err != nil {
+				return err
+			}
+
 			msg := &types.MsgBurn{
-				Denom:     args[0],
-				Signer:    clientCtx.GetFromAddress().String(),
-				From:      args[1],
-				Amount:    amount,
-				Signature: signature,
-				PubKey:    anyPubKey,
+				Denom:        args[0],
+				Signer:       clientCtx.GetFromAddress().String(),
+				From:         args[1],
+				Amount:       amount,
+				Signature:    signature,
+				PubKey:       anyPubKey,
+				ExpiryHeight: expiryHeight,
+				Reference:    reference,
 			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	cmd.Flags().Int64(FlagExpiryHeight, 0, "Block height after which the signature is no longer valid")
+	cmd.Flags().String(FlagReference, "", "External reference of the burn, rejected if already used")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
 }
 
-func TxMint() *cobra.Command {
+func TxCancelMint() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "mint [denom] [to] [amount]",
-		Short: "Transaction that mints a specific denom",
-		Args:  cobra.ExactArgs(3),
+		Use:   "cancel-mint [denom] [id]",
+		Short: "Cancel a pending mint of a specific denom",
+		Args:  cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 
-			amount, ok := math.NewIntFromString(args[2])
-			if !ok {
-				return errors.New("invalid amount")
+			id, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return errors.New("invalid id")
 			}
 
-			msg := &types.MsgMint{
+			msg := &types.MsgCancelMint{
 				Denom:  args[0],
 				Signer: clientCtx.GetFromAddress().String(),
-				To:     args[1],
-				Amount: amount,
+				Id:     id,
 			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
@@ -240,64 +383,99 @@ func TxMint() *cobra.Command {
 	return cmd
 }
 
-func TxRecover() *cobra.Command {
+func TxDeprecateLegacySignatures() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "recover [denom] [from] [to] [signature] [pub_key]",
-		Short: "Recover balance of a specific denom from an account",
-		Args:  cobra.ExactArgs(5),
+		Use:   "deprecate-legacy-signatures [deprecated]",
+		Short: "Toggle whether legacy burn and recover signatures are rejected",
+		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 
-			signature, err := base64.StdEncoding.DecodeString(args[3])
+			deprecated, err := strconv.ParseBool(args[0])
 			if err != nil {
 				return err
 			}
 
-			var pubKey cryptotypes.PubKey
-			if err = clientCtx.Codec.UnmarshalInterfaceJSON([]byte(args[4]), &pubKey); err != nil {
+			msg := &types.MsgDeprecateLegacySignatures{
+				Signer:     clientCtx.GetFromAddress().String(),
+				Deprecated: deprecated,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func TxMint() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mint [denom] [to] [amount]",
+		Short: "Transaction that mints a specific denom",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
 				return err
 			}
-			anyPubKey, err := codectypes.NewAnyWithValue(pubKey)
+
+			amount, ok := math.NewIntFromString(args[2])
+			if !ok {
+				return errors.New("invalid amount")
+			}
+
+			reference, err := cmd.Flags().GetString(FlagReference)
 			if err != nil {
 				return err
 			}
 
-			msg := &types.MsgRecover{
+			msg := &types.MsgMint{
 				Denom:     args[0],
 				Signer:    clientCtx.GetFromAddress().String(),
-				From:      args[1],
-				To:        args[2],
-				Signature: signature,
-				PubKey:    anyPubKey,
+				To:        args[1],
+				Amount:    amount,
+				Reference: reference,
 			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	cmd.Flags().String(FlagReference, "", "External reference of the mint, rejected if already used")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
 }
 
-func TxRemoveAdminAccount() *cobra.Command {
+func TxMintBatch() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "remove-admin-account [denom] [account]",
-		Short: "Remove an admin account for a specific denom",
-		Args:  cobra.ExactArgs(2),
+		Use:   "mint-batch [denom] [file]",
+		Short: "Transaction that mints a specific denom to many recipients",
+		Long: `Transaction that mints a specific denom to many recipients, debiting the mint allowance once for the total.
+
+Recipients are read from a JSON file (ending in .json) containing a list of {"to", "amount", "reference"} objects,
+or otherwise from a CSV file with "to,amount[,reference]" rows and an optional header row.`,
+		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 
-			msg := &types.MsgRemoveAdminAccount{
-				Denom:   args[0],
-				Signer:  clientCtx.GetFromAddress().String(),
-				Account: args[1],
+			recipients, err := ReadMintRecipients(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgMintBatch{
+				Denom:      args[0],
+				Signer:     clientCtx.GetFromAddress().String(),
+				Recipients: recipients,
 			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
@@ -309,21 +487,75 @@ func TxRemoveAdminAccount() *cobra.Command {
 	return cmd
 }
 
-func TxRemoveSystemAccount() *cobra.Command {
+// ReadMintRecipients reads the recipients of a batch mint from a JSON or CSV
+// file, depending on its extension.
+func ReadMintRecipients(path string) ([]types.MintRecipient, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var rows [][]string
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		var entries []struct {
+			To        string `json:"to"`
+			Amount    string `json:"amount"`
+			Reference string `json:"reference"`
+		}
+		if err := json.Unmarshal(bz, &entries); err != nil {
+			return nil, fmt.Errorf("unable to parse %s: %w", path, err)
+		}
+		for _, entry := range entries {
+			rows = append(rows, []string{entry.To, entry.Amount, entry.Reference})
+		}
+	} else {
+		reader := csv.NewReader(bytes.NewReader(bz))
+		reader.FieldsPerRecord = -1
+		reader.TrimLeadingSpace = true
+		rows, err = reader.ReadAll()
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse %s: %w", path, err)
+		}
+		if len(rows) > 0 && strings.EqualFold(rows[0][0], "to") {
+			rows = rows[1:]
+		}
+	}
+
+	recipients := make([]types.MintRecipient, 0, len(rows))
+	for i, row := range rows {
+		if len(row) < 2 || len(row) > 3 {
+			return nil, fmt.Errorf("invalid recipient %d: expected to, amount and optional reference", i)
+		}
+
+		amount, ok := math.NewIntFromString(row[1])
+		if !ok {
+			return nil, fmt.Errorf("invalid recipient %d: invalid amount %s", i, row[1])
+		}
+
+		recipient := types.MintRecipient{To: row[0], Amount: amount}
+		if len(row) == 3 {
+			recipient.Reference = row[2]
+		}
+		recipients = append(recipients, recipient)
+	}
+
+	return recipients, nil
+}
+
+func TxPause() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "remove-system-account [denom] [account]",
-		Short: "Remove a system account for a specific denom",
-		Args:  cobra.ExactArgs(2),
+		Use:   "pause [denom]",
+		Short: "Pause all activity of a specific denom",
+		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 
-			msg := &types.MsgRemoveSystemAccount{
-				Denom:   args[0],
-				Signer:  clientCtx.GetFromAddress().String(),
-				Account: args[1],
+			msg := &types.MsgPause{
+				Denom:  args[0],
+				Signer: clientCtx.GetFromAddress().String(),
 			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
@@ -335,10 +567,83 @@ func TxRemoveSystemAccount() *cobra.Command {
 	return cmd
 }
 
-func TxSetMaxMintAllowance() *cobra.Command {
+func TxRecover() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "set-max-mint-allowance [denom] [amount]",
-		Short: "Set the max mint allowance for a specific denom",
+		Use:   "recover [denom] [from] [to] [signature] [pub_key]",
+		Short: "Recover balance of a specific denom from an account",
+		Long:  "Recover balance of a specific denom from an account. The entire spendable balance is recovered unless an amount is provided. The signature must cover the recover sign-doc, unless no expiry height is provided, in which case the legacy constant message is used. Recovering from a banned account requires a compliance override.",
+		Args:  cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			signature, err := base64.StdEncoding.DecodeString(args[3])
+			if err != nil {
+				return err
+			}
+
+			var pubKey cryptotypes.PubKey
+			if err = clientCtx.Codec.UnmarshalInterfaceJSON([]byte(args[4]), &pubKey); err != nil {
+				return err
+			}
+			anyPubKey, err := codectypes.NewAnyWithValue(pubKey)
+			if err != nil {
+				return err
+			}
+
+			expiryHeight, err := cmd.Flags().GetInt64(FlagExpiryHeight)
+			if err != nil {
+				return err
+			}
+
+			amount := math.ZeroInt()
+			rawAmount, err := cmd.Flags().GetString(FlagAmount)
+			if err != nil {
+				return err
+			}
+			if rawAmount != "" {
+				var ok bool
+				amount, ok = math.NewIntFromString(rawAmount)
+				if !ok {
+					return errors.New("invalid amount")
+				}
+			}
+
+			complianceOverride, err := cmd.Flags().GetBool(FlagComplianceOverride)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgRecover{
+				Denom:              args[0],
+				Signer:             clientCtx.GetFromAddress().String(),
+				From:               args[1],
+				To:                 args[2],
+				Signature:          signature,
+				PubKey:             anyPubKey,
+				ExpiryHeight:       expiryHeight,
+				Amount:             amount,
+				ComplianceOverride: complianceOverride,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Int64(FlagExpiryHeight, 0, "Block height after which the signature is no longer valid")
+	cmd.Flags().String(FlagAmount, "", "Amount to recover, defaults to the entire spendable balance")
+	cmd.Flags().Bool(FlagComplianceOverride, false, "Recover from a banned account")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func TxRedeem() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "redeem [denom] [amount]",
+		Short: "Transaction that burns a specific denom held by the system account",
 		Args:  cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -351,42 +656,45 @@ func TxSetMaxMintAllowance() *cobra.Command {
 				return errors.New("invalid amount")
 			}
 
-			msg := &types.MsgSetMaxMintAllowance{
-				Denom:  args[0],
-				Signer: clientCtx.GetFromAddress().String(),
-				Amount: amount,
+			reference, err := cmd.Flags().GetString(FlagReference)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgRedeem{
+				Denom:     args[0],
+				Signer:    clientCtx.GetFromAddress().String(),
+				Amount:    amount,
+				Reference: reference,
 			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	cmd.Flags().String(FlagReference, "", "External reference of the redemption, rejected if already used")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
 }
 
-func TxSetMintAllowance() *cobra.Command {
+func TxRemoveAccountLimit() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "set-mint-allowance [denom] [account] [amount]",
-		Short: "Set the mint allowance of a system account for a specific denom",
-		Args:  cobra.ExactArgs(3),
+		Use:   "remove-account-limit [denom] (address)",
+		Short: "Remove the default or an address's holding and transfer limits of a specific denom",
+		Args:  cobra.RangeArgs(1, 2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 
-			amount, ok := math.NewIntFromString(args[2])
-			if !ok {
-				return errors.New("invalid amount")
+			msg := &types.MsgRemoveAccountLimit{
+				Denom:  args[0],
+				Signer: clientCtx.GetFromAddress().String(),
 			}
-
-			msg := &types.MsgSetMintAllowance{
-				Denom:   args[0],
-				Signer:  clientCtx.GetFromAddress().String(),
-				Account: args[1],
-				Amount:  amount,
+			if len(args) == 2 {
+				msg.Address = args[1]
 			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
@@ -398,10 +706,10 @@ func TxSetMintAllowance() *cobra.Command {
 	return cmd
 }
 
-func TxTransferOwnership() *cobra.Command {
+func TxRemoveAdminAccount() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "transfer-ownership [denom] [new-owner]",
-		Short: "Transfer ownership of a specific denom",
+		Use:   "remove-admin-account [denom] [account]",
+		Short: "Remove an admin account for a specific denom",
 		Args:  cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -409,10 +717,536 @@ func TxTransferOwnership() *cobra.Command {
 				return err
 			}
 
-			msg := &types.MsgTransferOwnership{
-				Denom:    args[0],
-				Signer:   clientCtx.GetFromAddress().String(),
-				NewOwner: args[1],
+			msg := &types.MsgRemoveAdminAccount{
+				Denom:   args[0],
+				Signer:  clientCtx.GetFromAddress().String(),
+				Account: args[1],
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func TxRemoveAllowlistAdmin() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-allowlist-admin [denom] [account]",
+		Short: "Remove an allowlist admin account for a specific denom",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgRemoveAllowlistAdmin{
+				Denom:   args[0],
+				Signer:  clientCtx.GetFromAddress().String(),
+				Account: args[1],
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func TxRemoveFromAllowlist() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-from-allowlist [denom] [account]",
+		Short: "Remove an account from the allowlist of a specific denom",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgRemoveFromAllowlist{
+				Denom:   args[0],
+				Signer:  clientCtx.GetFromAddress().String(),
+				Account: args[1],
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func TxRemoveRateLimit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-rate-limit [denom] (system)",
+		Short: "Remove the denom-wide or a system's mint rate limit of a specific denom",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgRemoveRateLimit{
+				Denom:  args[0],
+				Signer: clientCtx.GetFromAddress().String(),
+			}
+			if len(args) == 2 {
+				msg.Address = args[1]
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func TxRemoveSystemAccount() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-system-account [denom] [account]",
+		Short: "Remove a system account for a specific denom",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgRemoveSystemAccount{
+				Denom:   args[0],
+				Signer:  clientCtx.GetFromAddress().String(),
+				Account: args[1],
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func TxSeize() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "seize [denom] [from] [to]",
+		Short: "Transfer the full spendable balance of a specific denom from a banned address",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgSeize{
+				Denom:  args[0],
+				Signer: clientCtx.GetFromAddress().String(),
+				From:   args[1],
+				To:     args[2],
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func TxSetAccountLimit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-account-limit [denom] (address)",
+		Short: "Set the default or an address's holding and transfer limits of a specific denom",
+		Long:  "Set the max balance, max single transfer and max daily outflow of an account. Omitting the address sets the default limits of the denom, and an omitted or zero limit is not enforced.",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var amounts []math.Int
+			for _, flag := range []string{FlagMaxBalance, FlagMaxTransfer, FlagMaxDailyOutflow} {
+				rawAmount, err := cmd.Flags().GetString(flag)
+				if err != nil {
+					return err
+				}
+				amount, ok := math.NewIntFromString(rawAmount)
+				if !ok {
+					return fmt.Errorf("invalid %s", flag)
+				}
+				amounts = append(amounts, amount)
+			}
+
+			msg := &types.MsgSetAccountLimit{
+				Denom:           args[0],
+				Signer:          clientCtx.GetFromAddress().String(),
+				MaxBalance:      amounts[0],
+				MaxTransfer:     amounts[1],
+				MaxDailyOutflow: amounts[2],
+			}
+			if len(args) == 2 {
+				msg.Address = args[1]
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagMaxBalance, "0", "Max balance the account can hold")
+	cmd.Flags().String(FlagMaxTransfer, "0", "Max amount the account can send in a single transfer")
+	cmd.Flags().String(FlagMaxDailyOutflow, "0", "Max amount the account can send per day")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func TxSetMaxMintAllowance() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-max-mint-allowance [denom] [amount]",
+		Short: "Set the max mint allowance for a specific denom",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, ok := math.NewIntFromString(args[1])
+			if !ok {
+				return errors.New("invalid amount")
+			}
+
+			msg := &types.MsgSetMaxMintAllowance{
+				Denom:  args[0],
+				Signer: clientCtx.GetFromAddress().String(),
+				Amount: amount,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func TxSetMintAllowance() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-mint-allowance [denom] [account] [amount]",
+		Short: "Set the mint allowance of a system account for a specific denom",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, ok := math.NewIntFromString(args[2])
+			if !ok {
+				return errors.New("invalid amount")
+			}
+
+			msg := &types.MsgSetMintAllowance{
+				Denom:   args[0],
+				Signer:  clientCtx.GetFromAddress().String(),
+				Account: args[1],
+				Amount:  amount,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func TxSetMintApprovalPolicy() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-mint-approval-policy [denom] [threshold] [quorum] [expiry-blocks]",
+		Short: "Require admin approval for mints above a threshold of a specific denom, zero threshold to disable",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			threshold, ok := math.NewIntFromString(args[1])
+			if !ok {
+				return errors.New("invalid threshold")
+			}
+			quorum, err := strconv.ParseUint(args[2], 10, 32)
+			if err != nil {
+				return errors.New("invalid quorum")
+			}
+			expiryBlocks, err := strconv.ParseInt(args[3], 10, 64)
+			if err != nil {
+				return errors.New("invalid expiry blocks")
+			}
+
+			msg := &types.MsgSetMintApprovalPolicy{
+				Denom:        args[0],
+				Signer:       clientCtx.GetFromAddress().String(),
+				Threshold:    threshold,
+				Quorum:       uint32(quorum),
+				ExpiryBlocks: expiryBlocks,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func TxSetPauser() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-pauser [denom] [pauser]",
+		Short: "Set the pauser account for a specific denom",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgSetPauser{
+				Denom:  args[0],
+				Signer: clientCtx.GetFromAddress().String(),
+				Pauser: args[1],
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func TxSetRateLimit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-rate-limit [denom] [amount] (system)",
+		Short: "Set the denom-wide or a system's mint rate limit of a specific denom",
+		Long:  "Set the max amount that can be minted per window, specified with either --window-blocks or --window-seconds.",
+		Args:  cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, ok := math.NewIntFromString(args[1])
+			if !ok {
+				return errors.New("invalid amount")
+			}
+
+			windowBlocks, err := cmd.Flags().GetInt64(FlagWindowBlocks)
+			if err != nil {
+				return err
+			}
+			windowSeconds, err := cmd.Flags().GetInt64(FlagWindowSeconds)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgSetRateLimit{
+				Denom:         args[0],
+				Signer:        clientCtx.GetFromAddress().String(),
+				Amount:        amount,
+				WindowBlocks:  windowBlocks,
+				WindowSeconds: windowSeconds,
+			}
+			if len(args) == 3 {
+				msg.Address = args[2]
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Int64(FlagWindowBlocks, 0, "Length of the rate limit window in blocks")
+	cmd.Flags().Int64(FlagWindowSeconds, 0, "Length of the rate limit window in seconds")
+	cmd.MarkFlagsMutuallyExclusive(FlagWindowBlocks, FlagWindowSeconds)
+	cmd.MarkFlagsOneRequired(FlagWindowBlocks, FlagWindowSeconds)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func TxSetSeizer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-seizer [denom] [seizer]",
+		Short: "Set the seizer of a specific denom",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgSetSeizer{
+				Denom:  args[0],
+				Signer: clientCtx.GetFromAddress().String(),
+				Seizer: args[1],
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func TxSetSupplyCap() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-supply-cap [denom] [amount]",
+		Short: "Set the max total supply of a specific denom, zero to remove the cap",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, ok := math.NewIntFromString(args[1])
+			if !ok {
+				return errors.New("invalid amount")
+			}
+
+			msg := &types.MsgSetSupplyCap{
+				Denom:  args[0],
+				Signer: clientCtx.GetFromAddress().String(),
+				Amount: amount,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func TxSetTransferPolicy() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-transfer-policy [denom] [open|blacklist|allowlist]",
+		Short: "Set the transfer policy of a specific denom",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			policy, err := types.ParseTransferPolicy(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgSetTransferPolicy{
+				Denom:  args[0],
+				Signer: clientCtx.GetFromAddress().String(),
+				Policy: policy,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func TxTransferOwnership() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-ownership [denom] [new-owner]",
+		Short: "Transfer ownership of a specific denom",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgTransferOwnership{
+				Denom:    args[0],
+				Signer:   clientCtx.GetFromAddress().String(),
+				NewOwner: args[1],
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func TxUnpause() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unpause [denom]",
+		Short: "Unpause all activity of a specific denom",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgUnpause{
+				Denom:  args[0],
+				Signer: clientCtx.GetFromAddress().String(),
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func TxWipe() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "wipe [denom] [from]",
+		Short: "Burn the full spendable balance of a specific denom from a banned address",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgWipe{
+				Denom:  args[0],
+				Signer: clientCtx.GetFromAddress().String(),
+				From:   args[1],
 			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
@@ -0,0 +1,178 @@
+// Copyright 2024 Monerium ehf.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/monerium/module-noble/v2/client/signing"
+	"github.com/monerium/module-noble/v2/types"
+	"github.com/spf13/cobra"
+)
+
+func TxSignAuthorization() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sign-authorization [burn|recover] [denom] [destination]",
+		Short: "Sign an authorization for a burn or recover of a specific denom",
+		Long:  "Sign, with the key of the --from account, the message that authorizes a system account (the destination) to burn --amount, or that authorizes the recovery of --amount, or the entire balance if omitted, to the destination. Prints the signature and public key expected by the burn and recover commands. Unless --nonce is provided, the account's current nonce is queried.",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			doc, err := readSignDoc(cmd, clientCtx, args[0], args[1], clientCtx.GetFromAddress().String(), args[2])
+			if err != nil {
+				return err
+			}
+
+			signature, pubKey, err := signing.Sign(clientCtx.Keyring, clientCtx.GetFromName(), doc)
+			if err != nil {
+				return err
+			}
+			bz, err := clientCtx.Codec.MarshalInterfaceJSON(pubKey)
+			if err != nil {
+				return err
+			}
+
+			out, err := json.Marshal(struct {
+				Signature string          `json:"signature"`
+				PubKey    json.RawMessage `json:"pub_key"`
+			}{
+				Signature: base64.StdEncoding.EncodeToString(signature),
+				PubKey:    bz,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintRaw(out)
+		},
+	}
+
+	cmd.Flags().String(FlagAmount, "", "Amount authorized to be burned or recovered, required for burns and defaults to the entire balance for recovers")
+	cmd.Flags().Bool(FlagComplianceOverride, false, "Authorize a recover from a banned account")
+	cmd.Flags().Int64(FlagExpiryHeight, 0, "Block height after which the signature is no longer valid, the legacy constant message is signed if omitted")
+	cmd.Flags().Uint64(FlagNonce, 0, "Nonce of the signing account, queried if omitted")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func TxVerifyAuthorization() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify-authorization [burn|recover] [denom] [from] [destination] [signature] [pub_key]",
+		Short: "Verify an authorization for a burn or recover of a specific denom",
+		Long:  "Verify that a signature authorizes a system account (the destination) to burn --amount from an account, or authorizes the recovery of --amount, or an account's entire balance if omitted, to the destination, before broadcasting it. Unless --nonce is provided, the account's current nonce is queried.",
+		Args:  cobra.ExactArgs(6),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			signature, err := base64.StdEncoding.DecodeString(args[4])
+			if err != nil {
+				return err
+			}
+
+			var pubKey cryptotypes.PubKey
+			if err = clientCtx.Codec.UnmarshalInterfaceJSON([]byte(args[5]), &pubKey); err != nil {
+				return err
+			}
+
+			doc, err := readSignDoc(cmd, clientCtx, args[0], args[1], args[2], args[3])
+			if err != nil {
+				return err
+			}
+
+			if err := signing.Verify(args[2], pubKey, signature, doc); err != nil {
+				return err
+			}
+
+			return clientCtx.PrintString("signature is valid\n")
+		},
+	}
+
+	cmd.Flags().String(FlagAmount, "", "Amount authorized to be burned or recovered, required for burns and defaults to the entire balance for recovers")
+	cmd.Flags().Bool(FlagComplianceOverride, false, "Authorize a recover from a banned account")
+	cmd.Flags().Int64(FlagExpiryHeight, 0, "Block height after which the signature is no longer valid, the legacy constant message is verified if omitted")
+	cmd.Flags().Uint64(FlagNonce, 0, "Nonce of the signing account, queried if omitted")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// readSignDoc builds the sign-doc of a burn or recover authorization from the
+// command's flags, querying the nonce of the signing account if not provided.
+func readSignDoc(cmd *cobra.Command, clientCtx client.Context, action string, denom string, from string, destination string) (types.SignDoc, error) {
+	expiryHeight, err := cmd.Flags().GetInt64(FlagExpiryHeight)
+	if err != nil {
+		return types.SignDoc{}, err
+	}
+
+	nonce, err := cmd.Flags().GetUint64(FlagNonce)
+	if err != nil {
+		return types.SignDoc{}, err
+	}
+	if expiryHeight != 0 && !cmd.Flags().Changed(FlagNonce) {
+		res, err := types.NewQueryClient(clientCtx).Nonce(cmd.Context(), &types.QueryNonce{Address: from})
+		if err != nil {
+			return types.SignDoc{}, fmt.Errorf("unable to query nonce of %s: %w", from, err)
+		}
+		nonce = res.Nonce
+	}
+
+	rawAmount, err := cmd.Flags().GetString(FlagAmount)
+	if err != nil {
+		return types.SignDoc{}, err
+	}
+
+	switch action {
+	case types.SignActionBurn:
+		amount, ok := math.NewIntFromString(rawAmount)
+		if !ok {
+			return types.SignDoc{}, errors.New("invalid amount")
+		}
+
+		return types.NewBurnSignDoc(clientCtx.ChainID, denom, amount.String(), destination, nonce, expiryHeight), nil
+	case types.SignActionRecover:
+		amount := math.ZeroInt()
+		if rawAmount != "" {
+			var ok bool
+			amount, ok = math.NewIntFromString(rawAmount)
+			if !ok {
+				return types.SignDoc{}, errors.New("invalid amount")
+			}
+		}
+
+		complianceOverride, err := cmd.Flags().GetBool(FlagComplianceOverride)
+		if err != nil {
+			return types.SignDoc{}, err
+		}
+
+		return types.NewRecoverSignDoc(clientCtx.ChainID, denom, amount, destination, nonce, expiryHeight, complianceOverride), nil
+	default:
+		return types.SignDoc{}, fmt.Errorf("unknown action %s, expected %s or %s", action, types.SignActionBurn, types.SignActionRecover)
+	}
+}
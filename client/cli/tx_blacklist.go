@@ -15,9 +15,17 @@
 package cli
 
 import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/monerium/module-noble/v2/types/blacklist"
 	"github.com/spf13/cobra"
 )
@@ -35,6 +43,7 @@ func GetBlacklistTxCmd() *cobra.Command {
 	cmd.AddCommand(TxBlacklistAddAdminAccount())
 	cmd.AddCommand(TxBan())
 	cmd.AddCommand(TxBlacklistRemoveAdminAccount())
+	cmd.AddCommand(TxSyncAdversaries())
 	cmd.AddCommand(TxBlacklistTransferOwnership())
 	cmd.AddCommand(TxUnban())
 
@@ -68,9 +77,10 @@ func TxBlacklistAcceptOwnership() *cobra.Command {
 
 func TxBlacklistAddAdminAccount() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "add-admin-account [account]",
+		Use:   "add-admin-account [account] [denom]",
 		Short: "Adds an admin account to the submodule",
-		Args:  cobra.ExactArgs(1),
+		Long:  "Adds an admin account to the submodule, optionally scoped to a single denom",
+		Args:  cobra.RangeArgs(1, 2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -81,6 +91,9 @@ func TxBlacklistAddAdminAccount() *cobra.Command {
 				Signer:  clientCtx.GetFromAddress().String(),
 				Account: args[0],
 			}
+			if len(args) == 2 {
+				msg.Denom = args[1]
+			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
@@ -93,24 +106,49 @@ func TxBlacklistAddAdminAccount() *cobra.Command {
 
 func TxBan() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "ban",
+		Use:   "ban [adversary] [denom]",
 		Short: "Bans a specific adversary account",
-		Args:  cobra.ExactArgs(1),
+		Long:  "Bans a specific adversary account, optionally for a single denom only, until an expiry height or time, and with a reason (sanctions, court-order, fraud, other) and case id. A permanent ban must be lifted before it can be replaced with an expiring one",
+		Args:  cobra.RangeArgs(1, 2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 
+			expiryHeight, err := cmd.Flags().GetInt64(FlagExpiryHeight)
+			if err != nil {
+				return err
+			}
+			expiryTime, err := cmd.Flags().GetInt64(FlagExpiryTime)
+			if err != nil {
+				return err
+			}
+			reason, caseID, err := readBanReason(cmd)
+			if err != nil {
+				return err
+			}
+
 			msg := &blacklist.MsgBan{
-				Signer:    clientCtx.GetFromAddress().String(),
-				Adversary: args[0],
+				Signer:       clientCtx.GetFromAddress().String(),
+				Adversary:    args[0],
+				ExpiryHeight: expiryHeight,
+				ExpiryTime:   expiryTime,
+				Reason:       reason,
+				CaseId:       caseID,
+			}
+			if len(args) == 2 {
+				msg.Denom = args[1]
 			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	cmd.Flags().Int64(FlagExpiryHeight, 0, "Block height at which the ban expires, zero meaning never")
+	cmd.Flags().Int64(FlagExpiryTime, 0, "Unix time at which the ban expires, zero meaning never")
+	cmd.Flags().String(FlagReason, "", "Reason of the ban, one of sanctions, court-order, fraud or other")
+	cmd.Flags().String(FlagCaseID, "", "External case the ban relates to")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -118,9 +156,10 @@ func TxBan() *cobra.Command {
 
 func TxBlacklistRemoveAdminAccount() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "remove-admin-account [account]",
+		Use:   "remove-admin-account [account] [denom]",
 		Short: "Removes an admin account from the submodule",
-		Args:  cobra.ExactArgs(1),
+		Long:  "Removes an admin account from the submodule, optionally scoped to a single denom",
+		Args:  cobra.RangeArgs(1, 2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -131,6 +170,9 @@ func TxBlacklistRemoveAdminAccount() *cobra.Command {
 				Signer:  clientCtx.GetFromAddress().String(),
 				Account: args[0],
 			}
+			if len(args) == 2 {
+				msg.Denom = args[1]
+			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
@@ -168,9 +210,10 @@ func TxBlacklistTransferOwnership() *cobra.Command {
 
 func TxUnban() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "unban",
+		Use:   "unban [friend] [denom]",
 		Short: "Unbans a specific friend account",
-		Args:  cobra.ExactArgs(1),
+		Long:  "Unbans a specific friend account, optionally for a single denom only",
+		Args:  cobra.RangeArgs(1, 2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -181,6 +224,9 @@ func TxUnban() *cobra.Command {
 				Signer: clientCtx.GetFromAddress().String(),
 				Friend: args[0],
 			}
+			if len(args) == 2 {
+				msg.Denom = args[1]
+			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
@@ -190,3 +236,163 @@ func TxUnban() *cobra.Command {
 
 	return cmd
 }
+
+func TxSyncAdversaries() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sync-adversaries [file] [denom]",
+		Short: "Sync the banned adversary accounts with a local address file",
+		Long:  "Diffs a file of addresses, one per line, against the banned adversary accounts, optionally for a single denom only, and submits the batch ban and unban needed to match it",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var denom string
+			if len(args) == 2 {
+				denom = args[1]
+			}
+
+			addresses, err := ReadAddresses(args[0])
+			if err != nil {
+				return err
+			}
+			adversaries, err := queryAdversaries(cmd.Context(), blacklist.NewQueryClient(clientCtx), denom)
+			if err != nil {
+				return err
+			}
+
+			var bans, unbans []string
+			listed := make(map[string]bool)
+			for _, address := range addresses {
+				listed[address] = true
+				if !adversaries[address] {
+					bans = append(bans, address)
+				}
+			}
+			for adversary := range adversaries {
+				if !listed[adversary] {
+					unbans = append(unbans, adversary)
+				}
+			}
+			sort.Strings(unbans)
+
+			signer := clientCtx.GetFromAddress().String()
+			var msgs []sdk.Msg
+			if len(bans) > 0 {
+				reason, caseID, err := readBanReason(cmd)
+				if err != nil {
+					return err
+				}
+
+				msgs = append(msgs, &blacklist.MsgBanBatch{
+					Signer:      signer,
+					Adversaries: bans,
+					Denom:       denom,
+					Reason:      reason,
+					CaseId:      caseID,
+				})
+			}
+			if len(unbans) > 0 {
+				msgs = append(msgs, &blacklist.MsgUnbanBatch{
+					Signer:  signer,
+					Friends: unbans,
+					Denom:   denom,
+				})
+			}
+			if len(msgs) == 0 {
+				return clientCtx.PrintString("adversaries are already in sync\n")
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msgs...)
+		},
+	}
+
+	cmd.Flags().String(FlagReason, "", "Reason of the bans, one of sanctions, court-order, fraud or other")
+	cmd.Flags().String(FlagCaseID, "", "External case the bans relate to")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// ReadAddresses reads a list of addresses from a file, one per line, skipping
+// blank lines, comments starting with #, and duplicates.
+func ReadAddresses(path string) ([]string, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var addresses []string
+	seen := make(map[string]bool)
+	for _, line := range strings.Split(string(bz), "\n") {
+		address := strings.TrimSpace(line)
+		if address == "" || strings.HasPrefix(address, "#") || seen[address] {
+			continue
+		}
+		seen[address] = true
+		addresses = append(addresses, address)
+	}
+
+	return addresses, nil
+}
+
+// queryAdversaries returns the full set of banned adversary accounts, scoped
+// to a single denom if one is provided.
+func queryAdversaries(ctx context.Context, queryClient blacklist.QueryClient, denom string) (map[string]bool, error) {
+	adversaries := make(map[string]bool)
+
+	var key []byte
+	for {
+		// NOTE: Only the list we're interested in is paged through.
+		page := &query.PageRequest{Key: key, Limit: query.DefaultLimit}
+		req := &blacklist.QueryAdversaries{Denom: denom}
+		if denom == "" {
+			req.Pagination, req.DenomPagination = page, &query.PageRequest{Limit: 1}
+		} else {
+			req.Pagination, req.DenomPagination = &query.PageRequest{Limit: 1}, page
+		}
+
+		res, err := queryClient.Adversaries(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+
+		if denom == "" {
+			for _, adversary := range res.Adversaries {
+				adversaries[adversary] = true
+			}
+			key = res.Pagination.NextKey
+		} else {
+			for _, adversary := range res.DenomAdversaries {
+				adversaries[adversary.Address] = true
+			}
+			key = res.DenomPagination.NextKey
+		}
+
+		if len(key) == 0 {
+			return adversaries, nil
+		}
+	}
+}
+
+// readBanReason reads the optional reason and case id of a ban from flags.
+func readBanReason(cmd *cobra.Command) (blacklist.BanReason, string, error) {
+	caseID, err := cmd.Flags().GetString(FlagCaseID)
+	if err != nil {
+		return blacklist.BanReasonUnspecified, "", err
+	}
+
+	rawReason, err := cmd.Flags().GetString(FlagReason)
+	if err != nil || rawReason == "" {
+		return blacklist.BanReasonUnspecified, caseID, err
+	}
+
+	reason, err := blacklist.ParseBanReason(rawReason)
+	if err != nil {
+		return blacklist.BanReasonUnspecified, "", fmt.Errorf("invalid reason: %w", err)
+	}
+
+	return reason, caseID, nil
+}
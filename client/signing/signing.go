@@ -0,0 +1,76 @@
+// Copyright 2024 Monerium ehf.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package signing
+
+import (
+	"bytes"
+	"fmt"
+
+	"adr36.dev"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/monerium/module-noble/v2/types"
+)
+
+// Message returns the message a user signs to authorize a sign-doc. Sign-docs
+// without an expiry height are authorized using the legacy constant message.
+func Message(doc types.SignDoc) []byte {
+	if doc.ExpiryHeight == 0 {
+		return []byte(types.LegacySignMessage)
+	}
+
+	return doc.Bytes()
+}
+
+// Sign creates an ADR-36 signature authorizing a sign-doc using a key from the
+// keyring, returning the signature together with the public key of the key.
+func Sign(kr keyring.Keyring, uid string, doc types.SignDoc) ([]byte, cryptotypes.PubKey, error) {
+	record, err := kr.Key(uid)
+	if err != nil {
+		return nil, nil, err
+	}
+	pubKey, err := record.GetPubKey()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	signer := sdk.AccAddress(pubKey.Address()).String()
+	signature, _, err := kr.Sign(uid, types.ADR36SignBytes(signer, Message(doc)), signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return signature, pubKey, nil
+}
+
+// Verify checks that a signature is a valid ADR-36 signature authorizing a
+// sign-doc, created by the key of the provided address.
+func Verify(address string, pubKey cryptotypes.PubKey, signature []byte, doc types.SignDoc) error {
+	_, account, err := bech32.DecodeAndConvert(address)
+	if err != nil {
+		return fmt.Errorf("invalid address %s: %w", address, err)
+	}
+	if !bytes.Equal(account, pubKey.Address()) {
+		return types.ErrInvalidPubKey
+	}
+	if !adr36.VerifySignature(pubKey, Message(doc), signature) {
+		return types.ErrInvalidSignature
+	}
+
+	return nil
+}
@@ -0,0 +1,87 @@
+// Copyright 2024 Monerium ehf.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package signing_test
+
+import (
+	"testing"
+
+	"adr36.dev"
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/monerium/module-noble/v2/client/signing"
+	"github.com/monerium/module-noble/v2/types"
+	"github.com/monerium/module-noble/v2/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignAndVerify(t *testing.T) {
+	// ARRANGE: Create a keyring with a single key.
+	kr := keyring.NewInMemory(moduletestutil.MakeTestEncodingConfig().Codec)
+	record, _, err := kr.NewMnemonic("user", keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	address, err := record.GetAddress()
+	require.NoError(t, err)
+	user := address.String()
+	doc := types.NewBurnSignDoc("florin-1", "ueure", "1000000", utils.TestAccount().Address, 0, 100)
+
+	// ACT: Attempt to sign with an unknown key.
+	_, _, err = signing.Sign(kr, "unknown", doc)
+	// ASSERT: The signing should've failed.
+	require.Error(t, err)
+
+	// ACT: Attempt to sign a burn authorization.
+	signature, pubKey, err := signing.Sign(kr, "user", doc)
+	// ASSERT: The signature should be valid for the sign-doc, as verified on-chain.
+	require.NoError(t, err)
+	require.True(t, adr36.VerifySignature(pubKey, doc.Bytes(), signature))
+	require.NoError(t, signing.Verify(user, pubKey, signature, doc))
+
+	// ACT: Attempt to verify with a different address.
+	err = signing.Verify(utils.TestAccount().Address, pubKey, signature, doc)
+	// ASSERT: The verification should've failed due to invalid public key.
+	require.ErrorIs(t, err, types.ErrInvalidPubKey)
+
+	// ACT: Attempt to verify against a different nonce.
+	doc.Nonce = 1
+	err = signing.Verify(user, pubKey, signature, doc)
+	// ASSERT: The verification should've failed due to invalid signature.
+	require.ErrorIs(t, err, types.ErrInvalidSignature)
+
+	// ACT: Attempt to sign a legacy recover authorization.
+	doc = types.NewRecoverSignDoc("florin-1", "ueure", math.Int{}, utils.TestAccount().Address, 0, 0, false)
+	signature, pubKey, err = signing.Sign(kr, "user", doc)
+	// ASSERT: The signature should be valid for the legacy constant message.
+	require.NoError(t, err)
+	require.True(t, adr36.VerifySignature(pubKey, []byte(types.LegacySignMessage), signature))
+	require.NoError(t, signing.Verify(user, pubKey, signature, doc))
+
+	// ACT: Attempt to sign a partial recover authorization with a compliance override.
+	doc = types.NewRecoverSignDoc("florin-1", "ueure", math.NewInt(1000000), utils.TestAccount().Address, 0, 100, true)
+	signature, pubKey, err = signing.Sign(kr, "user", doc)
+	// ASSERT: The signature should cover both the amount and the override.
+	require.NoError(t, err)
+	require.Contains(t, string(doc.Bytes()), `"amount":"1000000"`)
+	require.Contains(t, string(doc.Bytes()), `"compliance_override":true`)
+	require.NoError(t, signing.Verify(user, pubKey, signature, doc))
+
+	// ACT: Attempt to verify without the compliance override.
+	doc.ComplianceOverride = false
+	err = signing.Verify(user, pubKey, signature, doc)
+	// ASSERT: The verification should've failed due to invalid signature.
+	require.ErrorIs(t, err, types.ErrInvalidSignature)
+}
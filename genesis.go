@@ -15,6 +15,8 @@
 package florin
 
 import (
+	"fmt"
+
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/monerium/module-noble/v2/keeper"
@@ -39,6 +41,21 @@ func InitGenesis(ctx sdk.Context, k *keeper.Keeper, genesis types.GenesisState)
 			panic(err)
 		}
 	}
+	for _, admin := range genesis.BlacklistState.DenomAdmins {
+		if err := k.SetDenomBlacklistAdmin(ctx, admin.Denom, admin.Address); err != nil {
+			panic(err)
+		}
+	}
+	for _, adversary := range genesis.BlacklistState.DenomAdversaries {
+		if err := k.SetDenomAdversary(ctx, adversary.Denom, adversary.Address); err != nil {
+			panic(err)
+		}
+	}
+	for _, record := range genesis.BlacklistState.BanRecords {
+		if err := k.SetBanRecord(ctx, record); err != nil {
+			panic(err)
+		}
+	}
 
 	for _, denom := range genesis.AllowedDenoms {
 		if err := k.SetAllowedDenom(ctx, denom); err != nil {
@@ -71,20 +88,111 @@ func InitGenesis(ctx sdk.Context, k *keeper.Keeper, genesis types.GenesisState)
 		}
 	}
 	for denom, rawMaxAllowance := range genesis.MaxMintAllowances {
-		maxAllowance, _ := math.NewIntFromString(rawMaxAllowance)
+		maxAllowance, ok := math.NewIntFromString(rawMaxAllowance)
+		if !ok {
+			panic(fmt.Errorf("invalid max mint allowance (%s) for denom %s", rawMaxAllowance, denom))
+		}
 		if err := k.SetMaxMintAllowance(ctx, denom, maxAllowance); err != nil {
 			panic(err)
 		}
 	}
+	for _, denom := range genesis.PausedDenoms {
+		if err := k.SetPaused(ctx, denom, true); err != nil {
+			panic(err)
+		}
+	}
+	for denom, pauser := range genesis.Pausers {
+		if err := k.SetPauser(ctx, denom, pauser); err != nil {
+			panic(err)
+		}
+	}
+	for denom, seizer := range genesis.Seizers {
+		if err := k.SetSeizer(ctx, denom, seizer); err != nil {
+			panic(err)
+		}
+	}
+	if err := k.SetLegacySignaturesDeprecated(ctx, genesis.LegacySignaturesDeprecated); err != nil {
+		panic(err)
+	}
+	for address, nonce := range genesis.Nonces {
+		if err := k.SetNonce(ctx, address, nonce); err != nil {
+			panic(err)
+		}
+	}
+	for _, rateLimit := range genesis.RateLimits {
+		if err := k.SetRateLimit(ctx, rateLimit); err != nil {
+			panic(err)
+		}
+	}
+	for _, usage := range genesis.RateLimitUsages {
+		if err := k.SetRateLimitUsage(ctx, usage); err != nil {
+			panic(err)
+		}
+	}
+	for denom, rawSupplyCap := range genesis.SupplyCaps {
+		supplyCap, ok := math.NewIntFromString(rawSupplyCap)
+		if !ok {
+			panic(fmt.Errorf("invalid supply cap (%s) for denom %s", rawSupplyCap, denom))
+		}
+		if err := k.SetSupplyCap(ctx, denom, supplyCap); err != nil {
+			panic(err)
+		}
+	}
+	for _, policy := range genesis.MintApprovalPolicies {
+		if err := k.SetMintApprovalPolicy(ctx, policy); err != nil {
+			panic(err)
+		}
+	}
+	for _, pendingMint := range genesis.PendingMints {
+		if err := k.SetPendingMint(ctx, pendingMint); err != nil {
+			panic(err)
+		}
+	}
+	if err := k.SetNextPendingMintID(ctx, genesis.NextPendingMintId); err != nil {
+		panic(err)
+	}
+	for _, reference := range genesis.References {
+		if err := k.SetReference(ctx, reference); err != nil {
+			panic(err)
+		}
+	}
+	for denom, policy := range genesis.TransferPolicies {
+		if err := k.SetTransferPolicy(ctx, denom, policy); err != nil {
+			panic(err)
+		}
+	}
+	for _, admin := range genesis.AllowlistAdmins {
+		if err := k.SetAllowlistAdmin(ctx, admin.Denom, admin.Address); err != nil {
+			panic(err)
+		}
+	}
+	for _, account := range genesis.Allowlist {
+		if err := k.SetAllowlisted(ctx, account.Denom, account.Address); err != nil {
+			panic(err)
+		}
+	}
+	for _, limit := range genesis.AccountLimits {
+		if err := k.SetAccountLimit(ctx, limit); err != nil {
+			panic(err)
+		}
+	}
+	for _, outflow := range genesis.AccountOutflows {
+		if err := k.SetAccountOutflow(ctx, outflow); err != nil {
+			panic(err)
+		}
+	}
 }
 
 func ExportGenesis(ctx sdk.Context, k *keeper.Keeper) *types.GenesisState {
 	return &types.GenesisState{
 		BlacklistState: blacklist.GenesisState{
-			Owner:        k.GetBlacklistOwner(ctx),
-			PendingOwner: k.GetBlacklistPendingOwner(ctx),
-			Admins:       k.GetBlacklistAdmins(ctx),
-			Adversaries:  k.GetAdversaries(ctx),
+			Owner:            k.GetBlacklistOwner(ctx),
+			PendingOwner:     k.GetBlacklistPendingOwner(ctx),
+			Admins:           k.GetBlacklistAdmins(ctx),
+			Adversaries:      k.GetAdversaries(ctx),
+			DenomAdmins:      k.GetDenomBlacklistAdmins(ctx, ""),
+			DenomAdversaries: k.GetDenomAdversaries(ctx, ""),
+			BanRecords:       k.GetBanRecords(ctx),
 		},
 		AllowedDenoms:     k.GetAllowedDenoms(ctx),
 		Owners:            k.GetOwners(ctx),
@@ -93,5 +201,28 @@ func ExportGenesis(ctx sdk.Context, k *keeper.Keeper) *types.GenesisState {
 		Admins:            k.GetAdmins(ctx),
 		MintAllowances:    k.GetMintAllowances(ctx),
 		MaxMintAllowances: k.GetMaxMintAllowances(ctx),
+		PausedDenoms:      k.GetPausedDenoms(ctx),
+		Pausers:           k.GetPausers(ctx),
+
+		LegacySignaturesDeprecated: k.IsLegacySignaturesDeprecated(ctx),
+		Nonces:                     k.GetNonces(ctx),
+
+		RateLimits:      k.GetRateLimits(ctx),
+		RateLimitUsages: k.GetRateLimitUsages(ctx),
+		SupplyCaps:      k.GetSupplyCaps(ctx),
+
+		MintApprovalPolicies: k.GetMintApprovalPolicies(ctx),
+		PendingMints:         k.GetPendingMints(ctx),
+		NextPendingMintId:    k.GetNextPendingMintID(ctx),
+
+		References: k.GetReferences(ctx),
+		Seizers:    k.GetSeizers(ctx),
+
+		TransferPolicies: k.GetTransferPolicies(ctx),
+		AllowlistAdmins:  k.GetAllowlistAdmins(ctx),
+		Allowlist:        k.GetAllowlist(ctx),
+
+		AccountLimits:   k.GetAccountLimits(ctx),
+		AccountOutflows: k.GetAccountOutflows(ctx),
 	}
 }
@@ -0,0 +1,179 @@
+// Copyright 2024 Monerium ehf.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package florin_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/monerium/module-noble/v2"
+	"github.com/monerium/module-noble/v2/types"
+	"github.com/monerium/module-noble/v2/types/blacklist"
+	"github.com/monerium/module-noble/v2/utils"
+	"github.com/monerium/module-noble/v2/utils/mocks"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenesisRoundTrip(t *testing.T) {
+	// ARRANGE: Create a genesis state with every field populated.
+	account1, account2, account3 := utils.TestAccount(), utils.TestAccount(), utils.TestAccount()
+	genesis := types.GenesisState{
+		BlacklistState: blacklist.GenesisState{
+			Owner:            account1.Address,
+			PendingOwner:     account2.Address,
+			Admins:           []string{account3.Address},
+			Adversaries:      []string{account1.Address, account2.Address},
+			DenomAdmins:      []blacklist.Account{{Denom: "ugbpe", Address: account2.Address}},
+			DenomAdversaries: []blacklist.Account{{Denom: "ueure", Address: account3.Address}},
+			BanRecords: []blacklist.BanRecord{
+				{Address: account1.Address, ExpiryHeight: 100, Reason: blacklist.BanReasonFraud, CaseId: "case-1"},
+				{Denom: "ueure", Address: account3.Address, ExpiryTime: 4_102_444_800},
+			},
+		},
+		AllowedDenoms: []string{"ueure", "ugbpe"},
+		Owners: map[string]string{
+			"ueure": account1.Address,
+			"ugbpe": account2.Address,
+		},
+		PendingOwners: map[string]string{
+			"ueure": account3.Address,
+		},
+		Systems: []types.Account{
+			{Denom: "ueure", Address: account1.Address},
+			{Denom: "ugbpe", Address: account2.Address},
+		},
+		Admins: []types.Account{
+			{Denom: "ueure", Address: account3.Address},
+		},
+		MintAllowances: []types.Allowance{
+			{Denom: "ueure", Address: account1.Address, Allowance: math.NewInt(1_000_000)},
+			{Denom: "ugbpe", Address: account2.Address, Allowance: math.NewInt(2_000_000)},
+		},
+		MaxMintAllowances: map[string]string{
+			"ueure": "3000000000000",
+			"ugbpe": "1000000000000",
+		},
+		PausedDenoms: []string{"ugbpe"},
+		Pausers: map[string]string{
+			"ugbpe": account3.Address,
+		},
+		LegacySignaturesDeprecated: true,
+		Nonces: map[string]uint64{
+			account1.Address: 5,
+		},
+		RateLimits: []types.RateLimit{
+			{Denom: "ueure", Amount: math.NewInt(5_000_000), WindowSeconds: 3600},
+			{Denom: "ueure", Address: account1.Address, Amount: math.NewInt(1_000_000), WindowBlocks: 100},
+		},
+		RateLimitUsages: []types.RateLimitUsage{
+			{Denom: "ueure", Address: account1.Address, Used: math.NewInt(500_000), Buckets: []types.RateLimitBucket{{Start: 10, Amount: math.NewInt(500_000)}}},
+		},
+		SupplyCaps: map[string]string{
+			"ueure": "10000000000000",
+		},
+		MintApprovalPolicies: []types.MintApprovalPolicy{
+			{Denom: "ueure", Threshold: math.NewInt(1_000_000), Quorum: 2, ExpiryBlocks: 100},
+		},
+		PendingMints: []types.PendingMint{
+			{Id: 1, Denom: "ueure", System: account1.Address, To: account2.Address, Amount: math.NewInt(2_000_000), Approvals: []string{account3.Address}, Quorum: 2, ExpiryHeight: 110},
+		},
+		NextPendingMintId: 2,
+		References: []types.Reference{
+			{Denom: "ueure", Reference: "SEPA-1", System: account1.Address, Account: account2.Address, Amount: math.NewInt(1_000_000), Height: 5},
+			{Denom: "ueure", Reference: "SEPA-2", System: account1.Address, Account: account3.Address, Amount: math.NewInt(500_000), Burn: true, Height: 6},
+		},
+		Seizers: map[string]string{
+			"ueure": account2.Address,
+		},
+		TransferPolicies: map[string]types.TransferPolicy{
+			"ugbpe": types.TransferPolicyAllowlist,
+		},
+		AllowlistAdmins: []types.Account{
+			{Denom: "ugbpe", Address: account3.Address},
+		},
+		Allowlist: []types.Account{
+			{Denom: "ugbpe", Address: account1.Address},
+			{Denom: "ugbpe", Address: account2.Address},
+		},
+		AccountLimits: []types.AccountLimit{
+			{Denom: "ueure", MaxBalance: math.NewInt(10_000_000), MaxTransfer: math.ZeroInt(), MaxDailyOutflow: math.NewInt(1_000_000)},
+			{Denom: "ueure", Address: account3.Address, MaxBalance: math.ZeroInt(), MaxTransfer: math.NewInt(500_000), MaxDailyOutflow: math.ZeroInt()},
+		},
+		AccountOutflows: []types.AccountOutflow{
+			{Denom: "ueure", Address: account1.Address, Used: math.NewInt(250_000), WindowStartTime: 1_700_000_000},
+		},
+	}
+	k, ctx := mocks.FlorinKeeper()
+	require.NoError(t, genesis.Validate(k.AddressCodec()))
+
+	// ACT: Import and then export the genesis state.
+	florin.InitGenesis(ctx, k, genesis)
+	exported := florin.ExportGenesis(ctx, k)
+
+	// ASSERT: The exported genesis state should match the imported one.
+	require.NoError(t, exported.Validate(k.AddressCodec()))
+	require.Equal(t, genesis.BlacklistState.Owner, exported.BlacklistState.Owner)
+	require.Equal(t, genesis.BlacklistState.PendingOwner, exported.BlacklistState.PendingOwner)
+	require.ElementsMatch(t, genesis.BlacklistState.Admins, exported.BlacklistState.Admins)
+	require.ElementsMatch(t, genesis.BlacklistState.Adversaries, exported.BlacklistState.Adversaries)
+	require.ElementsMatch(t, genesis.BlacklistState.DenomAdmins, exported.BlacklistState.DenomAdmins)
+	require.ElementsMatch(t, genesis.BlacklistState.DenomAdversaries, exported.BlacklistState.DenomAdversaries)
+	require.ElementsMatch(t, genesis.BlacklistState.BanRecords, exported.BlacklistState.BanRecords)
+	require.ElementsMatch(t, genesis.AllowedDenoms, exported.AllowedDenoms)
+	require.Equal(t, genesis.Owners, exported.Owners)
+	require.Equal(t, genesis.PendingOwners, exported.PendingOwners)
+	require.ElementsMatch(t, genesis.Systems, exported.Systems)
+	require.ElementsMatch(t, genesis.Admins, exported.Admins)
+	require.ElementsMatch(t, genesis.MintAllowances, exported.MintAllowances)
+	require.Equal(t, genesis.MaxMintAllowances, exported.MaxMintAllowances)
+	require.ElementsMatch(t, genesis.PausedDenoms, exported.PausedDenoms)
+	require.Equal(t, genesis.Pausers, exported.Pausers)
+	require.Equal(t, genesis.LegacySignaturesDeprecated, exported.LegacySignaturesDeprecated)
+	require.Equal(t, genesis.Nonces, exported.Nonces)
+	require.ElementsMatch(t, genesis.RateLimits, exported.RateLimits)
+	require.ElementsMatch(t, genesis.RateLimitUsages, exported.RateLimitUsages)
+	require.Equal(t, genesis.SupplyCaps, exported.SupplyCaps)
+	require.ElementsMatch(t, genesis.MintApprovalPolicies, exported.MintApprovalPolicies)
+	require.ElementsMatch(t, genesis.PendingMints, exported.PendingMints)
+	require.Equal(t, genesis.NextPendingMintId, exported.NextPendingMintId)
+	require.ElementsMatch(t, genesis.References, exported.References)
+	require.Equal(t, genesis.Seizers, exported.Seizers)
+	require.Equal(t, genesis.TransferPolicies, exported.TransferPolicies)
+	require.ElementsMatch(t, genesis.AllowlistAdmins, exported.AllowlistAdmins)
+	require.ElementsMatch(t, genesis.Allowlist, exported.Allowlist)
+	require.ElementsMatch(t, genesis.AccountLimits, exported.AccountLimits)
+	require.ElementsMatch(t, genesis.AccountOutflows, exported.AccountOutflows)
+
+	// ACT: Re-import the exported genesis state into a fresh keeper.
+	k, ctx = mocks.FlorinKeeper()
+	florin.InitGenesis(ctx, k, *exported)
+
+	// ASSERT: The max mint allowances should've been imported.
+	require.Equal(t, math.NewInt(3_000_000_000_000), k.GetMaxMintAllowance(ctx, "ueure"))
+	require.Equal(t, math.NewInt(1_000_000_000_000), k.GetMaxMintAllowance(ctx, "ugbpe"))
+}
+
+func TestInitGenesisInvalidMaxMintAllowance(t *testing.T) {
+	k, ctx := mocks.FlorinKeeper()
+	genesis := types.GenesisState{
+		AllowedDenoms:     []string{"ueure"},
+		MaxMintAllowances: map[string]string{"ueure": "\x00\x01"},
+	}
+
+	// ACT & ASSERT: Importing an unparseable max mint allowance should panic.
+	require.PanicsWithError(t, "invalid max mint allowance (\x00\x01) for denom ueure", func() {
+		florin.InitGenesis(ctx, k, genesis)
+	})
+}
@@ -0,0 +1,101 @@
+// Copyright 2024 Monerium ehf.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/monerium/module-noble/v2/types"
+)
+
+// GetEffectiveAccountLimit returns the limits that apply to an address, which
+// are its own limits if set, otherwise the default limits of the denom.
+func (k *Keeper) GetEffectiveAccountLimit(ctx context.Context, denom string, address string) (limit types.AccountLimit, isDefault bool, found bool) {
+	if limit, found = k.GetAccountLimit(ctx, denom, address); found {
+		return limit, false, true
+	}
+
+	limit, found = k.GetAccountLimit(ctx, denom, "")
+	return limit, found, found
+}
+
+// GetCurrentAccountOutflow returns the outflow of an address in the current
+// window. A new, empty window is started if the previous one has elapsed.
+func (k *Keeper) GetCurrentAccountOutflow(ctx context.Context, denom string, address string) types.AccountOutflow {
+	blockTime := sdk.UnwrapSDKContext(ctx).BlockTime()
+
+	outflow, found := k.GetAccountOutflow(ctx, denom, address)
+	if !found || outflow.IsExpired(blockTime) {
+		return types.NewAccountOutflow(denom, address, blockTime)
+	}
+
+	return outflow
+}
+
+// enforceAccountLimits checks a transfer of amount against the limits of the
+// sender and recipient, recording the outflow of the sender. The module
+// account is exempt, so that mints are only limited by the balance of the
+// recipient, and burns and redemptions are never limited.
+func (k *Keeper) enforceAccountLimits(ctx context.Context, denom string, fromAddr, toAddr sdk.AccAddress, amount math.Int) error {
+	moduleAddr := authtypes.NewModuleAddress(types.ModuleName)
+	if toAddr.Equals(moduleAddr) {
+		return nil
+	}
+
+	if !fromAddr.Equals(moduleAddr) {
+		from := fromAddr.String()
+		if limit, _, found := k.GetEffectiveAccountLimit(ctx, denom, from); found {
+			if limit.MaxTransfer.IsPositive() && amount.GT(limit.MaxTransfer) {
+				return errors.Wrapf(types.ErrAccountLimitExceeded, "max transfer of %s%s for %s", limit.MaxTransfer, denom, from)
+			}
+
+			if limit.MaxDailyOutflow.IsPositive() {
+				outflow := k.GetCurrentAccountOutflow(ctx, denom, from)
+				outflow.Used = outflow.Used.Add(amount)
+				if outflow.Used.GT(limit.MaxDailyOutflow) {
+					return errors.Wrapf(types.ErrAccountLimitExceeded, "max daily outflow of %s%s for %s", limit.MaxDailyOutflow, denom, from)
+				}
+
+				if err := k.SetAccountOutflow(ctx, outflow); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return k.enforceRecipientLimits(ctx, denom, toAddr, amount)
+}
+
+// enforceRecipientLimits checks a transfer of amount against the max balance
+// of the recipient. The module account is exempt.
+func (k *Keeper) enforceRecipientLimits(ctx context.Context, denom string, toAddr sdk.AccAddress, amount math.Int) error {
+	if toAddr.Equals(authtypes.NewModuleAddress(types.ModuleName)) {
+		return nil
+	}
+
+	to := toAddr.String()
+	if limit, _, found := k.GetEffectiveAccountLimit(ctx, denom, to); found && limit.MaxBalance.IsPositive() {
+		balance := k.bankKeeper.GetBalance(ctx, toAddr, denom).Amount
+		if balance.Add(amount).GT(limit.MaxBalance) {
+			return errors.Wrapf(types.ErrAccountLimitExceeded, "max balance of %s%s for %s", limit.MaxBalance, denom, to)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,52 @@
+// Copyright 2024 Monerium ehf.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/monerium/module-noble/v2/types/blacklist"
+)
+
+// PruneExpiredBans removes all time-bounded bans whose expiry has been
+// reached, together with their ban record.
+func (k *Keeper) PruneExpiredBans(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	for _, record := range k.GetExpiredBanRecords(ctx, sdkCtx.BlockHeight(), sdkCtx.BlockTime()) {
+		if record.Denom == "" {
+			if err := k.DeleteAdversary(ctx, record.Address); err != nil {
+				return err
+			}
+		} else {
+			if err := k.DeleteDenomAdversary(ctx, record.Denom, record.Address); err != nil {
+				return err
+			}
+		}
+		if err := k.DeleteBanRecord(ctx, record.Denom, record.Address); err != nil {
+			return err
+		}
+
+		if err := k.eventService.EventManager(ctx).Emit(ctx, &blacklist.BanExpired{
+			Adversary: record.Address,
+			Denom:     record.Denom,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
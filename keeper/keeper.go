@@ -22,6 +22,7 @@ import (
 	"cosmossdk.io/core/address"
 	"cosmossdk.io/core/event"
 	"cosmossdk.io/core/store"
+	"cosmossdk.io/errors"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/monerium/module-noble/v2/types"
@@ -38,15 +39,42 @@ type Keeper struct {
 	AllowedDenoms    collections.KeySet[string]
 	Owner            collections.Map[string, string]
 	PendingOwner     collections.Map[string, string]
-	Systems          collections.KeySet[[]byte]
-	Admins           collections.KeySet[[]byte]
-	MintAllowance    collections.Map[[]byte, []byte]
+	Systems          collections.KeySet[collections.Pair[string, []byte]]
+	Admins           collections.KeySet[collections.Pair[string, []byte]]
+	MintAllowance    collections.Map[collections.Pair[string, []byte], []byte]
 	MaxMintAllowance collections.Map[string, []byte]
+	Paused           collections.KeySet[string]
+	Pauser           collections.Map[string, string]
+	Seizer           collections.Map[string, string]
+	Nonces           collections.Map[string, uint64]
+	RateLimits       collections.Map[collections.Pair[string, string], types.RateLimit]
+	RateLimitUsages  collections.Map[collections.Pair[string, string], types.RateLimitUsage]
+	SupplyCap        collections.Map[string, []byte]
+
+	MintApprovalPolicies collections.Map[string, types.MintApprovalPolicy]
+	PendingMints         collections.Map[collections.Pair[string, uint64], types.PendingMint]
+	PendingMintExpiries  collections.KeySet[collections.Triple[int64, string, uint64]]
+	NextPendingMintID    collections.Sequence
+	References           collections.Map[collections.Pair[string, string], types.Reference]
+
+	TransferPolicies collections.Map[string, int32]
+	AllowlistAdmins  collections.KeySet[collections.Pair[string, []byte]]
+	Allowlist        collections.KeySet[collections.Pair[string, []byte]]
+
+	AccountLimits   collections.Map[collections.Pair[string, string], types.AccountLimit]
+	AccountOutflows collections.Map[collections.Pair[string, string], types.AccountOutflow]
+
+	LegacySignaturesDeprecated collections.Item[bool]
 
 	BlacklistOwner        collections.Item[string]
 	BlacklistPendingOwner collections.Item[string]
 	BlacklistAdmins       collections.KeySet[string]
 	Adversaries           collections.KeySet[string]
+	DenomBlacklistAdmins  collections.KeySet[collections.Pair[string, string]]
+	DenomAdversaries      collections.KeySet[collections.Pair[string, string]]
+	BanRecords            collections.Map[collections.Pair[string, string], blacklist.BanRecord]
+	BanExpiryHeights      collections.KeySet[collections.Triple[int64, string, string]]
+	BanExpiryTimes        collections.KeySet[collections.Triple[int64, string, string]]
 
 	cdc          codec.Codec
 	addressCodec address.Codec
@@ -72,15 +100,42 @@ func NewKeeper(
 		AllowedDenoms:    collections.NewKeySet(builder, types.AllowedDenomPrefix, "allowedDenoms", collections.StringKey),
 		Owner:            collections.NewMap(builder, types.OwnerPrefix, "owner", collections.StringKey, collections.StringValue),
 		PendingOwner:     collections.NewMap(builder, types.PendingOwnerPrefix, "pendingOwner", collections.StringKey, collections.StringValue),
-		Systems:          collections.NewKeySet(builder, types.SystemPrefix, "systems", collections.BytesKey),
-		Admins:           collections.NewKeySet(builder, types.AdminPrefix, "admins", collections.BytesKey),
-		MintAllowance:    collections.NewMap(builder, types.MintAllowancePrefix, "mintAllowance", collections.BytesKey, collections.BytesValue),
+		Systems:          collections.NewKeySet(builder, types.SystemPrefix, "systems", types.AccountKeyCodec),
+		Admins:           collections.NewKeySet(builder, types.AdminPrefix, "admins", types.AccountKeyCodec),
+		MintAllowance:    collections.NewMap(builder, types.MintAllowancePrefix, "mintAllowance", types.AccountKeyCodec, collections.BytesValue),
 		MaxMintAllowance: collections.NewMap(builder, types.MaxMintAllowancePrefix, "maxMintAllowance", collections.StringKey, collections.BytesValue),
+		Paused:           collections.NewKeySet(builder, types.PausedPrefix, "paused", collections.StringKey),
+		Pauser:           collections.NewMap(builder, types.PauserPrefix, "pauser", collections.StringKey, collections.StringValue),
+		Seizer:           collections.NewMap(builder, types.SeizerPrefix, "seizer", collections.StringKey, collections.StringValue),
+		Nonces:           collections.NewMap(builder, types.NoncePrefix, "nonces", collections.StringKey, collections.Uint64Value),
+		RateLimits:       collections.NewMap(builder, types.RateLimitPrefix, "rateLimits", collections.PairKeyCodec(collections.StringKey, collections.StringKey), codec.CollValue[types.RateLimit](cdc)),
+		RateLimitUsages:  collections.NewMap(builder, types.RateLimitUsagePrefix, "rateLimitUsages", collections.PairKeyCodec(collections.StringKey, collections.StringKey), codec.CollValue[types.RateLimitUsage](cdc)),
+		SupplyCap:        collections.NewMap(builder, types.SupplyCapPrefix, "supplyCap", collections.StringKey, collections.BytesValue),
+
+		MintApprovalPolicies: collections.NewMap(builder, types.MintApprovalPolicyPrefix, "mintApprovalPolicies", collections.StringKey, codec.CollValue[types.MintApprovalPolicy](cdc)),
+		PendingMints:         collections.NewMap(builder, types.PendingMintPrefix, "pendingMints", collections.PairKeyCodec(collections.StringKey, collections.Uint64Key), codec.CollValue[types.PendingMint](cdc)),
+		PendingMintExpiries:  collections.NewKeySet(builder, types.PendingMintExpiryPrefix, "pendingMintExpiries", collections.TripleKeyCodec(collections.Int64Key, collections.StringKey, collections.Uint64Key)),
+		NextPendingMintID:    collections.NewSequence(builder, types.NextPendingMintIDKey, "nextPendingMintID"),
+		References:           collections.NewMap(builder, types.ReferencePrefix, "references", collections.PairKeyCodec(collections.StringKey, collections.StringKey), codec.CollValue[types.Reference](cdc)),
+
+		TransferPolicies: collections.NewMap(builder, types.TransferPolicyPrefix, "transferPolicies", collections.StringKey, collections.Int32Value),
+		AllowlistAdmins:  collections.NewKeySet(builder, types.AllowlistAdminPrefix, "allowlistAdmins", types.AccountKeyCodec),
+		Allowlist:        collections.NewKeySet(builder, types.AllowlistPrefix, "allowlist", types.AccountKeyCodec),
+
+		AccountLimits:   collections.NewMap(builder, types.AccountLimitPrefix, "accountLimits", collections.PairKeyCodec(collections.StringKey, collections.StringKey), codec.CollValue[types.AccountLimit](cdc)),
+		AccountOutflows: collections.NewMap(builder, types.AccountOutflowPrefix, "accountOutflows", collections.PairKeyCodec(collections.StringKey, collections.StringKey), codec.CollValue[types.AccountOutflow](cdc)),
+
+		LegacySignaturesDeprecated: collections.NewItem(builder, types.LegacySignaturesDeprecatedKey, "legacySignaturesDeprecated", collections.BoolValue),
 
 		BlacklistOwner:        collections.NewItem(builder, blacklist.OwnerKey, "blacklistOwner", collections.StringValue),
 		BlacklistPendingOwner: collections.NewItem(builder, blacklist.PendingOwnerKey, "blacklistPendingOwner", collections.StringValue),
 		BlacklistAdmins:       collections.NewKeySet(builder, blacklist.AdminPrefix, "blacklistAdmins", collections.StringKey),
 		Adversaries:           collections.NewKeySet(builder, blacklist.AdversaryPrefix, "adversaries", collections.StringKey),
+		DenomBlacklistAdmins:  collections.NewKeySet(builder, blacklist.DenomAdminPrefix, "denomBlacklistAdmins", collections.PairKeyCodec(collections.StringKey, collections.StringKey)),
+		DenomAdversaries:      collections.NewKeySet(builder, blacklist.DenomAdversaryPrefix, "denomAdversaries", collections.PairKeyCodec(collections.StringKey, collections.StringKey)),
+		BanRecords:            collections.NewMap(builder, blacklist.BanRecordPrefix, "banRecords", collections.PairKeyCodec(collections.StringKey, collections.StringKey), codec.CollValue[blacklist.BanRecord](cdc)),
+		BanExpiryHeights:      collections.NewKeySet(builder, blacklist.BanExpiryHeightPrefix, "banExpiryHeights", collections.TripleKeyCodec(collections.Int64Key, collections.StringKey, collections.StringKey)),
+		BanExpiryTimes:        collections.NewKeySet(builder, blacklist.BanExpiryTimePrefix, "banExpiryTimes", collections.TripleKeyCodec(collections.Int64Key, collections.StringKey, collections.StringKey)),
 
 		cdc:          cdc,
 		addressCodec: addressCodec,
@@ -96,26 +151,75 @@ func NewKeeper(
 	return keeper
 }
 
+// StoreService returns the store service used in this module.
+func (k *Keeper) StoreService() store.KVStoreService {
+	return k.storeService
+}
+
+// AddressCodec returns the address codec used in this module.
+func (k *Keeper) AddressCodec() address.Codec {
+	return k.addressCodec
+}
+
 // SetBankKeeper overwrites the bank keeper used in this module.
 func (k *Keeper) SetBankKeeper(bankKeeper types.BankKeeper) {
 	k.bankKeeper = bankKeeper
 }
 
+// sendRestrictionBypassKey marks a context in which the pause, blacklist, and
+// transfer policy checks of SendRestrictionFn are skipped.
+type sendRestrictionBypassKey struct{}
+
+// withSendRestrictionBypass returns a context in which SendRestrictionFn
+// allows transfers regardless of the blacklist and transfer policy, while
+// still enforcing the limits of the recipient. It must only be used for
+// privileged transfers, such as seizing the balance of a banned address.
+func withSendRestrictionBypass(ctx context.Context) context.Context {
+	return sdk.UnwrapSDKContext(ctx).WithValue(sendRestrictionBypassKey{}, true)
+}
+
 // SendRestrictionFn executes necessary checks against all EURe, GBPe, ISKe, USDe transfers.
 func (k *Keeper) SendRestrictionFn(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (newToAddr sdk.AccAddress, err error) {
+	if bypass, _ := ctx.Value(sendRestrictionBypassKey{}).(bool); bypass {
+		for _, allowedDenom := range k.GetAllowedDenoms(ctx) {
+			if amount := amt.AmountOf(allowedDenom); !amount.IsZero() {
+				if err := k.enforceRecipientLimits(ctx, allowedDenom, toAddr, amount); err != nil {
+					return toAddr, err
+				}
+			}
+		}
+
+		return toAddr, nil
+	}
+
 	for _, allowedDenom := range k.GetAllowedDenoms(ctx) {
 		if amount := amt.AmountOf(allowedDenom); !amount.IsZero() {
-			isAdversary := k.IsAdversary(ctx, fromAddr.String())
+			if k.IsPaused(ctx, allowedDenom) {
+				return toAddr, errors.Wrapf(types.ErrPaused, "unable to transfer %s", allowedDenom)
+			}
+
+			policy := k.GetTransferPolicy(ctx, allowedDenom)
+			senderBlocked := k.isAccountTransferBlocked(ctx, policy, allowedDenom, fromAddr)
+			recipientBlocked := k.isAccountTransferBlocked(ctx, policy, allowedDenom, toAddr)
 			_ = k.eventService.EventManager(ctx).Emit(ctx, &blacklist.Decision{
-				From:   fromAddr.String(),
-				To:     toAddr.String(),
-				Amount: amount,
-				Valid:  !isAdversary,
+				From:             fromAddr.String(),
+				To:               toAddr.String(),
+				Amount:           amount,
+				Valid:            !senderBlocked && !recipientBlocked,
+				SenderBlocked:    senderBlocked,
+				RecipientBlocked: recipientBlocked,
 			})
 
-			if isAdversary {
+			if senderBlocked {
 				return toAddr, fmt.Errorf("%s is blocked from sending %s", fromAddr, allowedDenom)
 			}
+			if recipientBlocked {
+				return toAddr, fmt.Errorf("%s is blocked from receiving %s", toAddr, allowedDenom)
+			}
+
+			if err := k.enforceAccountLimits(ctx, allowedDenom, fromAddr, toAddr, amount); err != nil {
+				return toAddr, err
+			}
 		}
 	}
 
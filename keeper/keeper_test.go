@@ -16,14 +16,18 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
 	"cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/codec/address"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/monerium/module-noble/v2/keeper"
 	"github.com/monerium/module-noble/v2/types"
+	"github.com/monerium/module-noble/v2/types/blacklist"
 	"github.com/monerium/module-noble/v2/utils"
 	"github.com/monerium/module-noble/v2/utils/mocks"
 	"github.com/stretchr/testify/require"
@@ -57,6 +61,23 @@ func TestSendRestriction(t *testing.T) {
 	require.Len(t, events, 1)
 	require.Equal(t, "florin.blacklist.v1.Decision", events[0].Type)
 
+	// ARRANGE: Pause $EURe in state.
+	err = k.SetPaused(ctx, "ueure", true)
+	require.NoError(t, err)
+
+	// ACT: Attempt transfer with paused denom.
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	_, err = k.SendRestrictionFn(
+		ctx, sender.Bytes, recipient.Bytes,
+		sdk.NewCoins(ONE),
+	)
+	// ASSERT: The transfer should've failed.
+	require.ErrorIs(t, err, types.ErrPaused)
+
+	// ARRANGE: Unpause $EURe in state.
+	err = k.SetPaused(ctx, "ueure", false)
+	require.NoError(t, err)
+
 	// ARRANGE: Set sender as adversary.
 	err = k.SetAdversary(ctx, sender.Address)
 	require.NoError(t, err)
@@ -72,6 +93,196 @@ func TestSendRestriction(t *testing.T) {
 	events = ctx.EventManager().Events()
 	require.Len(t, events, 1)
 	require.Equal(t, "florin.blacklist.v1.Decision", events[0].Type)
+
+	// ARRANGE: Set sender as friend, recipient as adversary.
+	err = k.DeleteAdversary(ctx, sender.Address)
+	require.NoError(t, err)
+	err = k.SetAdversary(ctx, recipient.Address)
+	require.NoError(t, err)
+
+	// ACT: Attempt transfer with adversarial recipient.
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	_, err = k.SendRestrictionFn(
+		ctx, sender.Bytes, recipient.Bytes,
+		sdk.NewCoins(ONE),
+	)
+	// ASSERT: The transfer should've failed.
+	require.ErrorContains(t, err, "blocked from receiving")
+	events = ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, "florin.blacklist.v1.Decision", events[0].Type)
+	event, err := sdk.ParseTypedEvent(abci.Event(events[0]))
+	require.NoError(t, err)
+	decision := event.(*blacklist.Decision)
+	require.False(t, decision.Valid)
+	require.False(t, decision.SenderBlocked)
+	require.True(t, decision.RecipientBlocked)
+
+	// ARRANGE: Set recipient as friend, sender as $EURe adversary.
+	err = k.DeleteAdversary(ctx, recipient.Address)
+	require.NoError(t, err)
+	err = k.SetDenomAdversary(ctx, "ueure", sender.Address)
+	require.NoError(t, err)
+
+	// ACT: Attempt transfer with denom adversarial sender.
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	_, err = k.SendRestrictionFn(
+		ctx, sender.Bytes, recipient.Bytes,
+		sdk.NewCoins(ONE),
+	)
+	// ASSERT: The transfer should've failed.
+	require.ErrorContains(t, err, "blocked from sending")
+
+	// ARRANGE: Allow another denom.
+	err = k.SetAllowedDenom(ctx, "ugbpe")
+	require.NoError(t, err)
+
+	// ACT: Attempt transfer of another denom with denom adversarial sender.
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	_, err = k.SendRestrictionFn(
+		ctx, sender.Bytes, recipient.Bytes,
+		sdk.NewCoins(sdk.NewCoin("ugbpe", math.NewInt(1_000_000))),
+	)
+	// ASSERT: The transfer should've succeeded.
+	require.NoError(t, err)
+}
+
+func TestSendRestrictionWithTransferPolicy(t *testing.T) {
+	k, ctx := mocks.FlorinKeeper()
+	sender, recipient := utils.TestAccount(), utils.TestAccount()
+	module := authtypes.NewModuleAddress(types.ModuleName)
+	ONE := sdk.NewCoin("ueure", math.NewInt(1_000_000_000_000_000_000))
+
+	// ARRANGE: Set sender as adversary, and the open transfer policy.
+	require.NoError(t, k.SetAdversary(ctx, sender.Address))
+	require.NoError(t, k.SetTransferPolicy(ctx, "ueure", types.TransferPolicyOpen))
+
+	// ACT: Attempt transfer with adversarial sender.
+	_, err := k.SendRestrictionFn(ctx, sender.Bytes, recipient.Bytes, sdk.NewCoins(ONE))
+	// ASSERT: The transfer should've failed, as global adversaries are always blocked.
+	require.ErrorContains(t, err, "blocked from sending")
+
+	// ARRANGE: Replace the global ban of sender with a denom ban, e.g. imported in genesis.
+	require.NoError(t, k.DeleteAdversary(ctx, sender.Address))
+	require.NoError(t, k.SetDenomAdversary(ctx, "ueure", sender.Address))
+
+	// ACT: Attempt transfer with denom-banned sender.
+	_, err = k.SendRestrictionFn(ctx, sender.Bytes, recipient.Bytes, sdk.NewCoins(ONE))
+	// ASSERT: The transfer should've failed, as denom bans are still enforced.
+	require.ErrorContains(t, err, "blocked from sending")
+
+	// ARRANGE: Set sender as friend, and the allowlist transfer policy.
+	require.NoError(t, k.DeleteDenomAdversary(ctx, "ueure", sender.Address))
+	require.NoError(t, k.SetTransferPolicy(ctx, "ueure", types.TransferPolicyAllowlist))
+
+	// ACT: Attempt transfer with non-allowlisted sender and recipient.
+	_, err = k.SendRestrictionFn(ctx, sender.Bytes, recipient.Bytes, sdk.NewCoins(ONE))
+	// ASSERT: The transfer should've failed.
+	require.ErrorContains(t, err, "blocked from sending")
+
+	// ARRANGE: Allowlist sender.
+	require.NoError(t, k.SetAllowlisted(ctx, "ueure", sender.Address))
+
+	// ACT: Attempt transfer with non-allowlisted recipient.
+	_, err = k.SendRestrictionFn(ctx, sender.Bytes, recipient.Bytes, sdk.NewCoins(ONE))
+	// ASSERT: The transfer should've failed.
+	require.ErrorContains(t, err, "blocked from receiving")
+
+	// ACT: Attempt transfer to and from the module account.
+	_, err = k.SendRestrictionFn(ctx, sender.Bytes, module, sdk.NewCoins(ONE))
+	require.NoError(t, err)
+	_, err = k.SendRestrictionFn(ctx, module, sender.Bytes, sdk.NewCoins(ONE))
+	// ASSERT: The transfers should've succeeded.
+	require.NoError(t, err)
+
+	// ARRANGE: Allowlist recipient.
+	require.NoError(t, k.SetAllowlisted(ctx, "ueure", recipient.Address))
+
+	// ACT: Attempt transfer with allowlisted sender and recipient.
+	_, err = k.SendRestrictionFn(ctx, sender.Bytes, recipient.Bytes, sdk.NewCoins(ONE))
+	// ASSERT: The transfer should've succeeded.
+	require.NoError(t, err)
+
+	// ARRANGE: Set allowlisted recipient as adversary.
+	require.NoError(t, k.SetDenomAdversary(ctx, "ueure", recipient.Address))
+
+	// ACT: Attempt transfer with allowlisted adversarial recipient.
+	_, err = k.SendRestrictionFn(ctx, sender.Bytes, recipient.Bytes, sdk.NewCoins(ONE))
+	// ASSERT: The transfer should've failed, as the blacklist is still enforced.
+	require.ErrorContains(t, err, "blocked from receiving")
+}
+
+func TestSendRestrictionWithAccountLimits(t *testing.T) {
+	bank := mocks.BankKeeper{
+		Balances:    make(map[string]sdk.Coins),
+		Restriction: mocks.NoOpSendRestrictionFn,
+	}
+	k, ctx := mocks.FlorinWithKeepers(bank)
+	ctx = ctx.WithBlockTime(time.Unix(1_700_000_000, 0))
+	sender, recipient := utils.TestAccount(), utils.TestAccount()
+	module := authtypes.NewModuleAddress(types.ModuleName)
+	coins := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewCoin("ueure", One.MulRaw(amount)))
+	}
+
+	// ARRANGE: Set the default limits, and a max transfer override for the sender.
+	require.NoError(t, k.SetAccountLimit(ctx, types.AccountLimit{
+		Denom:           "ueure",
+		MaxBalance:      One.MulRaw(5),
+		MaxTransfer:     math.ZeroInt(),
+		MaxDailyOutflow: One.MulRaw(3),
+	}))
+	require.NoError(t, k.SetAccountLimit(ctx, types.AccountLimit{
+		Denom:           "ueure",
+		Address:         sender.Address,
+		MaxBalance:      math.ZeroInt(),
+		MaxTransfer:     One.MulRaw(2),
+		MaxDailyOutflow: math.ZeroInt(),
+	}))
+
+	// ACT: Attempt transfer above the sender's max transfer.
+	_, err := k.SendRestrictionFn(ctx, sender.Bytes, recipient.Bytes, coins(3))
+	// ASSERT: The transfer should've failed.
+	require.ErrorIs(t, err, types.ErrAccountLimitExceeded)
+	require.ErrorContains(t, err, "max transfer")
+
+	// ARRANGE: Give recipient a balance close to the default max balance.
+	bank.Balances[recipient.Address] = coins(4)
+
+	// ACT: Attempt transfer above the recipient's max balance.
+	_, err = k.SendRestrictionFn(ctx, sender.Bytes, recipient.Bytes, coins(2))
+	// ASSERT: The transfer should've failed.
+	require.ErrorIs(t, err, types.ErrAccountLimitExceeded)
+	require.ErrorContains(t, err, "max balance")
+
+	// ACT: Attempt transfer from the recipient, within its daily outflow.
+	_, err = k.SendRestrictionFn(ctx, recipient.Bytes, sender.Bytes, coins(2))
+	// ASSERT: The transfer should've succeeded, and the outflow recorded.
+	require.NoError(t, err)
+	outflow, found := k.GetAccountOutflow(ctx, "ueure", recipient.Address)
+	require.True(t, found)
+	require.Equal(t, One.MulRaw(2), outflow.Used)
+
+	// ACT: Attempt transfer from the recipient, above its daily outflow.
+	_, err = k.SendRestrictionFn(ctx, recipient.Bytes, sender.Bytes, coins(2))
+	// ASSERT: The transfer should've failed.
+	require.ErrorIs(t, err, types.ErrAccountLimitExceeded)
+	require.ErrorContains(t, err, "max daily outflow")
+
+	// ACT: Attempt the same transfer a day later.
+	_, err = k.SendRestrictionFn(ctx.WithBlockTime(ctx.BlockTime().Add(types.OutflowWindow)), recipient.Bytes, sender.Bytes, coins(2))
+	// ASSERT: The transfer should've succeeded, as a new window has started.
+	require.NoError(t, err)
+
+	// ACT: Attempt transfers to and from the module account.
+	_, err = k.SendRestrictionFn(ctx, recipient.Bytes, module, coins(4))
+	require.NoError(t, err)
+	_, err = k.SendRestrictionFn(ctx, module, recipient.Bytes, coins(2))
+	// ASSERT: The mint should've failed due to the recipient's max balance.
+	require.ErrorContains(t, err, "max balance")
+	_, err = k.SendRestrictionFn(ctx, module, recipient.Bytes, coins(1))
+	// ASSERT: The burn and remaining mint should've succeeded.
+	require.NoError(t, err)
 }
 
 func TestNewKeeper(t *testing.T) {
@@ -0,0 +1,127 @@
+// Copyright 2024 Monerium ehf.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/monerium/module-noble/v2/keeper"
+	"github.com/monerium/module-noble/v2/types"
+)
+
+// MigrateStore performs in-place store migrations from v1 to v2.
+//
+// The systems, admins and mint allowances are moved from keys that
+// concatenate the denom and bech32 address, to delimited denom and address
+// bytes pairs. Entries that can't be attributed to an allowed denom and a
+// valid address are dropped.
+func MigrateStore(ctx sdk.Context, k *keeper.Keeper) error {
+	denoms := k.GetAllowedDenoms(ctx)
+	// NOTE: Longer denoms are tried first, so that e.g. ueurex is preferred
+	// over ueure if both happen to produce a valid address.
+	sort.SliceStable(denoms, func(i, j int) bool { return len(denoms[i]) > len(denoms[j]) })
+
+	for _, prefix := range [][]byte{types.SystemPrefix, types.AdminPrefix, types.MintAllowancePrefix} {
+		entries, err := removeLegacyEntries(ctx, k, prefix)
+		if err != nil {
+			return err
+		}
+
+		for _, entry := range entries {
+			key, ok := parseLegacyKey(k, denoms, entry.key)
+			if !ok {
+				ctx.Logger().Info("dropping unparsable florin store entry", "prefix", string(prefix), "key", string(entry.key))
+				continue
+			}
+
+			switch {
+			case bytes.Equal(prefix, types.SystemPrefix):
+				err = k.Systems.Set(ctx, key)
+			case bytes.Equal(prefix, types.AdminPrefix):
+				err = k.Admins.Set(ctx, key)
+			case bytes.Equal(prefix, types.MintAllowancePrefix):
+				err = k.MintAllowance.Set(ctx, key, entry.value)
+			}
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+type legacyEntry struct {
+	key   []byte
+	value []byte
+}
+
+// removeLegacyEntries removes and returns all legacy entries under a prefix,
+// with the prefix stripped from their keys. Legacy keys never contain the
+// delimiter of the new pair keys, so already migrated entries are skipped.
+func removeLegacyEntries(ctx sdk.Context, k *keeper.Keeper, prefix []byte) ([]legacyEntry, error) {
+	store := k.StoreService().OpenKVStore(ctx)
+	itr, err := store.Iterator(prefix, storetypes.PrefixEndBytes(prefix))
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []legacyEntry
+	for ; itr.Valid(); itr.Next() {
+		key := itr.Key()[len(prefix):]
+		if bytes.IndexByte(key, 0) >= 0 {
+			continue
+		}
+
+		entries = append(entries, legacyEntry{
+			key:   bytes.Clone(key),
+			value: bytes.Clone(itr.Value()),
+		})
+	}
+	if err := itr.Close(); err != nil {
+		return nil, err
+	}
+
+	for _, entry := range entries {
+		if err := store.Delete(append(bytes.Clone(prefix), entry.key...)); err != nil {
+			return nil, fmt.Errorf("failed to delete legacy entry %s: %w", entry.key, err)
+		}
+	}
+
+	return entries, nil
+}
+
+// parseLegacyKey splits a concatenated denom and bech32 address key.
+func parseLegacyKey(k *keeper.Keeper, denoms []string, key []byte) (collections.Pair[string, []byte], bool) {
+	for _, denom := range denoms {
+		if !bytes.HasPrefix(key, []byte(denom)) {
+			continue
+		}
+
+		address, err := k.AddressCodec().StringToBytes(string(key[len(denom):]))
+		if err != nil {
+			continue
+		}
+
+		return collections.Join(denom, address), true
+	}
+
+	return collections.Pair[string, []byte]{}, false
+}
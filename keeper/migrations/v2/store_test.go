@@ -0,0 +1,75 @@
+// Copyright 2024 Monerium ehf.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2_test
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+
+	"cosmossdk.io/math"
+	v2 "github.com/monerium/module-noble/v2/keeper/migrations/v2"
+	"github.com/monerium/module-noble/v2/types"
+	"github.com/monerium/module-noble/v2/utils"
+	"github.com/monerium/module-noble/v2/utils/mocks"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrateStore(t *testing.T) {
+	k, ctx := mocks.FlorinKeeper()
+	store := utils.GetKVStore(ctx, types.ModuleName)
+
+	// ARRANGE: Load the v1 store fixture.
+	bz, err := os.ReadFile("testdata/v1_store.json")
+	require.NoError(t, err)
+	var fixture []struct {
+		Key   string `json:"key"`
+		Value string `json:"value"`
+	}
+	require.NoError(t, json.Unmarshal(bz, &fixture))
+	for _, entry := range fixture {
+		store.Set([]byte(entry.Key), []byte(entry.Value))
+	}
+
+	// ACT: Attempt to migrate.
+	err = v2.MigrateStore(ctx, k)
+	// ASSERT: The migration should've succeeded.
+	require.NoError(t, err)
+
+	// ASSERT: The systems should've been migrated.
+	require.Equal(t, []string{"noble1wq0y7r324qfu75fanwdv88escyk4dz6a7nla30"}, k.GetSystemsByDenom(ctx, "ueure"))
+	require.Equal(t, []string{"noble195waa6uwe7rs82hemhhpde3k5calm5ferytt98"}, k.GetSystemsByDenom(ctx, "ueurex"))
+	// ASSERT: The admins should've been migrated, dropping the invalid entry.
+	require.Equal(t, []string{"noble1gez9mj2tjktd3ncasad85yzm4a4u7ca2p8hux3"}, k.GetAdminsByDenom(ctx, "ueure"))
+	require.Empty(t, k.GetAdminsByDenom(ctx, "ueurex"))
+	// ASSERT: The mint allowances should've been migrated.
+	require.Equal(t, math.NewInt(1_000_000), k.GetMintAllowance(ctx, "ueure", "noble1wq0y7r324qfu75fanwdv88escyk4dz6a7nla30"))
+	require.Equal(t, math.NewInt(2_000_000), k.GetMintAllowance(ctx, "ueurex", "noble195waa6uwe7rs82hemhhpde3k5calm5ferytt98"))
+	require.True(t, k.GetMintAllowance(ctx, "ueure", "noble195waa6uwe7rs82hemhhpde3k5calm5ferytt98").IsZero())
+	// ASSERT: The untouched collections should've been preserved.
+	require.Equal(t, "noble1njxl3nrzqeassrn5m6fesf2m3dlyak05wh2y4l", k.GetOwner(ctx, "ueure"))
+	require.Equal(t, math.NewInt(3_000_000_000_000), k.GetMaxMintAllowance(ctx, "ueure"))
+
+	// ASSERT: The legacy entries should've been removed.
+	require.False(t, store.Has([]byte("system/ueurenoble1wq0y7r324qfu75fanwdv88escyk4dz6a7nla30")))
+	require.False(t, store.Has([]byte("admin/ueureinvalid")))
+	require.False(t, store.Has([]byte("mint_allowance/ueurexnoble195waa6uwe7rs82hemhhpde3k5calm5ferytt98")))
+
+	// ACT: Attempt to migrate again.
+	err = v2.MigrateStore(ctx, k)
+	// ASSERT: The migration should've succeeded, without changing state.
+	require.NoError(t, err)
+	require.Equal(t, []string{"noble1wq0y7r324qfu75fanwdv88escyk4dz6a7nla30"}, k.GetSystemsByDenom(ctx, "ueure"))
+}
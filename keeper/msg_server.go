@@ -21,8 +21,10 @@ import (
 
 	"adr36.dev"
 	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/monerium/module-noble/v2/types"
 )
 
@@ -37,6 +39,9 @@ func NewMsgServer(keeper *Keeper) types.MsgServer {
 }
 
 func (k msgServer) AcceptOwnership(ctx context.Context, msg *types.MsgAcceptOwnership) (*types.MsgAcceptOwnershipResponse, error) {
+	if err := msg.Validate(k.addressCodec); err != nil {
+		return nil, err
+	}
 	if !k.IsAllowedDenom(ctx, msg.Denom) {
 		return nil, fmt.Errorf("%s is not an allowed denom", msg.Denom)
 	}
@@ -64,6 +69,9 @@ func (k msgServer) AcceptOwnership(ctx context.Context, msg *types.MsgAcceptOwne
 }
 
 func (k msgServer) AddAdminAccount(ctx context.Context, msg *types.MsgAddAdminAccount) (*types.MsgAddAdminAccountResponse, error) {
+	if err := msg.Validate(k.addressCodec); err != nil {
+		return nil, err
+	}
 	if !k.IsAllowedDenom(ctx, msg.Denom) {
 		return nil, fmt.Errorf("%s is not an allowed denom", msg.Denom)
 	}
@@ -82,7 +90,32 @@ func (k msgServer) AddAdminAccount(ctx context.Context, msg *types.MsgAddAdminAc
 	})
 }
 
+func (k msgServer) AddAllowlistAdmin(ctx context.Context, msg *types.MsgAddAllowlistAdmin) (*types.MsgAddAllowlistAdminResponse, error) {
+	if err := msg.Validate(k.addressCodec); err != nil {
+		return nil, err
+	}
+	if !k.IsAllowedDenom(ctx, msg.Denom) {
+		return nil, fmt.Errorf("%s is not an allowed denom", msg.Denom)
+	}
+	_, err := k.EnsureOwner(ctx, msg.Denom, msg.Signer)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.SetAllowlistAdmin(ctx, msg.Denom, msg.Account); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAddAllowlistAdminResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.AllowlistAdminAdded{
+		Denom:   msg.Denom,
+		Account: msg.Account,
+	})
+}
+
 func (k msgServer) AddSystemAccount(ctx context.Context, msg *types.MsgAddSystemAccount) (*types.MsgAddSystemAccountResponse, error) {
+	if err := msg.Validate(k.addressCodec); err != nil {
+		return nil, err
+	}
 	if !k.IsAllowedDenom(ctx, msg.Denom) {
 		return nil, fmt.Errorf("%s is not an allowed denom", msg.Denom)
 	}
@@ -101,7 +134,31 @@ func (k msgServer) AddSystemAccount(ctx context.Context, msg *types.MsgAddSystem
 	})
 }
 
+func (k msgServer) AddToAllowlist(ctx context.Context, msg *types.MsgAddToAllowlist) (*types.MsgAddToAllowlistResponse, error) {
+	if err := msg.Validate(k.addressCodec); err != nil {
+		return nil, err
+	}
+	if !k.IsAllowedDenom(ctx, msg.Denom) {
+		return nil, fmt.Errorf("%s is not an allowed denom", msg.Denom)
+	}
+	if err := k.EnsureAllowlistAdmin(ctx, msg.Denom, msg.Signer); err != nil {
+		return nil, err
+	}
+
+	if err := k.SetAllowlisted(ctx, msg.Denom, msg.Account); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAddToAllowlistResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.AllowlistAccountAdded{
+		Denom:   msg.Denom,
+		Account: msg.Account,
+	})
+}
+
 func (k msgServer) AllowDenom(ctx context.Context, msg *types.MsgAllowDenom) (*types.MsgAllowDenomResponse, error) {
+	if err := msg.Validate(k.addressCodec); err != nil {
+		return nil, err
+	}
 	if msg.Signer != k.authority {
 		return nil, errors.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", k.authority, msg.Signer)
 	}
@@ -124,10 +181,81 @@ func (k msgServer) AllowDenom(ctx context.Context, msg *types.MsgAllowDenom) (*t
 	})
 }
 
+func (k msgServer) ApproveMint(ctx context.Context, msg *types.MsgApproveMint) (*types.MsgApproveMintResponse, error) {
+	if err := msg.Validate(k.addressCodec); err != nil {
+		return nil, err
+	}
+	if !k.IsAllowedDenom(ctx, msg.Denom) {
+		return nil, fmt.Errorf("%s is not an allowed denom", msg.Denom)
+	}
+
+	pendingMint, found := k.GetPendingMint(ctx, msg.Denom, msg.Id)
+	if !found {
+		return nil, errors.Wrapf(types.ErrNoPendingMint, "id %d", msg.Id)
+	}
+	if sdk.UnwrapSDKContext(ctx).BlockHeight() > pendingMint.ExpiryHeight {
+		return nil, types.ErrPendingMintExpired
+	}
+	if !k.IsAdmin(ctx, msg.Denom, msg.Signer) {
+		return nil, types.ErrInvalidAdmin
+	}
+	if msg.Signer == pendingMint.System {
+		return nil, errors.Wrap(types.ErrInvalidApprover, "minting system cannot approve its own mint")
+	}
+	if pendingMint.IsApprovedBy(msg.Signer) {
+		return nil, errors.Wrap(types.ErrInvalidApprover, "mint is already approved by signer")
+	}
+
+	pendingMint.Approvals = append(pendingMint.Approvals, msg.Signer)
+	if err := k.eventService.EventManager(ctx).Emit(ctx, &types.MintApproved{
+		Denom:     msg.Denom,
+		Id:        msg.Id,
+		Approver:  msg.Signer,
+		Approvals: uint32(len(pendingMint.Approvals)),
+	}); err != nil {
+		return nil, err
+	}
+
+	if !pendingMint.HasQuorum() {
+		return &types.MsgApproveMintResponse{}, k.SetPendingMint(ctx, pendingMint)
+	}
+
+	if k.IsPaused(ctx, msg.Denom) {
+		return nil, types.ErrPaused
+	}
+	if k.IsTransferBlocked(ctx, msg.Denom, pendingMint.To) {
+		return nil, fmt.Errorf("%s is blocked from receiving %s", pendingMint.To, msg.Denom)
+	}
+	if err := k.EnforceMintLimits(ctx, msg.Denom, pendingMint.System, pendingMint.Amount); err != nil {
+		return nil, err
+	}
+
+	if err := k.DeletePendingMint(ctx, msg.Denom, msg.Id); err != nil {
+		return nil, err
+	}
+	if err := k.MintCoins(ctx, msg.Denom, pendingMint.To, pendingMint.Amount); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgApproveMintResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.Minted{
+		Denom:     msg.Denom,
+		System:    pendingMint.System,
+		To:        pendingMint.To,
+		Amount:    pendingMint.Amount,
+		Reference: pendingMint.Reference,
+	})
+}
+
 func (k msgServer) Burn(ctx context.Context, msg *types.MsgBurn) (*types.MsgBurnResponse, error) {
+	if err := msg.Validate(k.addressCodec); err != nil {
+		return nil, err
+	}
 	if !k.IsAllowedDenom(ctx, msg.Denom) {
 		return nil, fmt.Errorf("%s is not an allowed denom", msg.Denom)
 	}
+	if k.IsPaused(ctx, msg.Denom) {
+		return nil, types.ErrPaused
+	}
 	if !k.IsSystem(ctx, msg.Denom, msg.Signer) {
 		return nil, types.ErrInvalidSystem
 	}
@@ -147,12 +275,13 @@ func (k msgServer) Burn(ctx context.Context, msg *types.MsgBurn) (*types.MsgBurn
 		return nil, types.ErrInvalidPubKey
 	}
 
-	if !adr36.VerifySignature(
-		pubKey,
-		[]byte("I hereby declare that I am the address owner."),
-		msg.Signature,
-	) {
-		return nil, types.ErrInvalidSignature
+	doc := types.NewBurnSignDoc(sdk.UnwrapSDKContext(ctx).ChainID(), msg.Denom, msg.Amount.String(), msg.Signer, k.GetNonce(ctx, msg.From), msg.ExpiryHeight)
+	if err := k.VerifySignature(ctx, msg.From, pubKey, msg.Signature, doc); err != nil {
+		return nil, err
+	}
+
+	if err := k.UseReference(ctx, msg.Denom, msg.Reference, msg.Signer, msg.From, msg.Amount, true); err != nil {
+		return nil, err
 	}
 
 	coins := sdk.NewCoins(sdk.NewCoin(msg.Denom, msg.Amount))
@@ -165,52 +294,256 @@ func (k msgServer) Burn(ctx context.Context, msg *types.MsgBurn) (*types.MsgBurn
 		return nil, errors.Wrap(err, "unable to burn from module")
 	}
 
-	return &types.MsgBurnResponse{}, nil
+	return &types.MsgBurnResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.Burned{
+		Denom:     msg.Denom,
+		System:    msg.Signer,
+		From:      msg.From,
+		Amount:    msg.Amount,
+		Reference: msg.Reference,
+	})
+}
+
+func (k msgServer) CancelMint(ctx context.Context, msg *types.MsgCancelMint) (*types.MsgCancelMintResponse, error) {
+	if err := msg.Validate(k.addressCodec); err != nil {
+		return nil, err
+	}
+	if !k.IsAllowedDenom(ctx, msg.Denom) {
+		return nil, fmt.Errorf("%s is not an allowed denom", msg.Denom)
+	}
+
+	pendingMint, found := k.GetPendingMint(ctx, msg.Denom, msg.Id)
+	if !found {
+		return nil, errors.Wrapf(types.ErrNoPendingMint, "id %d", msg.Id)
+	}
+	if msg.Signer != pendingMint.System && msg.Signer != k.GetOwner(ctx, msg.Denom) {
+		return nil, types.ErrInvalidCanceller
+	}
+
+	if err := k.RefundPendingMint(ctx, pendingMint); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelMintResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.PendingMintCancelled{
+		Denom:   msg.Denom,
+		Id:      msg.Id,
+		Account: msg.Signer,
+	})
+}
+
+func (k msgServer) DeprecateLegacySignatures(ctx context.Context, msg *types.MsgDeprecateLegacySignatures) (*types.MsgDeprecateLegacySignaturesResponse, error) {
+	if err := msg.Validate(k.addressCodec); err != nil {
+		return nil, err
+	}
+	if msg.Signer != k.authority {
+		return nil, errors.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", k.authority, msg.Signer)
+	}
+
+	if err := k.SetLegacySignaturesDeprecated(ctx, msg.Deprecated); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgDeprecateLegacySignaturesResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.LegacySignaturesDeprecated{
+		Deprecated: msg.Deprecated,
+	})
 }
 
 func (k msgServer) Mint(ctx context.Context, msg *types.MsgMint) (*types.MsgMintResponse, error) {
+	if err := msg.Validate(k.addressCodec); err != nil {
+		return nil, err
+	}
 	if !k.IsAllowedDenom(ctx, msg.Denom) {
 		return nil, fmt.Errorf("%s is not an allowed denom", msg.Denom)
 	}
+	if k.IsPaused(ctx, msg.Denom) {
+		return nil, types.ErrPaused
+	}
 	if !k.IsSystem(ctx, msg.Denom, msg.Signer) {
 		return nil, types.ErrInvalidSystem
 	}
+	if k.IsTransferBlocked(ctx, msg.Denom, msg.To) {
+		return nil, fmt.Errorf("%s is blocked from receiving %s", msg.To, msg.Denom)
+	}
 
 	allowance := k.GetMintAllowance(ctx, msg.Denom, msg.Signer)
 	if msg.Amount.GT(allowance) {
 		return nil, types.ErrInsufficientAllowance
 	}
 
+	policy, hasPolicy := k.GetMintApprovalPolicy(ctx, msg.Denom)
+	requiresApproval := hasPolicy && policy.RequiresApproval(msg.Amount)
+	if !requiresApproval {
+		if err := k.EnforceMintLimits(ctx, msg.Denom, msg.Signer, msg.Amount); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := k.UseReference(ctx, msg.Denom, msg.Reference, msg.Signer, msg.To, msg.Amount, false); err != nil {
+		return nil, err
+	}
+
 	allowance = allowance.Sub(msg.Amount)
 	if err := k.Keeper.SetMintAllowance(ctx, msg.Denom, msg.Signer, allowance); err != nil {
 		return nil, err
 	}
 
-	coins := sdk.NewCoins(sdk.NewCoin(msg.Denom, msg.Amount))
-	to, err := k.addressCodec.StringToBytes(msg.To)
-	if err != nil {
-		return nil, errors.Wrap(err, "unable to decode user address")
+	if requiresApproval {
+		if err := k.eventService.EventManager(ctx).Emit(ctx, &types.MintAllowance{
+			Denom:   msg.Denom,
+			Account: msg.Signer,
+			Amount:  allowance,
+		}); err != nil {
+			return nil, err
+		}
+
+		id, err := k.CreatePendingMint(ctx, policy, msg.Signer, msg.To, msg.Amount, msg.Reference)
+		return &types.MsgMintResponse{PendingMintId: id}, err
 	}
-	err = k.bankKeeper.MintCoins(ctx, types.ModuleName, coins)
-	if err != nil {
-		return nil, errors.Wrap(err, "unable to mint to module")
+
+	if err := k.MintCoins(ctx, msg.Denom, msg.To, msg.Amount); err != nil {
+		return nil, err
 	}
-	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, to, coins)
-	if err != nil {
-		return nil, errors.Wrap(err, "unable to transfer from module to user")
+
+	if err := k.eventService.EventManager(ctx).Emit(ctx, &types.MintAllowance{
+		Denom:   msg.Denom,
+		Account: msg.Signer,
+		Amount:  allowance,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgMintResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.Minted{
+		Denom:     msg.Denom,
+		System:    msg.Signer,
+		To:        msg.To,
+		Amount:    msg.Amount,
+		Reference: msg.Reference,
+	})
+}
+
+func (k msgServer) MintBatch(ctx context.Context, msg *types.MsgMintBatch) (*types.MsgMintBatchResponse, error) {
+	if err := msg.Validate(k.addressCodec); err != nil {
+		return nil, err
+	}
+	if !k.IsAllowedDenom(ctx, msg.Denom) {
+		return nil, fmt.Errorf("%s is not an allowed denom", msg.Denom)
+	}
+	if k.IsPaused(ctx, msg.Denom) {
+		return nil, types.ErrPaused
+	}
+	if !k.IsSystem(ctx, msg.Denom, msg.Signer) {
+		return nil, types.ErrInvalidSystem
+	}
+	if len(msg.Recipients) == 0 {
+		return nil, errors.Wrap(types.ErrInvalidBatch, "no recipients")
+	}
+
+	total := math.ZeroInt()
+	references := make(map[string]bool)
+	for i, recipient := range msg.Recipients {
+		if recipient.Amount.IsNil() || !recipient.Amount.IsPositive() {
+			return nil, errors.Wrapf(types.ErrInvalidBatch, "recipient %d has a non-positive amount", i)
+		}
+		if k.IsTransferBlocked(ctx, msg.Denom, recipient.To) {
+			return nil, fmt.Errorf("%s is blocked from receiving %s", recipient.To, msg.Denom)
+		}
+		if recipient.Reference != "" {
+			if references[recipient.Reference] || k.HasReference(ctx, msg.Denom, recipient.Reference) {
+				return nil, errors.Wrapf(types.ErrDuplicateReference, "%s for %s", recipient.Reference, msg.Denom)
+			}
+			references[recipient.Reference] = true
+		}
+		total = total.Add(recipient.Amount)
+	}
+
+	allowance := k.GetMintAllowance(ctx, msg.Denom, msg.Signer)
+	if total.GT(allowance) {
+		return nil, types.ErrInsufficientAllowance
 	}
 
-	return &types.MsgMintResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.MintAllowance{
+	allowance = allowance.Sub(total)
+	if err := k.Keeper.SetMintAllowance(ctx, msg.Denom, msg.Signer, allowance); err != nil {
+		return nil, err
+	}
+	if err := k.eventService.EventManager(ctx).Emit(ctx, &types.MintAllowance{
 		Denom:   msg.Denom,
 		Account: msg.Signer,
 		Amount:  allowance,
+	}); err != nil {
+		return nil, err
+	}
+
+	policy, hasPolicy := k.GetMintApprovalPolicy(ctx, msg.Denom)
+
+	var pendingMintIDs []uint64
+	for _, recipient := range msg.Recipients {
+		if err := k.UseReference(ctx, msg.Denom, recipient.Reference, msg.Signer, recipient.To, recipient.Amount, false); err != nil {
+			return nil, err
+		}
+
+		if hasPolicy && policy.RequiresApproval(recipient.Amount) {
+			id, err := k.CreatePendingMint(ctx, policy, msg.Signer, recipient.To, recipient.Amount, recipient.Reference)
+			if err != nil {
+				return nil, err
+			}
+			pendingMintIDs = append(pendingMintIDs, id)
+			continue
+		}
+
+		if err := k.EnforceMintLimits(ctx, msg.Denom, msg.Signer, recipient.Amount); err != nil {
+			return nil, err
+		}
+		if err := k.MintCoins(ctx, msg.Denom, recipient.To, recipient.Amount); err != nil {
+			return nil, err
+		}
+		if err := k.eventService.EventManager(ctx).Emit(ctx, &types.Minted{
+			Denom:     msg.Denom,
+			System:    msg.Signer,
+			To:        recipient.To,
+			Amount:    recipient.Amount,
+			Reference: recipient.Reference,
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.MsgMintBatchResponse{PendingMintIds: pendingMintIDs}, nil
+}
+
+func (k msgServer) Pause(ctx context.Context, msg *types.MsgPause) (*types.MsgPauseResponse, error) {
+	if err := msg.Validate(k.addressCodec); err != nil {
+		return nil, err
+	}
+	if !k.IsAllowedDenom(ctx, msg.Denom) {
+		return nil, fmt.Errorf("%s is not an allowed denom", msg.Denom)
+	}
+	if err := k.EnsurePauser(ctx, msg.Denom, msg.Signer); err != nil {
+		return nil, err
+	}
+
+	if k.IsPaused(ctx, msg.Denom) {
+		return nil, types.ErrPaused
+	}
+
+	if err := k.SetPaused(ctx, msg.Denom, true); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgPauseResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.Paused{
+		Denom:   msg.Denom,
+		Account: msg.Signer,
 	})
 }
 
 func (k msgServer) Recover(ctx context.Context, msg *types.MsgRecover) (*types.MsgRecoverResponse, error) {
+	if err := msg.Validate(k.addressCodec); err != nil {
+		return nil, err
+	}
 	if !k.IsAllowedDenom(ctx, msg.Denom) {
 		return nil, fmt.Errorf("%s is not an allowed denom", msg.Denom)
 	}
+	if k.IsPaused(ctx, msg.Denom) {
+		return nil, types.ErrPaused
+	}
 	if !k.IsSystem(ctx, msg.Denom, msg.Signer) {
 		return nil, types.ErrInvalidSystem
 	}
@@ -230,56 +563,114 @@ func (k msgServer) Recover(ctx context.Context, msg *types.MsgRecover) (*types.M
 		return nil, types.ErrInvalidPubKey
 	}
 
-	if !adr36.VerifySignature(
-		pubKey,
-		[]byte("I hereby declare that I am the address owner."),
-		msg.Signature,
-	) {
-		return nil, types.ErrInvalidSignature
-	}
-
-	balance := k.bankKeeper.GetBalance(ctx, from, msg.Denom)
-	if balance.IsZero() {
-		return &types.MsgRecoverResponse{}, nil
+	doc := types.NewRecoverSignDoc(sdk.UnwrapSDKContext(ctx).ChainID(), msg.Denom, msg.Amount, msg.To, k.GetNonce(ctx, msg.From), msg.ExpiryHeight, msg.ComplianceOverride)
+	if err := k.VerifySignature(ctx, msg.From, pubKey, msg.Signature, doc); err != nil {
+		return nil, err
 	}
 
 	to, err := k.addressCodec.StringToBytes(msg.To)
 	if err != nil {
 		return nil, errors.Wrapf(err, "unable to decode user address %s", msg.To)
 	}
-	err = k.bankKeeper.SendCoins(ctx, from, to, sdk.NewCoins(balance))
+	if bytes.Equal(from, to) {
+		return nil, errors.Wrap(types.ErrInvalidRecipient, "cannot recover to the same address")
+	}
+	if k.IsTransferBlocked(ctx, msg.Denom, msg.To) {
+		return nil, errors.Wrapf(types.ErrInvalidRecipient, "%s is blocked from receiving %s", msg.To, msg.Denom)
+	}
+
+	// Recovering from a banned address bypasses the blacklist checks of the
+	// send restriction, and so must be explicitly requested by the system.
+	fromBlocked := k.IsBlocked(ctx, msg.Denom, msg.From)
+	if fromBlocked && !msg.ComplianceOverride {
+		return nil, errors.Wrapf(types.ErrComplianceOverride, "%s is blocked from sending %s", msg.From, msg.Denom)
+	}
+	if !fromBlocked && msg.ComplianceOverride {
+		return nil, errors.Wrapf(types.ErrNotBanned, "%s does not require a compliance override", msg.From)
+	}
+
+	amount := k.bankKeeper.SpendableCoin(ctx, from, msg.Denom).Amount
+	if !msg.Amount.IsNil() && !msg.Amount.IsZero() {
+		if !msg.Amount.IsPositive() {
+			return nil, types.ErrInvalidAmount
+		}
+		if amount.LT(msg.Amount) {
+			return nil, errors.Wrapf(sdkerrors.ErrInsufficientFunds, "cannot recover %s, spendable balance is %s", msg.Amount, amount)
+		}
+		amount = msg.Amount
+	}
+	if amount.IsZero() {
+		return &types.MsgRecoverResponse{}, nil
+	}
+
+	transferCtx := ctx
+	if msg.ComplianceOverride {
+		transferCtx = withSendRestrictionBypass(ctx)
+	}
+	err = k.bankKeeper.SendCoins(transferCtx, from, to, sdk.NewCoins(sdk.NewCoin(msg.Denom, amount)))
 	if err != nil {
 		return nil, errors.Wrap(err, "unable to transfer from user to user")
 	}
 
 	return &types.MsgRecoverResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.Recovered{
-		Denom:  msg.Denom,
-		From:   msg.From,
-		To:     msg.To,
-		Amount: balance.Amount,
+		Denom:              msg.Denom,
+		From:               msg.From,
+		To:                 msg.To,
+		Amount:             amount,
+		System:             msg.Signer,
+		ComplianceOverride: msg.ComplianceOverride,
 	})
 }
 
-func (k msgServer) RemoveAdminAccount(ctx context.Context, msg *types.MsgRemoveAdminAccount) (*types.MsgRemoveAdminAccountResponse, error) {
+func (k msgServer) Redeem(ctx context.Context, msg *types.MsgRedeem) (*types.MsgRedeemResponse, error) {
+	if err := msg.Validate(k.addressCodec); err != nil {
+		return nil, err
+	}
 	if !k.IsAllowedDenom(ctx, msg.Denom) {
 		return nil, fmt.Errorf("%s is not an allowed denom", msg.Denom)
 	}
-	_, err := k.EnsureOwner(ctx, msg.Denom, msg.Signer)
+	if k.IsPaused(ctx, msg.Denom) {
+		return nil, types.ErrPaused
+	}
+	if !k.IsSystem(ctx, msg.Denom, msg.Signer) {
+		return nil, types.ErrInvalidSystem
+	}
+	if msg.Amount.IsNil() || !msg.Amount.IsPositive() {
+		return nil, types.ErrInvalidAmount
+	}
+
+	signer, err := k.addressCodec.StringToBytes(msg.Signer)
 	if err != nil {
-		return nil, err
+		return nil, errors.Wrap(err, "unable to decode system address")
 	}
 
-	if err := k.DeleteAdmin(ctx, msg.Denom, msg.Account); err != nil {
+	if err := k.UseReference(ctx, msg.Denom, msg.Reference, msg.Signer, msg.Signer, msg.Amount, true); err != nil {
 		return nil, err
 	}
 
-	return &types.MsgRemoveAdminAccountResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.AdminAccountRemoved{
-		Denom:   msg.Denom,
-		Account: msg.Account,
+	coins := sdk.NewCoins(sdk.NewCoin(msg.Denom, msg.Amount))
+	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, signer, types.ModuleName, coins)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to transfer from system to module")
+	}
+	err = k.bankKeeper.BurnCoins(ctx, types.ModuleName, coins)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to burn from module")
+	}
+
+	return &types.MsgRedeemResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.Burned{
+		Denom:     msg.Denom,
+		System:    msg.Signer,
+		From:      msg.Signer,
+		Amount:    msg.Amount,
+		Reference: msg.Reference,
 	})
 }
 
-func (k msgServer) RemoveSystemAccount(ctx context.Context, msg *types.MsgRemoveSystemAccount) (*types.MsgRemoveSystemAccountResponse, error) {
+func (k msgServer) RemoveAccountLimit(ctx context.Context, msg *types.MsgRemoveAccountLimit) (*types.MsgRemoveAccountLimitResponse, error) {
+	if err := msg.Validate(k.addressCodec); err != nil {
+		return nil, err
+	}
 	if !k.IsAllowedDenom(ctx, msg.Denom) {
 		return nil, fmt.Errorf("%s is not an allowed denom", msg.Denom)
 	}
@@ -288,17 +679,24 @@ func (k msgServer) RemoveSystemAccount(ctx context.Context, msg *types.MsgRemove
 		return nil, err
 	}
 
-	if err := k.DeleteSystem(ctx, msg.Denom, msg.Account); err != nil {
+	if _, found := k.GetAccountLimit(ctx, msg.Denom, msg.Address); !found {
+		return nil, types.ErrNoAccountLimit
+	}
+
+	if err := k.DeleteAccountLimit(ctx, msg.Denom, msg.Address); err != nil {
 		return nil, err
 	}
 
-	return &types.MsgRemoveSystemAccountResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.SystemAccountRemoved{
+	return &types.MsgRemoveAccountLimitResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.AccountLimitRemoved{
 		Denom:   msg.Denom,
-		Account: msg.Account,
+		Address: msg.Address,
 	})
 }
 
-func (k msgServer) SetMaxMintAllowance(ctx context.Context, msg *types.MsgSetMaxMintAllowance) (*types.MsgSetMaxMintAllowanceResponse, error) {
+func (k msgServer) RemoveAdminAccount(ctx context.Context, msg *types.MsgRemoveAdminAccount) (*types.MsgRemoveAdminAccountResponse, error) {
+	if err := msg.Validate(k.addressCodec); err != nil {
+		return nil, err
+	}
 	if !k.IsAllowedDenom(ctx, msg.Denom) {
 		return nil, fmt.Errorf("%s is not an allowed denom", msg.Denom)
 	}
@@ -307,22 +705,224 @@ func (k msgServer) SetMaxMintAllowance(ctx context.Context, msg *types.MsgSetMax
 		return nil, err
 	}
 
-	if err := k.Keeper.SetMaxMintAllowance(ctx, msg.Denom, msg.Amount); err != nil {
+	if err := k.DeleteAdmin(ctx, msg.Denom, msg.Account); err != nil {
 		return nil, err
 	}
 
-	return &types.MsgSetMaxMintAllowanceResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.MaxMintAllowance{
-		Denom:  msg.Denom,
-		Amount: msg.Amount,
+	return &types.MsgRemoveAdminAccountResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.AdminAccountRemoved{
+		Denom:   msg.Denom,
+		Account: msg.Account,
 	})
 }
 
-func (k msgServer) SetMintAllowance(ctx context.Context, msg *types.MsgSetMintAllowance) (*types.MsgSetMintAllowanceResponse, error) {
+func (k msgServer) RemoveAllowlistAdmin(ctx context.Context, msg *types.MsgRemoveAllowlistAdmin) (*types.MsgRemoveAllowlistAdminResponse, error) {
+	if err := msg.Validate(k.addressCodec); err != nil {
+		return nil, err
+	}
 	if !k.IsAllowedDenom(ctx, msg.Denom) {
 		return nil, fmt.Errorf("%s is not an allowed denom", msg.Denom)
 	}
-	if !k.IsAdmin(ctx, msg.Denom, msg.Signer) {
-		return nil, types.ErrInvalidAdmin
+	_, err := k.EnsureOwner(ctx, msg.Denom, msg.Signer)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.DeleteAllowlistAdmin(ctx, msg.Denom, msg.Account); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveAllowlistAdminResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.AllowlistAdminRemoved{
+		Denom:   msg.Denom,
+		Account: msg.Account,
+	})
+}
+
+func (k msgServer) RemoveFromAllowlist(ctx context.Context, msg *types.MsgRemoveFromAllowlist) (*types.MsgRemoveFromAllowlistResponse, error) {
+	if err := msg.Validate(k.addressCodec); err != nil {
+		return nil, err
+	}
+	if !k.IsAllowedDenom(ctx, msg.Denom) {
+		return nil, fmt.Errorf("%s is not an allowed denom", msg.Denom)
+	}
+	if err := k.EnsureAllowlistAdmin(ctx, msg.Denom, msg.Signer); err != nil {
+		return nil, err
+	}
+
+	if err := k.DeleteAllowlisted(ctx, msg.Denom, msg.Account); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveFromAllowlistResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.AllowlistAccountRemoved{
+		Denom:   msg.Denom,
+		Account: msg.Account,
+	})
+}
+
+func (k msgServer) RemoveRateLimit(ctx context.Context, msg *types.MsgRemoveRateLimit) (*types.MsgRemoveRateLimitResponse, error) {
+	if err := msg.Validate(k.addressCodec); err != nil {
+		return nil, err
+	}
+	if !k.IsAllowedDenom(ctx, msg.Denom) {
+		return nil, fmt.Errorf("%s is not an allowed denom", msg.Denom)
+	}
+	_, err := k.EnsureOwner(ctx, msg.Denom, msg.Signer)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, found := k.GetRateLimit(ctx, msg.Denom, msg.Address); !found {
+		return nil, types.ErrNoRateLimit
+	}
+
+	if err := k.DeleteRateLimit(ctx, msg.Denom, msg.Address); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveRateLimitResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.RateLimitRemoved{
+		Denom:   msg.Denom,
+		Address: msg.Address,
+	})
+}
+
+func (k msgServer) RemoveSystemAccount(ctx context.Context, msg *types.MsgRemoveSystemAccount) (*types.MsgRemoveSystemAccountResponse, error) {
+	if err := msg.Validate(k.addressCodec); err != nil {
+		return nil, err
+	}
+	if !k.IsAllowedDenom(ctx, msg.Denom) {
+		return nil, fmt.Errorf("%s is not an allowed denom", msg.Denom)
+	}
+	_, err := k.EnsureOwner(ctx, msg.Denom, msg.Signer)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.DeleteSystem(ctx, msg.Denom, msg.Account); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveSystemAccountResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.SystemAccountRemoved{
+		Denom:   msg.Denom,
+		Account: msg.Account,
+	})
+}
+
+func (k msgServer) Seize(ctx context.Context, msg *types.MsgSeize) (*types.MsgSeizeResponse, error) {
+	if err := msg.Validate(k.addressCodec); err != nil {
+		return nil, err
+	}
+	if !k.IsAllowedDenom(ctx, msg.Denom) {
+		return nil, fmt.Errorf("%s is not an allowed denom", msg.Denom)
+	}
+	if k.IsPaused(ctx, msg.Denom) {
+		return nil, types.ErrPaused
+	}
+	if err := k.EnsureSeizer(ctx, msg.Denom, msg.Signer); err != nil {
+		return nil, err
+	}
+	if !k.IsBlocked(ctx, msg.Denom, msg.From) {
+		return nil, errors.Wrapf(types.ErrNotBanned, "%s cannot be seized", msg.From)
+	}
+	if k.IsTransferBlocked(ctx, msg.Denom, msg.To) {
+		return nil, fmt.Errorf("%s is blocked from receiving %s", msg.To, msg.Denom)
+	}
+
+	from, err := k.addressCodec.StringToBytes(msg.From)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to decode user address %s", msg.From)
+	}
+	to, err := k.addressCodec.StringToBytes(msg.To)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to decode user address %s", msg.To)
+	}
+
+	// Only the spendable balance is moved, as locked funds, e.g. of a
+	// vesting account, can't be transferred.
+	balance := k.bankKeeper.SpendableCoin(ctx, from, msg.Denom)
+	if balance.IsZero() {
+		return &types.MsgSeizeResponse{}, nil
+	}
+
+	err = k.bankKeeper.SendCoins(withSendRestrictionBypass(ctx), from, to, sdk.NewCoins(balance))
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to transfer from user to user")
+	}
+
+	return &types.MsgSeizeResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.Seized{
+		Denom:  msg.Denom,
+		Seizer: msg.Signer,
+		From:   msg.From,
+		To:     msg.To,
+		Amount: balance.Amount,
+	})
+}
+
+func (k msgServer) SetAccountLimit(ctx context.Context, msg *types.MsgSetAccountLimit) (*types.MsgSetAccountLimitResponse, error) {
+	if err := msg.Validate(k.addressCodec); err != nil {
+		return nil, err
+	}
+	if !k.IsAllowedDenom(ctx, msg.Denom) {
+		return nil, fmt.Errorf("%s is not an allowed denom", msg.Denom)
+	}
+	_, err := k.EnsureOwner(ctx, msg.Denom, msg.Signer)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := types.AccountLimit{
+		Denom:           msg.Denom,
+		Address:         msg.Address,
+		MaxBalance:      msg.MaxBalance,
+		MaxTransfer:     msg.MaxTransfer,
+		MaxDailyOutflow: msg.MaxDailyOutflow,
+	}
+	if err := limit.Validate(k.addressCodec); err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.SetAccountLimit(ctx, limit); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetAccountLimitResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.AccountLimitSet{
+		Denom:           msg.Denom,
+		Address:         msg.Address,
+		MaxBalance:      msg.MaxBalance,
+		MaxTransfer:     msg.MaxTransfer,
+		MaxDailyOutflow: msg.MaxDailyOutflow,
+	})
+}
+
+func (k msgServer) SetMaxMintAllowance(ctx context.Context, msg *types.MsgSetMaxMintAllowance) (*types.MsgSetMaxMintAllowanceResponse, error) {
+	if err := msg.Validate(k.addressCodec); err != nil {
+		return nil, err
+	}
+	if !k.IsAllowedDenom(ctx, msg.Denom) {
+		return nil, fmt.Errorf("%s is not an allowed denom", msg.Denom)
+	}
+	_, err := k.EnsureOwner(ctx, msg.Denom, msg.Signer)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.SetMaxMintAllowance(ctx, msg.Denom, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetMaxMintAllowanceResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.MaxMintAllowance{
+		Denom:  msg.Denom,
+		Amount: msg.Amount,
+	})
+}
+
+func (k msgServer) SetMintAllowance(ctx context.Context, msg *types.MsgSetMintAllowance) (*types.MsgSetMintAllowanceResponse, error) {
+	if err := msg.Validate(k.addressCodec); err != nil {
+		return nil, err
+	}
+	if !k.IsAllowedDenom(ctx, msg.Denom) {
+		return nil, fmt.Errorf("%s is not an allowed denom", msg.Denom)
+	}
+	if !k.IsAdmin(ctx, msg.Denom, msg.Signer) {
+		return nil, types.ErrInvalidAdmin
 	}
 
 	if msg.Amount.IsNegative() || msg.Amount.GT(k.GetMaxMintAllowance(ctx, msg.Denom)) {
@@ -340,7 +940,187 @@ func (k msgServer) SetMintAllowance(ctx context.Context, msg *types.MsgSetMintAl
 	})
 }
 
+func (k msgServer) SetMintApprovalPolicy(ctx context.Context, msg *types.MsgSetMintApprovalPolicy) (*types.MsgSetMintApprovalPolicyResponse, error) {
+	if err := msg.Validate(k.addressCodec); err != nil {
+		return nil, err
+	}
+	if !k.IsAllowedDenom(ctx, msg.Denom) {
+		return nil, fmt.Errorf("%s is not an allowed denom", msg.Denom)
+	}
+	_, err := k.EnsureOwner(ctx, msg.Denom, msg.Signer)
+	if err != nil {
+		return nil, err
+	}
+
+	policy := types.MintApprovalPolicy{
+		Denom:        msg.Denom,
+		Threshold:    msg.Threshold,
+		Quorum:       msg.Quorum,
+		ExpiryBlocks: msg.ExpiryBlocks,
+	}
+	if err := policy.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.SetMintApprovalPolicy(ctx, policy); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetMintApprovalPolicyResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.MintApprovalPolicySet{
+		Denom:        msg.Denom,
+		Threshold:    msg.Threshold,
+		Quorum:       msg.Quorum,
+		ExpiryBlocks: msg.ExpiryBlocks,
+	})
+}
+
+func (k msgServer) SetPauser(ctx context.Context, msg *types.MsgSetPauser) (*types.MsgSetPauserResponse, error) {
+	if err := msg.Validate(k.addressCodec); err != nil {
+		return nil, err
+	}
+	if !k.IsAllowedDenom(ctx, msg.Denom) {
+		return nil, fmt.Errorf("%s is not an allowed denom", msg.Denom)
+	}
+	_, err := k.EnsureOwner(ctx, msg.Denom, msg.Signer)
+	if err != nil {
+		return nil, err
+	}
+
+	pauser := k.GetPauser(ctx, msg.Denom)
+	if err := k.Keeper.SetPauser(ctx, msg.Denom, msg.Pauser); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetPauserResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.PauserUpdated{
+		Denom:          msg.Denom,
+		PreviousPauser: pauser,
+		NewPauser:      msg.Pauser,
+	})
+}
+
+func (k msgServer) SetRateLimit(ctx context.Context, msg *types.MsgSetRateLimit) (*types.MsgSetRateLimitResponse, error) {
+	if err := msg.Validate(k.addressCodec); err != nil {
+		return nil, err
+	}
+	if !k.IsAllowedDenom(ctx, msg.Denom) {
+		return nil, fmt.Errorf("%s is not an allowed denom", msg.Denom)
+	}
+	_, err := k.EnsureOwner(ctx, msg.Denom, msg.Signer)
+	if err != nil {
+		return nil, err
+	}
+
+	rateLimit := types.RateLimit{
+		Denom:         msg.Denom,
+		Address:       msg.Address,
+		Amount:        msg.Amount,
+		WindowBlocks:  msg.WindowBlocks,
+		WindowSeconds: msg.WindowSeconds,
+	}
+	if err := rateLimit.Validate(k.addressCodec); err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.SetRateLimit(ctx, rateLimit); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetRateLimitResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.RateLimitSet{
+		Denom:         msg.Denom,
+		Address:       msg.Address,
+		Amount:        msg.Amount,
+		WindowBlocks:  msg.WindowBlocks,
+		WindowSeconds: msg.WindowSeconds,
+	})
+}
+
+func (k msgServer) SetSeizer(ctx context.Context, msg *types.MsgSetSeizer) (*types.MsgSetSeizerResponse, error) {
+	if err := msg.Validate(k.addressCodec); err != nil {
+		return nil, err
+	}
+	if !k.IsAllowedDenom(ctx, msg.Denom) {
+		return nil, fmt.Errorf("%s is not an allowed denom", msg.Denom)
+	}
+	_, err := k.EnsureOwner(ctx, msg.Denom, msg.Signer)
+	if err != nil {
+		return nil, err
+	}
+
+	seizer := k.GetSeizer(ctx, msg.Denom)
+	if err := k.Keeper.SetSeizer(ctx, msg.Denom, msg.Seizer); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetSeizerResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.SeizerUpdated{
+		Denom:          msg.Denom,
+		PreviousSeizer: seizer,
+		NewSeizer:      msg.Seizer,
+	})
+}
+
+func (k msgServer) SetSupplyCap(ctx context.Context, msg *types.MsgSetSupplyCap) (*types.MsgSetSupplyCapResponse, error) {
+	if err := msg.Validate(k.addressCodec); err != nil {
+		return nil, err
+	}
+	if !k.IsAllowedDenom(ctx, msg.Denom) {
+		return nil, fmt.Errorf("%s is not an allowed denom", msg.Denom)
+	}
+	_, err := k.EnsureOwner(ctx, msg.Denom, msg.Signer)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Amount.IsNil() || msg.Amount.IsNegative() {
+		return nil, types.ErrInvalidSupplyCap
+	}
+
+	if err := k.Keeper.SetSupplyCap(ctx, msg.Denom, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetSupplyCapResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.SupplyCapSet{
+		Denom:  msg.Denom,
+		Amount: msg.Amount,
+	})
+}
+
+func (k msgServer) SetTransferPolicy(ctx context.Context, msg *types.MsgSetTransferPolicy) (*types.MsgSetTransferPolicyResponse, error) {
+	if err := msg.Validate(k.addressCodec); err != nil {
+		return nil, err
+	}
+	if !k.IsAllowedDenom(ctx, msg.Denom) {
+		return nil, fmt.Errorf("%s is not an allowed denom", msg.Denom)
+	}
+	_, err := k.EnsureOwner(ctx, msg.Denom, msg.Signer)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := msg.Policy.Validate(); err != nil {
+		return nil, err
+	}
+	// The open policy doesn't use the blacklist of a denom, and so can't be
+	// set while any address is banned for it.
+	if msg.Policy == types.TransferPolicyOpen && k.HasDenomAdversaries(ctx, msg.Denom) {
+		return nil, errors.Wrapf(types.ErrInvalidTransferPolicy, "%s has denom-specific bans", msg.Denom)
+	}
+
+	policy := k.GetTransferPolicy(ctx, msg.Denom)
+	if err := k.Keeper.SetTransferPolicy(ctx, msg.Denom, msg.Policy); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetTransferPolicyResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.TransferPolicyUpdated{
+		Denom:          msg.Denom,
+		PreviousPolicy: policy,
+		NewPolicy:      msg.Policy,
+	})
+}
+
 func (k msgServer) TransferOwnership(ctx context.Context, msg *types.MsgTransferOwnership) (*types.MsgTransferOwnershipResponse, error) {
+	if err := msg.Validate(k.addressCodec); err != nil {
+		return nil, err
+	}
 	if !k.IsAllowedDenom(ctx, msg.Denom) {
 		return nil, fmt.Errorf("%s is not an allowed denom", msg.Denom)
 	}
@@ -364,8 +1144,85 @@ func (k msgServer) TransferOwnership(ctx context.Context, msg *types.MsgTransfer
 	})
 }
 
+func (k msgServer) Unpause(ctx context.Context, msg *types.MsgUnpause) (*types.MsgUnpauseResponse, error) {
+	if err := msg.Validate(k.addressCodec); err != nil {
+		return nil, err
+	}
+	if !k.IsAllowedDenom(ctx, msg.Denom) {
+		return nil, fmt.Errorf("%s is not an allowed denom", msg.Denom)
+	}
+	if err := k.EnsurePauser(ctx, msg.Denom, msg.Signer); err != nil {
+		return nil, err
+	}
+
+	if !k.IsPaused(ctx, msg.Denom) {
+		return nil, types.ErrNotPaused
+	}
+
+	if err := k.SetPaused(ctx, msg.Denom, false); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUnpauseResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.Unpaused{
+		Denom:   msg.Denom,
+		Account: msg.Signer,
+	})
+}
+
+func (k msgServer) Wipe(ctx context.Context, msg *types.MsgWipe) (*types.MsgWipeResponse, error) {
+	if err := msg.Validate(k.addressCodec); err != nil {
+		return nil, err
+	}
+	if !k.IsAllowedDenom(ctx, msg.Denom) {
+		return nil, fmt.Errorf("%s is not an allowed denom", msg.Denom)
+	}
+	if k.IsPaused(ctx, msg.Denom) {
+		return nil, types.ErrPaused
+	}
+	if err := k.EnsureSeizer(ctx, msg.Denom, msg.Signer); err != nil {
+		return nil, err
+	}
+	if !k.IsBlocked(ctx, msg.Denom, msg.From) {
+		return nil, errors.Wrapf(types.ErrNotBanned, "%s cannot be wiped", msg.From)
+	}
+
+	from, err := k.addressCodec.StringToBytes(msg.From)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to decode user address %s", msg.From)
+	}
+
+	balance := k.bankKeeper.SpendableCoin(ctx, from, msg.Denom)
+	if balance.IsZero() {
+		return &types.MsgWipeResponse{}, nil
+	}
+
+	coins := sdk.NewCoins(balance)
+	err = k.bankKeeper.SendCoinsFromAccountToModule(withSendRestrictionBypass(ctx), from, types.ModuleName, coins)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to transfer from user to module")
+	}
+	err = k.bankKeeper.BurnCoins(ctx, types.ModuleName, coins)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to burn from module")
+	}
+
+	return &types.MsgWipeResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.Wiped{
+		Denom:  msg.Denom,
+		Seizer: msg.Signer,
+		From:   msg.From,
+		Amount: balance.Amount,
+	})
+}
+
 //
 
+func (k msgServer) EnsureAllowlistAdmin(ctx context.Context, denom string, signer string) error {
+	if !k.IsAllowlistAdmin(ctx, denom, signer) {
+		return errors.Wrapf(types.ErrInvalidAllowlistAdmin, "%s cannot manage the %s allowlist", signer, denom)
+	}
+	return nil
+}
+
 func (k msgServer) EnsureOwner(ctx context.Context, denom string, signer string) (string, error) {
 	owner := k.GetOwner(ctx, denom)
 	if owner == "" {
@@ -376,3 +1233,46 @@ func (k msgServer) EnsureOwner(ctx context.Context, denom string, signer string)
 	}
 	return owner, nil
 }
+
+func (k msgServer) EnsurePauser(ctx context.Context, denom string, signer string) error {
+	if owner := k.GetOwner(ctx, denom); owner != "" && signer == owner {
+		return nil
+	}
+	if pauser := k.GetPauser(ctx, denom); pauser != "" && signer == pauser {
+		return nil
+	}
+	return errors.Wrapf(types.ErrInvalidPauser, "%s cannot pause or unpause %s", signer, denom)
+}
+
+func (k msgServer) EnsureSeizer(ctx context.Context, denom string, signer string) error {
+	if seizer := k.GetSeizer(ctx, denom); seizer == "" || signer != seizer {
+		return errors.Wrapf(types.ErrInvalidSeizer, "%s cannot seize %s", signer, denom)
+	}
+	return nil
+}
+
+// VerifySignature verifies a user's ADR-36 signature authorizing a burn or
+// recover. Signatures without an expiry height are treated as legacy
+// signatures over the constant message, and are only accepted while legacy
+// signatures aren't deprecated. Structured signatures consume the user's nonce.
+func (k msgServer) VerifySignature(ctx context.Context, address string, pubKey cryptotypes.PubKey, signature []byte, doc types.SignDoc) error {
+	if doc.ExpiryHeight == 0 {
+		if k.IsLegacySignaturesDeprecated(ctx) {
+			return types.ErrLegacySignature
+		}
+		if !adr36.VerifySignature(pubKey, []byte(types.LegacySignMessage), signature) {
+			return types.ErrInvalidSignature
+		}
+		return nil
+	}
+
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	if height > doc.ExpiryHeight {
+		return errors.Wrapf(types.ErrSignatureExpired, "expired at height %d, current height %d", doc.ExpiryHeight, height)
+	}
+	if !adr36.VerifySignature(pubKey, doc.Bytes(), signature) {
+		return types.ErrInvalidSignature
+	}
+
+	return k.SetNonce(ctx, address, doc.Nonce+1)
+}
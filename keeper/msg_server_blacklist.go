@@ -16,8 +16,11 @@ package keeper
 
 import (
 	"context"
+	"fmt"
 
 	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/monerium/module-noble/v2/types"
 	"github.com/monerium/module-noble/v2/types/blacklist"
 )
 
@@ -32,6 +35,9 @@ func NewBlacklistMsgServer(keeper *Keeper) blacklist.MsgServer {
 }
 
 func (k blacklistMsgServer) AcceptOwnership(ctx context.Context, msg *blacklist.MsgAcceptOwnership) (*blacklist.MsgAcceptOwnershipResponse, error) {
+	if err := msg.Validate(k.addressCodec); err != nil {
+		return nil, err
+	}
 	pendingOwner := k.GetBlacklistPendingOwner(ctx)
 	if pendingOwner == "" {
 		return nil, blacklist.ErrNoPendingOwner
@@ -55,50 +61,127 @@ func (k blacklistMsgServer) AcceptOwnership(ctx context.Context, msg *blacklist.
 }
 
 func (k blacklistMsgServer) AddAdminAccount(ctx context.Context, msg *blacklist.MsgAddAdminAccount) (*blacklist.MsgAddAdminAccountResponse, error) {
+	if err := msg.Validate(k.addressCodec); err != nil {
+		return nil, err
+	}
 	_, err := k.EnsureOwner(ctx, msg.Signer)
 	if err != nil {
 		return nil, err
 	}
 
-	if err := k.SetBlacklistAdmin(ctx, msg.Account); err != nil {
-		return nil, errors.Wrapf(err, "failed to set blacklist admin: %s", msg.Account)
+	if msg.Denom == "" {
+		if err := k.SetBlacklistAdmin(ctx, msg.Account); err != nil {
+			return nil, errors.Wrapf(err, "failed to set blacklist admin: %s", msg.Account)
+		}
+	} else {
+		if !k.IsAllowedDenom(ctx, msg.Denom) {
+			return nil, fmt.Errorf("%s is not an allowed denom", msg.Denom)
+		}
+		if err := k.SetDenomBlacklistAdmin(ctx, msg.Denom, msg.Account); err != nil {
+			return nil, errors.Wrapf(err, "failed to set %s blacklist admin: %s", msg.Denom, msg.Account)
+		}
 	}
 
 	return &blacklist.MsgAddAdminAccountResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &blacklist.AdminAccountAdded{
 		Account: msg.Account,
+		Denom:   msg.Denom,
 	})
 }
 
 func (k blacklistMsgServer) Ban(ctx context.Context, msg *blacklist.MsgBan) (*blacklist.MsgBanResponse, error) {
-	if !k.IsBlacklistAdmin(ctx, msg.Signer) {
-		return nil, blacklist.ErrInvalidAdmin
+	if err := msg.Validate(k.addressCodec); err != nil {
+		return nil, err
+	}
+	if err := k.EnsureAdmin(ctx, msg.Denom, msg.Signer); err != nil {
+		return nil, err
 	}
 
-	if err := k.SetAdversary(ctx, msg.Adversary); err != nil {
-		return nil, errors.Wrapf(err, "failed to set blacklist adversary: %s", msg.Adversary)
+	record := blacklist.BanRecord{
+		Denom:        msg.Denom,
+		Address:      msg.Adversary,
+		ExpiryHeight: msg.ExpiryHeight,
+		ExpiryTime:   msg.ExpiryTime,
+		Reason:       msg.Reason,
+		CaseId:       msg.CaseId,
+	}
+	if err := k.validateBanRecord(ctx, record); err != nil {
+		return nil, err
 	}
 
-	return &blacklist.MsgBanResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &blacklist.Ban{
-		Adversary: msg.Adversary,
-	})
+	return &blacklist.MsgBanResponse{}, k.ban(ctx, record)
+}
+
+func (k blacklistMsgServer) BanBatch(ctx context.Context, msg *blacklist.MsgBanBatch) (*blacklist.MsgBanBatchResponse, error) {
+	if err := msg.Validate(k.addressCodec); err != nil {
+		return nil, err
+	}
+	if err := k.EnsureAdmin(ctx, msg.Denom, msg.Signer); err != nil {
+		return nil, err
+	}
+	if len(msg.Adversaries) == 0 {
+		return nil, errors.Wrap(blacklist.ErrInvalidBatch, "no adversaries")
+	}
+
+	records := make([]blacklist.BanRecord, 0, len(msg.Adversaries))
+	adversaries := make(map[string]bool)
+	for _, adversary := range msg.Adversaries {
+		if adversaries[adversary] {
+			return nil, errors.Wrapf(blacklist.ErrInvalidBatch, "duplicate adversary %s", adversary)
+		}
+		adversaries[adversary] = true
+
+		record := blacklist.BanRecord{
+			Denom:        msg.Denom,
+			Address:      adversary,
+			ExpiryHeight: msg.ExpiryHeight,
+			ExpiryTime:   msg.ExpiryTime,
+			Reason:       msg.Reason,
+			CaseId:       msg.CaseId,
+		}
+		if err := k.validateBanRecord(ctx, record); err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+
+	for _, record := range records {
+		if err := k.ban(ctx, record); err != nil {
+			return nil, err
+		}
+	}
+
+	return &blacklist.MsgBanBatchResponse{}, nil
 }
 
 func (k blacklistMsgServer) RemoveAdminAccount(ctx context.Context, msg *blacklist.MsgRemoveAdminAccount) (*blacklist.MsgRemoveAdminAccountResponse, error) {
+	if err := msg.Validate(k.addressCodec); err != nil {
+		return nil, err
+	}
 	_, err := k.EnsureOwner(ctx, msg.Signer)
 	if err != nil {
 		return nil, err
 	}
 
-	if err := k.DeleteBlacklistAdmin(ctx, msg.Account); err != nil {
-		return nil, errors.Wrapf(err, "failed to delete blacklist admin: %s", msg.Account)
+	if msg.Denom == "" {
+		if err := k.DeleteBlacklistAdmin(ctx, msg.Account); err != nil {
+			return nil, errors.Wrapf(err, "failed to delete blacklist admin: %s", msg.Account)
+		}
+	} else {
+		if err := k.DeleteDenomBlacklistAdmin(ctx, msg.Denom, msg.Account); err != nil {
+			return nil, errors.Wrapf(err, "failed to delete %s blacklist admin: %s", msg.Denom, msg.Account)
+		}
 	}
 
 	return &blacklist.MsgRemoveAdminAccountResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &blacklist.AdminAccountRemoved{
 		Account: msg.Account,
+		Denom:   msg.Denom,
 	})
 }
 
 func (k blacklistMsgServer) TransferOwnership(ctx context.Context, msg *blacklist.MsgTransferOwnership) (*blacklist.MsgTransferOwnershipResponse, error) {
+	if err := msg.Validate(k.addressCodec); err != nil {
+		return nil, err
+	}
 	owner, err := k.EnsureOwner(ctx, msg.Signer)
 	if err != nil {
 		return nil, err
@@ -119,17 +202,42 @@ func (k blacklistMsgServer) TransferOwnership(ctx context.Context, msg *blacklis
 }
 
 func (k blacklistMsgServer) Unban(ctx context.Context, msg *blacklist.MsgUnban) (*blacklist.MsgUnbanResponse, error) {
-	if !k.IsBlacklistAdmin(ctx, msg.Signer) {
-		return nil, blacklist.ErrInvalidAdmin
+	if err := msg.Validate(k.addressCodec); err != nil {
+		return nil, err
+	}
+	if err := k.EnsureAdmin(ctx, msg.Denom, msg.Signer); err != nil {
+		return nil, err
+	}
+
+	return &blacklist.MsgUnbanResponse{}, k.unban(ctx, msg.Denom, msg.Friend)
+}
+
+func (k blacklistMsgServer) UnbanBatch(ctx context.Context, msg *blacklist.MsgUnbanBatch) (*blacklist.MsgUnbanBatchResponse, error) {
+	if err := msg.Validate(k.addressCodec); err != nil {
+		return nil, err
+	}
+	if err := k.EnsureAdmin(ctx, msg.Denom, msg.Signer); err != nil {
+		return nil, err
+	}
+	if len(msg.Friends) == 0 {
+		return nil, errors.Wrap(blacklist.ErrInvalidBatch, "no friends")
 	}
 
-	if err := k.DeleteAdversary(ctx, msg.Friend); err != nil {
-		return nil, errors.Wrapf(err, "failed to delete blacklist adversary: %s", msg.Friend)
+	friends := make(map[string]bool)
+	for _, friend := range msg.Friends {
+		if friends[friend] {
+			return nil, errors.Wrapf(blacklist.ErrInvalidBatch, "duplicate friend %s", friend)
+		}
+		friends[friend] = true
 	}
 
-	return &blacklist.MsgUnbanResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &blacklist.Unban{
-		Friend: msg.Friend,
-	})
+	for _, friend := range msg.Friends {
+		if err := k.unban(ctx, msg.Denom, friend); err != nil {
+			return nil, err
+		}
+	}
+
+	return &blacklist.MsgUnbanBatchResponse{}, nil
 }
 
 //
@@ -144,3 +252,100 @@ func (k blacklistMsgServer) EnsureOwner(ctx context.Context, signer string) (str
 	}
 	return owner, nil
 }
+
+// EnsureAdmin checks that the signer can manage the blacklist of a denom.
+// Global admins can manage every blacklist, denom admins only their own.
+func (k blacklistMsgServer) EnsureAdmin(ctx context.Context, denom string, signer string) error {
+	if denom == "" {
+		if !k.IsBlacklistAdmin(ctx, signer) {
+			return blacklist.ErrInvalidAdmin
+		}
+		return nil
+	}
+
+	if !k.IsAllowedDenom(ctx, denom) {
+		return fmt.Errorf("%s is not an allowed denom", denom)
+	}
+	if !k.IsBlacklistAdmin(ctx, signer) && !k.IsDenomBlacklistAdmin(ctx, denom, signer) {
+		return errors.Wrapf(blacklist.ErrInvalidAdmin, "%s cannot manage the %s blacklist", signer, denom)
+	}
+	return nil
+}
+
+// validateBanRecord ensures that a ban record is valid and, if time-bounded,
+// expires in the future.
+func (k blacklistMsgServer) validateBanRecord(ctx context.Context, record blacklist.BanRecord) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if record.ExpiryHeight < 0 || record.ExpiryTime < 0 || record.IsExpired(sdkCtx.BlockHeight(), sdkCtx.BlockTime()) {
+		return errors.Wrap(blacklist.ErrInvalidExpiry, "expiry must be in the future")
+	}
+	if err := record.Validate(); err != nil {
+		return errors.Wrap(blacklist.ErrInvalidBanRecord, err.Error())
+	}
+	// A permanent ban is only replaced by an expiring one once explicitly
+	// lifted, so that it can't be shortened by accident.
+	if !record.IsPermanent() && k.IsPermanentlyBanned(ctx, record.Denom, record.Address) {
+		return errors.Wrapf(blacklist.ErrInvalidExpiry, "%s is permanently banned, unban it before setting an expiry", record.Address)
+	}
+	if record.Denom != "" && k.GetTransferPolicy(ctx, record.Denom) == types.TransferPolicyOpen {
+		return errors.Wrapf(blacklist.ErrInvalidBanRecord, "%s has an open transfer policy", record.Denom)
+	}
+
+	return nil
+}
+
+// ban adds an address to the blacklist, globally if the denom of the record
+// is empty, storing the record if it holds any details.
+func (k blacklistMsgServer) ban(ctx context.Context, record blacklist.BanRecord) error {
+	if record.IsEmpty() {
+		if err := k.DeleteBanRecord(ctx, record.Denom, record.Address); err != nil {
+			return errors.Wrapf(err, "failed to delete ban record: %s", record.Address)
+		}
+	} else {
+		if err := k.SetBanRecord(ctx, record); err != nil {
+			return errors.Wrapf(err, "failed to set ban record: %s", record.Address)
+		}
+	}
+
+	if record.Denom == "" {
+		if err := k.SetAdversary(ctx, record.Address); err != nil {
+			return errors.Wrapf(err, "failed to set blacklist adversary: %s", record.Address)
+		}
+	} else {
+		if err := k.SetDenomAdversary(ctx, record.Denom, record.Address); err != nil {
+			return errors.Wrapf(err, "failed to set %s blacklist adversary: %s", record.Denom, record.Address)
+		}
+	}
+
+	return k.eventService.EventManager(ctx).Emit(ctx, &blacklist.Ban{
+		Adversary:    record.Address,
+		Denom:        record.Denom,
+		ExpiryHeight: record.ExpiryHeight,
+		ExpiryTime:   record.ExpiryTime,
+		Reason:       record.Reason,
+		CaseId:       record.CaseId,
+	})
+}
+
+// unban removes an address from the blacklist, globally if denom is empty,
+// together with its ban record.
+func (k blacklistMsgServer) unban(ctx context.Context, denom string, friend string) error {
+	if err := k.DeleteBanRecord(ctx, denom, friend); err != nil {
+		return errors.Wrapf(err, "failed to delete ban record: %s", friend)
+	}
+
+	if denom == "" {
+		if err := k.DeleteAdversary(ctx, friend); err != nil {
+			return errors.Wrapf(err, "failed to delete blacklist adversary: %s", friend)
+		}
+	} else {
+		if err := k.DeleteDenomAdversary(ctx, denom, friend); err != nil {
+			return errors.Wrapf(err, "failed to delete %s blacklist adversary: %s", denom, friend)
+		}
+	}
+
+	return k.eventService.EventManager(ctx).Emit(ctx, &blacklist.Unban{
+		Friend: friend,
+		Denom:  denom,
+	})
+}
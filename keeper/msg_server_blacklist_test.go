@@ -15,9 +15,12 @@
 package keeper_test
 
 import (
+	"strings"
 	"testing"
+	"time"
 
 	"cosmossdk.io/collections"
+	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/monerium/module-noble/v2/keeper"
 	"github.com/monerium/module-noble/v2/types"
 	"github.com/monerium/module-noble/v2/types/blacklist"
@@ -31,7 +34,9 @@ func TestBlacklistAcceptOwnership(t *testing.T) {
 	server := keeper.NewBlacklistMsgServer(k)
 
 	// ACT: Attempt to accept ownership with no pending owner set.
-	_, err := server.AcceptOwnership(ctx, &blacklist.MsgAcceptOwnership{})
+	_, err := server.AcceptOwnership(ctx, &blacklist.MsgAcceptOwnership{
+		Signer: utils.TestAccount().Address,
+	})
 	// ASSERT: The action should've failed due to no pending owner set.
 	require.ErrorIs(t, err, blacklist.ErrNoPendingOwner)
 
@@ -94,7 +99,10 @@ func TestBlacklistAddAdminAccount(t *testing.T) {
 	server := keeper.NewBlacklistMsgServer(k)
 
 	// ACT: Attempt to add admin account with no owner set.
-	_, err := server.AddAdminAccount(ctx, &blacklist.MsgAddAdminAccount{})
+	_, err := server.AddAdminAccount(ctx, &blacklist.MsgAddAdminAccount{
+		Signer:  utils.TestAccount().Address,
+		Account: utils.TestAccount().Address,
+	})
 	// ASSERT: The action should've failed due to no owner set.
 	require.ErrorIs(t, err, blacklist.ErrNoOwner)
 
@@ -104,7 +112,8 @@ func TestBlacklistAddAdminAccount(t *testing.T) {
 
 	// ACT: Attempt to add admin account with invalid signer.
 	_, err = server.AddAdminAccount(ctx, &blacklist.MsgAddAdminAccount{
-		Signer: utils.TestAccount().Address,
+		Signer:  utils.TestAccount().Address,
+		Account: utils.TestAccount().Address,
 	})
 	// ASSERT: The action should've failed due to invalid signer.
 	require.ErrorIs(t, err, blacklist.ErrInvalidOwner)
@@ -139,6 +148,29 @@ func TestBlacklistAddAdminAccount(t *testing.T) {
 	events := ctx.EventManager().Events()
 	require.Len(t, events, 1)
 	require.Equal(t, "florin.blacklist.v1.AdminAccountAdded", events[0].Type)
+
+	// ACT: Attempt to add denom admin account with not allowed denom.
+	_, err = server.AddAdminAccount(ctx, &blacklist.MsgAddAdminAccount{
+		Signer:  owner.Address,
+		Account: admin.Address,
+		Denom:   "uusde",
+	})
+	// ASSERT: The action should've failed due to not allowed denom.
+	require.ErrorContains(t, err, "uusde is not an allowed denom")
+
+	// ARRANGE: Generate a denom admin account.
+	denomAdmin := utils.TestAccount()
+
+	// ACT: Attempt to add denom admin account.
+	_, err = server.AddAdminAccount(ctx, &blacklist.MsgAddAdminAccount{
+		Signer:  owner.Address,
+		Account: denomAdmin.Address,
+		Denom:   "ueure",
+	})
+	// ASSERT: The action should've succeeded.
+	require.NoError(t, err)
+	require.True(t, k.IsDenomBlacklistAdmin(ctx, "ueure", denomAdmin.Address))
+	require.False(t, k.IsBlacklistAdmin(ctx, denomAdmin.Address))
 }
 
 func TestBan(t *testing.T) {
@@ -152,7 +184,8 @@ func TestBan(t *testing.T) {
 
 	// ACT: Attempt to ban with invalid signer.
 	_, err = server.Ban(ctx, &blacklist.MsgBan{
-		Signer: utils.TestAccount().Address,
+		Signer:    utils.TestAccount().Address,
+		Adversary: utils.TestAccount().Address,
 	})
 	// ASSERT: The action should've failed due to invalid signer.
 	require.ErrorIs(t, err, blacklist.ErrInvalidAdmin)
@@ -187,6 +220,357 @@ func TestBan(t *testing.T) {
 	events := ctx.EventManager().Events()
 	require.Len(t, events, 1)
 	require.Equal(t, "florin.blacklist.v1.Ban", events[0].Type)
+
+	// ARRANGE: Set denom admin in state.
+	denomAdmin := utils.TestAccount()
+	err = k.SetDenomBlacklistAdmin(ctx, "ueure", denomAdmin.Address)
+	require.NoError(t, err)
+
+	// ACT: Attempt to ban with not allowed denom.
+	_, err = server.Ban(ctx, &blacklist.MsgBan{
+		Signer:    denomAdmin.Address,
+		Adversary: adversary.Address,
+		Denom:     "uusde",
+	})
+	// ASSERT: The action should've failed due to not allowed denom.
+	require.ErrorContains(t, err, "uusde is not an allowed denom")
+
+	// ACT: Attempt to ban globally with denom admin.
+	_, err = server.Ban(ctx, &blacklist.MsgBan{
+		Signer:    denomAdmin.Address,
+		Adversary: adversary.Address,
+	})
+	// ASSERT: The action should've failed due to invalid signer.
+	require.ErrorIs(t, err, blacklist.ErrInvalidAdmin)
+
+	// ARRANGE: Generate a denom adversary account, and set the open transfer policy.
+	denomAdversary := utils.TestAccount()
+	require.NoError(t, k.SetTransferPolicy(ctx, "ueure", types.TransferPolicyOpen))
+
+	// ACT: Attempt to ban for a denom with the open transfer policy.
+	_, err = server.Ban(ctx, &blacklist.MsgBan{
+		Signer:    denomAdmin.Address,
+		Adversary: denomAdversary.Address,
+		Denom:     "ueure",
+	})
+	// ASSERT: The action should've failed, as open denoms can't have denom bans.
+	require.ErrorIs(t, err, blacklist.ErrInvalidBanRecord)
+	require.False(t, k.IsDenomAdversary(ctx, "ueure", denomAdversary.Address))
+
+	// ARRANGE: Set the blacklist transfer policy.
+	require.NoError(t, k.SetTransferPolicy(ctx, "ueure", types.TransferPolicyBlacklist))
+
+	// ACT: Attempt to ban for denom with denom admin.
+	_, err = server.Ban(ctx, &blacklist.MsgBan{
+		Signer:    denomAdmin.Address,
+		Adversary: denomAdversary.Address,
+		Denom:     "ueure",
+	})
+	// ASSERT: The action should've succeeded.
+	require.NoError(t, err)
+	require.True(t, k.IsDenomAdversary(ctx, "ueure", denomAdversary.Address))
+	require.False(t, k.IsAdversary(ctx, denomAdversary.Address))
+	require.True(t, k.IsBlocked(ctx, "ueure", denomAdversary.Address))
+}
+
+func TestBanBatch(t *testing.T) {
+	k, ctx := mocks.FlorinKeeper()
+	server := keeper.NewBlacklistMsgServer(k)
+
+	// ARRANGE: Set admin in state.
+	admin := utils.TestAccount()
+	err := k.SetBlacklistAdmin(ctx, admin.Address)
+	require.NoError(t, err)
+
+	// ARRANGE: Generate adversary accounts.
+	alice, bob := utils.TestAccount(), utils.TestAccount()
+
+	// ACT: Attempt to batch ban with invalid signer.
+	_, err = server.BanBatch(ctx, &blacklist.MsgBanBatch{
+		Signer:      utils.TestAccount().Address,
+		Adversaries: []string{alice.Address},
+	})
+	// ASSERT: The action should've failed due to invalid signer.
+	require.ErrorIs(t, err, blacklist.ErrInvalidAdmin)
+
+	// ACT: Attempt to batch ban with no adversaries.
+	_, err = server.BanBatch(ctx, &blacklist.MsgBanBatch{
+		Signer: admin.Address,
+	})
+	// ASSERT: The action should've failed due to invalid batch.
+	require.ErrorIs(t, err, blacklist.ErrInvalidBatch)
+
+	// ACT: Attempt to batch ban with a duplicate adversary.
+	_, err = server.BanBatch(ctx, &blacklist.MsgBanBatch{
+		Signer:      admin.Address,
+		Adversaries: []string{alice.Address, bob.Address, alice.Address},
+	})
+	// ASSERT: The action should've failed due to invalid batch, banning no one.
+	require.ErrorIs(t, err, blacklist.ErrInvalidBatch)
+	require.Empty(t, k.GetAdversaries(ctx))
+
+	// ACT: Attempt to batch ban with a too long case id.
+	_, err = server.BanBatch(ctx, &blacklist.MsgBanBatch{
+		Signer:      admin.Address,
+		Adversaries: []string{alice.Address, bob.Address},
+		CaseId:      strings.Repeat("a", blacklist.MaxCaseIDLength+1),
+	})
+	// ASSERT: The action should've failed due to invalid ban record, banning no one.
+	require.ErrorIs(t, err, blacklist.ErrInvalidBanRecord)
+	require.Empty(t, k.GetAdversaries(ctx))
+
+	// ACT: Attempt to batch ban.
+	_, err = server.BanBatch(ctx, &blacklist.MsgBanBatch{
+		Signer:      admin.Address,
+		Adversaries: []string{alice.Address, bob.Address},
+		Reason:      blacklist.BanReasonSanctions,
+		CaseId:      "ofac-2024-01",
+	})
+	// ASSERT: The action should've succeeded, with an event per adversary.
+	require.NoError(t, err)
+	require.True(t, k.IsAdversary(ctx, alice.Address))
+	require.True(t, k.IsAdversary(ctx, bob.Address))
+	record, found := k.GetBanRecord(ctx, "", bob.Address)
+	require.True(t, found)
+	require.Equal(t, blacklist.BanReasonSanctions, record.Reason)
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 2)
+	require.Equal(t, "florin.blacklist.v1.Ban", events[0].Type)
+	require.Equal(t, "florin.blacklist.v1.Ban", events[1].Type)
+
+	// ARRANGE: Set denom admin in state.
+	denomAdmin := utils.TestAccount()
+	err = k.SetDenomBlacklistAdmin(ctx, "ueure", denomAdmin.Address)
+	require.NoError(t, err)
+
+	// ACT: Attempt to batch ban globally with denom admin.
+	_, err = server.BanBatch(ctx, &blacklist.MsgBanBatch{
+		Signer:      denomAdmin.Address,
+		Adversaries: []string{alice.Address},
+	})
+	// ASSERT: The action should've failed due to invalid signer.
+	require.ErrorIs(t, err, blacklist.ErrInvalidAdmin)
+
+	// ACT: Attempt to batch ban for denom with denom admin.
+	_, err = server.BanBatch(ctx, &blacklist.MsgBanBatch{
+		Signer:      denomAdmin.Address,
+		Adversaries: []string{alice.Address},
+		Denom:       "ueure",
+	})
+	// ASSERT: The action should've succeeded.
+	require.NoError(t, err)
+	require.True(t, k.IsDenomAdversary(ctx, "ueure", alice.Address))
+	require.False(t, k.IsDenomAdversary(ctx, "ueure", bob.Address))
+}
+
+func TestBanWithExpiry(t *testing.T) {
+	k, ctx := mocks.FlorinKeeper()
+	server := keeper.NewBlacklistMsgServer(k)
+	ctx = ctx.WithBlockHeight(10).WithBlockTime(time.Unix(1_000, 0))
+
+	// ARRANGE: Set admins in state.
+	admin, denomAdmin := utils.TestAccount(), utils.TestAccount()
+	err := k.SetBlacklistAdmin(ctx, admin.Address)
+	require.NoError(t, err)
+	err = k.SetDenomBlacklistAdmin(ctx, "ueure", denomAdmin.Address)
+	require.NoError(t, err)
+
+	// ARRANGE: Generate adversary accounts.
+	adversary, denomAdversary := utils.TestAccount(), utils.TestAccount()
+
+	// ACT: Attempt to ban with a negative expiry height.
+	_, err = server.Ban(ctx, &blacklist.MsgBan{
+		Signer:       admin.Address,
+		Adversary:    adversary.Address,
+		ExpiryHeight: -1,
+	})
+	// ASSERT: The action should've failed due to invalid expiry.
+	require.ErrorIs(t, err, blacklist.ErrInvalidExpiry)
+
+	// ACT: Attempt to ban with a past expiry height.
+	_, err = server.Ban(ctx, &blacklist.MsgBan{
+		Signer:       admin.Address,
+		Adversary:    adversary.Address,
+		ExpiryHeight: 10,
+	})
+	// ASSERT: The action should've failed due to invalid expiry.
+	require.ErrorIs(t, err, blacklist.ErrInvalidExpiry)
+
+	// ACT: Attempt to ban with a past expiry time.
+	_, err = server.Ban(ctx, &blacklist.MsgBan{
+		Signer:     admin.Address,
+		Adversary:  adversary.Address,
+		ExpiryTime: 1_000,
+	})
+	// ASSERT: The action should've failed due to invalid expiry.
+	require.ErrorIs(t, err, blacklist.ErrInvalidExpiry)
+	require.False(t, k.IsAdversary(ctx, adversary.Address))
+
+	// ACT: Attempt to ban until a block height.
+	_, err = server.Ban(ctx, &blacklist.MsgBan{
+		Signer:       admin.Address,
+		Adversary:    adversary.Address,
+		ExpiryHeight: 20,
+	})
+	// ASSERT: The action should've succeeded.
+	require.NoError(t, err)
+	require.True(t, k.IsAdversary(ctx, adversary.Address))
+	expiry, found := k.GetBanRecord(ctx, "", adversary.Address)
+	require.True(t, found)
+	require.Equal(t, int64(20), expiry.ExpiryHeight)
+
+	// ACT: Attempt to ban until a time for a single denom.
+	_, err = server.Ban(ctx, &blacklist.MsgBan{
+		Signer:     denomAdmin.Address,
+		Adversary:  denomAdversary.Address,
+		Denom:      "ueure",
+		ExpiryTime: 2_000,
+	})
+	// ASSERT: The action should've succeeded.
+	require.NoError(t, err)
+	require.True(t, k.IsBlocked(ctx, "ueure", denomAdversary.Address))
+
+	// ACT: Prune expired bans before any expiry.
+	err = k.PruneExpiredBans(ctx)
+	// ASSERT: No ban should've been pruned.
+	require.NoError(t, err)
+	require.Len(t, k.GetBanRecords(ctx), 2)
+
+	// ACT: Advance past the expiry height.
+	ctx = ctx.WithBlockHeight(20)
+	// ASSERT: The global ban should've lapsed, the denom ban should still hold.
+	require.False(t, k.IsAdversary(ctx, adversary.Address))
+	require.False(t, k.IsBlocked(ctx, "ueure", adversary.Address))
+	require.True(t, k.IsBlocked(ctx, "ueure", denomAdversary.Address))
+
+	// ACT: Advance past the expiry time.
+	ctx = ctx.WithBlockTime(time.Unix(2_000, 0))
+	// ASSERT: The denom ban should've lapsed.
+	require.False(t, k.IsBlocked(ctx, "ueure", denomAdversary.Address))
+
+	// ACT: Prune expired bans.
+	err = k.PruneExpiredBans(ctx)
+	// ASSERT: Both bans and their expiries should've been removed.
+	require.NoError(t, err)
+	require.Empty(t, k.GetAdversaries(ctx))
+	require.Empty(t, k.GetDenomAdversaries(ctx, ""))
+	require.Empty(t, k.GetBanRecords(ctx))
+	events := ctx.EventManager().Events()
+	require.Equal(t, "florin.blacklist.v1.BanExpired", events[len(events)-1].Type)
+	require.Equal(t, "florin.blacklist.v1.BanExpired", events[len(events)-2].Type)
+
+	// ARRANGE: Ban until a block height.
+	_, err = server.Ban(ctx, &blacklist.MsgBan{
+		Signer:       admin.Address,
+		Adversary:    adversary.Address,
+		ExpiryHeight: 30,
+	})
+	require.NoError(t, err)
+
+	// ACT: Attempt to ban again without an expiry.
+	_, err = server.Ban(ctx, &blacklist.MsgBan{
+		Signer:    admin.Address,
+		Adversary: adversary.Address,
+	})
+	// ASSERT: The action should've succeeded, making the ban permanent.
+	require.NoError(t, err)
+	_, found = k.GetBanRecord(ctx, "", adversary.Address)
+	require.False(t, found)
+	require.True(t, k.IsAdversary(ctx.WithBlockHeight(30), adversary.Address))
+
+	// ACT: Attempt to ban the permanently banned address again with an expiry.
+	_, err = server.Ban(ctx, &blacklist.MsgBan{
+		Signer:       admin.Address,
+		Adversary:    adversary.Address,
+		ExpiryHeight: 40,
+	})
+	// ASSERT: The action should've failed, keeping the ban permanent.
+	require.ErrorIs(t, err, blacklist.ErrInvalidExpiry)
+	_, found = k.GetBanRecord(ctx, "", adversary.Address)
+	require.False(t, found)
+
+	// ARRANGE: Explicitly lift the permanent ban.
+	_, err = server.Unban(ctx, &blacklist.MsgUnban{
+		Signer: admin.Address,
+		Friend: adversary.Address,
+	})
+	require.NoError(t, err)
+
+	// ACT: Attempt to ban the address with an expiry.
+	_, err = server.Ban(ctx, &blacklist.MsgBan{
+		Signer:       admin.Address,
+		Adversary:    adversary.Address,
+		ExpiryHeight: 40,
+	})
+	// ASSERT: The action should've succeeded.
+	require.NoError(t, err)
+	expiry, found = k.GetBanRecord(ctx, "", adversary.Address)
+	require.True(t, found)
+	require.Equal(t, int64(40), expiry.ExpiryHeight)
+}
+
+func TestBanWithReason(t *testing.T) {
+	k, ctx := mocks.FlorinKeeper()
+	server := keeper.NewBlacklistMsgServer(k)
+
+	// ARRANGE: Set admin in state.
+	admin := utils.TestAccount()
+	err := k.SetBlacklistAdmin(ctx, admin.Address)
+	require.NoError(t, err)
+
+	// ARRANGE: Generate an adversary account.
+	adversary := utils.TestAccount()
+
+	// ACT: Attempt to ban with an unknown reason.
+	_, err = server.Ban(ctx, &blacklist.MsgBan{
+		Signer:    admin.Address,
+		Adversary: adversary.Address,
+		Reason:    blacklist.BanReason(99),
+	})
+	// ASSERT: The action should've failed due to invalid ban record.
+	require.ErrorIs(t, err, blacklist.ErrInvalidBanRecord)
+
+	// ACT: Attempt to ban with a too long case id.
+	_, err = server.Ban(ctx, &blacklist.MsgBan{
+		Signer:    admin.Address,
+		Adversary: adversary.Address,
+		CaseId:    strings.Repeat("a", blacklist.MaxCaseIDLength+1),
+	})
+	// ASSERT: The action should've failed due to invalid ban record.
+	require.ErrorIs(t, err, blacklist.ErrInvalidBanRecord)
+	require.False(t, k.IsAdversary(ctx, adversary.Address))
+
+	// ACT: Attempt to ban with a reason and case id.
+	_, err = server.Ban(ctx, &blacklist.MsgBan{
+		Signer:    admin.Address,
+		Adversary: adversary.Address,
+		Reason:    blacklist.BanReasonCourtOrder,
+		CaseId:    "case-1",
+	})
+	// ASSERT: The action should've succeeded, persisting the ban record.
+	require.NoError(t, err)
+	require.True(t, k.IsAdversary(ctx, adversary.Address))
+	record, found := k.GetBanRecord(ctx, "", adversary.Address)
+	require.True(t, found)
+	require.Equal(t, blacklist.BanRecord{
+		Address: adversary.Address,
+		Reason:  blacklist.BanReasonCourtOrder,
+		CaseId:  "case-1",
+	}, record)
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, "florin.blacklist.v1.Ban", events[0].Type)
+	require.Contains(t, events[0].Attributes, abci.EventAttribute{Key: "case_id", Value: `"case-1"`})
+
+	// ACT: Attempt to ban again without details.
+	_, err = server.Ban(ctx, &blacklist.MsgBan{
+		Signer:    admin.Address,
+		Adversary: adversary.Address,
+	})
+	// ASSERT: The action should've succeeded, removing the ban record.
+	require.NoError(t, err)
+	_, found = k.GetBanRecord(ctx, "", adversary.Address)
+	require.False(t, found)
 }
 
 func TestBlacklistRemoveAdminAccount(t *testing.T) {
@@ -194,7 +578,10 @@ func TestBlacklistRemoveAdminAccount(t *testing.T) {
 	server := keeper.NewBlacklistMsgServer(k)
 
 	// ACT: Attempt to remove admin account with no owner set.
-	_, err := server.RemoveAdminAccount(ctx, &blacklist.MsgRemoveAdminAccount{})
+	_, err := server.RemoveAdminAccount(ctx, &blacklist.MsgRemoveAdminAccount{
+		Signer:  utils.TestAccount().Address,
+		Account: utils.TestAccount().Address,
+	})
 	// ASSERT: The action should've failed due to no owner set.
 	require.ErrorIs(t, err, blacklist.ErrNoOwner)
 
@@ -204,7 +591,8 @@ func TestBlacklistRemoveAdminAccount(t *testing.T) {
 
 	// ACT: Attempt to remove admin account with invalid signer.
 	_, err = server.RemoveAdminAccount(ctx, &blacklist.MsgRemoveAdminAccount{
-		Signer: utils.TestAccount().Address,
+		Signer:  utils.TestAccount().Address,
+		Account: utils.TestAccount().Address,
 	})
 	// ASSERT: The action should've failed due to invalid signer.
 	require.ErrorIs(t, err, blacklist.ErrInvalidOwner)
@@ -249,7 +637,10 @@ func TestBlacklistTransferOwnership(t *testing.T) {
 	server := keeper.NewBlacklistMsgServer(k)
 
 	// ACT: Attempt to transfer ownership with no owner set.
-	_, err := server.TransferOwnership(ctx, &blacklist.MsgTransferOwnership{})
+	_, err := server.TransferOwnership(ctx, &blacklist.MsgTransferOwnership{
+		Signer:   utils.TestAccount().Address,
+		NewOwner: utils.TestAccount().Address,
+	})
 	// ASSERT: The action should've failed due to no owner set.
 	require.ErrorIs(t, err, blacklist.ErrNoOwner)
 
@@ -260,7 +651,8 @@ func TestBlacklistTransferOwnership(t *testing.T) {
 
 	// ACT: Attempt to transfer ownership with invalid signer.
 	_, err = server.TransferOwnership(ctx, &blacklist.MsgTransferOwnership{
-		Signer: utils.TestAccount().Address,
+		Signer:   utils.TestAccount().Address,
+		NewOwner: utils.TestAccount().Address,
 	})
 	// ASSERT: The action should've failed due to invalid signer.
 	require.ErrorIs(t, err, blacklist.ErrInvalidOwner)
@@ -318,6 +710,7 @@ func TestUnban(t *testing.T) {
 	// ACT: Attempt to unban with invalid signer.
 	_, err := server.Unban(ctx, &blacklist.MsgUnban{
 		Signer: utils.TestAccount().Address,
+		Friend: utils.TestAccount().Address,
 	})
 	// ASSERT: The action should've failed due to invalid signer.
 	require.ErrorIs(t, err, blacklist.ErrInvalidAdmin)
@@ -343,15 +736,90 @@ func TestUnban(t *testing.T) {
 	require.Error(t, err, mocks.ErrorStoreAccess)
 	k.Adversaries = tmp
 
+	// ARRANGE: Set a ban expiry in state.
+	err = k.SetBanRecord(ctx, blacklist.BanRecord{Address: adversary.Address, ExpiryHeight: 100})
+	require.NoError(t, err)
+
 	// ACT: Attempt to unban.
 	_, err = server.Unban(ctx, &blacklist.MsgUnban{
 		Signer: admin.Address,
 		Friend: adversary.Address,
 	})
-	// ASSERT: The action should've succeeded.
+	// ASSERT: The action should've succeeded, removing the ban expiry.
 	require.NoError(t, err)
 	require.False(t, k.IsAdversary(ctx, adversary.Address))
+	_, found := k.GetBanRecord(ctx, "", adversary.Address)
+	require.False(t, found)
 	events := ctx.EventManager().Events()
 	require.Len(t, events, 1)
 	require.Equal(t, "florin.blacklist.v1.Unban", events[0].Type)
+
+	// ARRANGE: Set denom admin and denom adversary in state.
+	denomAdmin := utils.TestAccount()
+	_ = k.SetDenomBlacklistAdmin(ctx, "ueure", denomAdmin.Address)
+	_ = k.SetDenomAdversary(ctx, "ueure", adversary.Address)
+
+	// ACT: Attempt to unban for denom with denom admin.
+	_, err = server.Unban(ctx, &blacklist.MsgUnban{
+		Signer: denomAdmin.Address,
+		Friend: adversary.Address,
+		Denom:  "ueure",
+	})
+	// ASSERT: The action should've succeeded.
+	require.NoError(t, err)
+	require.False(t, k.IsDenomAdversary(ctx, "ueure", adversary.Address))
+}
+
+func TestUnbanBatch(t *testing.T) {
+	k, ctx := mocks.FlorinKeeper()
+	server := keeper.NewBlacklistMsgServer(k)
+
+	// ARRANGE: Set admin and adversaries in state.
+	admin := utils.TestAccount()
+	err := k.SetBlacklistAdmin(ctx, admin.Address)
+	require.NoError(t, err)
+	alice, bob, charlie := utils.TestAccount(), utils.TestAccount(), utils.TestAccount()
+	for _, adversary := range []string{alice.Address, bob.Address, charlie.Address} {
+		require.NoError(t, k.SetAdversary(ctx, adversary))
+	}
+	err = k.SetBanRecord(ctx, blacklist.BanRecord{Address: alice.Address, CaseId: "case-1"})
+	require.NoError(t, err)
+
+	// ACT: Attempt to batch unban with invalid signer.
+	_, err = server.UnbanBatch(ctx, &blacklist.MsgUnbanBatch{
+		Signer:  utils.TestAccount().Address,
+		Friends: []string{alice.Address},
+	})
+	// ASSERT: The action should've failed due to invalid signer.
+	require.ErrorIs(t, err, blacklist.ErrInvalidAdmin)
+
+	// ACT: Attempt to batch unban with no friends.
+	_, err = server.UnbanBatch(ctx, &blacklist.MsgUnbanBatch{
+		Signer: admin.Address,
+	})
+	// ASSERT: The action should've failed due to invalid batch.
+	require.ErrorIs(t, err, blacklist.ErrInvalidBatch)
+
+	// ACT: Attempt to batch unban with a duplicate friend.
+	_, err = server.UnbanBatch(ctx, &blacklist.MsgUnbanBatch{
+		Signer:  admin.Address,
+		Friends: []string{alice.Address, alice.Address},
+	})
+	// ASSERT: The action should've failed due to invalid batch, unbanning no one.
+	require.ErrorIs(t, err, blacklist.ErrInvalidBatch)
+	require.True(t, k.IsAdversary(ctx, alice.Address))
+
+	// ACT: Attempt to batch unban.
+	_, err = server.UnbanBatch(ctx, &blacklist.MsgUnbanBatch{
+		Signer:  admin.Address,
+		Friends: []string{alice.Address, bob.Address},
+	})
+	// ASSERT: The action should've succeeded, with an event per friend.
+	require.NoError(t, err)
+	require.Equal(t, []string{charlie.Address}, k.GetAdversaries(ctx))
+	require.Empty(t, k.GetBanRecords(ctx))
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 2)
+	require.Equal(t, "florin.blacklist.v1.Unban", events[0].Type)
+	require.Equal(t, "florin.blacklist.v1.Unban", events[1].Type)
 }
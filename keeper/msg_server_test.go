@@ -16,13 +16,18 @@ package keeper_test
 
 import (
 	"encoding/base64"
+	"strings"
 	"testing"
+	"time"
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/monerium/module-noble/v2/keeper"
 	"github.com/monerium/module-noble/v2/types"
 	"github.com/monerium/module-noble/v2/utils"
@@ -41,14 +46,16 @@ func TestAcceptOwnership(t *testing.T) {
 
 	// ACT: Attempt to accept ownership with not allowed denom.
 	_, err := server.AcceptOwnership(ctx, &types.MsgAcceptOwnership{
-		Denom: "uusde",
+		Denom:  "uusde",
+		Signer: utils.TestAccount().Address,
 	})
 	// ASSERT: The action should've failed due to not allowed denom.
 	require.ErrorContains(t, err, "uusde is not an allowed denom")
 
 	// ACT: Attempt to accept ownership with no pending owner set.
 	_, err = server.AcceptOwnership(ctx, &types.MsgAcceptOwnership{
-		Denom: "ueure",
+		Denom:  "ueure",
+		Signer: utils.TestAccount().Address,
 	})
 	// ASSERT: The action should've failed due to no pending owner set.
 	require.ErrorIs(t, err, types.ErrNoPendingOwner)
@@ -118,14 +125,18 @@ func TestAddAdminAccount(t *testing.T) {
 
 	// ACT: Attempt to add admin account with not allowed denom.
 	_, err := server.AddAdminAccount(ctx, &types.MsgAddAdminAccount{
-		Denom: "uusde",
+		Denom:   "uusde",
+		Signer:  utils.TestAccount().Address,
+		Account: utils.TestAccount().Address,
 	})
 	// ASSERT: The action should've failed due to not allowed denom.
 	require.ErrorContains(t, err, "uusde is not an allowed denom")
 
 	// ACT: Attempt to add admin account with no owner set.
 	_, err = server.AddAdminAccount(ctx, &types.MsgAddAdminAccount{
-		Denom: "ueure",
+		Denom:   "ueure",
+		Signer:  utils.TestAccount().Address,
+		Account: utils.TestAccount().Address,
 	})
 	// ASSERT: The action should've failed due to no owner set.
 	require.ErrorIs(t, err, types.ErrNoOwner)
@@ -137,8 +148,9 @@ func TestAddAdminAccount(t *testing.T) {
 
 	// ACT: Attempt to add admin account with invalid signer.
 	_, err = server.AddAdminAccount(ctx, &types.MsgAddAdminAccount{
-		Denom:  "ueure",
-		Signer: utils.TestAccount().Address,
+		Denom:   "ueure",
+		Signer:  utils.TestAccount().Address,
+		Account: utils.TestAccount().Address,
 	})
 	// ASSERT: The action should've failed due to invalid signer.
 	require.ErrorIs(t, err, types.ErrInvalidOwner)
@@ -150,7 +162,7 @@ func TestAddAdminAccount(t *testing.T) {
 	tmp := k.Admins
 	k.Admins = collections.NewKeySet(
 		collections.NewSchemaBuilder(mocks.FailingStore(mocks.Set, utils.GetKVStore(ctx, types.ModuleName))),
-		types.AdminPrefix, "admins", collections.BytesKey,
+		types.AdminPrefix, "admins", types.AccountKeyCodec,
 	)
 
 	// ACT: Attempt to add admin account with failing Admins collection store.
@@ -177,20 +189,73 @@ func TestAddAdminAccount(t *testing.T) {
 	require.Equal(t, "florin.v2.AdminAccountAdded", events[0].Type)
 }
 
+func TestAddAllowlistAdmin(t *testing.T) {
+	k, ctx := mocks.FlorinKeeper()
+	server := keeper.NewMsgServer(k)
+
+	// ACT: Attempt to add allowlist admin with not allowed denom.
+	_, err := server.AddAllowlistAdmin(ctx, &types.MsgAddAllowlistAdmin{
+		Denom:   "uusde",
+		Signer:  utils.TestAccount().Address,
+		Account: utils.TestAccount().Address,
+	})
+	// ASSERT: The action should've failed due to not allowed denom.
+	require.ErrorContains(t, err, "uusde is not an allowed denom")
+
+	// ACT: Attempt to add allowlist admin with no owner set.
+	_, err = server.AddAllowlistAdmin(ctx, &types.MsgAddAllowlistAdmin{
+		Denom:   "ueure",
+		Signer:  utils.TestAccount().Address,
+		Account: utils.TestAccount().Address,
+	})
+	// ASSERT: The action should've failed due to no owner set.
+	require.ErrorIs(t, err, types.ErrNoOwner)
+
+	// ARRANGE: Set owner in state.
+	owner, admin := utils.TestAccount(), utils.TestAccount()
+	require.NoError(t, k.SetOwner(ctx, "ueure", owner.Address))
+
+	// ACT: Attempt to add allowlist admin with invalid signer.
+	_, err = server.AddAllowlistAdmin(ctx, &types.MsgAddAllowlistAdmin{
+		Denom:   "ueure",
+		Signer:  utils.TestAccount().Address,
+		Account: admin.Address,
+	})
+	// ASSERT: The action should've failed due to invalid signer.
+	require.ErrorIs(t, err, types.ErrInvalidOwner)
+
+	// ACT: Attempt to add allowlist admin.
+	_, err = server.AddAllowlistAdmin(ctx, &types.MsgAddAllowlistAdmin{
+		Denom:   "ueure",
+		Signer:  owner.Address,
+		Account: admin.Address,
+	})
+	// ASSERT: The action should've succeeded.
+	require.NoError(t, err)
+	require.True(t, k.IsAllowlistAdmin(ctx, "ueure", admin.Address))
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, "florin.v2.AllowlistAdminAdded", events[0].Type)
+}
+
 func TestAddSystemAccount(t *testing.T) {
 	k, ctx := mocks.FlorinKeeper()
 	server := keeper.NewMsgServer(k)
 
 	// ACT: Attempt to add system account with not allowed denom.
 	_, err := server.AddSystemAccount(ctx, &types.MsgAddSystemAccount{
-		Denom: "uusde",
+		Denom:   "uusde",
+		Signer:  utils.TestAccount().Address,
+		Account: utils.TestAccount().Address,
 	})
 	// ASSERT: The action should've failed due to not allowed denom.
 	require.ErrorContains(t, err, "uusde is not an allowed denom")
 
 	// ACT: Attempt to add system account with no owner set.
 	_, err = server.AddSystemAccount(ctx, &types.MsgAddSystemAccount{
-		Denom: "ueure",
+		Denom:   "ueure",
+		Signer:  utils.TestAccount().Address,
+		Account: utils.TestAccount().Address,
 	})
 	// ASSERT: The action should've failed due to no owner set.
 	require.ErrorIs(t, err, types.ErrNoOwner)
@@ -202,8 +267,9 @@ func TestAddSystemAccount(t *testing.T) {
 
 	// ACT: Attempt to add system account with invalid signer.
 	_, err = server.AddSystemAccount(ctx, &types.MsgAddSystemAccount{
-		Denom:  "ueure",
-		Signer: utils.TestAccount().Address,
+		Denom:   "ueure",
+		Signer:  utils.TestAccount().Address,
+		Account: utils.TestAccount().Address,
 	})
 	// ASSERT: The action should've failed due to invalid signer.
 	require.ErrorIs(t, err, types.ErrInvalidOwner)
@@ -215,7 +281,7 @@ func TestAddSystemAccount(t *testing.T) {
 	tmp := k.Systems
 	k.Systems = collections.NewKeySet(
 		collections.NewSchemaBuilder(mocks.FailingStore(mocks.Set, utils.GetKVStore(ctx, types.ModuleName))),
-		types.SystemPrefix, "systems", collections.BytesKey,
+		types.SystemPrefix, "systems", types.AccountKeyCodec,
 	)
 
 	// ACT: Attempt to add system account with failing Systems collection store.
@@ -242,6 +308,46 @@ func TestAddSystemAccount(t *testing.T) {
 	require.Equal(t, "florin.v2.SystemAccountAdded", events[0].Type)
 }
 
+func TestAddToAllowlist(t *testing.T) {
+	k, ctx := mocks.FlorinKeeper()
+	server := keeper.NewMsgServer(k)
+
+	// ACT: Attempt to add to allowlist with not allowed denom.
+	_, err := server.AddToAllowlist(ctx, &types.MsgAddToAllowlist{
+		Denom:   "uusde",
+		Signer:  utils.TestAccount().Address,
+		Account: utils.TestAccount().Address,
+	})
+	// ASSERT: The action should've failed due to not allowed denom.
+	require.ErrorContains(t, err, "uusde is not an allowed denom")
+
+	// ARRANGE: Set allowlist admin in state.
+	admin, user := utils.TestAccount(), utils.TestAccount()
+	require.NoError(t, k.SetAllowlistAdmin(ctx, "ueure", admin.Address))
+
+	// ACT: Attempt to add to allowlist with invalid signer.
+	_, err = server.AddToAllowlist(ctx, &types.MsgAddToAllowlist{
+		Denom:   "ueure",
+		Signer:  utils.TestAccount().Address,
+		Account: user.Address,
+	})
+	// ASSERT: The action should've failed due to invalid signer.
+	require.ErrorIs(t, err, types.ErrInvalidAllowlistAdmin)
+
+	// ACT: Attempt to add to allowlist.
+	_, err = server.AddToAllowlist(ctx, &types.MsgAddToAllowlist{
+		Denom:   "ueure",
+		Signer:  admin.Address,
+		Account: user.Address,
+	})
+	// ASSERT: The action should've succeeded.
+	require.NoError(t, err)
+	require.True(t, k.IsAllowlisted(ctx, "ueure", user.Address))
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, "florin.v2.AllowlistAccountAdded", events[0].Type)
+}
+
 func TestAllowDenom(t *testing.T) {
 	k, ctx := mocks.FlorinKeeper()
 	server := keeper.NewMsgServer(k)
@@ -249,14 +355,17 @@ func TestAllowDenom(t *testing.T) {
 	// ACT: Attempt to allow denom with invalid signer.
 	_, err := server.AllowDenom(ctx, &types.MsgAllowDenom{
 		Signer: utils.TestAccount().Address,
+		Denom:  "uusde",
+		Owner:  utils.TestAccount().Address,
 	})
 	// ASSERT: The action should've failed due to invalid signer.
 	require.ErrorIs(t, err, types.ErrInvalidAuthority)
 
 	// ACT: Attempt to allow denom already in use.
 	_, err = server.AllowDenom(ctx, &types.MsgAllowDenom{
-		Signer: "authority",
+		Signer: mocks.Authority,
 		Denom:  "uusdc",
+		Owner:  utils.TestAccount().Address,
 	})
 	// ASSERT: The action should've failed due to invalid denom.
 	require.ErrorIs(t, err, types.ErrInvalidDenom)
@@ -273,7 +382,7 @@ func TestAllowDenom(t *testing.T) {
 
 	// ACT: Attempt to allow denom with failing AllowedDenoms collection store.
 	_, err = server.AllowDenom(ctx, &types.MsgAllowDenom{
-		Signer: "authority",
+		Signer: mocks.Authority,
 		Denom:  "uusde",
 		Owner:  owner.Address,
 	})
@@ -290,7 +399,7 @@ func TestAllowDenom(t *testing.T) {
 
 	// ACT: Attempt to allow denom with failing Owner collection store.
 	_, err = server.AllowDenom(ctx, &types.MsgAllowDenom{
-		Signer: "authority",
+		Signer: mocks.Authority,
 		Denom:  "uusde",
 		Owner:  owner.Address,
 	})
@@ -300,7 +409,7 @@ func TestAllowDenom(t *testing.T) {
 
 	// ACT: Attempt to allow denom.
 	_, err = server.AllowDenom(ctx, &types.MsgAllowDenom{
-		Signer: "authority",
+		Signer: mocks.Authority,
 		Denom:  "uusde",
 		Owner:  owner.Address,
 	})
@@ -313,6 +422,135 @@ func TestAllowDenom(t *testing.T) {
 	require.Equal(t, "florin.v2.DenomAllowed", events[0].Type)
 }
 
+func TestApproveMint(t *testing.T) {
+	bank := mocks.BankKeeper{
+		Balances:    make(map[string]sdk.Coins),
+		Restriction: mocks.NoOpSendRestrictionFn,
+	}
+	k, ctx := mocks.FlorinWithKeepers(bank)
+	server := keeper.NewMsgServer(k)
+
+	// ACT: Attempt to approve mint with not allowed denom.
+	_, err := server.ApproveMint(ctx, &types.MsgApproveMint{
+		Denom:  "uusde",
+		Signer: utils.TestAccount().Address,
+	})
+	// ASSERT: The action should've failed due to not allowed denom.
+	require.ErrorContains(t, err, "uusde is not an allowed denom")
+
+	// ACT: Attempt to approve mint that doesn't exist.
+	_, err = server.ApproveMint(ctx, &types.MsgApproveMint{
+		Denom:  "ueure",
+		Signer: utils.TestAccount().Address,
+		Id:     1,
+	})
+	// ASSERT: The action should've failed due to no pending mint.
+	require.ErrorIs(t, err, types.ErrNoPendingMint)
+
+	// ARRANGE: Set system, admins, allowance and approval policy in state, and create a pending mint.
+	system, admin1, admin2, user := utils.TestAccount(), utils.TestAccount(), utils.TestAccount(), utils.TestAccount()
+	require.NoError(t, k.SetSystem(ctx, "ueure", system.Address))
+	require.NoError(t, k.SetAdmin(ctx, "ueure", admin1.Address))
+	require.NoError(t, k.SetAdmin(ctx, "ueure", admin2.Address))
+	require.NoError(t, k.SetMintAllowance(ctx, "ueure", system.Address, One.MulRaw(10)))
+	require.NoError(t, k.SetMintApprovalPolicy(ctx, types.MintApprovalPolicy{
+		Denom: "ueure", Threshold: One, Quorum: 2, ExpiryBlocks: 10,
+	}))
+	res, err := server.Mint(ctx, &types.MsgMint{Denom: "ueure", Signer: system.Address, To: user.Address, Amount: One.MulRaw(2)})
+	require.NoError(t, err)
+
+	// ACT: Attempt to approve mint with invalid signer.
+	_, err = server.ApproveMint(ctx, &types.MsgApproveMint{
+		Denom:  "ueure",
+		Signer: utils.TestAccount().Address,
+		Id:     res.PendingMintId,
+	})
+	// ASSERT: The action should've failed due to invalid signer.
+	require.ErrorIs(t, err, types.ErrInvalidAdmin)
+
+	// ARRANGE: Make the system account an admin as well.
+	require.NoError(t, k.SetAdmin(ctx, "ueure", system.Address))
+
+	// ACT: Attempt to approve mint with the minting system account.
+	_, err = server.ApproveMint(ctx, &types.MsgApproveMint{
+		Denom:  "ueure",
+		Signer: system.Address,
+		Id:     res.PendingMintId,
+	})
+	// ASSERT: The action should've failed due to self approval.
+	require.ErrorIs(t, err, types.ErrInvalidApprover)
+
+	// ACT: Attempt to approve mint.
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	_, err = server.ApproveMint(ctx, &types.MsgApproveMint{
+		Denom:  "ueure",
+		Signer: admin1.Address,
+		Id:     res.PendingMintId,
+	})
+	// ASSERT: The action should've succeeded, without reaching quorum.
+	require.NoError(t, err)
+	pendingMint, found := k.GetPendingMint(ctx, "ueure", res.PendingMintId)
+	require.True(t, found)
+	require.Equal(t, []string{admin1.Address}, pendingMint.Approvals)
+	require.True(t, bank.Balances[user.Address].IsZero())
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, "florin.v2.MintApproved", events[0].Type)
+
+	// ACT: Attempt to approve mint again with the same signer.
+	_, err = server.ApproveMint(ctx, &types.MsgApproveMint{
+		Denom:  "ueure",
+		Signer: admin1.Address,
+		Id:     res.PendingMintId,
+	})
+	// ASSERT: The action should've failed due to duplicate approval.
+	require.ErrorIs(t, err, types.ErrInvalidApprover)
+
+	// ACT: Attempt to approve mint while paused.
+	require.NoError(t, k.SetPaused(ctx, "ueure", true))
+	_, err = server.ApproveMint(ctx, &types.MsgApproveMint{
+		Denom:  "ueure",
+		Signer: admin2.Address,
+		Id:     res.PendingMintId,
+	})
+	// ASSERT: The action should've failed due to paused denom.
+	require.ErrorIs(t, err, types.ErrPaused)
+	require.NoError(t, k.SetPaused(ctx, "ueure", false))
+
+	// ACT: Attempt to approve mint reaching quorum.
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	_, err = server.ApproveMint(ctx, &types.MsgApproveMint{
+		Denom:  "ueure",
+		Signer: admin2.Address,
+		Id:     res.PendingMintId,
+	})
+	// ASSERT: The action should've succeeded, and minted.
+	require.NoError(t, err)
+	_, found = k.GetPendingMint(ctx, "ueure", res.PendingMintId)
+	require.False(t, found)
+	require.Equal(t, One.MulRaw(2), bank.Balances[user.Address].AmountOf("ueure"))
+	require.Equal(t, One.MulRaw(8), k.GetMintAllowance(ctx, "ueure", system.Address))
+	events = ctx.EventManager().Events()
+	require.Len(t, events, 3)
+	require.Equal(t, "florin.v2.MintApproved", events[0].Type)
+	require.Equal(t, "florin.blacklist.v1.Decision", events[1].Type)
+	require.Equal(t, "florin.v2.Minted", events[2].Type)
+
+	// ARRANGE: Create another pending mint and advance past its expiry.
+	res, err = server.Mint(ctx, &types.MsgMint{Denom: "ueure", Signer: system.Address, To: user.Address, Amount: One.MulRaw(2)})
+	require.NoError(t, err)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 11)
+
+	// ACT: Attempt to approve expired mint.
+	_, err = server.ApproveMint(ctx, &types.MsgApproveMint{
+		Denom:  "ueure",
+		Signer: admin1.Address,
+		Id:     res.PendingMintId,
+	})
+	// ASSERT: The action should've failed due to expiry.
+	require.ErrorIs(t, err, types.ErrPendingMintExpired)
+}
+
 func TestBurn(t *testing.T) {
 	// The below signature was generated using Keplr's signArbitrary function.
 	//
@@ -327,6 +565,7 @@ func TestBurn(t *testing.T) {
 	// }
 	bz, _ := base64.StdEncoding.DecodeString("AlE8CxHR19ID5lxrVtTxSgJFlK3T+eYtyDM/vBA3Fowr")
 	pubKey, _ := codectypes.NewAnyWithValue(&secp256k1.PubKey{Key: bz})
+	signature, _ := base64.StdEncoding.DecodeString("qe5dDxdOgY8B2LjMqnK5/5iRIFOCwdTu0G5ZQ66bHzVgP15V2Fb+fzOH0wPAUC5GUQ23M1cSvysulzKIbXY/4Q==")
 
 	bank := mocks.BankKeeper{
 		Balances:    make(map[string]sdk.Coins),
@@ -341,14 +580,45 @@ func TestBurn(t *testing.T) {
 	require.NoError(t, err)
 
 	// ACT: Attempt to burn invalid denom.
-	_, err = server.Burn(ctx, &types.MsgBurn{Denom: "uusde"})
+	_, err = server.Burn(ctx, &types.MsgBurn{
+		Denom:     "uusde",
+		Signer:    system.Address,
+		From:      "noble1rwvjzk28l38js7xx6mq23nrpghd8qqvxmj6ep2",
+		Amount:    One,
+		Signature: signature,
+		PubKey:    pubKey,
+	})
 	// ASSERT: The action should've failed due to invalid denom.
 	require.ErrorContains(t, err, "uusde is not an allowed denom")
 
+	// ARRANGE: Pause denom in state.
+	err = k.SetPaused(ctx, "ueure", true)
+	require.NoError(t, err)
+
+	// ACT: Attempt to burn paused denom.
+	_, err = server.Burn(ctx, &types.MsgBurn{
+		Denom:     "ueure",
+		Signer:    system.Address,
+		From:      "noble1rwvjzk28l38js7xx6mq23nrpghd8qqvxmj6ep2",
+		Amount:    One,
+		Signature: signature,
+		PubKey:    pubKey,
+	})
+	// ASSERT: The action should've failed due to paused denom.
+	require.ErrorIs(t, err, types.ErrPaused)
+
+	// ARRANGE: Unpause denom in state.
+	err = k.SetPaused(ctx, "ueure", false)
+	require.NoError(t, err)
+
 	// ACT: Attempt to burn with invalid signer.
 	_, err = server.Burn(ctx, &types.MsgBurn{
-		Denom:  "ueure",
-		Signer: utils.TestAccount().Address,
+		Denom:     "ueure",
+		Signer:    utils.TestAccount().Address,
+		From:      "noble1rwvjzk28l38js7xx6mq23nrpghd8qqvxmj6ep2",
+		Amount:    One,
+		Signature: signature,
+		PubKey:    pubKey,
 	})
 	// ASSERT: The action should've failed due to invalid signer.
 	require.ErrorIs(t, err, types.ErrInvalidSystem)
@@ -356,28 +626,35 @@ func TestBurn(t *testing.T) {
 	// ACT: Attempt to burn with invalid any.
 	invalidPubKey, _ := codectypes.NewAnyWithValue(&types.MsgBurn{})
 	_, err = server.Burn(ctx, &types.MsgBurn{
-		Denom:  "ueure",
-		Signer: system.Address,
-		PubKey: invalidPubKey,
+		Denom:     "ueure",
+		Signer:    system.Address,
+		From:      "noble1rwvjzk28l38js7xx6mq23nrpghd8qqvxmj6ep2",
+		Amount:    One,
+		Signature: signature,
+		PubKey:    invalidPubKey,
 	})
 	// ASSERT: The action should've failed due to invalid any.
 	require.ErrorContains(t, err, "unable to unpack pubkey")
 
 	// ACT: Attempt to burn from invalid user address.
 	_, err = server.Burn(ctx, &types.MsgBurn{
-		Denom:  "ueure",
-		Signer: system.Address,
-		From:   utils.TestAccount().Invalid,
-		PubKey: pubKey,
+		Denom:     "ueure",
+		Signer:    system.Address,
+		From:      utils.TestAccount().Invalid,
+		Amount:    One,
+		Signature: signature,
+		PubKey:    pubKey,
 	})
 	// ASSERT: The action should've failed due to invalid user address.
-	require.ErrorContains(t, err, "unable to decode user address")
+	require.ErrorIs(t, err, types.ErrInvalidAddress)
 
 	// ACT: Attempt to burn with missing public key.
 	_, err = server.Burn(ctx, &types.MsgBurn{
-		Denom:  "ueure",
-		Signer: system.Address,
-		From:   utils.TestAccount().Address,
+		Denom:     "ueure",
+		Signer:    system.Address,
+		From:      utils.TestAccount().Address,
+		Amount:    One,
+		Signature: signature,
 	})
 	// ASSERT: The action should've failed due to invalid public key.
 	require.ErrorIs(t, err, types.ErrInvalidPubKey)
@@ -385,28 +662,30 @@ func TestBurn(t *testing.T) {
 	// ACT: Attempt to burn with invalid public key.
 	invalidPubKey, _ = codectypes.NewAnyWithValue(secp256k1.GenPrivKey().PubKey())
 	_, err = server.Burn(ctx, &types.MsgBurn{
-		Denom:  "ueure",
-		Signer: system.Address,
-		From:   "noble1rwvjzk28l38js7xx6mq23nrpghd8qqvxmj6ep2",
-		PubKey: invalidPubKey,
+		Denom:     "ueure",
+		Signer:    system.Address,
+		From:      "noble1rwvjzk28l38js7xx6mq23nrpghd8qqvxmj6ep2",
+		Amount:    One,
+		Signature: signature,
+		PubKey:    invalidPubKey,
 	})
 	// ASSERT: The action should've failed due to invalid public key.
 	require.ErrorIs(t, err, types.ErrInvalidPubKey)
 
 	// ACT: Attempt to burn with invalid signature.
-	signature, _ := base64.StdEncoding.DecodeString("QBrRfIqjdBvXx9zaBcuiE9P5SVesxFO/He3deyx2OE0NoSNqwmSb7b5iP2UhZRI1duiOeho3+NETUkCBv14zjQ==")
+	invalidSignature, _ := base64.StdEncoding.DecodeString("QBrRfIqjdBvXx9zaBcuiE9P5SVesxFO/He3deyx2OE0NoSNqwmSb7b5iP2UhZRI1duiOeho3+NETUkCBv14zjQ==")
 	_, err = server.Burn(ctx, &types.MsgBurn{
 		Denom:     "ueure",
 		Signer:    system.Address,
 		From:      "noble1rwvjzk28l38js7xx6mq23nrpghd8qqvxmj6ep2",
-		Signature: signature,
+		Amount:    One,
+		Signature: invalidSignature,
 		PubKey:    pubKey,
 	})
 	// ASSERT: The action should've failed due to invalid signature.
 	require.ErrorIs(t, err, types.ErrInvalidSignature)
 
 	// ACT: Attempt to burn with insufficient balance.
-	signature, _ = base64.StdEncoding.DecodeString("qe5dDxdOgY8B2LjMqnK5/5iRIFOCwdTu0G5ZQ66bHzVgP15V2Fb+fzOH0wPAUC5GUQ23M1cSvysulzKIbXY/4Q==")
 	_, err = server.Burn(ctx, &types.MsgBurn{
 		Denom:     "ueure",
 		Signer:    system.Address,
@@ -433,14 +712,81 @@ func TestBurn(t *testing.T) {
 	// ASSERT: The action should've succeeded.
 	require.NoError(t, err)
 	require.True(t, bank.Balances["noble1rwvjzk28l38js7xx6mq23nrpghd8qqvxmj6ep2"].IsZero())
+	events := ctx.EventManager().Events()
+	require.NotEmpty(t, events)
+	require.Equal(t, "florin.v2.Burned", events[len(events)-1].Type)
+	event, err := sdk.ParseTypedEvent(abci.Event(events[len(events)-1]))
+	require.NoError(t, err)
+	require.Equal(t, &types.Burned{
+		Denom:  "ueure",
+		System: system.Address,
+		From:   "noble1rwvjzk28l38js7xx6mq23nrpghd8qqvxmj6ep2",
+		Amount: One,
+	}, event)
 }
 
-func TestMint(t *testing.T) {
+func TestBurnWithReference(t *testing.T) {
+	bank := mocks.BankKeeper{
+		Balances:    make(map[string]sdk.Coins),
+		Restriction: mocks.NoOpSendRestrictionFn,
+	}
+	k, ctx := mocks.FlorinWithKeepers(bank)
+	ctx = ctx.WithChainID("florin-1").WithBlockHeight(10)
+	server := keeper.NewMsgServer(k)
+
+	// ARRANGE: Set system in state, and give a user 2 $EURe.
+	system, user := utils.TestAccount(), utils.TestSigner()
+	require.NoError(t, k.SetSystem(ctx, "ueure", system.Address))
+	pubKey, _ := codectypes.NewAnyWithValue(user.PrivKey.PubKey())
+	bank.Balances[user.Address] = sdk.NewCoins(sdk.NewCoin("ueure", One.MulRaw(2)))
+
+	// ACT: Attempt to burn with a reference.
+	doc := types.NewBurnSignDoc("florin-1", "ueure", One.String(), system.Address, 0, 20)
+	_, err := server.Burn(ctx, &types.MsgBurn{
+		Denom:        "ueure",
+		Signer:       system.Address,
+		From:         user.Address,
+		Amount:       One,
+		Signature:    user.SignArbitrary(doc.Bytes()),
+		PubKey:       pubKey,
+		ExpiryHeight: 20,
+		Reference:    "SEPA-1",
+	})
+	// ASSERT: The action should've succeeded, and recorded the reference.
+	require.NoError(t, err)
+	reference, found := k.GetReference(ctx, "ueure", "SEPA-1")
+	require.True(t, found)
+	require.True(t, reference.Burn)
+	require.Equal(t, user.Address, reference.Account)
+	require.Equal(t, One, reference.Amount)
+	events := ctx.EventManager().Events()
+	require.Equal(t, "florin.v2.Burned", events[len(events)-1].Type)
+	require.Contains(t, events[len(events)-1].Attributes, abci.EventAttribute{Key: "reference", Value: "\"SEPA-1\""})
+
+	// ACT: Attempt to burn again with the same reference.
+	doc = types.NewBurnSignDoc("florin-1", "ueure", One.String(), system.Address, 1, 20)
+	_, err = server.Burn(ctx, &types.MsgBurn{
+		Denom:        "ueure",
+		Signer:       system.Address,
+		From:         user.Address,
+		Amount:       One,
+		Signature:    user.SignArbitrary(doc.Bytes()),
+		PubKey:       pubKey,
+		ExpiryHeight: 20,
+		Reference:    "SEPA-1",
+	})
+	// ASSERT: The action should've failed due to duplicate reference.
+	require.ErrorIs(t, err, types.ErrDuplicateReference)
+	require.Equal(t, One, bank.Balances[user.Address].AmountOf("ueure"))
+}
+
+func TestBurnWithSignDoc(t *testing.T) {
 	bank := mocks.BankKeeper{
 		Balances:    make(map[string]sdk.Coins),
 		Restriction: mocks.NoOpSendRestrictionFn,
 	}
 	k, ctx := mocks.FlorinWithKeepers(bank)
+	ctx = ctx.WithChainID("florin-1").WithBlockHeight(10)
 	server := keeper.NewMsgServer(k)
 
 	// ARRANGE: Set system in state.
@@ -448,108 +794,189 @@ func TestMint(t *testing.T) {
 	err := k.SetSystem(ctx, "ueure", system.Address)
 	require.NoError(t, err)
 
-	// ACT: Attempt to mint invalid denom.
-	_, err = server.Mint(ctx, &types.MsgMint{Denom: "uusde"})
-	// ASSERT: The action should've failed due to invalid denom.
-	require.ErrorContains(t, err, "uusde is not an allowed denom")
+	// ARRANGE: Generate a user account and give them 2 $EURe.
+	user := utils.TestSigner()
+	pubKey, _ := codectypes.NewAnyWithValue(user.PrivKey.PubKey())
+	bank.Balances[user.Address] = sdk.NewCoins(sdk.NewCoin("ueure", One.MulRaw(2)))
 
-	// ACT: Attempt to mint with invalid signer.
-	_, err = server.Mint(ctx, &types.MsgMint{
-		Denom:  "ueure",
-		Signer: utils.TestAccount().Address,
+	// ACT: Attempt to burn with expired signature.
+	doc := types.NewBurnSignDoc("florin-1", "ueure", One.String(), system.Address, 0, 9)
+	_, err = server.Burn(ctx, &types.MsgBurn{
+		Denom:        "ueure",
+		Signer:       system.Address,
+		From:         user.Address,
+		Amount:       One,
+		Signature:    user.SignArbitrary(doc.Bytes()),
+		PubKey:       pubKey,
+		ExpiryHeight: 9,
+	})
+	// ASSERT: The action should've failed due to expired signature.
+	require.ErrorIs(t, err, types.ErrSignatureExpired)
+
+	// ACT: Attempt to burn with signature for another chain.
+	doc = types.NewBurnSignDoc("florin-2", "ueure", One.String(), system.Address, 0, 20)
+	_, err = server.Burn(ctx, &types.MsgBurn{
+		Denom:        "ueure",
+		Signer:       system.Address,
+		From:         user.Address,
+		Amount:       One,
+		Signature:    user.SignArbitrary(doc.Bytes()),
+		PubKey:       pubKey,
+		ExpiryHeight: 20,
 	})
-	// ASSERT: The action should've failed due to invalid signer.
-	require.ErrorIs(t, err, types.ErrInvalidSystem)
+	// ASSERT: The action should've failed due to invalid signature.
+	require.ErrorIs(t, err, types.ErrInvalidSignature)
 
-	// ACT: Attempt to mint with no allowance.
-	_, err = server.Mint(ctx, &types.MsgMint{
-		Denom:  "ueure",
-		Signer: system.Address,
-		Amount: One,
+	// ACT: Attempt to burn a different amount than signed.
+	doc = types.NewBurnSignDoc("florin-1", "ueure", One.String(), system.Address, 0, 20)
+	signature := user.SignArbitrary(doc.Bytes())
+	_, err = server.Burn(ctx, &types.MsgBurn{
+		Denom:        "ueure",
+		Signer:       system.Address,
+		From:         user.Address,
+		Amount:       One.MulRaw(2),
+		Signature:    signature,
+		PubKey:       pubKey,
+		ExpiryHeight: 20,
 	})
-	// ASSERT: The action should've failed due to no allowance.
-	require.ErrorIs(t, err, types.ErrInsufficientAllowance)
+	// ASSERT: The action should've failed due to invalid signature.
+	require.ErrorIs(t, err, types.ErrInvalidSignature)
 
-	// ARRANGE: Set mint allowance in state.
-	err = k.SetMintAllowance(ctx, "ueure", system.Address, One)
+	// ACT: Attempt to burn.
+	_, err = server.Burn(ctx, &types.MsgBurn{
+		Denom:        "ueure",
+		Signer:       system.Address,
+		From:         user.Address,
+		Amount:       One,
+		Signature:    signature,
+		PubKey:       pubKey,
+		ExpiryHeight: 20,
+	})
+	// ASSERT: The action should've succeeded, and consumed the nonce.
 	require.NoError(t, err)
+	require.Equal(t, One, bank.Balances[user.Address].AmountOf("ueure"))
+	require.Equal(t, uint64(1), k.GetNonce(ctx, user.Address))
 
-	// ACT: Attempt to mint with insufficient allowance.
-	_, err = server.Mint(ctx, &types.MsgMint{
-		Denom:  "ueure",
-		Signer: system.Address,
-		Amount: One.MulRaw(2),
+	// ACT: Attempt to replay burn.
+	_, err = server.Burn(ctx, &types.MsgBurn{
+		Denom:        "ueure",
+		Signer:       system.Address,
+		From:         user.Address,
+		Amount:       One,
+		Signature:    signature,
+		PubKey:       pubKey,
+		ExpiryHeight: 20,
 	})
-	// ASSERT: The action should've failed due to insufficient allowance.
-	require.ErrorIs(t, err, types.ErrInsufficientAllowance)
-
-	// ARRANGE: Generate a user account.
-	user := utils.TestAccount()
+	// ASSERT: The action should've failed due to invalid signature.
+	require.ErrorIs(t, err, types.ErrInvalidSignature)
+	require.Equal(t, One, bank.Balances[user.Address].AmountOf("ueure"))
+}
 
-	// ARRANGE: Set up a failing collection store for the attribute setter.
-	tmp := k.MintAllowance
-	k.MintAllowance = collections.NewMap(
-		collections.NewSchemaBuilder(mocks.FailingStore(mocks.Set, utils.GetKVStore(ctx, types.ModuleName))),
-		types.MintAllowancePrefix, "mintAllowance", collections.BytesKey, collections.BytesValue,
-	)
+func TestCancelMint(t *testing.T) {
+	k, ctx := mocks.FlorinKeeper()
+	server := keeper.NewMsgServer(k)
 
-	// ACT: Attempt to mint with failing MintAllowance collection store.
-	_, err = server.Mint(ctx, &types.MsgMint{
-		Denom:  "ueure",
-		Signer: system.Address,
-		To:     user.Address,
-		Amount: One,
+	// ACT: Attempt to cancel mint with not allowed denom.
+	_, err := server.CancelMint(ctx, &types.MsgCancelMint{
+		Denom:  "uusde",
+		Signer: utils.TestAccount().Address,
 	})
-	// ASSERT: The action should've failed due to collection store setter error.
-	require.Error(t, err, mocks.ErrorStoreAccess)
-	k.MintAllowance = tmp
+	// ASSERT: The action should've failed due to not allowed denom.
+	require.ErrorContains(t, err, "uusde is not an allowed denom")
 
-	// ACT: Attempt to mint to invalid user address.
-	_, err = server.Mint(ctx, &types.MsgMint{
+	// ACT: Attempt to cancel mint that doesn't exist.
+	_, err = server.CancelMint(ctx, &types.MsgCancelMint{
 		Denom:  "ueure",
-		Signer: system.Address,
-		To:     user.Invalid,
-		Amount: One,
-	})
-	// ASSERT: The action should've failed due to invalid user address.
-	require.ErrorContains(t, err, "unable to decode user address")
-
-	// ARRANGE: Reset mint allowance in state.
-	err = k.SetMintAllowance(ctx, "ueure", system.Address, One)
+		Signer: utils.TestAccount().Address,
+		Id:     1,
+	})
+	// ASSERT: The action should've failed due to no pending mint.
+	require.ErrorIs(t, err, types.ErrNoPendingMint)
+
+	// ARRANGE: Set owner, system, allowance and approval policy in state, and create a pending mint.
+	owner, system, user := utils.TestAccount(), utils.TestAccount(), utils.TestAccount()
+	require.NoError(t, k.SetOwner(ctx, "ueure", owner.Address))
+	require.NoError(t, k.SetSystem(ctx, "ueure", system.Address))
+	require.NoError(t, k.SetMintAllowance(ctx, "ueure", system.Address, One.MulRaw(10)))
+	require.NoError(t, k.SetMintApprovalPolicy(ctx, types.MintApprovalPolicy{
+		Denom: "ueure", Threshold: One, Quorum: 1, ExpiryBlocks: 10,
+	}))
+	res, err := server.Mint(ctx, &types.MsgMint{Denom: "ueure", Signer: system.Address, To: user.Address, Amount: One.MulRaw(2)})
 	require.NoError(t, err)
+	require.Equal(t, One.MulRaw(8), k.GetMintAllowance(ctx, "ueure", system.Address))
 
-	// ACT: Attempt to mint.
-	_, err = server.Mint(ctx, &types.MsgMint{
+	// ACT: Attempt to cancel mint with invalid signer.
+	_, err = server.CancelMint(ctx, &types.MsgCancelMint{
 		Denom:  "ueure",
-		Signer: system.Address,
-		To:     user.Address,
-		Amount: One,
+		Signer: utils.TestAccount().Address,
+		Id:     res.PendingMintId,
 	})
-	// ASSERT: The action should've succeeded.
-	require.NoError(t, err)
-	require.Equal(t, One, bank.Balances[user.Address].AmountOf("ueure"))
+	// ASSERT: The action should've failed due to invalid signer.
+	require.ErrorIs(t, err, types.ErrInvalidCanceller)
+
+	// ACT: Attempt to cancel mint.
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	_, err = server.CancelMint(ctx, &types.MsgCancelMint{
+		Denom:  "ueure",
+		Signer: owner.Address,
+		Id:     res.PendingMintId,
+	})
+	// ASSERT: The action should've succeeded, and refunded the allowance.
 	require.NoError(t, err)
-	require.True(t, k.GetMintAllowance(ctx, "ueure", system.Address).IsZero())
+	_, found := k.GetPendingMint(ctx, "ueure", res.PendingMintId)
+	require.False(t, found)
+	require.Equal(t, One.MulRaw(10), k.GetMintAllowance(ctx, "ueure", system.Address))
 	events := ctx.EventManager().Events()
 	require.Len(t, events, 2)
-	require.Equal(t, "florin.v2.MintAllowance", events[1].Type)
+	require.Equal(t, "florin.v2.MintAllowance", events[0].Type)
+	require.Equal(t, "florin.v2.PendingMintCancelled", events[1].Type)
 }
 
-func TestRecover(t *testing.T) {
-	// The below signature was generated using Keplr's signArbitrary function.
-	//
-	// share bubble good swarm sustain leaf burst build spirit inflict undo shadow antique warm soft praise foam slab laptop hint giggle also book treat
-	//
-	// {
-	//     "pub_key": {
-	//         "type": "tendermint/PubKeySecp256k1",
-	//         "value": "AlE8CxHR19ID5lxrVtTxSgJFlK3T+eYtyDM/vBA3Fowr"
-	//     },
-	//     "signature": "qe5dDxdOgY8B2LjMqnK5/5iRIFOCwdTu0G5ZQ66bHzVgP15V2Fb+fzOH0wPAUC5GUQ23M1cSvysulzKIbXY/4Q=="
-	// }
-	bz, _ := base64.StdEncoding.DecodeString("AlE8CxHR19ID5lxrVtTxSgJFlK3T+eYtyDM/vBA3Fowr")
-	pubKey, _ := codectypes.NewAnyWithValue(&secp256k1.PubKey{Key: bz})
+func TestDeprecateLegacySignatures(t *testing.T) {
+	k, ctx := mocks.FlorinKeeper()
+	server := keeper.NewMsgServer(k)
+
+	// ACT: Attempt to deprecate legacy signatures with invalid signer.
+	_, err := server.DeprecateLegacySignatures(ctx, &types.MsgDeprecateLegacySignatures{
+		Signer:     utils.TestAccount().Address,
+		Deprecated: true,
+	})
+	// ASSERT: The action should've failed due to invalid signer.
+	require.ErrorIs(t, err, types.ErrInvalidAuthority)
+
+	// ACT: Attempt to deprecate legacy signatures.
+	_, err = server.DeprecateLegacySignatures(ctx, &types.MsgDeprecateLegacySignatures{
+		Signer:     mocks.Authority,
+		Deprecated: true,
+	})
+	// ASSERT: The action should've succeeded.
+	require.NoError(t, err)
+	require.True(t, k.IsLegacySignaturesDeprecated(ctx))
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, "florin.v2.LegacySignaturesDeprecated", events[0].Type)
+
+	// ARRANGE: Set system in state.
+	system := utils.TestAccount()
+	err = k.SetSystem(ctx, "ueure", system.Address)
+	require.NoError(t, err)
+
+	// ACT: Attempt to burn with legacy signature.
+	user := utils.TestSigner()
+	pubKey, _ := codectypes.NewAnyWithValue(user.PrivKey.PubKey())
+	_, err = server.Burn(ctx, &types.MsgBurn{
+		Denom:     "ueure",
+		Signer:    system.Address,
+		From:      user.Address,
+		Amount:    One,
+		Signature: user.SignArbitrary([]byte(types.LegacySignMessage)),
+		PubKey:    pubKey,
+	})
+	// ASSERT: The action should've failed due to deprecated legacy signatures.
+	require.ErrorIs(t, err, types.ErrLegacySignature)
+}
 
+func TestMint(t *testing.T) {
 	bank := mocks.BankKeeper{
 		Balances:    make(map[string]sdk.Coins),
 		Restriction: mocks.NoOpSendRestrictionFn,
@@ -562,270 +989,2341 @@ func TestRecover(t *testing.T) {
 	err := k.SetSystem(ctx, "ueure", system.Address)
 	require.NoError(t, err)
 
-	// ACT: Attempt to recover invalid denom.
-	_, err = server.Recover(ctx, &types.MsgRecover{Denom: "uusde"})
+	// ACT: Attempt to mint invalid denom.
+	_, err = server.Mint(ctx, &types.MsgMint{
+		Denom:  "uusde",
+		Signer: utils.TestAccount().Address,
+		To:     utils.TestAccount().Address,
+		Amount: One,
+	})
 	// ASSERT: The action should've failed due to invalid denom.
 	require.ErrorContains(t, err, "uusde is not an allowed denom")
 
-	// ACT: Attempt to recover with invalid signer.
-	_, err = server.Recover(ctx, &types.MsgRecover{
+	// ARRANGE: Pause denom in state.
+	err = k.SetPaused(ctx, "ueure", true)
+	require.NoError(t, err)
+
+	// ACT: Attempt to mint paused denom.
+	_, err = server.Mint(ctx, &types.MsgMint{
 		Denom:  "ueure",
 		Signer: utils.TestAccount().Address,
+		To:     utils.TestAccount().Address,
+		Amount: One,
 	})
-	// ASSERT: The action should've failed due to invalid signer.
-	require.ErrorIs(t, err, types.ErrInvalidSystem)
+	// ASSERT: The action should've failed due to paused denom.
+	require.ErrorIs(t, err, types.ErrPaused)
 
-	// ACT: Attempt to recover with invalid any.
-	invalidPubKey, _ := codectypes.NewAnyWithValue(&types.MsgRecover{})
-	_, err = server.Recover(ctx, &types.MsgRecover{
+	// ARRANGE: Unpause denom in state.
+	err = k.SetPaused(ctx, "ueure", false)
+	require.NoError(t, err)
+
+	// ACT: Attempt to mint with invalid signer.
+	_, err = server.Mint(ctx, &types.MsgMint{
 		Denom:  "ueure",
-		Signer: system.Address,
-		PubKey: invalidPubKey,
+		Signer: utils.TestAccount().Address,
+		To:     utils.TestAccount().Address,
+		Amount: One,
 	})
-	// ASSERT: The action should've failed due to invalid any.
-	require.ErrorContains(t, err, "unable to unpack pubkey")
+	// ASSERT: The action should've failed due to invalid signer.
+	require.ErrorIs(t, err, types.ErrInvalidSystem)
 
-	// ACT: Attempt to recover from invalid user address.
-	_, err = server.Recover(ctx, &types.MsgRecover{
+	// ACT: Attempt to mint with no allowance.
+	_, err = server.Mint(ctx, &types.MsgMint{
 		Denom:  "ueure",
 		Signer: system.Address,
-		From:   utils.TestAccount().Invalid,
-		PubKey: pubKey,
+		To:     utils.TestAccount().Address,
+		Amount: One,
 	})
-	// ASSERT: The action should've failed due to invalid user address.
-	require.ErrorContains(t, err, "unable to decode user address")
+	// ASSERT: The action should've failed due to no allowance.
+	require.ErrorIs(t, err, types.ErrInsufficientAllowance)
 
-	// ACT: Attempt to recover with invalid public key.
-	invalidPubKey, _ = codectypes.NewAnyWithValue(secp256k1.GenPrivKey().PubKey())
-	_, err = server.Recover(ctx, &types.MsgRecover{
+	// ARRANGE: Set mint allowance in state.
+	err = k.SetMintAllowance(ctx, "ueure", system.Address, One)
+	require.NoError(t, err)
+
+	// ACT: Attempt to mint with insufficient allowance.
+	_, err = server.Mint(ctx, &types.MsgMint{
 		Denom:  "ueure",
 		Signer: system.Address,
-		From:   "noble1rwvjzk28l38js7xx6mq23nrpghd8qqvxmj6ep2",
-		PubKey: invalidPubKey,
+		To:     utils.TestAccount().Address,
+		Amount: One.MulRaw(2),
 	})
-	// ASSERT: The action should've failed due to invalid public key.
-	require.ErrorIs(t, err, types.ErrInvalidPubKey)
+	// ASSERT: The action should've failed due to insufficient allowance.
+	require.ErrorIs(t, err, types.ErrInsufficientAllowance)
 
-	// ACT: Attempt to recover with missing public key.
-	_, err = server.Recover(ctx, &types.MsgRecover{
+	// ARRANGE: Generate an adversary account.
+	adversary := utils.TestAccount()
+	err = k.SetAdversary(ctx, adversary.Address)
+	require.NoError(t, err)
+
+	// ACT: Attempt to mint to adversary.
+	_, err = server.Mint(ctx, &types.MsgMint{
 		Denom:  "ueure",
 		Signer: system.Address,
-		From:   "noble1rwvjzk28l38js7xx6mq23nrpghd8qqvxmj6ep2",
+		To:     adversary.Address,
+		Amount: One,
 	})
-	// ASSERT: The action should've failed due to invalid public key.
-	require.ErrorIs(t, err, types.ErrInvalidPubKey)
+	// ASSERT: The action should've failed due to blocked recipient.
+	require.ErrorContains(t, err, "blocked from receiving")
+	require.Equal(t, One, k.GetMintAllowance(ctx, "ueure", system.Address))
 
-	// ACT: Attempt to recover with invalid signature.
-	signature, _ := base64.StdEncoding.DecodeString("QBrRfIqjdBvXx9zaBcuiE9P5SVesxFO/He3deyx2OE0NoSNqwmSb7b5iP2UhZRI1duiOeho3+NETUkCBv14zjQ==")
-	_, err = server.Recover(ctx, &types.MsgRecover{
-		Denom:     "ueure",
-		Signer:    system.Address,
-		From:      "noble1rwvjzk28l38js7xx6mq23nrpghd8qqvxmj6ep2",
-		Signature: signature,
-		PubKey:    pubKey,
-	})
-	// ASSERT: The action should've failed due to invalid signature.
-	require.ErrorIs(t, err, types.ErrInvalidSignature)
+	// ARRANGE: Generate a user account.
+	user := utils.TestAccount()
 
-	// ARRANGE: Generate a recipient address.
-	recipient := utils.TestAccount()
+	// ARRANGE: Set up a failing collection store for the attribute setter.
+	tmp := k.MintAllowance
+	k.MintAllowance = collections.NewMap(
+		collections.NewSchemaBuilder(mocks.FailingStore(mocks.Set, utils.GetKVStore(ctx, types.ModuleName))),
+		types.MintAllowancePrefix, "mintAllowance", types.AccountKeyCodec, collections.BytesValue,
+	)
 
-	// ACT: Attempt to recover with no balance.
-	signature, _ = base64.StdEncoding.DecodeString("qe5dDxdOgY8B2LjMqnK5/5iRIFOCwdTu0G5ZQ66bHzVgP15V2Fb+fzOH0wPAUC5GUQ23M1cSvysulzKIbXY/4Q==")
-	_, err = server.Recover(ctx, &types.MsgRecover{
-		Denom:     "ueure",
-		Signer:    system.Address,
-		From:      "noble1rwvjzk28l38js7xx6mq23nrpghd8qqvxmj6ep2",
-		To:        recipient.Address,
-		Signature: signature,
-		PubKey:    pubKey,
+	// ACT: Attempt to mint with failing MintAllowance collection store.
+	_, err = server.Mint(ctx, &types.MsgMint{
+		Denom:  "ueure",
+		Signer: system.Address,
+		To:     user.Address,
+		Amount: One,
 	})
-	// ASSERT: The action should've succeeded.
-	require.NoError(t, err)
-
-	// ARRANGE: Give user 1 $EURe.
-	bank.Balances["noble1rwvjzk28l38js7xx6mq23nrpghd8qqvxmj6ep2"] = sdk.NewCoins(sdk.NewCoin("ueure", One))
+	// ASSERT: The action should've failed due to collection store setter error.
+	require.Error(t, err, mocks.ErrorStoreAccess)
+	k.MintAllowance = tmp
 
-	// ACT: Attempt to recover to invalid user address.
-	_, err = server.Recover(ctx, &types.MsgRecover{
-		Denom:     "ueure",
-		Signer:    system.Address,
-		From:      "noble1rwvjzk28l38js7xx6mq23nrpghd8qqvxmj6ep2",
-		To:        recipient.Invalid,
-		Signature: signature,
-		PubKey:    pubKey,
+	// ACT: Attempt to mint to invalid user address.
+	_, err = server.Mint(ctx, &types.MsgMint{
+		Denom:  "ueure",
+		Signer: system.Address,
+		To:     user.Invalid,
+		Amount: One,
 	})
 	// ASSERT: The action should've failed due to invalid user address.
-	require.ErrorContains(t, err, "unable to decode user address")
+	require.ErrorIs(t, err, types.ErrInvalidAddress)
 
-	// ACT: Attempt to recover.
-	_, err = server.Recover(ctx, &types.MsgRecover{
-		Denom:     "ueure",
-		Signer:    system.Address,
-		From:      "noble1rwvjzk28l38js7xx6mq23nrpghd8qqvxmj6ep2",
-		To:        recipient.Address,
-		Signature: signature,
-		PubKey:    pubKey,
+	// ARRANGE: Reset mint allowance in state.
+	err = k.SetMintAllowance(ctx, "ueure", system.Address, One)
+	require.NoError(t, err)
+
+	// ACT: Attempt to mint.
+	_, err = server.Mint(ctx, &types.MsgMint{
+		Denom:  "ueure",
+		Signer: system.Address,
+		To:     user.Address,
+		Amount: One,
 	})
 	// ASSERT: The action should've succeeded.
 	require.NoError(t, err)
-	require.True(t, bank.Balances["noble1rwvjzk28l38js7xx6mq23nrpghd8qqvxmj6ep2"].IsZero())
-	require.Equal(t, One, bank.Balances[recipient.Address].AmountOf("ueure"))
+	require.Equal(t, One, bank.Balances[user.Address].AmountOf("ueure"))
+	require.NoError(t, err)
+	require.True(t, k.GetMintAllowance(ctx, "ueure", system.Address).IsZero())
 	events := ctx.EventManager().Events()
-	require.Len(t, events, 2)
-	require.Equal(t, "florin.v2.Recovered", events[1].Type)
+	require.Len(t, events, 3)
+	require.Equal(t, "florin.v2.MintAllowance", events[1].Type)
+	require.Equal(t, "florin.v2.Minted", events[2].Type)
+	event, err := sdk.ParseTypedEvent(abci.Event(events[2]))
+	require.NoError(t, err)
+	require.Equal(t, &types.Minted{
+		Denom:  "ueure",
+		System: system.Address,
+		To:     user.Address,
+		Amount: One,
+	}, event)
 }
 
-func TestRemoveAdminAccount(t *testing.T) {
-	k, ctx := mocks.FlorinKeeper()
+func TestMintBatch(t *testing.T) {
+	bank := mocks.BankKeeper{
+		Balances:    make(map[string]sdk.Coins),
+		Restriction: mocks.NoOpSendRestrictionFn,
+	}
+	k, ctx := mocks.FlorinWithKeepers(bank)
 	server := keeper.NewMsgServer(k)
 
-	// ACT: Attempt to remove admin account with not allowed denom.
-	_, err := server.RemoveAdminAccount(ctx, &types.MsgRemoveAdminAccount{
-		Denom: "uusde",
+	// ACT: Attempt to mint batch with not allowed denom.
+	_, err := server.MintBatch(ctx, &types.MsgMintBatch{
+		Denom:      "uusde",
+		Signer:     utils.TestAccount().Address,
+		Recipients: []types.MintRecipient{{To: utils.TestAccount().Address, Amount: One}},
 	})
 	// ASSERT: The action should've failed due to not allowed denom.
 	require.ErrorContains(t, err, "uusde is not an allowed denom")
 
-	// ACT: Attempt to remove admin account with no owner set.
-	_, err = server.RemoveAdminAccount(ctx, &types.MsgRemoveAdminAccount{
-		Denom: "ueure",
+	// ACT: Attempt to mint batch with invalid signer.
+	_, err = server.MintBatch(ctx, &types.MsgMintBatch{
+		Denom:      "ueure",
+		Signer:     utils.TestAccount().Address,
+		Recipients: []types.MintRecipient{{To: utils.TestAccount().Address, Amount: One}},
 	})
-	// ASSERT: The action should've failed due to no owner set.
-	require.ErrorIs(t, err, types.ErrNoOwner)
+	// ASSERT: The action should've failed due to invalid signer.
+	require.ErrorIs(t, err, types.ErrInvalidSystem)
 
-	// ARRANGE: Set owner in state.
-	owner := utils.TestAccount()
-	err = k.SetOwner(ctx, "ueure", owner.Address)
+	// ARRANGE: Set system with allowance in state.
+	system, user1, user2, adversary := utils.TestAccount(), utils.TestAccount(), utils.TestAccount(), utils.TestAccount()
+	require.NoError(t, k.SetSystem(ctx, "ueure", system.Address))
+	require.NoError(t, k.SetMintAllowance(ctx, "ueure", system.Address, One.MulRaw(10)))
+
+	// ACT: Attempt to mint batch without recipients.
+	_, err = server.MintBatch(ctx, &types.MsgMintBatch{
+		Denom:  "ueure",
+		Signer: system.Address,
+	})
+	// ASSERT: The action should've failed due to an empty batch.
+	require.ErrorIs(t, err, types.ErrInvalidBatch)
+
+	// ACT: Attempt to mint batch with a zero amount.
+	_, err = server.MintBatch(ctx, &types.MsgMintBatch{
+		Denom:  "ueure",
+		Signer: system.Address,
+		Recipients: []types.MintRecipient{
+			{To: user1.Address, Amount: One},
+			{To: user2.Address, Amount: math.ZeroInt()},
+		},
+	})
+	// ASSERT: The action should've failed due to an invalid amount.
+	require.ErrorIs(t, err, types.ErrInvalidAmount)
+
+	// ARRANGE: Set adversary in state.
+	require.NoError(t, k.SetAdversary(ctx, adversary.Address))
+
+	// ACT: Attempt to mint batch with a blocked recipient.
+	_, err = server.MintBatch(ctx, &types.MsgMintBatch{
+		Denom:  "ueure",
+		Signer: system.Address,
+		Recipients: []types.MintRecipient{
+			{To: user1.Address, Amount: One},
+			{To: adversary.Address, Amount: One},
+		},
+	})
+	// ASSERT: The action should've failed due to blocked recipient.
+	require.ErrorContains(t, err, "blocked from receiving")
+	require.True(t, bank.Balances[user1.Address].IsZero())
+
+	// ACT: Attempt to mint batch exceeding the allowance in total.
+	_, err = server.MintBatch(ctx, &types.MsgMintBatch{
+		Denom:  "ueure",
+		Signer: system.Address,
+		Recipients: []types.MintRecipient{
+			{To: user1.Address, Amount: One.MulRaw(6)},
+			{To: user2.Address, Amount: One.MulRaw(5)},
+		},
+	})
+	// ASSERT: The action should've failed due to insufficient allowance.
+	require.ErrorIs(t, err, types.ErrInsufficientAllowance)
+
+	// ACT: Attempt to mint batch.
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	res, err := server.MintBatch(ctx, &types.MsgMintBatch{
+		Denom:  "ueure",
+		Signer: system.Address,
+		Recipients: []types.MintRecipient{
+			{To: user1.Address, Amount: One, Reference: "SEPA-1"},
+			{To: user2.Address, Amount: One.MulRaw(2), Reference: "SEPA-2"},
+		},
+	})
+	// ASSERT: The action should've succeeded, debiting the allowance once.
 	require.NoError(t, err)
+	require.Empty(t, res.PendingMintIds)
+	require.Equal(t, One, bank.Balances[user1.Address].AmountOf("ueure"))
+	require.Equal(t, One.MulRaw(2), bank.Balances[user2.Address].AmountOf("ueure"))
+	require.Equal(t, One.MulRaw(7), k.GetMintAllowance(ctx, "ueure", system.Address))
+	require.True(t, k.HasReference(ctx, "ueure", "SEPA-2"))
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 5)
+	require.Equal(t, "florin.v2.MintAllowance", events[0].Type)
+	require.Equal(t, "florin.v2.Minted", events[2].Type)
+	require.Equal(t, "florin.v2.Minted", events[4].Type)
 
-	// ACT: Attempt to remove admin account with invalid signer.
-	_, err = server.RemoveAdminAccount(ctx, &types.MsgRemoveAdminAccount{
+	// ACT: Attempt to mint batch with a reused reference.
+	_, err = server.MintBatch(ctx, &types.MsgMintBatch{
 		Denom:  "ueure",
+		Signer: system.Address,
+		Recipients: []types.MintRecipient{
+			{To: user1.Address, Amount: One, Reference: "SEPA-3"},
+			{To: user2.Address, Amount: One, Reference: "SEPA-3"},
+		},
+	})
+	// ASSERT: The action should've failed due to duplicate reference.
+	require.ErrorIs(t, err, types.ErrDuplicateReference)
+
+	// ARRANGE: Set an approval policy in state.
+	require.NoError(t, k.SetMintApprovalPolicy(ctx, types.MintApprovalPolicy{
+		Denom: "ueure", Threshold: One, Quorum: 1, ExpiryBlocks: 10,
+	}))
+
+	// ACT: Attempt to mint batch with a recipient above the approval threshold.
+	res, err = server.MintBatch(ctx, &types.MsgMintBatch{
+		Denom:  "ueure",
+		Signer: system.Address,
+		Recipients: []types.MintRecipient{
+			{To: user1.Address, Amount: One},
+			{To: user2.Address, Amount: One.MulRaw(2)},
+		},
+	})
+	// ASSERT: The action should've succeeded, leaving the large mint pending.
+	require.NoError(t, err)
+	require.Len(t, res.PendingMintIds, 1)
+	require.Equal(t, One.MulRaw(2), bank.Balances[user1.Address].AmountOf("ueure"))
+	require.Equal(t, One.MulRaw(2), bank.Balances[user2.Address].AmountOf("ueure"))
+	_, found := k.GetPendingMint(ctx, "ueure", res.PendingMintIds[0])
+	require.True(t, found)
+}
+
+func TestMintWithAllowlist(t *testing.T) {
+	bank := mocks.BankKeeper{
+		Balances:    make(map[string]sdk.Coins),
+		Restriction: mocks.NoOpSendRestrictionFn,
+	}
+	k, ctx := mocks.FlorinWithKeepers(bank)
+	server := keeper.NewMsgServer(k)
+
+	// ARRANGE: Set system with allowance, and the allowlist transfer policy in state.
+	system, user := utils.TestAccount(), utils.TestAccount()
+	require.NoError(t, k.SetSystem(ctx, "ueure", system.Address))
+	require.NoError(t, k.SetMintAllowance(ctx, "ueure", system.Address, One.MulRaw(2)))
+	require.NoError(t, k.SetTransferPolicy(ctx, "ueure", types.TransferPolicyAllowlist))
+
+	// ACT: Attempt to mint to a non-allowlisted user.
+	_, err := server.Mint(ctx, &types.MsgMint{Denom: "ueure", Signer: system.Address, To: user.Address, Amount: One})
+	// ASSERT: The action should've failed due to the user not being allowlisted.
+	require.ErrorContains(t, err, "blocked from receiving")
+
+	// ARRANGE: Allowlist the user.
+	require.NoError(t, k.SetAllowlisted(ctx, "ueure", user.Address))
+
+	// ACT: Attempt to mint to an allowlisted user.
+	_, err = server.Mint(ctx, &types.MsgMint{Denom: "ueure", Signer: system.Address, To: user.Address, Amount: One})
+	// ASSERT: The action should've succeeded.
+	require.NoError(t, err)
+	require.Equal(t, One, bank.Balances[user.Address].AmountOf("ueure"))
+
+	// ARRANGE: Set the user as adversary, and the open transfer policy in state.
+	require.NoError(t, k.SetAdversary(ctx, user.Address))
+	require.NoError(t, k.SetTransferPolicy(ctx, "ueure", types.TransferPolicyOpen))
+
+	// ACT: Attempt to mint to an adversarial user.
+	_, err = server.Mint(ctx, &types.MsgMint{Denom: "ueure", Signer: system.Address, To: user.Address, Amount: One})
+	// ASSERT: The action should've failed, as global adversaries are always blocked.
+	require.ErrorContains(t, err, "blocked from receiving")
+
+	// ARRANGE: Replace the global ban of the user with a denom ban.
+	require.NoError(t, k.DeleteAdversary(ctx, user.Address))
+	require.NoError(t, k.SetDenomAdversary(ctx, "ueure", user.Address))
+
+	// ACT: Attempt to mint to a denom-banned user.
+	_, err = server.Mint(ctx, &types.MsgMint{Denom: "ueure", Signer: system.Address, To: user.Address, Amount: One})
+	// ASSERT: The action should've failed, as denom bans are still enforced.
+	require.ErrorContains(t, err, "blocked from receiving")
+
+	// ARRANGE: Unban the user.
+	require.NoError(t, k.DeleteDenomAdversary(ctx, "ueure", user.Address))
+
+	// ACT: Attempt to mint to a friendly user.
+	_, err = server.Mint(ctx, &types.MsgMint{Denom: "ueure", Signer: system.Address, To: user.Address, Amount: One})
+	// ASSERT: The action should've succeeded.
+	require.NoError(t, err)
+	require.Equal(t, One.MulRaw(2), bank.Balances[user.Address].AmountOf("ueure"))
+}
+
+func TestMintWithApprovalPolicy(t *testing.T) {
+	bank := mocks.BankKeeper{
+		Balances:    make(map[string]sdk.Coins),
+		Restriction: mocks.NoOpSendRestrictionFn,
+	}
+	k, ctx := mocks.FlorinWithKeepers(bank)
+	server := keeper.NewMsgServer(k)
+
+	// ARRANGE: Set system with allowance and an approval policy in state.
+	system, user := utils.TestAccount(), utils.TestAccount()
+	require.NoError(t, k.SetSystem(ctx, "ueure", system.Address))
+	require.NoError(t, k.SetMintAllowance(ctx, "ueure", system.Address, One.MulRaw(10)))
+	require.NoError(t, k.SetMintApprovalPolicy(ctx, types.MintApprovalPolicy{
+		Denom: "ueure", Threshold: One, Quorum: 1, ExpiryBlocks: 10,
+	}))
+
+	// ACT: Attempt to mint up to the threshold.
+	res, err := server.Mint(ctx, &types.MsgMint{Denom: "ueure", Signer: system.Address, To: user.Address, Amount: One})
+	// ASSERT: The action should've succeeded immediately.
+	require.NoError(t, err)
+	require.Zero(t, res.PendingMintId)
+	require.Equal(t, One, bank.Balances[user.Address].AmountOf("ueure"))
+
+	// ACT: Attempt to mint above the threshold.
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	res, err = server.Mint(ctx, &types.MsgMint{Denom: "ueure", Signer: system.Address, To: user.Address, Amount: One.MulRaw(2)})
+	// ASSERT: The action should've created a pending mint, reserving the allowance.
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), res.PendingMintId)
+	require.Equal(t, One, bank.Balances[user.Address].AmountOf("ueure"))
+	require.Equal(t, One.MulRaw(7), k.GetMintAllowance(ctx, "ueure", system.Address))
+	pendingMint, found := k.GetPendingMint(ctx, "ueure", res.PendingMintId)
+	require.True(t, found)
+	require.Equal(t, ctx.BlockHeight()+10, pendingMint.ExpiryHeight)
+	require.Equal(t, uint32(1), pendingMint.Quorum)
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 2)
+	require.Equal(t, "florin.v2.MintAllowance", events[0].Type)
+	require.Equal(t, "florin.v2.MintPending", events[1].Type)
+
+	// ACT: Expire pending mints before the expiry height has passed.
+	require.NoError(t, k.ExpirePendingMints(ctx.WithBlockHeight(pendingMint.ExpiryHeight)))
+	// ASSERT: The pending mint should still exist.
+	_, found = k.GetPendingMint(ctx, "ueure", res.PendingMintId)
+	require.True(t, found)
+
+	// ACT: Expire pending mints after the expiry height has passed.
+	ctx = ctx.WithEventManager(sdk.NewEventManager()).WithBlockHeight(pendingMint.ExpiryHeight + 1)
+	require.NoError(t, k.ExpirePendingMints(ctx))
+	// ASSERT: The pending mint should've been removed, and the allowance refunded.
+	_, found = k.GetPendingMint(ctx, "ueure", res.PendingMintId)
+	require.False(t, found)
+	require.Equal(t, One.MulRaw(9), k.GetMintAllowance(ctx, "ueure", system.Address))
+	events = ctx.EventManager().Events()
+	require.Len(t, events, 2)
+	require.Equal(t, "florin.v2.MintAllowance", events[0].Type)
+	require.Equal(t, "florin.v2.PendingMintExpired", events[1].Type)
+}
+
+func TestMintWithRateLimits(t *testing.T) {
+	bank := mocks.BankKeeper{
+		Balances:    make(map[string]sdk.Coins),
+		Restriction: mocks.NoOpSendRestrictionFn,
+	}
+	k, ctx := mocks.FlorinWithKeepers(bank)
+	ctx = ctx.WithBlockHeight(10).WithBlockTime(time.Unix(1_000, 0))
+	server := keeper.NewMsgServer(k)
+
+	// ARRANGE: Set systems with allowances and a per-system rate limit in state.
+	system1, system2, user := utils.TestAccount(), utils.TestAccount(), utils.TestAccount()
+	for _, system := range []string{system1.Address, system2.Address} {
+		require.NoError(t, k.SetSystem(ctx, "ueure", system))
+		require.NoError(t, k.SetMintAllowance(ctx, "ueure", system, One.MulRaw(10)))
+	}
+	err := k.SetRateLimit(ctx, types.RateLimit{
+		Denom:        "ueure",
+		Address:      system1.Address,
+		Amount:       One.MulRaw(2),
+		WindowBlocks: 5,
+	})
+	require.NoError(t, err)
+
+	// ACT: Attempt to mint up to the system's rate limit.
+	_, err = server.Mint(ctx, &types.MsgMint{Denom: "ueure", Signer: system1.Address, To: user.Address, Amount: One.MulRaw(2)})
+	// ASSERT: The action should've succeeded.
+	require.NoError(t, err)
+
+	// ACT: Attempt to mint beyond the system's rate limit in the same window.
+	_, err = server.Mint(ctx, &types.MsgMint{Denom: "ueure", Signer: system1.Address, To: user.Address, Amount: One})
+	// ASSERT: The action should've failed due to the exceeded rate limit.
+	require.ErrorIs(t, err, types.ErrRateLimitExceeded)
+	require.Equal(t, One.MulRaw(8), k.GetMintAllowance(ctx, "ueure", system1.Address))
+
+	// ACT: Attempt to mint with another system.
+	_, err = server.Mint(ctx, &types.MsgMint{Denom: "ueure", Signer: system2.Address, To: user.Address, Amount: One.MulRaw(3)})
+	// ASSERT: The action should've succeeded, as the limit is per system.
+	require.NoError(t, err)
+
+	// ARRANGE: Advance to the next window.
+	ctx = ctx.WithBlockHeight(15)
+
+	// ACT: Attempt to mint with the system in the next window.
+	_, err = server.Mint(ctx, &types.MsgMint{Denom: "ueure", Signer: system1.Address, To: user.Address, Amount: One})
+	// ASSERT: The action should've succeeded.
+	require.NoError(t, err)
+	usage, found := k.GetRateLimitUsage(ctx, "ueure", system1.Address)
+	require.True(t, found)
+	require.Equal(t, One, usage.Used)
+	require.Equal(t, []types.RateLimitBucket{{Start: 15, Amount: One}}, usage.Buckets)
+
+	// ARRANGE: Set a denom-wide rate limit in state.
+	err = k.SetRateLimit(ctx, types.RateLimit{
+		Denom:         "ueure",
+		Amount:        One.MulRaw(2),
+		WindowSeconds: 60,
+	})
+	require.NoError(t, err)
+
+	// ACT: Attempt to mint beyond the denom-wide rate limit with another system.
+	_, err = server.Mint(ctx, &types.MsgMint{Denom: "ueure", Signer: system2.Address, To: user.Address, Amount: One.MulRaw(3)})
+	// ASSERT: The action should've failed due to the exceeded denom-wide rate limit.
+	require.ErrorIs(t, err, types.ErrRateLimitExceeded)
+
+	// ACT: Attempt to mint within both rate limits.
+	_, err = server.Mint(ctx, &types.MsgMint{Denom: "ueure", Signer: system1.Address, To: user.Address, Amount: One})
+	// ASSERT: The action should've succeeded, consuming both rate limits.
+	require.NoError(t, err)
+	usage, _ = k.GetRateLimitUsage(ctx, "ueure", "")
+	require.Equal(t, One, usage.Used)
+	usage, _ = k.GetRateLimitUsage(ctx, "ueure", system1.Address)
+	require.Equal(t, One.MulRaw(2), usage.Used)
+
+	// ARRANGE: Advance until the earlier mints have left the denom-wide window.
+	ctx = ctx.WithBlockTime(time.Unix(1_070, 0))
+
+	// ACT: Attempt to mint with the other system after the denom-wide window has rolled.
+	_, err = server.Mint(ctx, &types.MsgMint{Denom: "ueure", Signer: system2.Address, To: user.Address, Amount: One.MulRaw(2)})
+	// ASSERT: The action should've succeeded.
+	require.NoError(t, err)
+	require.Equal(t, One.MulRaw(9), bank.Balances[user.Address].AmountOf("ueure"))
+}
+
+func TestMintWithRollingRateLimit(t *testing.T) {
+	bank := mocks.BankKeeper{
+		Balances:    make(map[string]sdk.Coins),
+		Restriction: mocks.NoOpSendRestrictionFn,
+	}
+	k, ctx := mocks.FlorinWithKeepers(bank)
+	server := keeper.NewMsgServer(k)
+
+	// ARRANGE: Set system with allowance and a rate limit of 2 per 10 blocks in state.
+	system, user := utils.TestAccount(), utils.TestAccount()
+	require.NoError(t, k.SetSystem(ctx, "ueure", system.Address))
+	require.NoError(t, k.SetMintAllowance(ctx, "ueure", system.Address, One.MulRaw(10)))
+	require.NoError(t, k.SetRateLimit(ctx, types.RateLimit{Denom: "ueure", Address: system.Address, Amount: One.MulRaw(2), WindowBlocks: 10}))
+	mint := func(height int64) error {
+		_, err := server.Mint(ctx.WithBlockHeight(height), &types.MsgMint{Denom: "ueure", Signer: system.Address, To: user.Address, Amount: One.MulRaw(2)})
+		return err
+	}
+
+	// ACT: Attempt to mint the full limit at the end of a window.
+	err := mint(19)
+	// ASSERT: The action should've succeeded.
+	require.NoError(t, err)
+
+	// ACT: Attempt to mint the full limit again at the start of the next window.
+	err = mint(20)
+	// ASSERT: The action should've failed, as both mints are within 10 blocks.
+	require.ErrorIs(t, err, types.ErrRateLimitExceeded)
+
+	// ACT: Attempt to mint on the last block that the first mint is within the window.
+	err = mint(28)
+	// ASSERT: The action should've failed.
+	require.ErrorIs(t, err, types.ErrRateLimitExceeded)
+
+	// ACT: Attempt to mint once the first mint has left the window.
+	err = mint(29)
+	// ASSERT: The action should've succeeded.
+	require.NoError(t, err)
+	require.Equal(t, One.MulRaw(4), bank.Balances[user.Address].AmountOf("ueure"))
+}
+
+func TestMintWithRateLimitWindowChange(t *testing.T) {
+	bank := mocks.BankKeeper{
+		Balances:    make(map[string]sdk.Coins),
+		Restriction: mocks.NoOpSendRestrictionFn,
+	}
+	k, ctx := mocks.FlorinWithKeepers(bank)
+	ctx = ctx.WithBlockHeight(100).WithBlockTime(time.Unix(1_700_000_000, 0))
+	server := keeper.NewMsgServer(k)
+
+	// ARRANGE: Set owner, and system with allowance and a rate limit of 2 per minute in state.
+	owner, system, user := utils.TestAccount(), utils.TestAccount(), utils.TestAccount()
+	require.NoError(t, k.SetOwner(ctx, "ueure", owner.Address))
+	require.NoError(t, k.SetSystem(ctx, "ueure", system.Address))
+	require.NoError(t, k.SetMintAllowance(ctx, "ueure", system.Address, One.MulRaw(10)))
+	setRateLimit := func(amount math.Int, windowBlocks int64, windowSeconds int64) {
+		_, err := server.SetRateLimit(ctx, &types.MsgSetRateLimit{
+			Denom:         "ueure",
+			Signer:        owner.Address,
+			Address:       system.Address,
+			Amount:        amount,
+			WindowBlocks:  windowBlocks,
+			WindowSeconds: windowSeconds,
+		})
+		require.NoError(t, err)
+	}
+	setRateLimit(One.MulRaw(2), 0, 60)
+
+	// ACT: Attempt to mint the full limit.
+	_, err := server.Mint(ctx, &types.MsgMint{Denom: "ueure", Signer: system.Address, To: user.Address, Amount: One.MulRaw(2)})
+	// ASSERT: The action should've succeeded.
+	require.NoError(t, err)
+
+	// ARRANGE: Raise the amount of the rate limit, keeping its window.
+	setRateLimit(One.MulRaw(3), 0, 60)
+
+	// ASSERT: The usage should've been kept.
+	usage, found := k.GetRateLimitUsage(ctx, "ueure", system.Address)
+	require.True(t, found)
+	require.Equal(t, One.MulRaw(2), usage.Used)
+
+	// ARRANGE: Switch the rate limit to a window of 10 blocks.
+	setRateLimit(One.MulRaw(2), 10, 0)
+
+	// ASSERT: The usage recorded under the time window should've been reset.
+	_, found = k.GetRateLimitUsage(ctx, "ueure", system.Address)
+	require.False(t, found)
+
+	// ACT: Attempt to mint the full limit under the block window.
+	_, err = server.Mint(ctx, &types.MsgMint{Denom: "ueure", Signer: system.Address, To: user.Address, Amount: One.MulRaw(2)})
+	// ASSERT: The action should've succeeded.
+	require.NoError(t, err)
+	usage, _ = k.GetRateLimitUsage(ctx, "ueure", system.Address)
+	require.Equal(t, []types.RateLimitBucket{{Start: 100, Amount: One.MulRaw(2)}}, usage.Buckets)
+	require.Equal(t, One.MulRaw(4), bank.Balances[user.Address].AmountOf("ueure"))
+}
+
+func TestMintWithReference(t *testing.T) {
+	bank := mocks.BankKeeper{
+		Balances:    make(map[string]sdk.Coins),
+		Restriction: mocks.NoOpSendRestrictionFn,
+	}
+	k, ctx := mocks.FlorinWithKeepers(bank)
+	server := keeper.NewMsgServer(k)
+
+	// ARRANGE: Set owner and system with allowance in state.
+	owner, system, user := utils.TestAccount(), utils.TestAccount(), utils.TestAccount()
+	require.NoError(t, k.SetOwner(ctx, "ueure", owner.Address))
+	require.NoError(t, k.SetSystem(ctx, "ueure", system.Address))
+	require.NoError(t, k.SetMintAllowance(ctx, "ueure", system.Address, One.MulRaw(10)))
+
+	// ACT: Attempt to mint with a too long reference.
+	_, err := server.Mint(ctx, &types.MsgMint{Denom: "ueure", Signer: system.Address, To: user.Address, Amount: One, Reference: strings.Repeat("x", types.MaxReferenceLength+1)})
+	// ASSERT: The action should've failed due to invalid reference.
+	require.ErrorIs(t, err, types.ErrInvalidReference)
+
+	// ACT: Attempt to mint with a reference.
+	_, err = server.Mint(ctx, &types.MsgMint{Denom: "ueure", Signer: system.Address, To: user.Address, Amount: One, Reference: "SEPA-1"})
+	// ASSERT: The action should've succeeded, and recorded the reference.
+	require.NoError(t, err)
+	reference, found := k.GetReference(ctx, "ueure", "SEPA-1")
+	require.True(t, found)
+	require.False(t, reference.Burn)
+	require.Equal(t, system.Address, reference.System)
+	require.Equal(t, user.Address, reference.Account)
+	require.Equal(t, One, reference.Amount)
+	events := ctx.EventManager().Events()
+	require.Equal(t, "florin.v2.Minted", events[len(events)-1].Type)
+	require.Contains(t, events[len(events)-1].Attributes, abci.EventAttribute{Key: "reference", Value: "\"SEPA-1\""})
+
+	// ACT: Attempt to retry the mint with the same reference.
+	_, err = server.Mint(ctx, &types.MsgMint{Denom: "ueure", Signer: system.Address, To: user.Address, Amount: One, Reference: "SEPA-1"})
+	// ASSERT: The action should've failed due to duplicate reference.
+	require.ErrorIs(t, err, types.ErrDuplicateReference)
+	require.Equal(t, One, bank.Balances[user.Address].AmountOf("ueure"))
+	require.Equal(t, One.MulRaw(9), k.GetMintAllowance(ctx, "ueure", system.Address))
+
+	// ACT: Attempt to mint the same reference of another denom.
+	require.NoError(t, k.SetAllowedDenom(ctx, "ugbpe"))
+	require.NoError(t, k.SetSystem(ctx, "ugbpe", system.Address))
+	require.NoError(t, k.SetMintAllowance(ctx, "ugbpe", system.Address, One))
+	_, err = server.Mint(ctx, &types.MsgMint{Denom: "ugbpe", Signer: system.Address, To: user.Address, Amount: One, Reference: "SEPA-1"})
+	// ASSERT: The action should've succeeded, as references are per denom.
+	require.NoError(t, err)
+
+	// ARRANGE: Set an approval policy in state, and create a pending mint with a reference.
+	require.NoError(t, k.SetMintApprovalPolicy(ctx, types.MintApprovalPolicy{
+		Denom: "ueure", Threshold: One, Quorum: 1, ExpiryBlocks: 10,
+	}))
+	res, err := server.Mint(ctx, &types.MsgMint{Denom: "ueure", Signer: system.Address, To: user.Address, Amount: One.MulRaw(2), Reference: "SEPA-2"})
+	require.NoError(t, err)
+	require.True(t, k.HasReference(ctx, "ueure", "SEPA-2"))
+
+	// ACT: Attempt to cancel the pending mint.
+	_, err = server.CancelMint(ctx, &types.MsgCancelMint{Denom: "ueure", Signer: owner.Address, Id: res.PendingMintId})
+	// ASSERT: The action should've succeeded, and released the reference.
+	require.NoError(t, err)
+	require.False(t, k.HasReference(ctx, "ueure", "SEPA-2"))
+
+	// ACT: Attempt to retry the cancelled mint with the same reference.
+	_, err = server.Mint(ctx, &types.MsgMint{Denom: "ueure", Signer: system.Address, To: user.Address, Amount: One.MulRaw(2), Reference: "SEPA-2"})
+	// ASSERT: The action should've succeeded.
+	require.NoError(t, err)
+}
+
+func TestMintWithSupplyCap(t *testing.T) {
+	bank := mocks.BankKeeper{
+		Balances:    make(map[string]sdk.Coins),
+		Restriction: mocks.NoOpSendRestrictionFn,
+	}
+	k, ctx := mocks.FlorinWithKeepers(bank)
+	server := keeper.NewMsgServer(k)
+
+	// ARRANGE: Set system with allowance, existing supply and a supply cap in state.
+	system, user := utils.TestAccount(), utils.TestAccount()
+	require.NoError(t, k.SetSystem(ctx, "ueure", system.Address))
+	require.NoError(t, k.SetMintAllowance(ctx, "ueure", system.Address, One.MulRaw(10)))
+	bank.Balances[user.Address] = sdk.NewCoins(sdk.NewCoin("ueure", One))
+	require.NoError(t, k.SetSupplyCap(ctx, "ueure", One.MulRaw(3)))
+
+	// ACT: Attempt to mint beyond the supply cap.
+	_, err := server.Mint(ctx, &types.MsgMint{Denom: "ueure", Signer: system.Address, To: user.Address, Amount: One.MulRaw(3)})
+	// ASSERT: The action should've failed due to the exceeded supply cap.
+	require.ErrorIs(t, err, types.ErrSupplyCapExceeded)
+	require.Equal(t, One.MulRaw(10), k.GetMintAllowance(ctx, "ueure", system.Address))
+
+	// ACT: Attempt to mint up to the supply cap.
+	_, err = server.Mint(ctx, &types.MsgMint{Denom: "ueure", Signer: system.Address, To: user.Address, Amount: One.MulRaw(2)})
+	// ASSERT: The action should've succeeded.
+	require.NoError(t, err)
+	require.Equal(t, One.MulRaw(3), bank.Balances[user.Address].AmountOf("ueure"))
+
+	// ARRANGE: Remove the supply cap from state.
+	require.NoError(t, k.SetSupplyCap(ctx, "ueure", math.ZeroInt()))
+
+	// ACT: Attempt to mint without a supply cap.
+	_, err = server.Mint(ctx, &types.MsgMint{Denom: "ueure", Signer: system.Address, To: user.Address, Amount: One})
+	// ASSERT: The action should've succeeded.
+	require.NoError(t, err)
+}
+
+func TestPause(t *testing.T) {
+	k, ctx := mocks.FlorinKeeper()
+	server := keeper.NewMsgServer(k)
+
+	// ACT: Attempt to pause with not allowed denom.
+	_, err := server.Pause(ctx, &types.MsgPause{
+		Denom:  "uusde",
+		Signer: utils.TestAccount().Address,
+	})
+	// ASSERT: The action should've failed due to not allowed denom.
+	require.ErrorContains(t, err, "uusde is not an allowed denom")
+
+	// ARRANGE: Set owner and pauser in state.
+	owner, pauser := utils.TestAccount(), utils.TestAccount()
+	err = k.SetOwner(ctx, "ueure", owner.Address)
+	require.NoError(t, err)
+	err = k.SetPauser(ctx, "ueure", pauser.Address)
+	require.NoError(t, err)
+
+	// ACT: Attempt to pause with invalid signer.
+	_, err = server.Pause(ctx, &types.MsgPause{
+		Denom:  "ueure",
+		Signer: utils.TestAccount().Address,
+	})
+	// ASSERT: The action should've failed due to invalid signer.
+	require.ErrorIs(t, err, types.ErrInvalidPauser)
+
+	// ARRANGE: Set up a failing collection store for the attribute setter.
+	tmp := k.Paused
+	k.Paused = collections.NewKeySet(
+		collections.NewSchemaBuilder(mocks.FailingStore(mocks.Set, utils.GetKVStore(ctx, types.ModuleName))),
+		types.PausedPrefix, "paused", collections.StringKey,
+	)
+
+	// ACT: Attempt to pause with failing Paused collection store.
+	_, err = server.Pause(ctx, &types.MsgPause{
+		Denom:  "ueure",
+		Signer: pauser.Address,
+	})
+	// ASSERT: The action should've failed due to collection store setter error.
+	require.Error(t, err, mocks.ErrorStoreAccess)
+	k.Paused = tmp
+
+	// ACT: Attempt to pause.
+	_, err = server.Pause(ctx, &types.MsgPause{
+		Denom:  "ueure",
+		Signer: pauser.Address,
+	})
+	// ASSERT: The action should've succeeded.
+	require.NoError(t, err)
+	require.True(t, k.IsPaused(ctx, "ueure"))
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, "florin.v2.Paused", events[0].Type)
+
+	// ACT: Attempt to pause when already paused.
+	_, err = server.Pause(ctx, &types.MsgPause{
+		Denom:  "ueure",
+		Signer: owner.Address,
+	})
+	// ASSERT: The action should've failed due to already paused.
+	require.ErrorIs(t, err, types.ErrPaused)
+}
+
+func TestRecover(t *testing.T) {
+	// The below signature was generated using Keplr's signArbitrary function.
+	//
+	// share bubble good swarm sustain leaf burst build spirit inflict undo shadow antique warm soft praise foam slab laptop hint giggle also book treat
+	//
+	// {
+	//     "pub_key": {
+	//         "type": "tendermint/PubKeySecp256k1",
+	//         "value": "AlE8CxHR19ID5lxrVtTxSgJFlK3T+eYtyDM/vBA3Fowr"
+	//     },
+	//     "signature": "qe5dDxdOgY8B2LjMqnK5/5iRIFOCwdTu0G5ZQ66bHzVgP15V2Fb+fzOH0wPAUC5GUQ23M1cSvysulzKIbXY/4Q=="
+	// }
+	bz, _ := base64.StdEncoding.DecodeString("AlE8CxHR19ID5lxrVtTxSgJFlK3T+eYtyDM/vBA3Fowr")
+	pubKey, _ := codectypes.NewAnyWithValue(&secp256k1.PubKey{Key: bz})
+	signature, _ := base64.StdEncoding.DecodeString("qe5dDxdOgY8B2LjMqnK5/5iRIFOCwdTu0G5ZQ66bHzVgP15V2Fb+fzOH0wPAUC5GUQ23M1cSvysulzKIbXY/4Q==")
+
+	bank := mocks.BankKeeper{
+		Balances:    make(map[string]sdk.Coins),
+		Restriction: mocks.NoOpSendRestrictionFn,
+	}
+	k, ctx := mocks.FlorinWithKeepers(bank)
+	server := keeper.NewMsgServer(k)
+
+	// ARRANGE: Set system in state, and generate a recipient address.
+	system, recipient := utils.TestAccount(), utils.TestAccount()
+	err := k.SetSystem(ctx, "ueure", system.Address)
+	require.NoError(t, err)
+
+	// ACT: Attempt to recover invalid denom.
+	_, err = server.Recover(ctx, &types.MsgRecover{
+		Denom:     "uusde",
+		Signer:    system.Address,
+		From:      "noble1rwvjzk28l38js7xx6mq23nrpghd8qqvxmj6ep2",
+		To:        recipient.Address,
+		Signature: signature,
+		PubKey:    pubKey,
+	})
+	// ASSERT: The action should've failed due to invalid denom.
+	require.ErrorContains(t, err, "uusde is not an allowed denom")
+
+	// ARRANGE: Pause denom in state.
+	err = k.SetPaused(ctx, "ueure", true)
+	require.NoError(t, err)
+
+	// ACT: Attempt to recover paused denom.
+	_, err = server.Recover(ctx, &types.MsgRecover{
+		Denom:     "ueure",
+		Signer:    system.Address,
+		From:      "noble1rwvjzk28l38js7xx6mq23nrpghd8qqvxmj6ep2",
+		To:        recipient.Address,
+		Signature: signature,
+		PubKey:    pubKey,
+	})
+	// ASSERT: The action should've failed due to paused denom.
+	require.ErrorIs(t, err, types.ErrPaused)
+
+	// ARRANGE: Unpause denom in state.
+	err = k.SetPaused(ctx, "ueure", false)
+	require.NoError(t, err)
+
+	// ACT: Attempt to recover with invalid signer.
+	_, err = server.Recover(ctx, &types.MsgRecover{
+		Denom:     "ueure",
+		Signer:    utils.TestAccount().Address,
+		From:      "noble1rwvjzk28l38js7xx6mq23nrpghd8qqvxmj6ep2",
+		To:        recipient.Address,
+		Signature: signature,
+		PubKey:    pubKey,
+	})
+	// ASSERT: The action should've failed due to invalid signer.
+	require.ErrorIs(t, err, types.ErrInvalidSystem)
+
+	// ACT: Attempt to recover with invalid any.
+	invalidPubKey, _ := codectypes.NewAnyWithValue(&types.MsgRecover{})
+	_, err = server.Recover(ctx, &types.MsgRecover{
+		Denom:     "ueure",
+		Signer:    system.Address,
+		From:      "noble1rwvjzk28l38js7xx6mq23nrpghd8qqvxmj6ep2",
+		To:        recipient.Address,
+		Signature: signature,
+		PubKey:    invalidPubKey,
+	})
+	// ASSERT: The action should've failed due to invalid any.
+	require.ErrorContains(t, err, "unable to unpack pubkey")
+
+	// ACT: Attempt to recover from invalid user address.
+	_, err = server.Recover(ctx, &types.MsgRecover{
+		Denom:     "ueure",
+		Signer:    system.Address,
+		From:      utils.TestAccount().Invalid,
+		To:        recipient.Address,
+		Signature: signature,
+		PubKey:    pubKey,
+	})
+	// ASSERT: The action should've failed due to invalid user address.
+	require.ErrorIs(t, err, types.ErrInvalidAddress)
+
+	// ACT: Attempt to recover with invalid public key.
+	invalidPubKey, _ = codectypes.NewAnyWithValue(secp256k1.GenPrivKey().PubKey())
+	_, err = server.Recover(ctx, &types.MsgRecover{
+		Denom:     "ueure",
+		Signer:    system.Address,
+		From:      "noble1rwvjzk28l38js7xx6mq23nrpghd8qqvxmj6ep2",
+		To:        recipient.Address,
+		Signature: signature,
+		PubKey:    invalidPubKey,
+	})
+	// ASSERT: The action should've failed due to invalid public key.
+	require.ErrorIs(t, err, types.ErrInvalidPubKey)
+
+	// ACT: Attempt to recover with missing public key.
+	_, err = server.Recover(ctx, &types.MsgRecover{
+		Denom:     "ueure",
+		Signer:    system.Address,
+		From:      "noble1rwvjzk28l38js7xx6mq23nrpghd8qqvxmj6ep2",
+		To:        recipient.Address,
+		Signature: signature,
+	})
+	// ASSERT: The action should've failed due to invalid public key.
+	require.ErrorIs(t, err, types.ErrInvalidPubKey)
+
+	// ACT: Attempt to recover with invalid signature.
+	invalidSignature, _ := base64.StdEncoding.DecodeString("QBrRfIqjdBvXx9zaBcuiE9P5SVesxFO/He3deyx2OE0NoSNqwmSb7b5iP2UhZRI1duiOeho3+NETUkCBv14zjQ==")
+	_, err = server.Recover(ctx, &types.MsgRecover{
+		Denom:     "ueure",
+		Signer:    system.Address,
+		From:      "noble1rwvjzk28l38js7xx6mq23nrpghd8qqvxmj6ep2",
+		To:        recipient.Address,
+		Signature: invalidSignature,
+		PubKey:    pubKey,
+	})
+	// ASSERT: The action should've failed due to invalid signature.
+	require.ErrorIs(t, err, types.ErrInvalidSignature)
+
+	// ACT: Attempt to recover with no balance.
+	_, err = server.Recover(ctx, &types.MsgRecover{
+		Denom:     "ueure",
+		Signer:    system.Address,
+		From:      "noble1rwvjzk28l38js7xx6mq23nrpghd8qqvxmj6ep2",
+		To:        recipient.Address,
+		Signature: signature,
+		PubKey:    pubKey,
+	})
+	// ASSERT: The action should've succeeded.
+	require.NoError(t, err)
+
+	// ARRANGE: Give user 1 $EURe.
+	bank.Balances["noble1rwvjzk28l38js7xx6mq23nrpghd8qqvxmj6ep2"] = sdk.NewCoins(sdk.NewCoin("ueure", One))
+
+	// ACT: Attempt to recover to invalid user address.
+	_, err = server.Recover(ctx, &types.MsgRecover{
+		Denom:     "ueure",
+		Signer:    system.Address,
+		From:      "noble1rwvjzk28l38js7xx6mq23nrpghd8qqvxmj6ep2",
+		To:        recipient.Invalid,
+		Signature: signature,
+		PubKey:    pubKey,
+	})
+	// ASSERT: The action should've failed due to invalid user address.
+	require.ErrorIs(t, err, types.ErrInvalidAddress)
+
+	// ACT: Attempt to recover.
+	_, err = server.Recover(ctx, &types.MsgRecover{
+		Denom:     "ueure",
+		Signer:    system.Address,
+		From:      "noble1rwvjzk28l38js7xx6mq23nrpghd8qqvxmj6ep2",
+		To:        recipient.Address,
+		Signature: signature,
+		PubKey:    pubKey,
+	})
+	// ASSERT: The action should've succeeded.
+	require.NoError(t, err)
+	require.True(t, bank.Balances["noble1rwvjzk28l38js7xx6mq23nrpghd8qqvxmj6ep2"].IsZero())
+	require.Equal(t, One, bank.Balances[recipient.Address].AmountOf("ueure"))
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 2)
+	require.Equal(t, "florin.v2.Recovered", events[1].Type)
+}
+
+func TestRecoverWithSignDoc(t *testing.T) {
+	bank := mocks.BankKeeper{
+		Balances:    make(map[string]sdk.Coins),
+		Restriction: mocks.NoOpSendRestrictionFn,
+	}
+	k, ctx := mocks.FlorinWithKeepers(bank)
+	ctx = ctx.WithChainID("florin-1").WithBlockHeight(10)
+	server := keeper.NewMsgServer(k)
+
+	// ARRANGE: Set system in state.
+	system := utils.TestAccount()
+	err := k.SetSystem(ctx, "ueure", system.Address)
+	require.NoError(t, err)
+
+	// ARRANGE: Generate a user account and give them 1 $EURe.
+	user := utils.TestSigner()
+	pubKey, _ := codectypes.NewAnyWithValue(user.PrivKey.PubKey())
+	bank.Balances[user.Address] = sdk.NewCoins(sdk.NewCoin("ueure", One))
+
+	// ARRANGE: Generate recipient accounts.
+	recipient, attacker := utils.TestAccount(), utils.TestAccount()
+	doc := types.NewRecoverSignDoc("florin-1", "ueure", math.Int{}, recipient.Address, 0, 20, false)
+	signature := user.SignArbitrary(doc.Bytes())
+
+	// ACT: Attempt to recover to a different destination than signed.
+	_, err = server.Recover(ctx, &types.MsgRecover{
+		Denom:        "ueure",
+		Signer:       system.Address,
+		From:         user.Address,
+		To:           attacker.Address,
+		Signature:    signature,
+		PubKey:       pubKey,
+		ExpiryHeight: 20,
+	})
+	// ASSERT: The action should've failed due to invalid signature.
+	require.ErrorIs(t, err, types.ErrInvalidSignature)
+
+	// ACT: Attempt to recover a different amount than signed.
+	_, err = server.Recover(ctx, &types.MsgRecover{
+		Denom:        "ueure",
+		Signer:       system.Address,
+		From:         user.Address,
+		To:           recipient.Address,
+		Signature:    signature,
+		PubKey:       pubKey,
+		ExpiryHeight: 20,
+		Amount:       One.QuoRaw(2),
+	})
+	// ASSERT: The action should've failed due to invalid signature.
+	require.ErrorIs(t, err, types.ErrInvalidSignature)
+
+	// ACT: Attempt to recover with a compliance override that wasn't signed.
+	_, err = server.Recover(ctx, &types.MsgRecover{
+		Denom:              "ueure",
+		Signer:             system.Address,
+		From:               user.Address,
+		To:                 recipient.Address,
+		Signature:          signature,
+		PubKey:             pubKey,
+		ExpiryHeight:       20,
+		ComplianceOverride: true,
+	})
+	// ASSERT: The action should've failed due to invalid signature.
+	require.ErrorIs(t, err, types.ErrInvalidSignature)
+
+	// ACT: Attempt to recover.
+	_, err = server.Recover(ctx, &types.MsgRecover{
+		Denom:        "ueure",
+		Signer:       system.Address,
+		From:         user.Address,
+		To:           recipient.Address,
+		Signature:    signature,
+		PubKey:       pubKey,
+		ExpiryHeight: 20,
+	})
+	// ASSERT: The action should've succeeded, and consumed the nonce.
+	require.NoError(t, err)
+	require.Equal(t, One, bank.Balances[recipient.Address].AmountOf("ueure"))
+	require.Equal(t, uint64(1), k.GetNonce(ctx, user.Address))
+}
+
+func TestRecoverWithGuards(t *testing.T) {
+	bank := mocks.BankKeeper{
+		Balances:    make(map[string]sdk.Coins),
+		Locked:      make(map[string]sdk.Coins),
+		Restriction: mocks.NoOpSendRestrictionFn,
+	}
+	k, ctx := mocks.FlorinWithKeepers(bank)
+	ctx = ctx.WithChainID("florin-1").WithBlockHeight(10)
+	server := keeper.NewMsgServer(k)
+
+	// ARRANGE: Set system in state.
+	system := utils.TestAccount()
+	require.NoError(t, k.SetSystem(ctx, "ueure", system.Address))
+
+	// ARRANGE: Generate a user account and give them 2 $EURe.
+	user := utils.TestSigner()
+	pubKey, _ := codectypes.NewAnyWithValue(user.PrivKey.PubKey())
+	bank.Balances[user.Address] = sdk.NewCoins(sdk.NewCoin("ueure", One.MulRaw(2)))
+
+	recoverTo := func(to string, amount math.Int, complianceOverride bool) error {
+		doc := types.NewRecoverSignDoc("florin-1", "ueure", amount, to, k.GetNonce(ctx, user.Address), 20, complianceOverride)
+		_, err := server.Recover(ctx, &types.MsgRecover{
+			Denom:              "ueure",
+			Signer:             system.Address,
+			From:               user.Address,
+			To:                 to,
+			Signature:          user.SignArbitrary(doc.Bytes()),
+			PubKey:             pubKey,
+			ExpiryHeight:       20,
+			Amount:             amount,
+			ComplianceOverride: complianceOverride,
+		})
+		return err
+	}
+
+	// ACT: Attempt to recover to the same address.
+	err := recoverTo(user.Address, math.Int{}, false)
+	// ASSERT: The action should've failed due to invalid recipient.
+	require.ErrorIs(t, err, types.ErrInvalidRecipient)
+
+	// ARRANGE: Ban a recipient.
+	adversary := utils.TestAccount()
+	require.NoError(t, k.SetAdversary(ctx, adversary.Address))
+
+	// ACT: Attempt to recover to a banned address.
+	err = recoverTo(adversary.Address, math.Int{}, false)
+	// ASSERT: The action should've failed due to invalid recipient.
+	require.ErrorIs(t, err, types.ErrInvalidRecipient)
+
+	// ARRANGE: Generate a recipient account.
+	recipient := utils.TestAccount()
+
+	// ACT: Attempt to recover with a compliance override from a non-banned address.
+	err = recoverTo(recipient.Address, math.Int{}, true)
+	// ASSERT: The action should've failed due to address not being banned.
+	require.ErrorIs(t, err, types.ErrNotBanned)
+
+	// ACT: Attempt to recover more than the balance.
+	err = recoverTo(recipient.Address, One.MulRaw(3), false)
+	// ASSERT: The action should've failed due to insufficient funds.
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+
+	// ACT: Attempt to recover a partial amount.
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	err = recoverTo(recipient.Address, One, false)
+	// ASSERT: The action should've succeeded, and only moved the amount.
+	require.NoError(t, err)
+	require.Equal(t, One, bank.Balances[user.Address].AmountOf("ueure"))
+	require.Equal(t, One, bank.Balances[recipient.Address].AmountOf("ueure"))
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 2)
+	require.Equal(t, "florin.v2.Recovered", events[1].Type)
+	attribute, ok := events[1].GetAttribute("system")
+	require.True(t, ok)
+	require.Contains(t, attribute.Value, system.Address)
+
+	// ARRANGE: Ban the user.
+	require.NoError(t, k.SetDenomAdversary(ctx, "ueure", user.Address))
+
+	// ACT: Attempt to recover from a banned address without a compliance override.
+	err = recoverTo(recipient.Address, math.Int{}, false)
+	// ASSERT: The action should've failed due to missing compliance override.
+	require.ErrorIs(t, err, types.ErrComplianceOverride)
+
+	// ARRANGE: Limit the balance of the recipient.
+	require.NoError(t, k.SetAccountLimit(ctx, types.AccountLimit{
+		Denom:           "ueure",
+		Address:         recipient.Address,
+		MaxBalance:      One,
+		MaxTransfer:     math.ZeroInt(),
+		MaxDailyOutflow: math.ZeroInt(),
+	}))
+
+	// ACT: Attempt to recover from a banned address above the max balance of the recipient.
+	err = recoverTo(recipient.Address, math.Int{}, true)
+	// ASSERT: The action should've failed, as recipient limits are still enforced.
+	require.ErrorIs(t, err, types.ErrAccountLimitExceeded)
+	require.Equal(t, One, bank.Balances[user.Address].AmountOf("ueure"))
+
+	// ARRANGE: Remove the limit of the recipient.
+	require.NoError(t, k.DeleteAccountLimit(ctx, "ueure", recipient.Address))
+
+	// ACT: Attempt to recover from a banned address with a compliance override.
+	err = recoverTo(recipient.Address, math.Int{}, true)
+	// ASSERT: The action should've succeeded, and moved the remaining balance.
+	require.NoError(t, err)
+	require.True(t, bank.Balances[user.Address].IsZero())
+	require.Equal(t, One.MulRaw(2), bank.Balances[recipient.Address].AmountOf("ueure"))
+
+	// ARRANGE: Give the user 2 $EURe again, of which 1 $EURe is locked.
+	bank.Balances[user.Address] = sdk.NewCoins(sdk.NewCoin("ueure", One.MulRaw(2)))
+	bank.Locked[user.Address] = sdk.NewCoins(sdk.NewCoin("ueure", One))
+
+	// ACT: Attempt to recover more than the spendable balance.
+	err = recoverTo(recipient.Address, One.MulRaw(2), true)
+	// ASSERT: The action should've failed due to insufficient funds.
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+
+	// ACT: Attempt to recover the entire balance.
+	err = recoverTo(recipient.Address, math.Int{}, true)
+	// ASSERT: The action should've succeeded, and moved only the spendable balance.
+	require.NoError(t, err)
+	require.Equal(t, One, bank.Balances[user.Address].AmountOf("ueure"))
+	require.Equal(t, One.MulRaw(3), bank.Balances[recipient.Address].AmountOf("ueure"))
+}
+
+func TestRedeem(t *testing.T) {
+	bank := mocks.BankKeeper{
+		Balances:    make(map[string]sdk.Coins),
+		Restriction: mocks.NoOpSendRestrictionFn,
+	}
+	k, ctx := mocks.FlorinWithKeepers(bank)
+	server := keeper.NewMsgServer(k)
+
+	// ACT: Attempt to redeem with not allowed denom.
+	_, err := server.Redeem(ctx, &types.MsgRedeem{
+		Denom:  "uusde",
+		Signer: utils.TestAccount().Address,
+		Amount: One,
+	})
+	// ASSERT: The action should've failed due to not allowed denom.
+	require.ErrorContains(t, err, "uusde is not an allowed denom")
+
+	// ARRANGE: Set paused state to true.
+	require.NoError(t, k.SetPaused(ctx, "ueure", true))
+
+	// ACT: Attempt to redeem when paused.
+	_, err = server.Redeem(ctx, &types.MsgRedeem{
+		Denom:  "ueure",
+		Signer: utils.TestAccount().Address,
+		Amount: One,
+	})
+	// ASSERT: The action should've failed due to module being paused.
+	require.ErrorIs(t, err, types.ErrPaused)
+
+	// ARRANGE: Set paused state to false.
+	require.NoError(t, k.SetPaused(ctx, "ueure", false))
+
+	// ACT: Attempt to redeem with invalid signer.
+	_, err = server.Redeem(ctx, &types.MsgRedeem{
+		Denom:  "ueure",
+		Signer: utils.TestAccount().Address,
+		Amount: One,
+	})
+	// ASSERT: The action should've failed due to invalid signer.
+	require.ErrorIs(t, err, types.ErrInvalidSystem)
+
+	// ARRANGE: Set system in state, and give it 2 $EURe.
+	system := utils.TestAccount()
+	require.NoError(t, k.SetSystem(ctx, "ueure", system.Address))
+	bank.Balances[system.Address] = sdk.NewCoins(sdk.NewCoin("ueure", One.MulRaw(2)))
+
+	// ACT: Attempt to redeem a zero amount.
+	_, err = server.Redeem(ctx, &types.MsgRedeem{
+		Denom:  "ueure",
+		Signer: system.Address,
+		Amount: math.ZeroInt(),
+	})
+	// ASSERT: The action should've failed due to invalid amount.
+	require.ErrorIs(t, err, types.ErrInvalidAmount)
+
+	// ACT: Attempt to redeem more than the system holds.
+	_, err = server.Redeem(ctx, &types.MsgRedeem{
+		Denom:  "ueure",
+		Signer: system.Address,
+		Amount: One.MulRaw(3),
+	})
+	// ASSERT: The action should've failed due to insufficient funds.
+	require.ErrorContains(t, err, "unable to transfer from system to module")
+
+	// ACT: Attempt to redeem.
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	_, err = server.Redeem(ctx, &types.MsgRedeem{
+		Denom:     "ueure",
+		Signer:    system.Address,
+		Amount:    One,
+		Reference: "RED-1",
+	})
+	// ASSERT: The action should've succeeded.
+	require.NoError(t, err)
+	require.Equal(t, One, bank.Balances[system.Address].AmountOf("ueure"))
+	require.True(t, k.HasReference(ctx, "ueure", "RED-1"))
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 2)
+	require.Equal(t, "florin.blacklist.v1.Decision", events[0].Type)
+	require.Equal(t, "florin.v2.Burned", events[1].Type)
+
+	// ACT: Attempt to redeem again with the same reference.
+	_, err = server.Redeem(ctx, &types.MsgRedeem{
+		Denom:     "ueure",
+		Signer:    system.Address,
+		Amount:    One,
+		Reference: "RED-1",
+	})
+	// ASSERT: The action should've failed due to duplicate reference.
+	require.ErrorIs(t, err, types.ErrDuplicateReference)
+	require.Equal(t, One, bank.Balances[system.Address].AmountOf("ueure"))
+}
+
+func TestRemoveAccountLimit(t *testing.T) {
+	k, ctx := mocks.FlorinKeeper()
+	server := keeper.NewMsgServer(k)
+
+	// ACT: Attempt to remove account limit with not allowed denom.
+	_, err := server.RemoveAccountLimit(ctx, &types.MsgRemoveAccountLimit{
+		Denom:  "uusde",
+		Signer: utils.TestAccount().Address,
+	})
+	// ASSERT: The action should've failed due to not allowed denom.
+	require.ErrorContains(t, err, "uusde is not an allowed denom")
+
+	// ARRANGE: Set owner in state.
+	owner, user := utils.TestAccount(), utils.TestAccount()
+	require.NoError(t, k.SetOwner(ctx, "ueure", owner.Address))
+
+	// ACT: Attempt to remove account limit with invalid signer.
+	_, err = server.RemoveAccountLimit(ctx, &types.MsgRemoveAccountLimit{
+		Denom:  "ueure",
+		Signer: utils.TestAccount().Address,
+	})
+	// ASSERT: The action should've failed due to invalid signer.
+	require.ErrorIs(t, err, types.ErrInvalidOwner)
+
+	// ACT: Attempt to remove account limit that doesn't exist.
+	_, err = server.RemoveAccountLimit(ctx, &types.MsgRemoveAccountLimit{
+		Denom:   "ueure",
+		Signer:  owner.Address,
+		Address: user.Address,
+	})
+	// ASSERT: The action should've failed due to no account limit.
+	require.ErrorIs(t, err, types.ErrNoAccountLimit)
+
+	// ARRANGE: Set account limit in state.
+	require.NoError(t, k.SetAccountLimit(ctx, types.AccountLimit{
+		Denom:           "ueure",
+		Address:         user.Address,
+		MaxBalance:      One,
+		MaxTransfer:     math.ZeroInt(),
+		MaxDailyOutflow: math.ZeroInt(),
+	}))
+
+	// ACT: Attempt to remove account limit.
+	_, err = server.RemoveAccountLimit(ctx, &types.MsgRemoveAccountLimit{
+		Denom:   "ueure",
+		Signer:  owner.Address,
+		Address: user.Address,
+	})
+	// ASSERT: The action should've succeeded.
+	require.NoError(t, err)
+	_, found := k.GetAccountLimit(ctx, "ueure", user.Address)
+	require.False(t, found)
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, "florin.v2.AccountLimitRemoved", events[0].Type)
+}
+
+func TestRemoveAdminAccount(t *testing.T) {
+	k, ctx := mocks.FlorinKeeper()
+	server := keeper.NewMsgServer(k)
+
+	// ACT: Attempt to remove admin account with not allowed denom.
+	_, err := server.RemoveAdminAccount(ctx, &types.MsgRemoveAdminAccount{
+		Denom:   "uusde",
+		Signer:  utils.TestAccount().Address,
+		Account: utils.TestAccount().Address,
+	})
+	// ASSERT: The action should've failed due to not allowed denom.
+	require.ErrorContains(t, err, "uusde is not an allowed denom")
+
+	// ACT: Attempt to remove admin account with no owner set.
+	_, err = server.RemoveAdminAccount(ctx, &types.MsgRemoveAdminAccount{
+		Denom:   "ueure",
+		Signer:  utils.TestAccount().Address,
+		Account: utils.TestAccount().Address,
+	})
+	// ASSERT: The action should've failed due to no owner set.
+	require.ErrorIs(t, err, types.ErrNoOwner)
+
+	// ARRANGE: Set owner in state.
+	owner := utils.TestAccount()
+	err = k.SetOwner(ctx, "ueure", owner.Address)
+	require.NoError(t, err)
+
+	// ACT: Attempt to remove admin account with invalid signer.
+	_, err = server.RemoveAdminAccount(ctx, &types.MsgRemoveAdminAccount{
+		Denom:   "ueure",
+		Signer:  utils.TestAccount().Address,
+		Account: utils.TestAccount().Address,
+	})
+	// ASSERT: The action should've failed due to invalid signer.
+	require.ErrorIs(t, err, types.ErrInvalidOwner)
+
+	// ARRANGE: Set admin in state.
+	admin := utils.TestAccount()
+	err = k.SetAdmin(ctx, "ueure", admin.Address)
+	require.NoError(t, err)
+	require.True(t, k.IsAdmin(ctx, "ueure", admin.Address))
+
+	// ARRANGE: Set up a failing collection store for the attribute deleter.
+	tmp := k.Admins
+	k.Admins = collections.NewKeySet(
+		collections.NewSchemaBuilder(mocks.FailingStore(mocks.Delete, utils.GetKVStore(ctx, types.ModuleName))),
+		types.AdminPrefix, "admins", types.AccountKeyCodec,
+	)
+
+	// ACT: Attempt to remove admin account with failing Admins collection store.
+	_, err = server.RemoveAdminAccount(ctx, &types.MsgRemoveAdminAccount{
+		Denom:   "ueure",
+		Signer:  owner.Address,
+		Account: admin.Address,
+	})
+	// ASSERT: The action should've failed due to collection store deleter error.
+	require.Error(t, err, mocks.ErrorStoreAccess)
+	k.Admins = tmp
+
+	// ACT: Attempt to remove admin account.
+	_, err = server.RemoveAdminAccount(ctx, &types.MsgRemoveAdminAccount{
+		Denom:   "ueure",
+		Signer:  owner.Address,
+		Account: admin.Address,
+	})
+	// ASSERT: The action should've succeeded.
+	require.NoError(t, err)
+	require.False(t, k.IsAdmin(ctx, "ueure", admin.Address))
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, "florin.v2.AdminAccountRemoved", events[0].Type)
+}
+
+func TestRemoveAllowlistAdmin(t *testing.T) {
+	k, ctx := mocks.FlorinKeeper()
+	server := keeper.NewMsgServer(k)
+
+	// ACT: Attempt to remove allowlist admin with not allowed denom.
+	_, err := server.RemoveAllowlistAdmin(ctx, &types.MsgRemoveAllowlistAdmin{
+		Denom:   "uusde",
+		Signer:  utils.TestAccount().Address,
+		Account: utils.TestAccount().Address,
+	})
+	// ASSERT: The action should've failed due to not allowed denom.
+	require.ErrorContains(t, err, "uusde is not an allowed denom")
+
+	// ACT: Attempt to remove allowlist admin with no owner set.
+	_, err = server.RemoveAllowlistAdmin(ctx, &types.MsgRemoveAllowlistAdmin{
+		Denom:   "ueure",
+		Signer:  utils.TestAccount().Address,
+		Account: utils.TestAccount().Address,
+	})
+	// ASSERT: The action should've failed due to no owner set.
+	require.ErrorIs(t, err, types.ErrNoOwner)
+
+	// ARRANGE: Set owner in state.
+	owner, admin := utils.TestAccount(), utils.TestAccount()
+	require.NoError(t, k.SetOwner(ctx, "ueure", owner.Address))
+
+	// ARRANGE: Set allowlist admin in state.
+	require.NoError(t, k.SetAllowlistAdmin(ctx, "ueure", admin.Address))
+
+	// ACT: Attempt to remove allowlist admin with invalid signer.
+	_, err = server.RemoveAllowlistAdmin(ctx, &types.MsgRemoveAllowlistAdmin{
+		Denom:   "ueure",
+		Signer:  utils.TestAccount().Address,
+		Account: admin.Address,
+	})
+	// ASSERT: The action should've failed due to invalid signer.
+	require.ErrorIs(t, err, types.ErrInvalidOwner)
+
+	// ACT: Attempt to remove allowlist admin.
+	_, err = server.RemoveAllowlistAdmin(ctx, &types.MsgRemoveAllowlistAdmin{
+		Denom:   "ueure",
+		Signer:  owner.Address,
+		Account: admin.Address,
+	})
+	// ASSERT: The action should've succeeded.
+	require.NoError(t, err)
+	require.False(t, k.IsAllowlistAdmin(ctx, "ueure", admin.Address))
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, "florin.v2.AllowlistAdminRemoved", events[0].Type)
+}
+
+func TestRemoveFromAllowlist(t *testing.T) {
+	k, ctx := mocks.FlorinKeeper()
+	server := keeper.NewMsgServer(k)
+
+	// ACT: Attempt to remove from allowlist with not allowed denom.
+	_, err := server.RemoveFromAllowlist(ctx, &types.MsgRemoveFromAllowlist{
+		Denom:   "uusde",
+		Signer:  utils.TestAccount().Address,
+		Account: utils.TestAccount().Address,
+	})
+	// ASSERT: The action should've failed due to not allowed denom.
+	require.ErrorContains(t, err, "uusde is not an allowed denom")
+
+	// ARRANGE: Set allowlist admin in state.
+	admin, user := utils.TestAccount(), utils.TestAccount()
+	require.NoError(t, k.SetAllowlistAdmin(ctx, "ueure", admin.Address))
+	require.NoError(t, k.SetAllowlisted(ctx, "ueure", user.Address))
+
+	// ACT: Attempt to remove from allowlist with invalid signer.
+	_, err = server.RemoveFromAllowlist(ctx, &types.MsgRemoveFromAllowlist{
+		Denom:   "ueure",
+		Signer:  utils.TestAccount().Address,
+		Account: user.Address,
+	})
+	// ASSERT: The action should've failed due to invalid signer.
+	require.ErrorIs(t, err, types.ErrInvalidAllowlistAdmin)
+
+	// ACT: Attempt to remove from allowlist.
+	_, err = server.RemoveFromAllowlist(ctx, &types.MsgRemoveFromAllowlist{
+		Denom:   "ueure",
+		Signer:  admin.Address,
+		Account: user.Address,
+	})
+	// ASSERT: The action should've succeeded.
+	require.NoError(t, err)
+	require.False(t, k.IsAllowlisted(ctx, "ueure", user.Address))
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, "florin.v2.AllowlistAccountRemoved", events[0].Type)
+}
+
+func TestRemoveRateLimit(t *testing.T) {
+	k, ctx := mocks.FlorinKeeper()
+	server := keeper.NewMsgServer(k)
+
+	// ACT: Attempt to remove rate limit with not allowed denom.
+	_, err := server.RemoveRateLimit(ctx, &types.MsgRemoveRateLimit{
+		Denom:  "uusde",
+		Signer: utils.TestAccount().Address,
+	})
+	// ASSERT: The action should've failed due to not allowed denom.
+	require.ErrorContains(t, err, "uusde is not an allowed denom")
+
+	// ARRANGE: Set owner in state.
+	owner, system := utils.TestAccount(), utils.TestAccount()
+	err = k.SetOwner(ctx, "ueure", owner.Address)
+	require.NoError(t, err)
+
+	// ACT: Attempt to remove rate limit with invalid signer.
+	_, err = server.RemoveRateLimit(ctx, &types.MsgRemoveRateLimit{
+		Denom:  "ueure",
+		Signer: utils.TestAccount().Address,
+	})
+	// ASSERT: The action should've failed due to invalid signer.
+	require.ErrorIs(t, err, types.ErrInvalidOwner)
+
+	// ACT: Attempt to remove rate limit that doesn't exist.
+	_, err = server.RemoveRateLimit(ctx, &types.MsgRemoveRateLimit{
+		Denom:   "ueure",
+		Signer:  owner.Address,
+		Address: system.Address,
+	})
+	// ASSERT: The action should've failed due to no rate limit.
+	require.ErrorIs(t, err, types.ErrNoRateLimit)
+
+	// ARRANGE: Set rate limit and usage in state.
+	err = k.SetRateLimit(ctx, types.RateLimit{Denom: "ueure", Address: system.Address, Amount: One, WindowBlocks: 10})
+	require.NoError(t, err)
+	err = k.SetRateLimitUsage(ctx, types.NewRateLimitUsage("ueure", system.Address))
+	require.NoError(t, err)
+
+	// ACT: Attempt to remove rate limit.
+	_, err = server.RemoveRateLimit(ctx, &types.MsgRemoveRateLimit{
+		Denom:   "ueure",
+		Signer:  owner.Address,
+		Address: system.Address,
+	})
+	// ASSERT: The action should've succeeded.
+	require.NoError(t, err)
+	_, found := k.GetRateLimit(ctx, "ueure", system.Address)
+	require.False(t, found)
+	_, found = k.GetRateLimitUsage(ctx, "ueure", system.Address)
+	require.False(t, found)
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, "florin.v2.RateLimitRemoved", events[0].Type)
+}
+
+func TestRemoveSystemAccount(t *testing.T) {
+	k, ctx := mocks.FlorinKeeper()
+	server := keeper.NewMsgServer(k)
+
+	// ACT: Attempt to remove system account with not allowed denom.
+	_, err := server.RemoveSystemAccount(ctx, &types.MsgRemoveSystemAccount{
+		Denom:   "uusde",
+		Signer:  utils.TestAccount().Address,
+		Account: utils.TestAccount().Address,
+	})
+	// ASSERT: The action should've failed due to not allowed denom.
+	require.ErrorContains(t, err, "uusde is not an allowed denom")
+
+	// ACT: Attempt to remove system account with no owner set.
+	_, err = server.RemoveSystemAccount(ctx, &types.MsgRemoveSystemAccount{
+		Denom:   "ueure",
+		Signer:  utils.TestAccount().Address,
+		Account: utils.TestAccount().Address,
+	})
+	// ASSERT: The action should've failed due to no owner set.
+	require.ErrorIs(t, err, types.ErrNoOwner)
+
+	// ARRANGE: Set owner in state.
+	owner := utils.TestAccount()
+	err = k.SetOwner(ctx, "ueure", owner.Address)
+	require.NoError(t, err)
+
+	// ACT: Attempt to remove system account with invalid signer.
+	_, err = server.RemoveSystemAccount(ctx, &types.MsgRemoveSystemAccount{
+		Denom:   "ueure",
+		Signer:  utils.TestAccount().Address,
+		Account: utils.TestAccount().Address,
+	})
+	// ASSERT: The action should've failed due to invalid signer.
+	require.ErrorIs(t, err, types.ErrInvalidOwner)
+
+	// ARRANGE: Set system in state.
+	system := utils.TestAccount()
+	err = k.SetSystem(ctx, "ueure", system.Address)
+	require.NoError(t, err)
+	require.True(t, k.IsSystem(ctx, "ueure", system.Address))
+
+	// ARRANGE: Set up a failing collection store for the attribute deleter.
+	tmp := k.Systems
+	k.Systems = collections.NewKeySet(
+		collections.NewSchemaBuilder(mocks.FailingStore(mocks.Delete, utils.GetKVStore(ctx, types.ModuleName))),
+		types.SystemPrefix, "systems", types.AccountKeyCodec,
+	)
+
+	// ACT: Attempt to remove system account with failing Systems collection store.
+	_, err = server.RemoveSystemAccount(ctx, &types.MsgRemoveSystemAccount{
+		Denom:   "ueure",
+		Signer:  owner.Address,
+		Account: system.Address,
+	})
+	// ASSERT: The action should've failed due to collection store deleter error.
+	require.Error(t, err, mocks.ErrorStoreAccess)
+	k.Systems = tmp
+
+	// ACT: Attempt to remove system account.
+	_, err = server.RemoveSystemAccount(ctx, &types.MsgRemoveSystemAccount{
+		Denom:   "ueure",
+		Signer:  owner.Address,
+		Account: system.Address,
+	})
+	// ASSERT: The action should've succeeded.
+	require.NoError(t, err)
+	require.False(t, k.IsSystem(ctx, "ueure", system.Address))
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, "florin.v2.SystemAccountRemoved", events[0].Type)
+}
+
+func TestSeize(t *testing.T) {
+	bank := mocks.BankKeeper{
+		Balances:    make(map[string]sdk.Coins),
+		Locked:      make(map[string]sdk.Coins),
+		Restriction: mocks.NoOpSendRestrictionFn,
+	}
+	k, ctx := mocks.FlorinWithKeepers(bank)
+	server := keeper.NewMsgServer(k)
+
+	// ACT: Attempt to seize with not allowed denom.
+	_, err := server.Seize(ctx, &types.MsgSeize{
+		Denom:  "uusde",
+		Signer: utils.TestAccount().Address,
+		From:   utils.TestAccount().Address,
+		To:     utils.TestAccount().Address,
+	})
+	// ASSERT: The action should've failed due to not allowed denom.
+	require.ErrorContains(t, err, "uusde is not an allowed denom")
+
+	// ACT: Attempt to seize with no seizer set.
+	_, err = server.Seize(ctx, &types.MsgSeize{
+		Denom:  "ueure",
+		Signer: utils.TestAccount().Address,
+		From:   utils.TestAccount().Address,
+		To:     utils.TestAccount().Address,
+	})
+	// ASSERT: The action should've failed due to invalid signer.
+	require.ErrorIs(t, err, types.ErrInvalidSeizer)
+
+	// ARRANGE: Set owner and seizer in state, and give a user and an adversary 1 $EURe each.
+	owner, seizer, user, adversary, treasury := utils.TestAccount(), utils.TestAccount(), utils.TestAccount(), utils.TestAccount(), utils.TestAccount()
+	require.NoError(t, k.SetOwner(ctx, "ueure", owner.Address))
+	require.NoError(t, k.SetSeizer(ctx, "ueure", seizer.Address))
+	bank.Balances[user.Address] = sdk.NewCoins(sdk.NewCoin("ueure", One))
+	bank.Balances[adversary.Address] = sdk.NewCoins(sdk.NewCoin("ueure", One))
+
+	// ACT: Attempt to seize with the owner.
+	_, err = server.Seize(ctx, &types.MsgSeize{
+		Denom:  "ueure",
+		Signer: owner.Address,
+		From:   adversary.Address,
+		To:     treasury.Address,
+	})
+	// ASSERT: The action should've failed due to invalid signer.
+	require.ErrorIs(t, err, types.ErrInvalidSeizer)
+
+	// ACT: Attempt to seize from a non-banned address.
+	_, err = server.Seize(ctx, &types.MsgSeize{
+		Denom:  "ueure",
+		Signer: seizer.Address,
+		From:   user.Address,
+		To:     treasury.Address,
+	})
+	// ASSERT: The action should've failed due to the address not being banned.
+	require.ErrorIs(t, err, types.ErrNotBanned)
+	require.Equal(t, One, bank.Balances[user.Address].AmountOf("ueure"))
+
+	// ARRANGE: Ban the adversary.
+	require.NoError(t, k.SetAdversary(ctx, adversary.Address))
+
+	// ACT: Attempt to seize to a banned address.
+	_, err = server.Seize(ctx, &types.MsgSeize{
+		Denom:  "ueure",
+		Signer: seizer.Address,
+		From:   adversary.Address,
+		To:     adversary.Address,
+	})
+	// ASSERT: The action should've failed due to blocked recipient.
+	require.ErrorContains(t, err, "blocked from receiving")
+
+	// ARRANGE: Set paused state to true.
+	require.NoError(t, k.SetPaused(ctx, "ueure", true))
+
+	// ACT: Attempt to seize when paused.
+	_, err = server.Seize(ctx, &types.MsgSeize{
+		Denom:  "ueure",
+		Signer: seizer.Address,
+		From:   adversary.Address,
+		To:     treasury.Address,
+	})
+	// ASSERT: The action should've failed due to module being paused.
+	require.ErrorIs(t, err, types.ErrPaused)
+
+	// ARRANGE: Set paused state to false, and limit the balance of the treasury.
+	require.NoError(t, k.SetPaused(ctx, "ueure", false))
+	require.NoError(t, k.SetAccountLimit(ctx, types.AccountLimit{
+		Denom:           "ueure",
+		Address:         treasury.Address,
+		MaxBalance:      One.QuoRaw(2),
+		MaxTransfer:     math.ZeroInt(),
+		MaxDailyOutflow: math.ZeroInt(),
+	}))
+
+	// ACT: Attempt to seize above the max balance of the treasury.
+	_, err = server.Seize(ctx, &types.MsgSeize{
+		Denom:  "ueure",
+		Signer: seizer.Address,
+		From:   adversary.Address,
+		To:     treasury.Address,
+	})
+	// ASSERT: The action should've failed, as recipient limits are still enforced.
+	require.ErrorIs(t, err, types.ErrAccountLimitExceeded)
+	require.Equal(t, One, bank.Balances[adversary.Address].AmountOf("ueure"))
+
+	// ARRANGE: Remove the limit of the treasury.
+	require.NoError(t, k.DeleteAccountLimit(ctx, "ueure", treasury.Address))
+
+	// ACT: Attempt to seize.
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	_, err = server.Seize(ctx, &types.MsgSeize{
+		Denom:  "ueure",
+		Signer: seizer.Address,
+		From:   adversary.Address,
+		To:     treasury.Address,
+	})
+	// ASSERT: The action should've succeeded, bypassing the send restriction.
+	require.NoError(t, err)
+	require.True(t, bank.Balances[adversary.Address].IsZero())
+	require.Equal(t, One, bank.Balances[treasury.Address].AmountOf("ueure"))
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, "florin.v2.Seized", events[0].Type)
+
+	// ACT: Attempt to transfer from the adversary outside of a seizure.
+	_, err = k.SendRestrictionFn(ctx, sdk.MustAccAddressFromBech32(adversary.Address), sdk.MustAccAddressFromBech32(treasury.Address), sdk.NewCoins(sdk.NewCoin("ueure", One)))
+	// ASSERT: The transfer should've failed due to the send restriction.
+	require.ErrorContains(t, err, "blocked from sending")
+
+	// ARRANGE: Give a vesting adversary 2 $EURe, of which 1 $EURe is locked.
+	vesting := utils.TestAccount()
+	bank.Balances[vesting.Address] = sdk.NewCoins(sdk.NewCoin("ueure", One.MulRaw(2)))
+	bank.Locked[vesting.Address] = sdk.NewCoins(sdk.NewCoin("ueure", One))
+	require.NoError(t, k.SetAdversary(ctx, vesting.Address))
+
+	// ACT: Attempt to seize from a vesting adversary.
+	_, err = server.Seize(ctx, &types.MsgSeize{
+		Denom:  "ueure",
+		Signer: seizer.Address,
+		From:   vesting.Address,
+		To:     treasury.Address,
+	})
+	// ASSERT: The action should've succeeded, seizing only the spendable balance.
+	require.NoError(t, err)
+	require.Equal(t, One, bank.Balances[vesting.Address].AmountOf("ueure"))
+	require.Equal(t, One.MulRaw(2), bank.Balances[treasury.Address].AmountOf("ueure"))
+}
+
+func TestSetAccountLimit(t *testing.T) {
+	k, ctx := mocks.FlorinKeeper()
+	server := keeper.NewMsgServer(k)
+
+	// ACT: Attempt to set account limit with not allowed denom.
+	_, err := server.SetAccountLimit(ctx, &types.MsgSetAccountLimit{
+		Denom:           "uusde",
+		Signer:          utils.TestAccount().Address,
+		MaxBalance:      One,
+		MaxTransfer:     One,
+		MaxDailyOutflow: One,
+	})
+	// ASSERT: The action should've failed due to not allowed denom.
+	require.ErrorContains(t, err, "uusde is not an allowed denom")
+
+	// ACT: Attempt to set account limit with no owner set.
+	_, err = server.SetAccountLimit(ctx, &types.MsgSetAccountLimit{
+		Denom:           "ueure",
+		Signer:          utils.TestAccount().Address,
+		MaxBalance:      One,
+		MaxTransfer:     One,
+		MaxDailyOutflow: One,
+	})
+	// ASSERT: The action should've failed due to no owner set.
+	require.ErrorIs(t, err, types.ErrNoOwner)
+
+	// ARRANGE: Set owner in state.
+	owner, user := utils.TestAccount(), utils.TestAccount()
+	require.NoError(t, k.SetOwner(ctx, "ueure", owner.Address))
+
+	// ACT: Attempt to set account limit with invalid signer.
+	_, err = server.SetAccountLimit(ctx, &types.MsgSetAccountLimit{
+		Denom:           "ueure",
+		Signer:          utils.TestAccount().Address,
+		MaxBalance:      One,
+		MaxTransfer:     One,
+		MaxDailyOutflow: One,
+	})
+	// ASSERT: The action should've failed due to invalid signer.
+	require.ErrorIs(t, err, types.ErrInvalidOwner)
+
+	// ACT: Attempt to set account limit with a negative amount.
+	_, err = server.SetAccountLimit(ctx, &types.MsgSetAccountLimit{
+		Denom:           "ueure",
+		Signer:          owner.Address,
+		MaxBalance:      One.Neg(),
+		MaxTransfer:     math.ZeroInt(),
+		MaxDailyOutflow: math.ZeroInt(),
+	})
+	// ASSERT: The action should've failed due to invalid account limit.
+	require.ErrorIs(t, err, types.ErrInvalidAccountLimit)
+
+	// ACT: Attempt to set account limit with invalid address.
+	_, err = server.SetAccountLimit(ctx, &types.MsgSetAccountLimit{
+		Denom:           "ueure",
+		Signer:          owner.Address,
+		Address:         "invalid",
+		MaxBalance:      One,
+		MaxTransfer:     math.ZeroInt(),
+		MaxDailyOutflow: math.ZeroInt(),
+	})
+	// ASSERT: The action should've failed due to invalid address.
+	require.ErrorIs(t, err, types.ErrInvalidAddress)
+
+	// ACT: Attempt to set the default account limit.
+	_, err = server.SetAccountLimit(ctx, &types.MsgSetAccountLimit{
+		Denom:           "ueure",
+		Signer:          owner.Address,
+		MaxBalance:      One.MulRaw(10),
+		MaxTransfer:     math.ZeroInt(),
+		MaxDailyOutflow: One.MulRaw(5),
+	})
+	// ASSERT: The action should've succeeded.
+	require.NoError(t, err)
+
+	// ACT: Attempt to set an account limit override.
+	_, err = server.SetAccountLimit(ctx, &types.MsgSetAccountLimit{
+		Denom:           "ueure",
+		Signer:          owner.Address,
+		Address:         user.Address,
+		MaxBalance:      One,
+		MaxTransfer:     One,
+		MaxDailyOutflow: math.ZeroInt(),
+	})
+	// ASSERT: The action should've succeeded, and the override should apply to the user.
+	require.NoError(t, err)
+	limit, isDefault, found := k.GetEffectiveAccountLimit(ctx, "ueure", user.Address)
+	require.True(t, found)
+	require.False(t, isDefault)
+	require.Equal(t, One, limit.MaxBalance)
+	limit, isDefault, found = k.GetEffectiveAccountLimit(ctx, "ueure", owner.Address)
+	require.True(t, found)
+	require.True(t, isDefault)
+	require.Equal(t, One.MulRaw(10), limit.MaxBalance)
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 2)
+	require.Equal(t, "florin.v2.AccountLimitSet", events[1].Type)
+}
+
+func TestSetMaxMintAllowance(t *testing.T) {
+	k, ctx := mocks.FlorinKeeper()
+	server := keeper.NewMsgServer(k)
+
+	// ACT: Attempt to set max mint allowance with not allowed denom.
+	_, err := server.SetMaxMintAllowance(ctx, &types.MsgSetMaxMintAllowance{
+		Denom:  "uusde",
+		Signer: utils.TestAccount().Address,
+		Amount: One,
+	})
+	// ASSERT: The action should've failed due to not allowed denom.
+	require.ErrorContains(t, err, "uusde is not an allowed denom")
+
+	// ACT: Attempt to set max mint allowance with no owner set.
+	_, err = server.SetMaxMintAllowance(ctx, &types.MsgSetMaxMintAllowance{
+		Denom:  "ueure",
+		Signer: utils.TestAccount().Address,
+		Amount: One,
+	})
+	// ASSERT: The action should've failed due to no owner set.
+	require.ErrorIs(t, err, types.ErrNoOwner)
+
+	// ARRANGE: Set owner in state.
+	owner := utils.TestAccount()
+	err = k.SetOwner(ctx, "ueure", owner.Address)
+	require.NoError(t, err)
+
+	// ACT: Attempt to set max mint allowance with invalid signer.
+	_, err = server.SetMaxMintAllowance(ctx, &types.MsgSetMaxMintAllowance{
+		Denom:  "ueure",
+		Signer: utils.TestAccount().Address,
+		Amount: One,
+	})
+	// ASSERT: The action should've failed due to invalid signer.
+	require.ErrorIs(t, err, types.ErrInvalidOwner)
+
+	// ARRANGE: Set up a failing collection store for the attribute setter.
+	tmp := k.MaxMintAllowance
+	k.MaxMintAllowance = collections.NewMap(
+		collections.NewSchemaBuilder(mocks.FailingStore(mocks.Set, utils.GetKVStore(ctx, types.ModuleName))),
+		types.MaxMintAllowancePrefix, "maxMintAllowance", collections.StringKey, collections.BytesValue,
+	)
+
+	// ACT: Attempt to set max mint allowance with failing MaxMintAllowance collection store.
+	_, err = server.SetMaxMintAllowance(ctx, &types.MsgSetMaxMintAllowance{
+		Denom:  "ueure",
+		Signer: owner.Address,
+		Amount: MaxMintAllowance,
+	})
+	// ASSERT: The action should've failed due to collection store setter error.
+	require.Error(t, err, mocks.ErrorStoreAccess)
+	k.MaxMintAllowance = tmp
+
+	// ACT: Attempt to set max mint allowance.
+	_, err = server.SetMaxMintAllowance(ctx, &types.MsgSetMaxMintAllowance{
+		Denom:  "ueure",
+		Signer: owner.Address,
+		Amount: MaxMintAllowance,
+	})
+	// ASSERT: The action should've succeeded.
+	require.NoError(t, err)
+	require.NoError(t, err)
+	require.Equal(t, MaxMintAllowance, k.GetMaxMintAllowance(ctx, "ueure"))
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, "florin.v2.MaxMintAllowance", events[0].Type)
+}
+
+func TestSetMintAllowance(t *testing.T) {
+	k, ctx := mocks.FlorinKeeper()
+	server := keeper.NewMsgServer(k)
+
+	// ARRANGE: Set admin in state.
+	admin := utils.TestAccount()
+	err := k.SetAdmin(ctx, "ueure", admin.Address)
+	require.NoError(t, err)
+	// ARRANGE: Set max mint allowance in state.
+	err = k.SetMaxMintAllowance(ctx, "ueure", MaxMintAllowance)
+	require.NoError(t, err)
+
+	// ACT: Attempt to set mint allowance with invalid denom.
+	_, err = server.SetMintAllowance(ctx, &types.MsgSetMintAllowance{
+		Denom:   "uusde",
+		Signer:  utils.TestAccount().Address,
+		Account: utils.TestAccount().Address,
+		Amount:  One,
+	})
+	// ASSERT: The action should've failed due to not allowed denom.
+	require.ErrorContains(t, err, "uusde is not an allowed denom")
+
+	// ACT: Attempt to set mint allowance with invalid signer.
+	_, err = server.SetMintAllowance(ctx, &types.MsgSetMintAllowance{
+		Denom:   "ueure",
+		Signer:  utils.TestAccount().Address,
+		Account: utils.TestAccount().Address,
+		Amount:  One,
+	})
+	// ASSERT: The action should've failed due to invalid signer.
+	require.ErrorIs(t, err, types.ErrInvalidAdmin)
+
+	// ACT: Attempt to set mint allowance with negative amount.
+	_, err = server.SetMintAllowance(ctx, &types.MsgSetMintAllowance{
+		Denom:   "ueure",
+		Signer:  admin.Address,
+		Amount:  One.Neg(),
+		Account: utils.TestAccount().Address,
+	})
+	// ASSERT: The action should've failed due to negative amount.
+	require.ErrorIs(t, err, types.ErrInvalidAllowance)
+
+	// ACT: Attempt to set mint allowance to more than max.
+	_, err = server.SetMintAllowance(ctx, &types.MsgSetMintAllowance{
+		Denom:   "ueure",
+		Signer:  admin.Address,
+		Amount:  MaxMintAllowance.Add(One),
+		Account: utils.TestAccount().Address,
+	})
+	// ASSERT: The action should've failed due to more than max.
+	require.ErrorIs(t, err, types.ErrInvalidAllowance)
+
+	// ARRANGE: Generate a minter account.
+	minter := utils.TestAccount()
+
+	// ARRANGE: Set up a failing collection store for the attribute setter.
+	tmp := k.MintAllowance
+	k.MintAllowance = collections.NewMap(
+		collections.NewSchemaBuilder(mocks.FailingStore(mocks.Set, utils.GetKVStore(ctx, types.ModuleName))),
+		types.MintAllowancePrefix, "mintAllowance", types.AccountKeyCodec, collections.BytesValue,
+	)
+
+	// ACT: Attempt to set mint allowance with failing MintAllowance collection store.
+	_, err = server.SetMintAllowance(ctx, &types.MsgSetMintAllowance{
+		Denom:   "ueure",
+		Signer:  admin.Address,
+		Account: minter.Address,
+		Amount:  One,
+	})
+	// ASSERT: The action should've failed due to collection store setter error.
+	require.Error(t, err, mocks.ErrorStoreAccess)
+	k.MintAllowance = tmp
+
+	// ACT: Attempt to set mint allowance.
+	_, err = server.SetMintAllowance(ctx, &types.MsgSetMintAllowance{
+		Denom:   "ueure",
+		Signer:  admin.Address,
+		Account: minter.Address,
+		Amount:  One,
+	})
+	// ASSERT: The action should've succeeded.
+	require.NoError(t, err)
+	require.NoError(t, err)
+	require.Equal(t, One, k.GetMintAllowance(ctx, "ueure", minter.Address))
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, "florin.v2.MintAllowance", events[0].Type)
+}
+
+func TestSetMintApprovalPolicy(t *testing.T) {
+	k, ctx := mocks.FlorinKeeper()
+	server := keeper.NewMsgServer(k)
+
+	// ACT: Attempt to set mint approval policy with not allowed denom.
+	_, err := server.SetMintApprovalPolicy(ctx, &types.MsgSetMintApprovalPolicy{
+		Denom:     "uusde",
+		Signer:    utils.TestAccount().Address,
+		Threshold: math.ZeroInt(),
+	})
+	// ASSERT: The action should've failed due to not allowed denom.
+	require.ErrorContains(t, err, "uusde is not an allowed denom")
+
+	// ACT: Attempt to set mint approval policy with no owner set.
+	_, err = server.SetMintApprovalPolicy(ctx, &types.MsgSetMintApprovalPolicy{
+		Denom:     "ueure",
+		Signer:    utils.TestAccount().Address,
+		Threshold: math.ZeroInt(),
+	})
+	// ASSERT: The action should've failed due to no owner set.
+	require.ErrorIs(t, err, types.ErrNoOwner)
+
+	// ARRANGE: Set owner in state.
+	owner := utils.TestAccount()
+	err = k.SetOwner(ctx, "ueure", owner.Address)
+	require.NoError(t, err)
+
+	// ACT: Attempt to set mint approval policy with invalid signer.
+	_, err = server.SetMintApprovalPolicy(ctx, &types.MsgSetMintApprovalPolicy{
+		Denom:     "ueure",
+		Signer:    utils.TestAccount().Address,
+		Threshold: math.ZeroInt(),
+	})
+	// ASSERT: The action should've failed due to invalid signer.
+	require.ErrorIs(t, err, types.ErrInvalidOwner)
+
+	// ACT: Attempt to set mint approval policy without quorum.
+	_, err = server.SetMintApprovalPolicy(ctx, &types.MsgSetMintApprovalPolicy{
+		Denom:        "ueure",
+		Signer:       owner.Address,
+		Threshold:    One,
+		ExpiryBlocks: 10,
+	})
+	// ASSERT: The action should've failed due to invalid policy.
+	require.ErrorIs(t, err, types.ErrInvalidApprovalPolicy)
+
+	// ACT: Attempt to set mint approval policy without expiry.
+	_, err = server.SetMintApprovalPolicy(ctx, &types.MsgSetMintApprovalPolicy{
+		Denom:     "ueure",
+		Signer:    owner.Address,
+		Threshold: One,
+		Quorum:    1,
+	})
+	// ASSERT: The action should've failed due to invalid policy.
+	require.ErrorIs(t, err, types.ErrInvalidApprovalPolicy)
+
+	// ARRANGE: Set up a failing collection store for the attribute setter.
+	tmp := k.MintApprovalPolicies
+	k.MintApprovalPolicies = collections.NewMap(
+		collections.NewSchemaBuilder(mocks.FailingStore(mocks.Set, utils.GetKVStore(ctx, types.ModuleName))),
+		types.MintApprovalPolicyPrefix, "mintApprovalPolicies", collections.StringKey, codec.CollValue[types.MintApprovalPolicy](codec.NewProtoCodec(codectypes.NewInterfaceRegistry())),
+	)
+
+	// ACT: Attempt to set mint approval policy with failing MintApprovalPolicies collection store.
+	_, err = server.SetMintApprovalPolicy(ctx, &types.MsgSetMintApprovalPolicy{
+		Denom:        "ueure",
+		Signer:       owner.Address,
+		Threshold:    One,
+		Quorum:       2,
+		ExpiryBlocks: 10,
+	})
+	// ASSERT: The action should've failed due to collection store setter error.
+	require.Error(t, err, mocks.ErrorStoreAccess)
+	k.MintApprovalPolicies = tmp
+
+	// ACT: Attempt to set mint approval policy.
+	_, err = server.SetMintApprovalPolicy(ctx, &types.MsgSetMintApprovalPolicy{
+		Denom:        "ueure",
+		Signer:       owner.Address,
+		Threshold:    One,
+		Quorum:       2,
+		ExpiryBlocks: 10,
+	})
+	// ASSERT: The action should've succeeded.
+	require.NoError(t, err)
+	policy, found := k.GetMintApprovalPolicy(ctx, "ueure")
+	require.True(t, found)
+	require.Equal(t, uint32(2), policy.Quorum)
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, "florin.v2.MintApprovalPolicySet", events[0].Type)
+
+	// ACT: Attempt to disable mint approval policy.
+	_, err = server.SetMintApprovalPolicy(ctx, &types.MsgSetMintApprovalPolicy{
+		Denom:     "ueure",
+		Signer:    owner.Address,
+		Threshold: math.ZeroInt(),
+	})
+	// ASSERT: The action should've succeeded, and removed the policy.
+	require.NoError(t, err)
+	_, found = k.GetMintApprovalPolicy(ctx, "ueure")
+	require.False(t, found)
+}
+
+func TestSetPauser(t *testing.T) {
+	k, ctx := mocks.FlorinKeeper()
+	server := keeper.NewMsgServer(k)
+
+	// ACT: Attempt to set pauser with not allowed denom.
+	_, err := server.SetPauser(ctx, &types.MsgSetPauser{
+		Denom:  "uusde",
 		Signer: utils.TestAccount().Address,
 	})
-	// ASSERT: The action should've failed due to invalid signer.
-	require.ErrorIs(t, err, types.ErrInvalidOwner)
+	// ASSERT: The action should've failed due to not allowed denom.
+	require.ErrorContains(t, err, "uusde is not an allowed denom")
+
+	// ACT: Attempt to set pauser with no owner set.
+	_, err = server.SetPauser(ctx, &types.MsgSetPauser{
+		Denom:  "ueure",
+		Signer: utils.TestAccount().Address,
+	})
+	// ASSERT: The action should've failed due to no owner set.
+	require.ErrorIs(t, err, types.ErrNoOwner)
+
+	// ARRANGE: Set owner in state.
+	owner := utils.TestAccount()
+	err = k.SetOwner(ctx, "ueure", owner.Address)
+	require.NoError(t, err)
+
+	// ACT: Attempt to set pauser with invalid signer.
+	_, err = server.SetPauser(ctx, &types.MsgSetPauser{
+		Denom:  "ueure",
+		Signer: utils.TestAccount().Address,
+	})
+	// ASSERT: The action should've failed due to invalid signer.
+	require.ErrorIs(t, err, types.ErrInvalidOwner)
+
+	// ARRANGE: Generate a pauser account.
+	pauser := utils.TestAccount()
+
+	// ARRANGE: Set up a failing collection store for the attribute setter.
+	tmp := k.Pauser
+	k.Pauser = collections.NewMap(
+		collections.NewSchemaBuilder(mocks.FailingStore(mocks.Set, utils.GetKVStore(ctx, types.ModuleName))),
+		types.PauserPrefix, "pauser", collections.StringKey, collections.StringValue,
+	)
+
+	// ACT: Attempt to set pauser with failing Pauser collection store.
+	_, err = server.SetPauser(ctx, &types.MsgSetPauser{
+		Denom:  "ueure",
+		Signer: owner.Address,
+		Pauser: pauser.Address,
+	})
+	// ASSERT: The action should've failed due to collection store setter error.
+	require.Error(t, err, mocks.ErrorStoreAccess)
+	k.Pauser = tmp
+
+	// ACT: Attempt to set pauser.
+	_, err = server.SetPauser(ctx, &types.MsgSetPauser{
+		Denom:  "ueure",
+		Signer: owner.Address,
+		Pauser: pauser.Address,
+	})
+	// ASSERT: The action should've succeeded.
+	require.NoError(t, err)
+	require.Equal(t, pauser.Address, k.GetPauser(ctx, "ueure"))
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, "florin.v2.PauserUpdated", events[0].Type)
+}
+
+func TestSetRateLimit(t *testing.T) {
+	k, ctx := mocks.FlorinKeeper()
+	server := keeper.NewMsgServer(k)
+
+	// ACT: Attempt to set rate limit with not allowed denom.
+	_, err := server.SetRateLimit(ctx, &types.MsgSetRateLimit{
+		Denom:        "uusde",
+		Signer:       utils.TestAccount().Address,
+		Amount:       One,
+		WindowBlocks: 10,
+	})
+	// ASSERT: The action should've failed due to not allowed denom.
+	require.ErrorContains(t, err, "uusde is not an allowed denom")
+
+	// ACT: Attempt to set rate limit with no owner set.
+	_, err = server.SetRateLimit(ctx, &types.MsgSetRateLimit{
+		Denom:        "ueure",
+		Signer:       utils.TestAccount().Address,
+		Amount:       One,
+		WindowBlocks: 10,
+	})
+	// ASSERT: The action should've failed due to no owner set.
+	require.ErrorIs(t, err, types.ErrNoOwner)
+
+	// ARRANGE: Set owner in state.
+	owner, system := utils.TestAccount(), utils.TestAccount()
+	err = k.SetOwner(ctx, "ueure", owner.Address)
+	require.NoError(t, err)
+
+	// ACT: Attempt to set rate limit with invalid signer.
+	_, err = server.SetRateLimit(ctx, &types.MsgSetRateLimit{
+		Denom:        "ueure",
+		Signer:       utils.TestAccount().Address,
+		Amount:       One,
+		WindowBlocks: 10,
+	})
+	// ASSERT: The action should've failed due to invalid signer.
+	require.ErrorIs(t, err, types.ErrInvalidOwner)
+
+	// ACT: Attempt to set rate limit with no window.
+	_, err = server.SetRateLimit(ctx, &types.MsgSetRateLimit{
+		Denom:  "ueure",
+		Signer: owner.Address,
+		Amount: One,
+	})
+	// ASSERT: The action should've failed due to invalid rate limit.
+	require.ErrorIs(t, err, types.ErrInvalidRateLimit)
+
+	// ACT: Attempt to set rate limit with both windows.
+	_, err = server.SetRateLimit(ctx, &types.MsgSetRateLimit{
+		Denom:         "ueure",
+		Signer:        owner.Address,
+		Amount:        One,
+		WindowBlocks:  10,
+		WindowSeconds: 60,
+	})
+	// ASSERT: The action should've failed due to invalid rate limit.
+	require.ErrorIs(t, err, types.ErrInvalidRateLimit)
 
-	// ARRANGE: Set admin in state.
-	admin := utils.TestAccount()
-	err = k.SetAdmin(ctx, "ueure", admin.Address)
-	require.NoError(t, err)
-	require.True(t, k.IsAdmin(ctx, "ueure", admin.Address))
+	// ACT: Attempt to set rate limit with invalid address.
+	_, err = server.SetRateLimit(ctx, &types.MsgSetRateLimit{
+		Denom:        "ueure",
+		Signer:       owner.Address,
+		Address:      "invalid",
+		Amount:       One,
+		WindowBlocks: 10,
+	})
+	// ASSERT: The action should've failed due to invalid address.
+	require.ErrorIs(t, err, types.ErrInvalidAddress)
 
-	// ARRANGE: Set up a failing collection store for the attribute deleter.
-	tmp := k.Admins
-	k.Admins = collections.NewKeySet(
-		collections.NewSchemaBuilder(mocks.FailingStore(mocks.Delete, utils.GetKVStore(ctx, types.ModuleName))),
-		types.AdminPrefix, "admins", collections.BytesKey,
+	// ARRANGE: Set up a failing collection store for the attribute setter.
+	tmp := k.RateLimits
+	k.RateLimits = collections.NewMap(
+		collections.NewSchemaBuilder(mocks.FailingStore(mocks.Set, utils.GetKVStore(ctx, types.ModuleName))),
+		types.RateLimitPrefix, "rateLimits", collections.PairKeyCodec(collections.StringKey, collections.StringKey), codec.CollValue[types.RateLimit](codec.NewProtoCodec(codectypes.NewInterfaceRegistry())),
 	)
 
-	// ACT: Attempt to remove admin account with failing Admins collection store.
-	_, err = server.RemoveAdminAccount(ctx, &types.MsgRemoveAdminAccount{
-		Denom:   "ueure",
-		Signer:  owner.Address,
-		Account: admin.Address,
+	// ACT: Attempt to set rate limit with failing RateLimits collection store.
+	_, err = server.SetRateLimit(ctx, &types.MsgSetRateLimit{
+		Denom:        "ueure",
+		Signer:       owner.Address,
+		Address:      system.Address,
+		Amount:       One,
+		WindowBlocks: 10,
 	})
-	// ASSERT: The action should've failed due to collection store deleter error.
+	// ASSERT: The action should've failed due to collection store setter error.
 	require.Error(t, err, mocks.ErrorStoreAccess)
-	k.Admins = tmp
+	k.RateLimits = tmp
 
-	// ACT: Attempt to remove admin account.
-	_, err = server.RemoveAdminAccount(ctx, &types.MsgRemoveAdminAccount{
-		Denom:   "ueure",
-		Signer:  owner.Address,
-		Account: admin.Address,
+	// ACT: Attempt to set rate limit.
+	_, err = server.SetRateLimit(ctx, &types.MsgSetRateLimit{
+		Denom:        "ueure",
+		Signer:       owner.Address,
+		Address:      system.Address,
+		Amount:       One,
+		WindowBlocks: 10,
 	})
 	// ASSERT: The action should've succeeded.
 	require.NoError(t, err)
-	require.False(t, k.IsAdmin(ctx, "ueure", admin.Address))
+	rateLimit, found := k.GetRateLimit(ctx, "ueure", system.Address)
+	require.True(t, found)
+	require.Equal(t, One, rateLimit.Amount)
+	require.Equal(t, int64(10), rateLimit.WindowBlocks)
 	events := ctx.EventManager().Events()
 	require.Len(t, events, 1)
-	require.Equal(t, "florin.v2.AdminAccountRemoved", events[0].Type)
+	require.Equal(t, "florin.v2.RateLimitSet", events[0].Type)
 }
 
-func TestRemoveSystemAccount(t *testing.T) {
+func TestSetSeizer(t *testing.T) {
 	k, ctx := mocks.FlorinKeeper()
 	server := keeper.NewMsgServer(k)
 
-	// ACT: Attempt to remove system account with not allowed denom.
-	_, err := server.RemoveSystemAccount(ctx, &types.MsgRemoveSystemAccount{
-		Denom: "uusde",
+	// ACT: Attempt to set seizer with not allowed denom.
+	_, err := server.SetSeizer(ctx, &types.MsgSetSeizer{
+		Denom:  "uusde",
+		Signer: utils.TestAccount().Address,
 	})
 	// ASSERT: The action should've failed due to not allowed denom.
 	require.ErrorContains(t, err, "uusde is not an allowed denom")
 
-	// ACT: Attempt to remove system account with no owner set.
-	_, err = server.RemoveSystemAccount(ctx, &types.MsgRemoveSystemAccount{
-		Denom: "ueure",
+	// ACT: Attempt to set seizer with no owner set.
+	_, err = server.SetSeizer(ctx, &types.MsgSetSeizer{
+		Denom:  "ueure",
+		Signer: utils.TestAccount().Address,
 	})
 	// ASSERT: The action should've failed due to no owner set.
 	require.ErrorIs(t, err, types.ErrNoOwner)
 
 	// ARRANGE: Set owner in state.
-	owner := utils.TestAccount()
+	owner, seizer := utils.TestAccount(), utils.TestAccount()
 	err = k.SetOwner(ctx, "ueure", owner.Address)
 	require.NoError(t, err)
 
-	// ACT: Attempt to remove system account with invalid signer.
-	_, err = server.RemoveSystemAccount(ctx, &types.MsgRemoveSystemAccount{
+	// ACT: Attempt to set seizer with invalid signer.
+	_, err = server.SetSeizer(ctx, &types.MsgSetSeizer{
 		Denom:  "ueure",
 		Signer: utils.TestAccount().Address,
+		Seizer: seizer.Address,
 	})
 	// ASSERT: The action should've failed due to invalid signer.
 	require.ErrorIs(t, err, types.ErrInvalidOwner)
 
-	// ARRANGE: Set system in state.
-	system := utils.TestAccount()
-	err = k.SetSystem(ctx, "ueure", system.Address)
-	require.NoError(t, err)
-	require.True(t, k.IsSystem(ctx, "ueure", system.Address))
-
-	// ARRANGE: Set up a failing collection store for the attribute deleter.
-	tmp := k.Systems
-	k.Systems = collections.NewKeySet(
-		collections.NewSchemaBuilder(mocks.FailingStore(mocks.Delete, utils.GetKVStore(ctx, types.ModuleName))),
-		types.SystemPrefix, "systems", collections.BytesKey,
+	// ARRANGE: Set up a failing collection store for the attribute setter.
+	tmp := k.Seizer
+	k.Seizer = collections.NewMap(
+		collections.NewSchemaBuilder(mocks.FailingStore(mocks.Set, utils.GetKVStore(ctx, types.ModuleName))),
+		types.SeizerPrefix, "seizer", collections.StringKey, collections.StringValue,
 	)
 
-	// ACT: Attempt to remove system account with failing Systems collection store.
-	_, err = server.RemoveSystemAccount(ctx, &types.MsgRemoveSystemAccount{
-		Denom:   "ueure",
-		Signer:  owner.Address,
-		Account: system.Address,
+	// ACT: Attempt to set seizer with failing Seizer collection store.
+	_, err = server.SetSeizer(ctx, &types.MsgSetSeizer{
+		Denom:  "ueure",
+		Signer: owner.Address,
+		Seizer: seizer.Address,
 	})
-	// ASSERT: The action should've failed due to collection store deleter error.
+	// ASSERT: The action should've failed due to collection store setter error.
 	require.Error(t, err, mocks.ErrorStoreAccess)
-	k.Systems = tmp
+	k.Seizer = tmp
 
-	// ACT: Attempt to remove system account.
-	_, err = server.RemoveSystemAccount(ctx, &types.MsgRemoveSystemAccount{
-		Denom:   "ueure",
-		Signer:  owner.Address,
-		Account: system.Address,
+	// ACT: Attempt to set seizer.
+	_, err = server.SetSeizer(ctx, &types.MsgSetSeizer{
+		Denom:  "ueure",
+		Signer: owner.Address,
+		Seizer: seizer.Address,
 	})
 	// ASSERT: The action should've succeeded.
 	require.NoError(t, err)
-	require.False(t, k.IsSystem(ctx, "ueure", system.Address))
+	require.Equal(t, seizer.Address, k.GetSeizer(ctx, "ueure"))
 	events := ctx.EventManager().Events()
 	require.Len(t, events, 1)
-	require.Equal(t, "florin.v2.SystemAccountRemoved", events[0].Type)
+	require.Equal(t, "florin.v2.SeizerUpdated", events[0].Type)
 }
 
-func TestSetMaxMintAllowance(t *testing.T) {
+func TestSetSupplyCap(t *testing.T) {
 	k, ctx := mocks.FlorinKeeper()
 	server := keeper.NewMsgServer(k)
 
-	// ACT: Attempt to set max mint allowance with not allowed denom.
-	_, err := server.SetMaxMintAllowance(ctx, &types.MsgSetMaxMintAllowance{
-		Denom: "uusde",
+	// ACT: Attempt to set supply cap with not allowed denom.
+	_, err := server.SetSupplyCap(ctx, &types.MsgSetSupplyCap{
+		Denom:  "uusde",
+		Signer: utils.TestAccount().Address,
+		Amount: One,
 	})
 	// ASSERT: The action should've failed due to not allowed denom.
 	require.ErrorContains(t, err, "uusde is not an allowed denom")
 
-	// ACT: Attempt to set max mint allowance with no owner set.
-	_, err = server.SetMaxMintAllowance(ctx, &types.MsgSetMaxMintAllowance{
-		Denom: "ueure",
+	// ACT: Attempt to set supply cap with no owner set.
+	_, err = server.SetSupplyCap(ctx, &types.MsgSetSupplyCap{
+		Denom:  "ueure",
+		Signer: utils.TestAccount().Address,
+		Amount: One,
 	})
 	// ASSERT: The action should've failed due to no owner set.
 	require.ErrorIs(t, err, types.ErrNoOwner)
@@ -835,126 +3333,136 @@ func TestSetMaxMintAllowance(t *testing.T) {
 	err = k.SetOwner(ctx, "ueure", owner.Address)
 	require.NoError(t, err)
 
-	// ACT: Attempt to set max mint allowance with invalid signer.
-	_, err = server.SetMaxMintAllowance(ctx, &types.MsgSetMaxMintAllowance{
+	// ACT: Attempt to set supply cap with invalid signer.
+	_, err = server.SetSupplyCap(ctx, &types.MsgSetSupplyCap{
 		Denom:  "ueure",
 		Signer: utils.TestAccount().Address,
+		Amount: One,
 	})
 	// ASSERT: The action should've failed due to invalid signer.
 	require.ErrorIs(t, err, types.ErrInvalidOwner)
 
+	// ACT: Attempt to set negative supply cap.
+	_, err = server.SetSupplyCap(ctx, &types.MsgSetSupplyCap{
+		Denom:  "ueure",
+		Signer: owner.Address,
+		Amount: One.Neg(),
+	})
+	// ASSERT: The action should've failed due to invalid supply cap.
+	require.ErrorIs(t, err, types.ErrInvalidSupplyCap)
+
 	// ARRANGE: Set up a failing collection store for the attribute setter.
-	tmp := k.MaxMintAllowance
-	k.MaxMintAllowance = collections.NewMap(
+	tmp := k.SupplyCap
+	k.SupplyCap = collections.NewMap(
 		collections.NewSchemaBuilder(mocks.FailingStore(mocks.Set, utils.GetKVStore(ctx, types.ModuleName))),
-		types.MaxMintAllowancePrefix, "maxMintAllowance", collections.StringKey, collections.BytesValue,
+		types.SupplyCapPrefix, "supplyCap", collections.StringKey, collections.BytesValue,
 	)
 
-	// ACT: Attempt to set max mint allowance with failing MaxMintAllowance collection store.
-	_, err = server.SetMaxMintAllowance(ctx, &types.MsgSetMaxMintAllowance{
+	// ACT: Attempt to set supply cap with failing SupplyCap collection store.
+	_, err = server.SetSupplyCap(ctx, &types.MsgSetSupplyCap{
 		Denom:  "ueure",
 		Signer: owner.Address,
 		Amount: MaxMintAllowance,
 	})
 	// ASSERT: The action should've failed due to collection store setter error.
 	require.Error(t, err, mocks.ErrorStoreAccess)
-	k.MaxMintAllowance = tmp
+	k.SupplyCap = tmp
 
-	// ACT: Attempt to set max mint allowance.
-	_, err = server.SetMaxMintAllowance(ctx, &types.MsgSetMaxMintAllowance{
+	// ACT: Attempt to set supply cap.
+	_, err = server.SetSupplyCap(ctx, &types.MsgSetSupplyCap{
 		Denom:  "ueure",
 		Signer: owner.Address,
 		Amount: MaxMintAllowance,
 	})
 	// ASSERT: The action should've succeeded.
 	require.NoError(t, err)
-	require.NoError(t, err)
-	require.Equal(t, MaxMintAllowance, k.GetMaxMintAllowance(ctx, "ueure"))
+	require.Equal(t, MaxMintAllowance, k.GetSupplyCap(ctx, "ueure"))
 	events := ctx.EventManager().Events()
 	require.Len(t, events, 1)
-	require.Equal(t, "florin.v2.MaxMintAllowance", events[0].Type)
+	require.Equal(t, "florin.v2.SupplyCapSet", events[0].Type)
 }
 
-func TestSetMintAllowance(t *testing.T) {
+func TestSetTransferPolicy(t *testing.T) {
 	k, ctx := mocks.FlorinKeeper()
 	server := keeper.NewMsgServer(k)
 
-	// ARRANGE: Set admin in state.
-	admin := utils.TestAccount()
-	err := k.SetAdmin(ctx, "ueure", admin.Address)
-	require.NoError(t, err)
-	// ARRANGE: Set max mint allowance in state.
-	err = k.SetMaxMintAllowance(ctx, "ueure", MaxMintAllowance)
-	require.NoError(t, err)
-
-	// ACT: Attempt to set mint allowance with invalid denom.
-	_, err = server.SetMintAllowance(ctx, &types.MsgSetMintAllowance{
-		Denom: "uusde",
+	// ACT: Attempt to set transfer policy with not allowed denom.
+	_, err := server.SetTransferPolicy(ctx, &types.MsgSetTransferPolicy{
+		Denom:  "uusde",
+		Signer: utils.TestAccount().Address,
 	})
 	// ASSERT: The action should've failed due to not allowed denom.
 	require.ErrorContains(t, err, "uusde is not an allowed denom")
 
-	// ACT: Attempt to set mint allowance with invalid signer.
-	_, err = server.SetMintAllowance(ctx, &types.MsgSetMintAllowance{
+	// ACT: Attempt to set transfer policy with no owner set.
+	_, err = server.SetTransferPolicy(ctx, &types.MsgSetTransferPolicy{
 		Denom:  "ueure",
 		Signer: utils.TestAccount().Address,
 	})
-	// ASSERT: The action should've failed due to invalid signer.
-	require.ErrorIs(t, err, types.ErrInvalidAdmin)
+	// ASSERT: The action should've failed due to no owner set.
+	require.ErrorIs(t, err, types.ErrNoOwner)
 
-	// ACT: Attempt to set mint allowance with negative amount.
-	_, err = server.SetMintAllowance(ctx, &types.MsgSetMintAllowance{
+	// ARRANGE: Set owner in state.
+	owner := utils.TestAccount()
+	require.NoError(t, k.SetOwner(ctx, "ueure", owner.Address))
+
+	// ACT: Attempt to set transfer policy with invalid signer.
+	_, err = server.SetTransferPolicy(ctx, &types.MsgSetTransferPolicy{
 		Denom:  "ueure",
-		Signer: admin.Address,
-		Amount: One.Neg(),
+		Signer: utils.TestAccount().Address,
+		Policy: types.TransferPolicyAllowlist,
 	})
-	// ASSERT: The action should've failed due to negative amount.
-	require.ErrorIs(t, err, types.ErrInvalidAllowance)
+	// ASSERT: The action should've failed due to invalid signer.
+	require.ErrorIs(t, err, types.ErrInvalidOwner)
 
-	// ACT: Attempt to set mint allowance to more than max.
-	_, err = server.SetMintAllowance(ctx, &types.MsgSetMintAllowance{
+	// ACT: Attempt to set an unknown transfer policy.
+	_, err = server.SetTransferPolicy(ctx, &types.MsgSetTransferPolicy{
 		Denom:  "ueure",
-		Signer: admin.Address,
-		Amount: MaxMintAllowance.Add(One),
+		Signer: owner.Address,
+		Policy: types.TransferPolicy(99),
 	})
-	// ASSERT: The action should've failed due to more than max.
-	require.ErrorIs(t, err, types.ErrInvalidAllowance)
+	// ASSERT: The action should've failed due to invalid transfer policy.
+	require.ErrorIs(t, err, types.ErrInvalidTransferPolicy)
 
-	// ARRANGE: Generate a minter account.
-	minter := utils.TestAccount()
+	// ACT: Attempt to set transfer policy.
+	_, err = server.SetTransferPolicy(ctx, &types.MsgSetTransferPolicy{
+		Denom:  "ueure",
+		Signer: owner.Address,
+		Policy: types.TransferPolicyAllowlist,
+	})
+	// ASSERT: The action should've succeeded.
+	require.NoError(t, err)
+	require.Equal(t, types.TransferPolicyAllowlist, k.GetTransferPolicy(ctx, "ueure"))
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, "florin.v2.TransferPolicyUpdated", events[0].Type)
 
-	// ARRANGE: Set up a failing collection store for the attribute setter.
-	tmp := k.MintAllowance
-	k.MintAllowance = collections.NewMap(
-		collections.NewSchemaBuilder(mocks.FailingStore(mocks.Set, utils.GetKVStore(ctx, types.ModuleName))),
-		types.MintAllowancePrefix, "mintAllowance", collections.BytesKey, collections.BytesValue,
-	)
+	// ARRANGE: Ban an address for $EURe only.
+	adversary := utils.TestAccount()
+	require.NoError(t, k.SetDenomAdversary(ctx, "ueure", adversary.Address))
 
-	// ACT: Attempt to set mint allowance with failing MintAllowance collection store.
-	_, err = server.SetMintAllowance(ctx, &types.MsgSetMintAllowance{
-		Denom:   "ueure",
-		Signer:  admin.Address,
-		Account: minter.Address,
-		Amount:  One,
+	// ACT: Attempt to set the open transfer policy while a denom ban exists.
+	_, err = server.SetTransferPolicy(ctx, &types.MsgSetTransferPolicy{
+		Denom:  "ueure",
+		Signer: owner.Address,
+		Policy: types.TransferPolicyOpen,
 	})
-	// ASSERT: The action should've failed due to collection store setter error.
-	require.Error(t, err, mocks.ErrorStoreAccess)
-	k.MintAllowance = tmp
+	// ASSERT: The action should've failed due to invalid transfer policy.
+	require.ErrorIs(t, err, types.ErrInvalidTransferPolicy)
+	require.Equal(t, types.TransferPolicyAllowlist, k.GetTransferPolicy(ctx, "ueure"))
 
-	// ACT: Attempt to set mint allowance.
-	_, err = server.SetMintAllowance(ctx, &types.MsgSetMintAllowance{
-		Denom:   "ueure",
-		Signer:  admin.Address,
-		Account: minter.Address,
-		Amount:  One,
+	// ARRANGE: Unban the address.
+	require.NoError(t, k.DeleteDenomAdversary(ctx, "ueure", adversary.Address))
+
+	// ACT: Attempt to set the open transfer policy.
+	_, err = server.SetTransferPolicy(ctx, &types.MsgSetTransferPolicy{
+		Denom:  "ueure",
+		Signer: owner.Address,
+		Policy: types.TransferPolicyOpen,
 	})
 	// ASSERT: The action should've succeeded.
 	require.NoError(t, err)
-	require.NoError(t, err)
-	require.Equal(t, One, k.GetMintAllowance(ctx, "ueure", minter.Address))
-	events := ctx.EventManager().Events()
-	require.Len(t, events, 1)
-	require.Equal(t, "florin.v2.MintAllowance", events[0].Type)
+	require.Equal(t, types.TransferPolicyOpen, k.GetTransferPolicy(ctx, "ueure"))
 }
 
 func TestTransferOwnership(t *testing.T) {
@@ -963,14 +3471,18 @@ func TestTransferOwnership(t *testing.T) {
 
 	// ACT: Attempt to transfer ownership with not allowed denom.
 	_, err := server.TransferOwnership(ctx, &types.MsgTransferOwnership{
-		Denom: "uusde",
+		Denom:    "uusde",
+		Signer:   utils.TestAccount().Address,
+		NewOwner: utils.TestAccount().Address,
 	})
 	// ASSERT: The action should've failed due to not allowed denom.
 	require.ErrorContains(t, err, "uusde is not an allowed denom")
 
 	// ACT: Attempt to transfer ownership with no owner set.
 	_, err = server.TransferOwnership(ctx, &types.MsgTransferOwnership{
-		Denom: "ueure",
+		Denom:    "ueure",
+		Signer:   utils.TestAccount().Address,
+		NewOwner: utils.TestAccount().Address,
 	})
 	// ASSERT: The action should've failed due to no owner set.
 	require.ErrorIs(t, err, types.ErrNoOwner)
@@ -982,8 +3494,9 @@ func TestTransferOwnership(t *testing.T) {
 
 	// ACT: Attempt to transfer ownership with invalid signer.
 	_, err = server.TransferOwnership(ctx, &types.MsgTransferOwnership{
-		Denom:  "ueure",
-		Signer: utils.TestAccount().Address,
+		Denom:    "ueure",
+		Signer:   utils.TestAccount().Address,
+		NewOwner: utils.TestAccount().Address,
 	})
 	// ASSERT: The action should've failed due to invalid signer.
 	require.ErrorIs(t, err, types.ErrInvalidOwner)
@@ -1031,3 +3544,141 @@ func TestTransferOwnership(t *testing.T) {
 	require.Len(t, events, 1)
 	require.Equal(t, "florin.v2.OwnershipTransferStarted", events[0].Type)
 }
+
+func TestUnpause(t *testing.T) {
+	k, ctx := mocks.FlorinKeeper()
+	server := keeper.NewMsgServer(k)
+
+	// ACT: Attempt to unpause with not allowed denom.
+	_, err := server.Unpause(ctx, &types.MsgUnpause{
+		Denom:  "uusde",
+		Signer: utils.TestAccount().Address,
+	})
+	// ASSERT: The action should've failed due to not allowed denom.
+	require.ErrorContains(t, err, "uusde is not an allowed denom")
+
+	// ARRANGE: Set owner in state.
+	owner := utils.TestAccount()
+	err = k.SetOwner(ctx, "ueure", owner.Address)
+	require.NoError(t, err)
+
+	// ACT: Attempt to unpause with invalid signer.
+	_, err = server.Unpause(ctx, &types.MsgUnpause{
+		Denom:  "ueure",
+		Signer: utils.TestAccount().Address,
+	})
+	// ASSERT: The action should've failed due to invalid signer.
+	require.ErrorIs(t, err, types.ErrInvalidPauser)
+
+	// ACT: Attempt to unpause when not paused.
+	_, err = server.Unpause(ctx, &types.MsgUnpause{
+		Denom:  "ueure",
+		Signer: owner.Address,
+	})
+	// ASSERT: The action should've failed due to not paused.
+	require.ErrorIs(t, err, types.ErrNotPaused)
+
+	// ARRANGE: Pause denom in state.
+	err = k.SetPaused(ctx, "ueure", true)
+	require.NoError(t, err)
+
+	// ACT: Attempt to unpause.
+	_, err = server.Unpause(ctx, &types.MsgUnpause{
+		Denom:  "ueure",
+		Signer: owner.Address,
+	})
+	// ASSERT: The action should've succeeded.
+	require.NoError(t, err)
+	require.False(t, k.IsPaused(ctx, "ueure"))
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, "florin.v2.Unpaused", events[0].Type)
+}
+
+func TestWipe(t *testing.T) {
+	bank := mocks.BankKeeper{
+		Balances:    make(map[string]sdk.Coins),
+		Locked:      make(map[string]sdk.Coins),
+		Restriction: mocks.NoOpSendRestrictionFn,
+	}
+	k, ctx := mocks.FlorinWithKeepers(bank)
+	server := keeper.NewMsgServer(k)
+
+	// ACT: Attempt to wipe with not allowed denom.
+	_, err := server.Wipe(ctx, &types.MsgWipe{
+		Denom:  "uusde",
+		Signer: utils.TestAccount().Address,
+		From:   utils.TestAccount().Address,
+	})
+	// ASSERT: The action should've failed due to not allowed denom.
+	require.ErrorContains(t, err, "uusde is not an allowed denom")
+
+	// ARRANGE: Set seizer in state, and give a user and an adversary 1 $EURe each.
+	seizer, user, adversary := utils.TestAccount(), utils.TestAccount(), utils.TestAccount()
+	require.NoError(t, k.SetSeizer(ctx, "ueure", seizer.Address))
+	bank.Balances[user.Address] = sdk.NewCoins(sdk.NewCoin("ueure", One))
+	bank.Balances[adversary.Address] = sdk.NewCoins(sdk.NewCoin("ueure", One))
+
+	// ACT: Attempt to wipe with invalid signer.
+	_, err = server.Wipe(ctx, &types.MsgWipe{
+		Denom:  "ueure",
+		Signer: utils.TestAccount().Address,
+		From:   adversary.Address,
+	})
+	// ASSERT: The action should've failed due to invalid signer.
+	require.ErrorIs(t, err, types.ErrInvalidSeizer)
+
+	// ACT: Attempt to wipe a non-banned address.
+	_, err = server.Wipe(ctx, &types.MsgWipe{
+		Denom:  "ueure",
+		Signer: seizer.Address,
+		From:   user.Address,
+	})
+	// ASSERT: The action should've failed due to the address not being banned.
+	require.ErrorIs(t, err, types.ErrNotBanned)
+	require.Equal(t, One, bank.Balances[user.Address].AmountOf("ueure"))
+
+	// ARRANGE: Ban the adversary for $EURe only.
+	require.NoError(t, k.SetDenomAdversary(ctx, "ueure", adversary.Address))
+
+	// ACT: Attempt to wipe.
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	_, err = server.Wipe(ctx, &types.MsgWipe{
+		Denom:  "ueure",
+		Signer: seizer.Address,
+		From:   adversary.Address,
+	})
+	// ASSERT: The action should've succeeded, and burned the balance.
+	require.NoError(t, err)
+	require.True(t, bank.Balances[adversary.Address].IsZero())
+	require.True(t, bank.GetSupply(ctx, "ueure").Amount.Equal(One))
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, "florin.v2.Wiped", events[0].Type)
+
+	// ACT: Attempt to wipe an empty balance.
+	_, err = server.Wipe(ctx, &types.MsgWipe{
+		Denom:  "ueure",
+		Signer: seizer.Address,
+		From:   adversary.Address,
+	})
+	// ASSERT: The action should've succeeded without effect.
+	require.NoError(t, err)
+
+	// ARRANGE: Give a vesting adversary 2 $EURe, of which 1 $EURe is locked.
+	vesting := utils.TestAccount()
+	bank.Balances[vesting.Address] = sdk.NewCoins(sdk.NewCoin("ueure", One.MulRaw(2)))
+	bank.Locked[vesting.Address] = sdk.NewCoins(sdk.NewCoin("ueure", One))
+	require.NoError(t, k.SetAdversary(ctx, vesting.Address))
+
+	// ACT: Attempt to wipe a vesting adversary.
+	_, err = server.Wipe(ctx, &types.MsgWipe{
+		Denom:  "ueure",
+		Signer: seizer.Address,
+		From:   vesting.Address,
+	})
+	// ASSERT: The action should've succeeded, burning only the spendable balance.
+	require.NoError(t, err)
+	require.Equal(t, One, bank.Balances[vesting.Address].AmountOf("ueure"))
+	require.True(t, bank.GetSupply(ctx, "ueure").Amount.Equal(One.MulRaw(2)))
+}
@@ -0,0 +1,143 @@
+// Copyright 2024 Monerium ehf.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/monerium/module-noble/v2/types"
+)
+
+// EnforceMintLimits ensures that a mint of amount by a system account stays
+// within the supply cap and rate limits of a denom, consuming the latter.
+func (k *Keeper) EnforceMintLimits(ctx context.Context, denom string, system string, amount math.Int) error {
+	if supplyCap := k.GetSupplyCap(ctx, denom); supplyCap.IsPositive() {
+		supply := k.bankKeeper.GetSupply(ctx, denom).Amount
+		if supply.Add(amount).GT(supplyCap) {
+			return errors.Wrapf(types.ErrSupplyCapExceeded, "supply of %s would exceed %s", supply.Add(amount), supplyCap)
+		}
+	}
+
+	return k.ConsumeRateLimits(ctx, denom, system, amount)
+}
+
+// MintCoins mints amount of denom and sends it to the recipient.
+func (k *Keeper) MintCoins(ctx context.Context, denom string, recipient string, amount math.Int) error {
+	coins := sdk.NewCoins(sdk.NewCoin(denom, amount))
+	to, err := k.addressCodec.StringToBytes(recipient)
+	if err != nil {
+		return errors.Wrap(err, "unable to decode user address")
+	}
+	err = k.bankKeeper.MintCoins(ctx, types.ModuleName, coins)
+	if err != nil {
+		return errors.Wrap(err, "unable to mint to module")
+	}
+	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, to, coins)
+	if err != nil {
+		return errors.Wrap(err, "unable to transfer from module to user")
+	}
+
+	return nil
+}
+
+// NewPendingMintID returns the identifier of a new pending mint. Identifiers
+// start at one, so that a zero identifier means a mint was not made pending.
+func (k *Keeper) NewPendingMintID(ctx context.Context) (uint64, error) {
+	id := k.GetNextPendingMintID(ctx)
+	if id == 0 {
+		id = 1
+	}
+	return id, k.SetNextPendingMintID(ctx, id+1)
+}
+
+// CreatePendingMint stores a mint that awaits approval under policy, and
+// returns its identifier. The mint allowance must already be deducted.
+func (k *Keeper) CreatePendingMint(ctx context.Context, policy types.MintApprovalPolicy, system string, to string, amount math.Int, reference string) (uint64, error) {
+	id, err := k.NewPendingMintID(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	pendingMint := types.PendingMint{
+		Id:           id,
+		Denom:        policy.Denom,
+		System:       system,
+		To:           to,
+		Amount:       amount,
+		Quorum:       policy.Quorum,
+		ExpiryHeight: sdk.UnwrapSDKContext(ctx).BlockHeight() + policy.ExpiryBlocks,
+		Reference:    reference,
+	}
+	if err := k.SetPendingMint(ctx, pendingMint); err != nil {
+		return 0, err
+	}
+
+	return id, k.eventService.EventManager(ctx).Emit(ctx, &types.MintPending{
+		Denom:        pendingMint.Denom,
+		Id:           id,
+		System:       system,
+		To:           to,
+		Amount:       amount,
+		ExpiryHeight: pendingMint.ExpiryHeight,
+		Reference:    reference,
+	})
+}
+
+// RefundPendingMint returns the amount reserved by a pending mint to the mint
+// allowance of its system account, and removes the pending mint. Its external
+// reference is released, so that the mint can be retried.
+func (k *Keeper) RefundPendingMint(ctx context.Context, pendingMint types.PendingMint) error {
+	allowance := k.GetMintAllowance(ctx, pendingMint.Denom, pendingMint.System).Add(pendingMint.Amount)
+	if err := k.SetMintAllowance(ctx, pendingMint.Denom, pendingMint.System, allowance); err != nil {
+		return err
+	}
+	if err := k.DeletePendingMint(ctx, pendingMint.Denom, pendingMint.Id); err != nil {
+		return err
+	}
+	if pendingMint.Reference != "" {
+		if err := k.DeleteReference(ctx, pendingMint.Denom, pendingMint.Reference); err != nil {
+			return err
+		}
+	}
+
+	return k.eventService.EventManager(ctx).Emit(ctx, &types.MintAllowance{
+		Denom:   pendingMint.Denom,
+		Account: pendingMint.System,
+		Amount:  allowance,
+	})
+}
+
+// ExpirePendingMints refunds and removes all pending mints whose expiry
+// height has passed.
+func (k *Keeper) ExpirePendingMints(ctx context.Context) error {
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+
+	for _, pendingMint := range k.GetExpiredPendingMints(ctx, height) {
+		if err := k.RefundPendingMint(ctx, pendingMint); err != nil {
+			return err
+		}
+		if err := k.eventService.EventManager(ctx).Emit(ctx, &types.PendingMintExpired{
+			Denom: pendingMint.Denom,
+			Id:    pendingMint.Id,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
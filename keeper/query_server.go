@@ -18,7 +18,10 @@ import (
 	"context"
 	"fmt"
 
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/monerium/module-noble/v2/types"
 )
 
@@ -55,9 +58,23 @@ func (k queryServer) Owners(ctx context.Context, req *types.QueryOwners) (*types
 		return nil, errors.ErrInvalidRequest
 	}
 
+	owners := make(map[string]string)
+	pendingOwners := make(map[string]string)
+	_, pagination, err := query.CollectionPaginate(ctx, k.Keeper.Owner, req.Pagination, func(denom string, owner string) (string, error) {
+		owners[denom] = owner
+		if pendingOwner := k.GetPendingOwner(ctx, denom); pendingOwner != "" {
+			pendingOwners[denom] = pendingOwner
+		}
+		return denom, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	return &types.QueryOwnersResponse{
-		Owners:        k.GetOwners(ctx),
-		PendingOwners: k.GetPendingOwners(ctx),
+		Owners:        owners,
+		PendingOwners: pendingOwners,
+		Pagination:    pagination,
 	}, nil
 }
 
@@ -81,8 +98,14 @@ func (k queryServer) Systems(ctx context.Context, req *types.QuerySystems) (*typ
 		return nil, errors.ErrInvalidRequest
 	}
 
+	systems, pagination, err := query.CollectionPaginate(ctx, k.Keeper.Systems, req.Pagination, k.accountFromKey)
+	if err != nil {
+		return nil, err
+	}
+
 	return &types.QuerySystemsResponse{
-		Systems: k.GetSystems(ctx),
+		Systems:    systems,
+		Pagination: pagination,
 	}, nil
 }
 
@@ -105,8 +128,14 @@ func (k queryServer) Admins(ctx context.Context, req *types.QueryAdmins) (*types
 		return nil, errors.ErrInvalidRequest
 	}
 
+	admins, pagination, err := query.CollectionPaginate(ctx, k.Keeper.Admins, req.Pagination, k.accountFromKey)
+	if err != nil {
+		return nil, err
+	}
+
 	return &types.QueryAdminsResponse{
-		Admins: k.GetAdmins(ctx),
+		Admins:     admins,
+		Pagination: pagination,
 	}, nil
 }
 
@@ -158,11 +187,28 @@ func (k queryServer) MintAllowances(ctx context.Context, req *types.QueryMintAll
 	}
 
 	allowances := make(map[string]string)
-	for _, entry := range k.GetMintAllowancesByDenom(ctx, req.Denom) {
-		allowances[entry.Address] = entry.Allowance.String()
+	_, pagination, err := query.CollectionPaginate(ctx, k.Keeper.MintAllowance, req.Pagination, func(key collections.Pair[string, []byte], value []byte) (string, error) {
+		address, err := k.addressCodec.BytesToString(key.K2())
+		if err != nil {
+			return "", err
+		}
+
+		var allowance math.Int
+		if err := allowance.Unmarshal(value); err != nil {
+			return "", err
+		}
+
+		allowances[address] = allowance.String()
+		return address, nil
+	}, query.WithCollectionPaginationPairPrefix[string, []byte](req.Denom))
+	if err != nil {
+		return nil, err
 	}
 
-	return &types.QueryMintAllowancesResponse{Allowances: allowances}, nil
+	return &types.QueryMintAllowancesResponse{
+		Allowances: allowances,
+		Pagination: pagination,
+	}, nil
 }
 
 func (k queryServer) MintAllowance(ctx context.Context, req *types.QueryMintAllowance) (*types.QueryMintAllowanceResponse, error) {
@@ -178,3 +224,286 @@ func (k queryServer) MintAllowance(ctx context.Context, req *types.QueryMintAllo
 		Allowance: k.GetMintAllowance(ctx, req.Denom, req.Account),
 	}, nil
 }
+
+func (k queryServer) Paused(ctx context.Context, req *types.QueryPaused) (*types.QueryPausedResponse, error) {
+	if req == nil {
+		return nil, errors.ErrInvalidRequest
+	}
+
+	if !k.IsAllowedDenom(ctx, req.Denom) {
+		return nil, fmt.Errorf("%s is not an allowed denom", req.Denom)
+	}
+
+	return &types.QueryPausedResponse{
+		Paused: k.IsPaused(ctx, req.Denom),
+		Pauser: k.GetPauser(ctx, req.Denom),
+	}, nil
+}
+
+func (k queryServer) Seizer(ctx context.Context, req *types.QuerySeizer) (*types.QuerySeizerResponse, error) {
+	if req == nil {
+		return nil, errors.ErrInvalidRequest
+	}
+
+	if !k.IsAllowedDenom(ctx, req.Denom) {
+		return nil, fmt.Errorf("%s is not an allowed denom", req.Denom)
+	}
+
+	return &types.QuerySeizerResponse{Seizer: k.GetSeizer(ctx, req.Denom)}, nil
+}
+
+func (k queryServer) Nonce(ctx context.Context, req *types.QueryNonce) (*types.QueryNonceResponse, error) {
+	if req == nil {
+		return nil, errors.ErrInvalidRequest
+	}
+
+	return &types.QueryNonceResponse{
+		Nonce:                      k.GetNonce(ctx, req.Address),
+		LegacySignaturesDeprecated: k.IsLegacySignaturesDeprecated(ctx),
+	}, nil
+}
+
+func (k queryServer) RateLimits(ctx context.Context, req *types.QueryRateLimits) (*types.QueryRateLimitsResponse, error) {
+	if req == nil {
+		return nil, errors.ErrInvalidRequest
+	}
+
+	if !k.IsAllowedDenom(ctx, req.Denom) {
+		return nil, fmt.Errorf("%s is not an allowed denom", req.Denom)
+	}
+
+	var rateLimits []types.RateLimitStatus
+	for _, rateLimit := range k.GetRateLimitsByDenom(ctx, req.Denom) {
+		rateLimits = append(rateLimits, types.RateLimitStatus{
+			RateLimit: rateLimit,
+			Remaining: k.GetRemainingRateLimit(ctx, rateLimit),
+		})
+	}
+
+	return &types.QueryRateLimitsResponse{RateLimits: rateLimits}, nil
+}
+
+func (k queryServer) SupplyCap(ctx context.Context, req *types.QuerySupplyCap) (*types.QuerySupplyCapResponse, error) {
+	if req == nil {
+		return nil, errors.ErrInvalidRequest
+	}
+
+	if !k.IsAllowedDenom(ctx, req.Denom) {
+		return nil, fmt.Errorf("%s is not an allowed denom", req.Denom)
+	}
+
+	supplyCap := k.GetSupplyCap(ctx, req.Denom)
+	supply := k.bankKeeper.GetSupply(ctx, req.Denom).Amount
+	headroom := math.ZeroInt()
+	if supplyCap.GT(supply) {
+		headroom = supplyCap.Sub(supply)
+	}
+
+	return &types.QuerySupplyCapResponse{
+		SupplyCap: supplyCap,
+		Supply:    supply,
+		Headroom:  headroom,
+	}, nil
+}
+
+func (k queryServer) MintApprovalPolicy(ctx context.Context, req *types.QueryMintApprovalPolicy) (*types.QueryMintApprovalPolicyResponse, error) {
+	if req == nil {
+		return nil, errors.ErrInvalidRequest
+	}
+
+	if !k.IsAllowedDenom(ctx, req.Denom) {
+		return nil, fmt.Errorf("%s is not an allowed denom", req.Denom)
+	}
+
+	policy, found := k.GetMintApprovalPolicy(ctx, req.Denom)
+	if !found {
+		policy = types.MintApprovalPolicy{Denom: req.Denom, Threshold: math.ZeroInt()}
+	}
+
+	return &types.QueryMintApprovalPolicyResponse{Policy: policy}, nil
+}
+
+func (k queryServer) PendingMints(ctx context.Context, req *types.QueryPendingMints) (*types.QueryPendingMintsResponse, error) {
+	if req == nil {
+		return nil, errors.ErrInvalidRequest
+	}
+
+	if !k.IsAllowedDenom(ctx, req.Denom) {
+		return nil, fmt.Errorf("%s is not an allowed denom", req.Denom)
+	}
+
+	pendingMints, pagination, err := query.CollectionPaginate(ctx, k.Keeper.PendingMints, req.Pagination, func(_ collections.Pair[string, uint64], pendingMint types.PendingMint) (types.PendingMint, error) {
+		return pendingMint, nil
+	}, query.WithCollectionPaginationPairPrefix[string, uint64](req.Denom))
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryPendingMintsResponse{
+		PendingMints: pendingMints,
+		Pagination:   pagination,
+	}, nil
+}
+
+func (k queryServer) PendingMint(ctx context.Context, req *types.QueryPendingMint) (*types.QueryPendingMintResponse, error) {
+	if req == nil {
+		return nil, errors.ErrInvalidRequest
+	}
+
+	if !k.IsAllowedDenom(ctx, req.Denom) {
+		return nil, fmt.Errorf("%s is not an allowed denom", req.Denom)
+	}
+
+	pendingMint, found := k.GetPendingMint(ctx, req.Denom, req.Id)
+	if !found {
+		return nil, errors.ErrNotFound.Wrapf("pending mint %d", req.Id)
+	}
+
+	return &types.QueryPendingMintResponse{PendingMint: pendingMint}, nil
+}
+
+func (k queryServer) MintReference(ctx context.Context, req *types.QueryMintReference) (*types.QueryMintReferenceResponse, error) {
+	if req == nil {
+		return nil, errors.ErrInvalidRequest
+	}
+
+	if !k.IsAllowedDenom(ctx, req.Denom) {
+		return nil, fmt.Errorf("%s is not an allowed denom", req.Denom)
+	}
+
+	reference, found := k.GetReference(ctx, req.Denom, req.Reference)
+	if !found {
+		return nil, errors.ErrNotFound.Wrapf("reference %s", req.Reference)
+	}
+
+	return &types.QueryMintReferenceResponse{Reference: reference}, nil
+}
+
+func (k queryServer) Roles(ctx context.Context, req *types.QueryRoles) (*types.QueryRolesResponse, error) {
+	if req == nil {
+		return nil, errors.ErrInvalidRequest
+	}
+
+	if _, err := k.addressCodec.StringToBytes(req.Address); err != nil {
+		return nil, errors.ErrInvalidAddress.Wrap(err.Error())
+	}
+
+	var denoms []types.DenomRoles
+	for _, denom := range k.GetAllowedDenoms(ctx) {
+		roles := types.DenomRoles{
+			Denom:          denom,
+			Owner:          k.GetOwner(ctx, denom) == req.Address,
+			PendingOwner:   k.GetPendingOwner(ctx, denom) == req.Address,
+			System:         k.IsSystem(ctx, denom, req.Address),
+			Admin:          k.IsAdmin(ctx, denom, req.Address),
+			Pauser:         k.GetPauser(ctx, denom) == req.Address,
+			MintAllowance:  k.GetMintAllowance(ctx, denom, req.Address),
+			BlacklistAdmin: k.IsDenomBlacklistAdmin(ctx, denom, req.Address),
+			Adversary:      k.IsDenomAdversary(ctx, denom, req.Address),
+			Seizer:         k.GetSeizer(ctx, denom) == req.Address,
+			AllowlistAdmin: k.IsAllowlistAdmin(ctx, denom, req.Address),
+			Allowlisted:    k.IsAllowlisted(ctx, denom, req.Address),
+		}
+
+		if roles.Owner || roles.PendingOwner || roles.System || roles.Admin || roles.Pauser ||
+			roles.MintAllowance.IsPositive() || roles.BlacklistAdmin || roles.Adversary || roles.Seizer ||
+			roles.AllowlistAdmin || roles.Allowlisted {
+			denoms = append(denoms, roles)
+		}
+	}
+
+	return &types.QueryRolesResponse{
+		Denoms:                denoms,
+		BlacklistOwner:        k.GetBlacklistOwner(ctx) == req.Address,
+		BlacklistPendingOwner: k.GetBlacklistPendingOwner(ctx) == req.Address,
+		BlacklistAdmin:        k.IsBlacklistAdmin(ctx, req.Address),
+		Adversary:             k.IsAdversary(ctx, req.Address),
+	}, nil
+}
+
+func (k queryServer) TransferPolicy(ctx context.Context, req *types.QueryTransferPolicy) (*types.QueryTransferPolicyResponse, error) {
+	if req == nil {
+		return nil, errors.ErrInvalidRequest
+	}
+
+	if !k.IsAllowedDenom(ctx, req.Denom) {
+		return nil, fmt.Errorf("%s is not an allowed denom", req.Denom)
+	}
+
+	return &types.QueryTransferPolicyResponse{Policy: k.GetTransferPolicy(ctx, req.Denom)}, nil
+}
+
+func (k queryServer) AllowlistAdmins(ctx context.Context, req *types.QueryAllowlistAdmins) (*types.QueryAllowlistAdminsResponse, error) {
+	if req == nil {
+		return nil, errors.ErrInvalidRequest
+	}
+
+	if !k.IsAllowedDenom(ctx, req.Denom) {
+		return nil, fmt.Errorf("%s is not an allowed denom", req.Denom)
+	}
+
+	return &types.QueryAllowlistAdminsResponse{
+		AllowlistAdmins: k.GetAllowlistAdminsByDenom(ctx, req.Denom),
+	}, nil
+}
+
+func (k queryServer) Allowlist(ctx context.Context, req *types.QueryAllowlist) (*types.QueryAllowlistResponse, error) {
+	if req == nil {
+		return nil, errors.ErrInvalidRequest
+	}
+
+	if !k.IsAllowedDenom(ctx, req.Denom) {
+		return nil, fmt.Errorf("%s is not an allowed denom", req.Denom)
+	}
+
+	accounts, pagination, err := query.CollectionPaginate(ctx, k.Keeper.Allowlist, req.Pagination, func(key collections.Pair[string, []byte], _ collections.NoValue) (string, error) {
+		return k.addressCodec.BytesToString(key.K2())
+	}, query.WithCollectionPaginationPairPrefix[string, []byte](req.Denom))
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAllowlistResponse{
+		Accounts:   accounts,
+		Pagination: pagination,
+	}, nil
+}
+
+func (k queryServer) AccountLimit(ctx context.Context, req *types.QueryAccountLimit) (*types.QueryAccountLimitResponse, error) {
+	if req == nil {
+		return nil, errors.ErrInvalidRequest
+	}
+
+	if !k.IsAllowedDenom(ctx, req.Denom) {
+		return nil, fmt.Errorf("%s is not an allowed denom", req.Denom)
+	}
+	if _, err := k.addressCodec.StringToBytes(req.Address); err != nil {
+		return nil, errors.ErrInvalidAddress.Wrap(err.Error())
+	}
+
+	limit, isDefault, found := k.GetEffectiveAccountLimit(ctx, req.Denom, req.Address)
+	if !found {
+		limit = types.AccountLimit{
+			Denom:           req.Denom,
+			MaxBalance:      math.ZeroInt(),
+			MaxTransfer:     math.ZeroInt(),
+			MaxDailyOutflow: math.ZeroInt(),
+		}
+	}
+
+	return &types.QueryAccountLimitResponse{
+		AccountLimit: limit,
+		IsDefault:    isDefault,
+		DailyOutflow: k.GetCurrentAccountOutflow(ctx, req.Denom, req.Address).Used,
+	}, nil
+}
+
+// accountFromKey converts a denom-scoped account key into an account.
+func (k queryServer) accountFromKey(key collections.Pair[string, []byte], _ collections.NoValue) (types.Account, error) {
+	address, err := k.addressCodec.BytesToString(key.K2())
+	if err != nil {
+		return types.Account{}, err
+	}
+
+	return types.Account{Denom: key.K1(), Address: address}, nil
+}
@@ -17,7 +17,9 @@ package keeper
 import (
 	"context"
 
+	"cosmossdk.io/collections"
 	"github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/monerium/module-noble/v2/types/blacklist"
 )
 
@@ -48,7 +50,8 @@ func (k blacklistQueryServer) Admins(ctx context.Context, req *blacklist.QueryAd
 	}
 
 	return &blacklist.QueryAdminsResponse{
-		Admins: k.GetBlacklistAdmins(ctx),
+		Admins:      k.GetBlacklistAdmins(ctx),
+		DenomAdmins: k.GetDenomBlacklistAdmins(ctx, req.Denom),
 	}, nil
 }
 
@@ -57,7 +60,69 @@ func (k blacklistQueryServer) Adversaries(ctx context.Context, req *blacklist.Qu
 		return nil, errors.ErrInvalidRequest
 	}
 
+	adversaries, pagination, err := query.CollectionPaginate(ctx, k.Keeper.Adversaries, req.Pagination, func(adversary string, _ collections.NoValue) (string, error) {
+		return adversary, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var opts []func(*query.CollectionsPaginateOptions[collections.Pair[string, string]])
+	if req.Denom != "" {
+		opts = append(opts, query.WithCollectionPaginationPairPrefix[string, string](req.Denom))
+	}
+
+	denomAdversaries, denomPagination, err := query.CollectionPaginate(ctx, k.DenomAdversaries, req.DenomPagination, func(key collections.Pair[string, string], _ collections.NoValue) (blacklist.Account, error) {
+		return blacklist.Account{Denom: key.K1(), Address: key.K2()}, nil
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	var banRecords []blacklist.BanRecord
+	for _, adversary := range adversaries {
+		if record, found := k.GetBanRecord(ctx, "", adversary); found {
+			banRecords = append(banRecords, record)
+		}
+	}
+	for _, adversary := range denomAdversaries {
+		if record, found := k.GetBanRecord(ctx, adversary.Denom, adversary.Address); found {
+			banRecords = append(banRecords, record)
+		}
+	}
+
 	return &blacklist.QueryAdversariesResponse{
-		Adversaries: k.GetAdversaries(ctx),
+		Adversaries:      adversaries,
+		DenomAdversaries: denomAdversaries,
+		Pagination:       pagination,
+		DenomPagination:  denomPagination,
+		BanRecords:       banRecords,
 	}, nil
 }
+
+func (k blacklistQueryServer) Adversary(ctx context.Context, req *blacklist.QueryAdversary) (*blacklist.QueryAdversaryResponse, error) {
+	if req == nil {
+		return nil, errors.ErrInvalidRequest
+	}
+
+	var denoms []string
+	if k.IsAdversary(ctx, req.Address) {
+		denoms = append(denoms, "")
+	}
+	for _, denom := range k.GetAllowedDenoms(ctx) {
+		if k.IsDenomAdversary(ctx, denom, req.Address) {
+			denoms = append(denoms, denom)
+		}
+	}
+
+	bans := make([]blacklist.BanRecord, 0, len(denoms))
+	for _, denom := range denoms {
+		record, found := k.GetBanRecord(ctx, denom, req.Address)
+		if !found {
+			record = blacklist.BanRecord{Denom: denom, Address: req.Address}
+		}
+		bans = append(bans, record)
+	}
+
+	return &blacklist.QueryAdversaryResponse{Bans: bans}, nil
+}
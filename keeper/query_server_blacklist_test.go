@@ -18,6 +18,7 @@ import (
 	"testing"
 
 	"github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/monerium/module-noble/v2/keeper"
 	"github.com/monerium/module-noble/v2/types/blacklist"
 	"github.com/monerium/module-noble/v2/utils"
@@ -88,6 +89,22 @@ func TestBlacklistAdminsQuery(t *testing.T) {
 	require.Len(t, res.Admins, 2)
 	require.Contains(t, res.Admins, admin1.Address)
 	require.Contains(t, res.Admins, admin2.Address)
+	require.Empty(t, res.DenomAdmins)
+
+	// ARRANGE: Set denom admin accounts in state.
+	err = k.SetAllowedDenom(ctx, "ugbpe")
+	require.NoError(t, err)
+	err = k.SetDenomBlacklistAdmin(ctx, "ueure", admin1.Address)
+	require.NoError(t, err)
+	err = k.SetDenomBlacklistAdmin(ctx, "ugbpe", admin2.Address)
+	require.NoError(t, err)
+
+	// ACT: Attempt to query admins scoped to a denom.
+	res, err = server.Admins(ctx, &blacklist.QueryAdmins{Denom: "ugbpe"})
+	// ASSERT: The query should've succeeded.
+	require.NoError(t, err)
+	require.Len(t, res.Admins, 2)
+	require.Equal(t, []blacklist.Account{{Denom: "ugbpe", Address: admin2.Address}}, res.DenomAdmins)
 }
 
 func TestBlacklistAdversariesQuery(t *testing.T) {
@@ -119,4 +136,98 @@ func TestBlacklistAdversariesQuery(t *testing.T) {
 	require.Len(t, res.Adversaries, 2)
 	require.Contains(t, res.Adversaries, alice.Address)
 	require.Contains(t, res.Adversaries, bob.Address)
+	require.Empty(t, res.DenomAdversaries)
+	require.Empty(t, res.BanRecords)
+
+	// ARRANGE: Set a ban expiry in state.
+	expiry := blacklist.BanRecord{Address: bob.Address, ExpiryHeight: 100}
+	err = k.SetBanRecord(ctx, expiry)
+	require.NoError(t, err)
+
+	// ACT: Attempt to query adversaries.
+	res, err = server.Adversaries(ctx, &blacklist.QueryAdversaries{})
+	// ASSERT: The query should've succeeded, including the ban expiry.
+	require.NoError(t, err)
+	require.Equal(t, []blacklist.BanRecord{expiry}, res.BanRecords)
+
+	// ARRANGE: Set denom adversaries in state.
+	err = k.SetAllowedDenom(ctx, "ugbpe")
+	require.NoError(t, err)
+	charlie := utils.TestAccount()
+	err = k.SetDenomAdversary(ctx, "ueure", alice.Address)
+	require.NoError(t, err)
+	err = k.SetDenomAdversary(ctx, "ugbpe", charlie.Address)
+	require.NoError(t, err)
+
+	// ACT: Attempt to query adversaries across all denoms.
+	res, err = server.Adversaries(ctx, &blacklist.QueryAdversaries{})
+	// ASSERT: The query should've succeeded.
+	require.NoError(t, err)
+	require.Len(t, res.DenomAdversaries, 2)
+
+	// ACT: Attempt to query adversaries scoped to a denom.
+	res, err = server.Adversaries(ctx, &blacklist.QueryAdversaries{Denom: "ugbpe"})
+	// ASSERT: The query should've succeeded.
+	require.NoError(t, err)
+	require.Len(t, res.Adversaries, 2)
+	require.Equal(t, []blacklist.Account{{Denom: "ugbpe", Address: charlie.Address}}, res.DenomAdversaries)
+
+	// ACT: Attempt to query a page of adversaries.
+	res, err = server.Adversaries(ctx, &blacklist.QueryAdversaries{
+		Pagination:      &query.PageRequest{Limit: 1, CountTotal: true},
+		DenomPagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	// ASSERT: The query should've succeeded, with both lists paged.
+	require.NoError(t, err)
+	require.Len(t, res.Adversaries, 1)
+	require.Equal(t, uint64(2), res.Pagination.Total)
+	require.NotEmpty(t, res.Pagination.NextKey)
+	require.Len(t, res.DenomAdversaries, 1)
+	require.Equal(t, uint64(2), res.DenomPagination.Total)
+	require.NotEmpty(t, res.DenomPagination.NextKey)
+}
+
+func TestBlacklistAdversaryQuery(t *testing.T) {
+	k, ctx := mocks.FlorinKeeper()
+	server := keeper.NewBlacklistQueryServer(k)
+
+	// ACT: Attempt to query adversary with invalid request.
+	_, err := server.Adversary(ctx, nil)
+	// ASSERT: The query should've failed due to invalid request.
+	require.ErrorContains(t, err, errors.ErrInvalidRequest.Error())
+
+	// ARRANGE: Generate an adversary account.
+	adversary := utils.TestAccount()
+
+	// ACT: Attempt to query adversary with no state.
+	res, err := server.Adversary(ctx, &blacklist.QueryAdversary{Address: adversary.Address})
+	// ASSERT: The query should've succeeded, returns empty.
+	require.NoError(t, err)
+	require.Empty(t, res.Bans)
+
+	// ARRANGE: Ban the adversary globally with a record, and for a denom without.
+	record := blacklist.BanRecord{Address: adversary.Address, Reason: blacklist.BanReasonSanctions, CaseId: "case-1"}
+	require.NoError(t, k.SetAdversary(ctx, adversary.Address))
+	require.NoError(t, k.SetBanRecord(ctx, record))
+	require.NoError(t, k.SetDenomAdversary(ctx, "ueure", adversary.Address))
+	require.NoError(t, k.SetDenomAdversary(ctx, "ueure", utils.TestAccount().Address))
+
+	// ACT: Attempt to query adversary.
+	res, err = server.Adversary(ctx, &blacklist.QueryAdversary{Address: adversary.Address})
+	// ASSERT: The query should've succeeded, returning both bans.
+	require.NoError(t, err)
+	require.Equal(t, []blacklist.BanRecord{
+		record,
+		{Denom: "ueure", Address: adversary.Address},
+	}, res.Bans)
+
+	// ARRANGE: Expire the global ban.
+	record.ExpiryHeight = 10
+	require.NoError(t, k.SetBanRecord(ctx, record))
+
+	// ACT: Attempt to query adversary after the global ban expired.
+	res, err = server.Adversary(ctx.WithBlockHeight(10), &blacklist.QueryAdversary{Address: adversary.Address})
+	// ASSERT: The query should've succeeded, returning only the denom ban.
+	require.NoError(t, err)
+	require.Equal(t, []blacklist.BanRecord{{Denom: "ueure", Address: adversary.Address}}, res.Bans)
 }
@@ -16,8 +16,12 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/monerium/module-noble/v2/keeper"
 	"github.com/monerium/module-noble/v2/types"
 	"github.com/monerium/module-noble/v2/utils"
@@ -38,7 +42,7 @@ func TestAuthorityQuery(t *testing.T) {
 	res, err := server.Authority(ctx, &types.QueryAuthority{})
 	// ASSERT: The query should've succeeded.
 	require.NoError(t, err)
-	require.Equal(t, "authority", res.Authority)
+	require.Equal(t, mocks.Authority, res.Authority)
 }
 
 func TestAllowedDenomsQuery(t *testing.T) {
@@ -163,6 +167,27 @@ func TestSystemsQuery(t *testing.T) {
 	require.Len(t, res.Systems, 2)
 	require.Contains(t, res.Systems, types.Account{Denom: "ueure", Address: system1.Address})
 	require.Contains(t, res.Systems, types.Account{Denom: "ueure", Address: system2.Address})
+
+	// ACT: Attempt to query the first page of systems.
+	res, err = server.Systems(ctx, &types.QuerySystems{
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	// ASSERT: The query should've succeeded, with a next page.
+	require.NoError(t, err)
+	require.Len(t, res.Systems, 1)
+	require.Equal(t, uint64(2), res.Pagination.Total)
+	require.NotEmpty(t, res.Pagination.NextKey)
+	first := res.Systems[0]
+
+	// ACT: Attempt to query the second page of systems.
+	res, err = server.Systems(ctx, &types.QuerySystems{
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Limit: 1},
+	})
+	// ASSERT: The query should've succeeded, with no next page.
+	require.NoError(t, err)
+	require.Len(t, res.Systems, 1)
+	require.NotEqual(t, first, res.Systems[0])
+	require.Empty(t, res.Pagination.NextKey)
 }
 
 func TestSystemsByDenomQuery(t *testing.T) {
@@ -355,6 +380,24 @@ func TestMintAllowancesQuery(t *testing.T) {
 	require.NoError(t, err)
 	require.Len(t, res.Allowances, 1)
 	require.Equal(t, One.String(), res.Allowances[minter.Address])
+
+	// ARRANGE: Set mint allowances in state, including for another denom.
+	err = k.SetAllowedDenom(ctx, "ugbpe")
+	require.NoError(t, err)
+	err = k.SetMintAllowance(ctx, "ueure", utils.TestAccount().Address, One)
+	require.NoError(t, err)
+	err = k.SetMintAllowance(ctx, "ugbpe", utils.TestAccount().Address, One)
+	require.NoError(t, err)
+
+	// ACT: Attempt to query a page of mint allowances.
+	res, err = server.MintAllowances(ctx, &types.QueryMintAllowances{
+		Denom:      "ueure",
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	// ASSERT: The query should've succeeded, only counting the denom's allowances.
+	require.NoError(t, err)
+	require.Len(t, res.Allowances, 1)
+	require.Equal(t, uint64(2), res.Pagination.Total)
 }
 
 func TestMintAllowanceQuery(t *testing.T) {
@@ -394,3 +437,491 @@ func TestMintAllowanceQuery(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, One, res.Allowance)
 }
+
+func TestPausedQuery(t *testing.T) {
+	k, ctx := mocks.FlorinKeeper()
+	server := keeper.NewQueryServer(k)
+
+	// ACT: Attempt to query paused with invalid request.
+	_, err := server.Paused(ctx, nil)
+	// ASSERT: The query should've failed due to invalid request.
+	require.ErrorContains(t, err, errors.ErrInvalidRequest.Error())
+
+	// ACT: Attempt to query paused with not allowed denom.
+	_, err = server.Paused(ctx, &types.QueryPaused{Denom: "uusde"})
+	// ASSERT: The query should've failed due to not allowed denom.
+	require.ErrorContains(t, err, "uusde is not an allowed denom")
+
+	// ACT: Attempt to query paused of unpaused denom.
+	res, err := server.Paused(ctx, &types.QueryPaused{Denom: "ueure"})
+	// ASSERT: The query should've succeeded.
+	require.NoError(t, err)
+	require.False(t, res.Paused)
+	require.Empty(t, res.Pauser)
+
+	// ARRANGE: Set pauser and pause denom in state.
+	pauser := utils.TestAccount()
+	err = k.SetPauser(ctx, "ueure", pauser.Address)
+	require.NoError(t, err)
+	err = k.SetPaused(ctx, "ueure", true)
+	require.NoError(t, err)
+
+	// ACT: Attempt to query paused.
+	res, err = server.Paused(ctx, &types.QueryPaused{Denom: "ueure"})
+	// ASSERT: The query should've succeeded.
+	require.NoError(t, err)
+	require.True(t, res.Paused)
+	require.Equal(t, pauser.Address, res.Pauser)
+}
+
+func TestSeizerQuery(t *testing.T) {
+	k, ctx := mocks.FlorinKeeper()
+	server := keeper.NewQueryServer(k)
+
+	// ACT: Attempt to query seizer with invalid request.
+	_, err := server.Seizer(ctx, nil)
+	// ASSERT: The query should've failed due to invalid request.
+	require.ErrorContains(t, err, errors.ErrInvalidRequest.Error())
+
+	// ACT: Attempt to query seizer of not allowed denom.
+	_, err = server.Seizer(ctx, &types.QuerySeizer{Denom: "uusde"})
+	// ASSERT: The query should've failed due to not allowed denom.
+	require.ErrorContains(t, err, "uusde is not an allowed denom")
+
+	// ARRANGE: Set seizer in state.
+	seizer := utils.TestAccount()
+	require.NoError(t, k.SetSeizer(ctx, "ueure", seizer.Address))
+
+	// ACT: Attempt to query seizer.
+	res, err := server.Seizer(ctx, &types.QuerySeizer{Denom: "ueure"})
+	// ASSERT: The query should've succeeded.
+	require.NoError(t, err)
+	require.Equal(t, seizer.Address, res.Seizer)
+}
+
+func TestNonceQuery(t *testing.T) {
+	k, ctx := mocks.FlorinKeeper()
+	server := keeper.NewQueryServer(k)
+
+	// ACT: Attempt to query nonce with invalid request.
+	_, err := server.Nonce(ctx, nil)
+	// ASSERT: The query should've failed due to invalid request.
+	require.ErrorContains(t, err, errors.ErrInvalidRequest.Error())
+
+	// ACT: Attempt to query nonce of random account.
+	account := utils.TestAccount()
+	res, err := server.Nonce(ctx, &types.QueryNonce{Address: account.Address})
+	// ASSERT: The query should've succeeded, returns zero.
+	require.NoError(t, err)
+	require.Zero(t, res.Nonce)
+	require.False(t, res.LegacySignaturesDeprecated)
+
+	// ARRANGE: Set nonce in state.
+	err = k.SetNonce(ctx, account.Address, 5)
+	require.NoError(t, err)
+
+	// ACT: Attempt to query nonce.
+	res, err = server.Nonce(ctx, &types.QueryNonce{Address: account.Address})
+	// ASSERT: The query should've succeeded.
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), res.Nonce)
+}
+
+func TestRateLimitsQuery(t *testing.T) {
+	k, ctx := mocks.FlorinKeeper()
+	ctx = ctx.WithBlockHeight(10)
+	server := keeper.NewQueryServer(k)
+
+	// ACT: Attempt to query rate limits with invalid request.
+	_, err := server.RateLimits(ctx, nil)
+	// ASSERT: The query should've failed due to invalid request.
+	require.ErrorContains(t, err, errors.ErrInvalidRequest.Error())
+
+	// ACT: Attempt to query rate limits of not allowed denom.
+	_, err = server.RateLimits(ctx, &types.QueryRateLimits{Denom: "uusde"})
+	// ASSERT: The query should've failed due to not allowed denom.
+	require.ErrorContains(t, err, "uusde is not an allowed denom")
+
+	// ARRANGE: Set rate limits and usages in state.
+	system := utils.TestAccount()
+	denomLimit := types.RateLimit{Denom: "ueure", Amount: One.MulRaw(5), WindowBlocks: 10}
+	systemLimit := types.RateLimit{Denom: "ueure", Address: system.Address, Amount: One.MulRaw(2), WindowBlocks: 5}
+	require.NoError(t, k.SetRateLimit(ctx, denomLimit))
+	require.NoError(t, k.SetRateLimit(ctx, systemLimit))
+	usage := systemLimit.Record(types.NewRateLimitUsage("ueure", system.Address), One, 8, ctx.BlockTime())
+	require.NoError(t, k.SetRateLimitUsage(ctx, usage))
+
+	// ACT: Attempt to query rate limits.
+	res, err := server.RateLimits(ctx, &types.QueryRateLimits{Denom: "ueure"})
+	// ASSERT: The query should've succeeded, with remaining capacity.
+	require.NoError(t, err)
+	require.Equal(t, []types.RateLimitStatus{
+		{RateLimit: denomLimit, Remaining: One.MulRaw(5)},
+		{RateLimit: systemLimit, Remaining: One},
+	}, res.RateLimits)
+
+	// ACT: Attempt to query rate limits after the mint left the system's window.
+	res, err = server.RateLimits(ctx.WithBlockHeight(13), &types.QueryRateLimits{Denom: "ueure"})
+	// ASSERT: The query should've succeeded, with full capacity.
+	require.NoError(t, err)
+	require.Equal(t, One.MulRaw(2), res.RateLimits[1].Remaining)
+}
+
+func TestSupplyCapQuery(t *testing.T) {
+	bank := mocks.BankKeeper{
+		Balances: map[string]sdk.Coins{
+			utils.TestAccount().Address: sdk.NewCoins(sdk.NewCoin("ueure", One)),
+		},
+	}
+	k, ctx := mocks.FlorinWithKeepers(bank)
+	server := keeper.NewQueryServer(k)
+
+	// ACT: Attempt to query supply cap with invalid request.
+	_, err := server.SupplyCap(ctx, nil)
+	// ASSERT: The query should've failed due to invalid request.
+	require.ErrorContains(t, err, errors.ErrInvalidRequest.Error())
+
+	// ACT: Attempt to query supply cap of not allowed denom.
+	_, err = server.SupplyCap(ctx, &types.QuerySupplyCap{Denom: "uusde"})
+	// ASSERT: The query should've failed due to not allowed denom.
+	require.ErrorContains(t, err, "uusde is not an allowed denom")
+
+	// ACT: Attempt to query supply cap with no cap set.
+	res, err := server.SupplyCap(ctx, &types.QuerySupplyCap{Denom: "ueure"})
+	// ASSERT: The query should've succeeded, with no cap.
+	require.NoError(t, err)
+	require.True(t, res.SupplyCap.IsZero())
+	require.Equal(t, One, res.Supply)
+
+	// ARRANGE: Set supply cap in state.
+	err = k.SetSupplyCap(ctx, "ueure", One.MulRaw(3))
+	require.NoError(t, err)
+
+	// ACT: Attempt to query supply cap.
+	res, err = server.SupplyCap(ctx, &types.QuerySupplyCap{Denom: "ueure"})
+	// ASSERT: The query should've succeeded, with headroom.
+	require.NoError(t, err)
+	require.Equal(t, One.MulRaw(3), res.SupplyCap)
+	require.Equal(t, One.MulRaw(2), res.Headroom)
+}
+
+func TestMintApprovalPolicyQuery(t *testing.T) {
+	k, ctx := mocks.FlorinKeeper()
+	server := keeper.NewQueryServer(k)
+
+	// ACT: Attempt to query mint approval policy with invalid request.
+	_, err := server.MintApprovalPolicy(ctx, nil)
+	// ASSERT: The query should've failed due to invalid request.
+	require.ErrorContains(t, err, errors.ErrInvalidRequest.Error())
+
+	// ACT: Attempt to query mint approval policy of not allowed denom.
+	_, err = server.MintApprovalPolicy(ctx, &types.QueryMintApprovalPolicy{Denom: "uusde"})
+	// ASSERT: The query should've failed due to not allowed denom.
+	require.ErrorContains(t, err, "uusde is not an allowed denom")
+
+	// ACT: Attempt to query mint approval policy with no policy set.
+	res, err := server.MintApprovalPolicy(ctx, &types.QueryMintApprovalPolicy{Denom: "ueure"})
+	// ASSERT: The query should've succeeded, with a disabled policy.
+	require.NoError(t, err)
+	require.True(t, res.Policy.Threshold.IsZero())
+
+	// ARRANGE: Set mint approval policy in state.
+	policy := types.MintApprovalPolicy{Denom: "ueure", Threshold: One, Quorum: 2, ExpiryBlocks: 10}
+	require.NoError(t, k.SetMintApprovalPolicy(ctx, policy))
+
+	// ACT: Attempt to query mint approval policy.
+	res, err = server.MintApprovalPolicy(ctx, &types.QueryMintApprovalPolicy{Denom: "ueure"})
+	// ASSERT: The query should've succeeded.
+	require.NoError(t, err)
+	require.Equal(t, policy, res.Policy)
+}
+
+func TestMintReferenceQuery(t *testing.T) {
+	k, ctx := mocks.FlorinKeeper()
+	server := keeper.NewQueryServer(k)
+
+	// ACT: Attempt to query mint reference with invalid request.
+	_, err := server.MintReference(ctx, nil)
+	// ASSERT: The query should've failed due to invalid request.
+	require.ErrorContains(t, err, errors.ErrInvalidRequest.Error())
+
+	// ACT: Attempt to query mint reference of not allowed denom.
+	_, err = server.MintReference(ctx, &types.QueryMintReference{Denom: "uusde", Reference: "SEPA-1"})
+	// ASSERT: The query should've failed due to not allowed denom.
+	require.ErrorContains(t, err, "uusde is not an allowed denom")
+
+	// ACT: Attempt to query mint reference that doesn't exist.
+	_, err = server.MintReference(ctx, &types.QueryMintReference{Denom: "ueure", Reference: "SEPA-1"})
+	// ASSERT: The query should've failed due to not found.
+	require.ErrorContains(t, err, errors.ErrNotFound.Error())
+
+	// ARRANGE: Set reference in state.
+	reference := types.Reference{
+		Denom:     "ueure",
+		Reference: "SEPA-1",
+		System:    utils.TestAccount().Address,
+		Account:   utils.TestAccount().Address,
+		Amount:    One,
+		Height:    10,
+	}
+	require.NoError(t, k.SetReference(ctx, reference))
+
+	// ACT: Attempt to query mint reference.
+	res, err := server.MintReference(ctx, &types.QueryMintReference{Denom: "ueure", Reference: "SEPA-1"})
+	// ASSERT: The query should've succeeded.
+	require.NoError(t, err)
+	require.Equal(t, reference, res.Reference)
+}
+
+func TestPendingMintsQuery(t *testing.T) {
+	k, ctx := mocks.FlorinKeeper()
+	server := keeper.NewQueryServer(k)
+
+	// ACT: Attempt to query pending mints with invalid request.
+	_, err := server.PendingMints(ctx, nil)
+	// ASSERT: The query should've failed due to invalid request.
+	require.ErrorContains(t, err, errors.ErrInvalidRequest.Error())
+
+	// ACT: Attempt to query pending mint with invalid request.
+	_, err = server.PendingMint(ctx, nil)
+	// ASSERT: The query should've failed due to invalid request.
+	require.ErrorContains(t, err, errors.ErrInvalidRequest.Error())
+
+	// ACT: Attempt to query pending mint that doesn't exist.
+	_, err = server.PendingMint(ctx, &types.QueryPendingMint{Denom: "ueure", Id: 1})
+	// ASSERT: The query should've failed due to not found.
+	require.ErrorContains(t, err, errors.ErrNotFound.Error())
+
+	// ARRANGE: Set pending mints in state.
+	system, user := utils.TestAccount(), utils.TestAccount()
+	pendingMint1 := types.PendingMint{Id: 1, Denom: "ueure", System: system.Address, To: user.Address, Amount: One, Quorum: 1, ExpiryHeight: 10}
+	pendingMint2 := types.PendingMint{Id: 2, Denom: "ueure", System: system.Address, To: user.Address, Amount: One.MulRaw(2), Quorum: 1, ExpiryHeight: 10}
+	pendingMint3 := types.PendingMint{Id: 3, Denom: "ugbpe", System: system.Address, To: user.Address, Amount: One, Quorum: 1, ExpiryHeight: 10}
+	for _, pendingMint := range []types.PendingMint{pendingMint1, pendingMint2, pendingMint3} {
+		require.NoError(t, k.SetPendingMint(ctx, pendingMint))
+	}
+
+	// ACT: Attempt to query pending mints.
+	res, err := server.PendingMints(ctx, &types.QueryPendingMints{Denom: "ueure"})
+	// ASSERT: The query should've succeeded, with only the denom's pending mints.
+	require.NoError(t, err)
+	require.Equal(t, []types.PendingMint{pendingMint1, pendingMint2}, res.PendingMints)
+
+	// ACT: Attempt to query pending mints with pagination.
+	res, err = server.PendingMints(ctx, &types.QueryPendingMints{Denom: "ueure", Pagination: &query.PageRequest{Limit: 1}})
+	// ASSERT: The query should've succeeded, with a single pending mint.
+	require.NoError(t, err)
+	require.Equal(t, []types.PendingMint{pendingMint1}, res.PendingMints)
+	require.NotNil(t, res.Pagination.NextKey)
+
+	// ACT: Attempt to query pending mint.
+	res2, err := server.PendingMint(ctx, &types.QueryPendingMint{Denom: "ueure", Id: 2})
+	// ASSERT: The query should've succeeded.
+	require.NoError(t, err)
+	require.Equal(t, pendingMint2, res2.PendingMint)
+}
+
+func TestTransferPolicyQuery(t *testing.T) {
+	k, ctx := mocks.FlorinKeeper()
+	server := keeper.NewQueryServer(k)
+
+	// ACT: Attempt to query transfer policy with invalid request.
+	_, err := server.TransferPolicy(ctx, nil)
+	// ASSERT: The query should've failed due to invalid request.
+	require.ErrorContains(t, err, errors.ErrInvalidRequest.Error())
+
+	// ACT: Attempt to query transfer policy with not allowed denom.
+	_, err = server.TransferPolicy(ctx, &types.QueryTransferPolicy{Denom: "uusde"})
+	// ASSERT: The query should've failed due to not allowed denom.
+	require.ErrorContains(t, err, "uusde is not an allowed denom")
+
+	// ACT: Attempt to query transfer policy with no state.
+	res, err := server.TransferPolicy(ctx, &types.QueryTransferPolicy{Denom: "ueure"})
+	// ASSERT: The query should've succeeded, and returned the default policy.
+	require.NoError(t, err)
+	require.Equal(t, types.TransferPolicyBlacklist, res.Policy)
+
+	// ARRANGE: Set transfer policy in state.
+	require.NoError(t, k.SetTransferPolicy(ctx, "ueure", types.TransferPolicyAllowlist))
+
+	// ACT: Attempt to query transfer policy.
+	res, err = server.TransferPolicy(ctx, &types.QueryTransferPolicy{Denom: "ueure"})
+	// ASSERT: The query should've succeeded.
+	require.NoError(t, err)
+	require.Equal(t, types.TransferPolicyAllowlist, res.Policy)
+}
+
+func TestAllowlistAdminsQuery(t *testing.T) {
+	k, ctx := mocks.FlorinKeeper()
+	server := keeper.NewQueryServer(k)
+
+	// ACT: Attempt to query allowlist admins with invalid request.
+	_, err := server.AllowlistAdmins(ctx, nil)
+	// ASSERT: The query should've failed due to invalid request.
+	require.ErrorContains(t, err, errors.ErrInvalidRequest.Error())
+
+	// ACT: Attempt to query allowlist admins with not allowed denom.
+	_, err = server.AllowlistAdmins(ctx, &types.QueryAllowlistAdmins{Denom: "uusde"})
+	// ASSERT: The query should've failed due to not allowed denom.
+	require.ErrorContains(t, err, "uusde is not an allowed denom")
+
+	// ARRANGE: Set allowlist admin accounts in state.
+	admin1, admin2 := utils.TestAccount(), utils.TestAccount()
+	require.NoError(t, k.SetAllowlistAdmin(ctx, "ueure", admin1.Address))
+	require.NoError(t, k.SetAllowlistAdmin(ctx, "ueure", admin2.Address))
+
+	// ACT: Attempt to query allowlist admins.
+	res, err := server.AllowlistAdmins(ctx, &types.QueryAllowlistAdmins{Denom: "ueure"})
+	// ASSERT: The query should've succeeded.
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{admin1.Address, admin2.Address}, res.AllowlistAdmins)
+}
+
+func TestAllowlistQuery(t *testing.T) {
+	k, ctx := mocks.FlorinKeeper()
+	server := keeper.NewQueryServer(k)
+
+	// ACT: Attempt to query allowlist with invalid request.
+	_, err := server.Allowlist(ctx, nil)
+	// ASSERT: The query should've failed due to invalid request.
+	require.ErrorContains(t, err, errors.ErrInvalidRequest.Error())
+
+	// ACT: Attempt to query allowlist with not allowed denom.
+	_, err = server.Allowlist(ctx, &types.QueryAllowlist{Denom: "uusde"})
+	// ASSERT: The query should've failed due to not allowed denom.
+	require.ErrorContains(t, err, "uusde is not an allowed denom")
+
+	// ARRANGE: Set allowlisted accounts in state, including one of another denom.
+	account1, account2, account3 := utils.TestAccount(), utils.TestAccount(), utils.TestAccount()
+	require.NoError(t, k.SetAllowlisted(ctx, "ueure", account1.Address))
+	require.NoError(t, k.SetAllowlisted(ctx, "ueure", account2.Address))
+	require.NoError(t, k.SetAllowlisted(ctx, "ugbpe", account3.Address))
+
+	// ACT: Attempt to query allowlist.
+	res, err := server.Allowlist(ctx, &types.QueryAllowlist{Denom: "ueure"})
+	// ASSERT: The query should've succeeded, with only the denom's accounts.
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{account1.Address, account2.Address}, res.Accounts)
+
+	// ACT: Attempt to query allowlist with pagination.
+	res, err = server.Allowlist(ctx, &types.QueryAllowlist{Denom: "ueure", Pagination: &query.PageRequest{Limit: 1}})
+	// ASSERT: The query should've succeeded, with a single account.
+	require.NoError(t, err)
+	require.Len(t, res.Accounts, 1)
+	require.NotNil(t, res.Pagination.NextKey)
+}
+
+func TestAccountLimitQuery(t *testing.T) {
+	k, ctx := mocks.FlorinKeeper()
+	ctx = ctx.WithBlockTime(time.Unix(1_700_000_000, 0))
+	server := keeper.NewQueryServer(k)
+	user := utils.TestAccount()
+
+	// ACT: Attempt to query account limit with invalid request.
+	_, err := server.AccountLimit(ctx, nil)
+	// ASSERT: The query should've failed due to invalid request.
+	require.ErrorContains(t, err, errors.ErrInvalidRequest.Error())
+
+	// ACT: Attempt to query account limit with not allowed denom.
+	_, err = server.AccountLimit(ctx, &types.QueryAccountLimit{Denom: "uusde", Address: user.Address})
+	// ASSERT: The query should've failed due to not allowed denom.
+	require.ErrorContains(t, err, "uusde is not an allowed denom")
+
+	// ACT: Attempt to query account limit with invalid address.
+	_, err = server.AccountLimit(ctx, &types.QueryAccountLimit{Denom: "ueure", Address: user.Invalid})
+	// ASSERT: The query should've failed due to invalid address.
+	require.ErrorContains(t, err, errors.ErrInvalidAddress.Error())
+
+	// ACT: Attempt to query account limit with no state.
+	res, err := server.AccountLimit(ctx, &types.QueryAccountLimit{Denom: "ueure", Address: user.Address})
+	// ASSERT: The query should've succeeded, and returned no limits.
+	require.NoError(t, err)
+	require.True(t, res.AccountLimit.MaxBalance.IsZero())
+	require.False(t, res.IsDefault)
+	require.True(t, res.DailyOutflow.IsZero())
+
+	// ARRANGE: Set the default account limit and an outflow in state.
+	require.NoError(t, k.SetAccountLimit(ctx, types.AccountLimit{
+		Denom:           "ueure",
+		MaxBalance:      One.MulRaw(10),
+		MaxTransfer:     math.ZeroInt(),
+		MaxDailyOutflow: One.MulRaw(5),
+	}))
+	outflow := types.NewAccountOutflow("ueure", user.Address, ctx.BlockTime())
+	outflow.Used = One.MulRaw(2)
+	require.NoError(t, k.SetAccountOutflow(ctx, outflow))
+
+	// ACT: Attempt to query account limit.
+	res, err = server.AccountLimit(ctx, &types.QueryAccountLimit{Denom: "ueure", Address: user.Address})
+	// ASSERT: The query should've succeeded, and returned the default limits.
+	require.NoError(t, err)
+	require.True(t, res.IsDefault)
+	require.Equal(t, One.MulRaw(10), res.AccountLimit.MaxBalance)
+	require.Equal(t, One.MulRaw(2), res.DailyOutflow)
+
+	// ACT: Attempt to query account limit a day later.
+	res, err = server.AccountLimit(ctx.WithBlockTime(ctx.BlockTime().Add(types.OutflowWindow)), &types.QueryAccountLimit{Denom: "ueure", Address: user.Address})
+	// ASSERT: The query should've succeeded, and returned no outflow.
+	require.NoError(t, err)
+	require.True(t, res.DailyOutflow.IsZero())
+}
+
+func TestRolesQuery(t *testing.T) {
+	k, ctx := mocks.FlorinKeeper()
+	server := keeper.NewQueryServer(k)
+
+	// ACT: Attempt to query roles with invalid request.
+	_, err := server.Roles(ctx, nil)
+	// ASSERT: The query should've failed due to invalid request.
+	require.ErrorContains(t, err, errors.ErrInvalidRequest.Error())
+
+	// ACT: Attempt to query roles with invalid address.
+	_, err = server.Roles(ctx, &types.QueryRoles{Address: "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn"})
+	// ASSERT: The query should've failed due to invalid address.
+	require.ErrorContains(t, err, errors.ErrInvalidAddress.Error())
+
+	// ACT: Attempt to query roles of random account.
+	account := utils.TestAccount()
+	res, err := server.Roles(ctx, &types.QueryRoles{Address: account.Address})
+	// ASSERT: The query should've succeeded, returns empty.
+	require.NoError(t, err)
+	require.Empty(t, res.Denoms)
+	require.False(t, res.BlacklistOwner)
+	require.False(t, res.BlacklistAdmin)
+	require.False(t, res.Adversary)
+
+	// ARRANGE: Assign roles to account in state.
+	err = k.SetAllowedDenom(ctx, "ugbpe")
+	require.NoError(t, err)
+	require.NoError(t, k.SetOwner(ctx, "ueure", account.Address))
+	require.NoError(t, k.SetSystem(ctx, "ueure", account.Address))
+	require.NoError(t, k.SetMintAllowance(ctx, "ueure", account.Address, One))
+	require.NoError(t, k.SetPauser(ctx, "ueure", account.Address))
+	require.NoError(t, k.SetDenomAdversary(ctx, "ugbpe", account.Address))
+	require.NoError(t, k.SetBlacklistAdmin(ctx, account.Address))
+	require.NoError(t, k.SetAllowlistAdmin(ctx, "ugbpe", account.Address))
+	require.NoError(t, k.SetAllowlisted(ctx, "ueure", account.Address))
+
+	// ACT: Attempt to query roles.
+	res, err = server.Roles(ctx, &types.QueryRoles{Address: account.Address})
+	// ASSERT: The query should've succeeded.
+	require.NoError(t, err)
+	require.Len(t, res.Denoms, 2)
+	require.Contains(t, res.Denoms, types.DenomRoles{
+		Denom:         "ueure",
+		Owner:         true,
+		System:        true,
+		Pauser:        true,
+		MintAllowance: One,
+		Allowlisted:   true,
+	})
+	require.Contains(t, res.Denoms, types.DenomRoles{
+		Denom:          "ugbpe",
+		MintAllowance:  math.ZeroInt(),
+		Adversary:      true,
+		AllowlistAdmin: true,
+	})
+	require.False(t, res.BlacklistOwner)
+	require.True(t, res.BlacklistAdmin)
+	require.False(t, res.Adversary)
+}
@@ -0,0 +1,81 @@
+// Copyright 2024 Monerium ehf.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/monerium/module-noble/v2/types"
+)
+
+// GetCurrentRateLimitUsage returns the usage of a rate limit in the rolling
+// window ending at the current block.
+func (k *Keeper) GetCurrentRateLimitUsage(ctx context.Context, rateLimit types.RateLimit) types.RateLimitUsage {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	usage, found := k.GetRateLimitUsage(ctx, rateLimit.Denom, rateLimit.Address)
+	if !found {
+		usage = types.NewRateLimitUsage(rateLimit.Denom, rateLimit.Address)
+	}
+
+	return rateLimit.Prune(usage, sdkCtx.BlockHeight(), sdkCtx.BlockTime())
+}
+
+// GetRemainingRateLimit returns the amount that can still be minted in the
+// rolling window of a rate limit.
+func (k *Keeper) GetRemainingRateLimit(ctx context.Context, rateLimit types.RateLimit) math.Int {
+	usage := k.GetCurrentRateLimitUsage(ctx, rateLimit)
+	if usage.Used.GTE(rateLimit.Amount) {
+		return math.ZeroInt()
+	}
+	return rateLimit.Amount.Sub(usage.Used)
+}
+
+// ConsumeRateLimits records a mint of amount by a system account against both
+// the denom-wide and system-specific rate limits, failing if either would be
+// exceeded.
+func (k *Keeper) ConsumeRateLimits(ctx context.Context, denom string, system string, amount math.Int) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	var usages []types.RateLimitUsage
+	for _, address := range []string{"", system} {
+		rateLimit, found := k.GetRateLimit(ctx, denom, address)
+		if !found {
+			continue
+		}
+
+		usage := k.GetCurrentRateLimitUsage(ctx, rateLimit)
+		usage = rateLimit.Record(usage, amount, sdkCtx.BlockHeight(), sdkCtx.BlockTime())
+		if usage.Used.GT(rateLimit.Amount) {
+			if address == "" {
+				return errors.Wrapf(types.ErrRateLimitExceeded, "denom-wide limit of %s", rateLimit.Amount)
+			}
+			return errors.Wrapf(types.ErrRateLimitExceeded, "limit of %s for %s", rateLimit.Amount, address)
+		}
+
+		usages = append(usages, usage)
+	}
+
+	for _, usage := range usages {
+		if err := k.SetRateLimitUsage(ctx, usage); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
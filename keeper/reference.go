@@ -0,0 +1,48 @@
+// Copyright 2024 Monerium ehf.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/monerium/module-noble/v2/types"
+)
+
+// UseReference records the external reference of a mint or burn, so that a
+// retried message can't change supply twice. An empty reference is ignored.
+func (k *Keeper) UseReference(ctx context.Context, denom string, reference string, system string, account string, amount math.Int, burn bool) error {
+	if reference == "" {
+		return nil
+	}
+	if err := types.ValidateReference(reference); err != nil {
+		return err
+	}
+	if k.HasReference(ctx, denom, reference) {
+		return errors.Wrapf(types.ErrDuplicateReference, "%s for %s", reference, denom)
+	}
+
+	return k.SetReference(ctx, types.Reference{
+		Denom:     denom,
+		Reference: reference,
+		System:    system,
+		Account:   account,
+		Amount:    amount,
+		Burn:      burn,
+		Height:    sdk.UnwrapSDKContext(ctx).BlockHeight(),
+	})
+}
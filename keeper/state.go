@@ -16,8 +16,10 @@ package keeper
 
 import (
 	"context"
+	"fmt"
 
 	"cosmossdk.io/collections"
+	"cosmossdk.io/errors"
 	"cosmossdk.io/math"
 	"github.com/monerium/module-noble/v2/types"
 )
@@ -88,20 +90,22 @@ func (k *Keeper) SetPendingOwner(ctx context.Context, denom string, pendingOwner
 //
 
 func (k *Keeper) DeleteSystem(ctx context.Context, denom string, address string) error {
-	return k.Systems.Remove(ctx, types.SystemKey(denom, address))
+	key, err := k.AccountKey(denom, address)
+	if err != nil {
+		return err
+	}
+	return k.Systems.Remove(ctx, key)
 }
 
 func (k *Keeper) GetSystemsByDenom(ctx context.Context, denom string) (systems []string) {
-	prefix := []byte(denom)
-	itr, _ := k.Systems.Iterate(ctx, new(collections.Range[[]byte]).Prefix(prefix))
-
-	defer itr.Close()
-
-	for ; itr.Valid(); itr.Next() {
-		key, _ := itr.Key()
-		systems = append(systems, string(key[len(prefix):]))
-	}
-
+	_ = k.Systems.Walk(ctx, collections.NewPrefixedPairRange[string, []byte](denom), func(key collections.Pair[string, []byte]) (bool, error) {
+		system, err := k.addressCodec.BytesToString(key.K2())
+		if err != nil {
+			return false, nil
+		}
+		systems = append(systems, system)
+		return false, nil
+	})
 	return
 }
 
@@ -119,31 +123,41 @@ func (k *Keeper) GetSystems(ctx context.Context) (systems []types.Account) {
 }
 
 func (k *Keeper) IsSystem(ctx context.Context, denom string, address string) bool {
-	system, _ := k.Systems.Has(ctx, types.SystemKey(denom, address))
+	key, err := k.AccountKey(denom, address)
+	if err != nil {
+		return false
+	}
+	system, _ := k.Systems.Has(ctx, key)
 	return system
 }
 
 func (k *Keeper) SetSystem(ctx context.Context, denom string, address string) error {
-	return k.Systems.Set(ctx, types.SystemKey(denom, address))
+	key, err := k.AccountKey(denom, address)
+	if err != nil {
+		return err
+	}
+	return k.Systems.Set(ctx, key)
 }
 
 //
 
 func (k *Keeper) DeleteAdmin(ctx context.Context, denom string, admin string) error {
-	return k.Admins.Remove(ctx, types.AdminKey(denom, admin))
+	key, err := k.AccountKey(denom, admin)
+	if err != nil {
+		return err
+	}
+	return k.Admins.Remove(ctx, key)
 }
 
 func (k *Keeper) GetAdminsByDenom(ctx context.Context, denom string) (admins []string) {
-	prefix := []byte(denom)
-	itr, _ := k.Admins.Iterate(ctx, new(collections.Range[[]byte]).Prefix(prefix))
-
-	defer itr.Close()
-
-	for ; itr.Valid(); itr.Next() {
-		key, _ := itr.Key()
-		admins = append(admins, string(key[len(prefix):]))
-	}
-
+	_ = k.Admins.Walk(ctx, collections.NewPrefixedPairRange[string, []byte](denom), func(key collections.Pair[string, []byte]) (bool, error) {
+		admin, err := k.addressCodec.BytesToString(key.K2())
+		if err != nil {
+			return false, nil
+		}
+		admins = append(admins, admin)
+		return false, nil
+	})
 	return
 }
 
@@ -161,19 +175,31 @@ func (k *Keeper) GetAdmins(ctx context.Context) (admins []types.Account) {
 }
 
 func (k *Keeper) IsAdmin(ctx context.Context, denom string, admin string) bool {
-	isAdmin, _ := k.Admins.Has(ctx, types.AdminKey(denom, admin))
+	key, err := k.AccountKey(denom, admin)
+	if err != nil {
+		return false
+	}
+	isAdmin, _ := k.Admins.Has(ctx, key)
 	return isAdmin
 }
 
 func (k *Keeper) SetAdmin(ctx context.Context, denom string, admin string) error {
-	return k.Admins.Set(ctx, types.AdminKey(denom, admin))
+	key, err := k.AccountKey(denom, admin)
+	if err != nil {
+		return err
+	}
+	return k.Admins.Set(ctx, key)
 }
 
 //
 
 func (k *Keeper) GetMintAllowance(ctx context.Context, denom string, address string) (allowance math.Int) {
 	allowance = math.ZeroInt()
-	bz, err := k.MintAllowance.Get(ctx, types.MintAllowanceKey(denom, address))
+	key, err := k.AccountKey(denom, address)
+	if err != nil {
+		return
+	}
+	bz, err := k.MintAllowance.Get(ctx, key)
 	if err != nil {
 		return
 	}
@@ -183,28 +209,24 @@ func (k *Keeper) GetMintAllowance(ctx context.Context, denom string, address str
 }
 
 func (k *Keeper) GetMintAllowancesByDenom(ctx context.Context, denom string) (allowances []types.Allowance) {
-	prefix := []byte(denom)
-	itr, _ := k.MintAllowance.Iterate(ctx, new(collections.Range[[]byte]).Prefix(prefix))
-
-	defer itr.Close()
-
-	for ; itr.Valid(); itr.Next() {
-		key, _ := itr.Key()
-		value, _ := itr.Value()
+	_ = k.MintAllowance.Walk(ctx, collections.NewPrefixedPairRange[string, []byte](denom), func(key collections.Pair[string, []byte], value []byte) (bool, error) {
+		address, err := k.addressCodec.BytesToString(key.K2())
+		if err != nil {
+			return false, nil
+		}
 
 		var allowance math.Int
-		err := allowance.Unmarshal(value)
-		if err != nil {
-			continue
+		if err := allowance.Unmarshal(value); err != nil {
+			return false, nil
 		}
 
 		allowances = append(allowances, types.Allowance{
 			Denom:     denom,
-			Address:   string(key[len(prefix):]),
+			Address:   address,
 			Allowance: allowance,
 		})
-	}
-
+		return false, nil
+	})
 	return
 }
 
@@ -217,8 +239,12 @@ func (k *Keeper) GetMintAllowances(ctx context.Context) (allowances []types.Allo
 }
 
 func (k *Keeper) SetMintAllowance(ctx context.Context, denom string, address string, allowance math.Int) error {
+	key, err := k.AccountKey(denom, address)
+	if err != nil {
+		return err
+	}
 	bz, _ := allowance.Marshal()
-	return k.MintAllowance.Set(ctx, types.MintAllowanceKey(denom, address), bz)
+	return k.MintAllowance.Set(ctx, key, bz)
 }
 
 //
@@ -233,10 +259,17 @@ func (k *Keeper) GetMaxMintAllowance(ctx context.Context, denom string) (maxAllo
 	return
 }
 
+// GetMaxMintAllowances returns the max mint allowances of all denoms, panicking
+// on a value that can't be decoded so that it isn't silently dropped on export.
 func (k *Keeper) GetMaxMintAllowances(ctx context.Context) (maxAllowances map[string]string) {
 	maxAllowances = make(map[string]string)
 	_ = k.MaxMintAllowance.Walk(ctx, nil, func(key string, value []byte) (stop bool, err error) {
-		maxAllowances[key] = string(value)
+		var maxAllowance math.Int
+		if err := maxAllowance.Unmarshal(value); err != nil {
+			panic(fmt.Errorf("invalid max mint allowance for denom %s: %w", key, err))
+		}
+
+		maxAllowances[key] = maxAllowance.String()
 		return false, nil
 	})
 	return
@@ -246,3 +279,473 @@ func (k *Keeper) SetMaxMintAllowance(ctx context.Context, denom string, maxAllow
 	bz, _ := maxAllowance.Marshal()
 	return k.MaxMintAllowance.Set(ctx, denom, bz)
 }
+
+//
+
+func (k *Keeper) GetPausedDenoms(ctx context.Context) (pausedDenoms []string) {
+	_ = k.Paused.Walk(ctx, nil, func(denom string) (bool, error) {
+		pausedDenoms = append(pausedDenoms, denom)
+		return false, nil
+	})
+	return
+}
+
+func (k *Keeper) IsPaused(ctx context.Context, denom string) bool {
+	paused, _ := k.Paused.Has(ctx, denom)
+	return paused
+}
+
+func (k *Keeper) SetPaused(ctx context.Context, denom string, paused bool) error {
+	if paused {
+		return k.Paused.Set(ctx, denom)
+	}
+	return k.Paused.Remove(ctx, denom)
+}
+
+//
+
+func (k *Keeper) GetPauser(ctx context.Context, denom string) string {
+	pauser, _ := k.Pauser.Get(ctx, denom)
+	return pauser
+}
+
+func (k *Keeper) GetPausers(ctx context.Context) map[string]string {
+	pausers := make(map[string]string)
+	_ = k.Pauser.Walk(ctx, nil, func(key string, value string) (bool, error) {
+		pausers[key] = value
+		return false, nil
+	})
+	return pausers
+}
+
+func (k *Keeper) SetPauser(ctx context.Context, denom string, pauser string) error {
+	return k.Pauser.Set(ctx, denom, pauser)
+}
+
+//
+
+func (k *Keeper) GetSeizer(ctx context.Context, denom string) string {
+	seizer, _ := k.Seizer.Get(ctx, denom)
+	return seizer
+}
+
+func (k *Keeper) GetSeizers(ctx context.Context) map[string]string {
+	seizers := make(map[string]string)
+	_ = k.Seizer.Walk(ctx, nil, func(key string, value string) (bool, error) {
+		seizers[key] = value
+		return false, nil
+	})
+	return seizers
+}
+
+func (k *Keeper) SetSeizer(ctx context.Context, denom string, seizer string) error {
+	return k.Seizer.Set(ctx, denom, seizer)
+}
+
+//
+
+func (k *Keeper) GetNonce(ctx context.Context, address string) uint64 {
+	nonce, _ := k.Nonces.Get(ctx, address)
+	return nonce
+}
+
+func (k *Keeper) GetNonces(ctx context.Context) map[string]uint64 {
+	nonces := make(map[string]uint64)
+	_ = k.Nonces.Walk(ctx, nil, func(key string, value uint64) (bool, error) {
+		nonces[key] = value
+		return false, nil
+	})
+	return nonces
+}
+
+func (k *Keeper) SetNonce(ctx context.Context, address string, nonce uint64) error {
+	return k.Nonces.Set(ctx, address, nonce)
+}
+
+//
+
+func (k *Keeper) IsLegacySignaturesDeprecated(ctx context.Context) bool {
+	deprecated, _ := k.LegacySignaturesDeprecated.Get(ctx)
+	return deprecated
+}
+
+func (k *Keeper) SetLegacySignaturesDeprecated(ctx context.Context, deprecated bool) error {
+	return k.LegacySignaturesDeprecated.Set(ctx, deprecated)
+}
+
+//
+
+func (k *Keeper) DeleteRateLimit(ctx context.Context, denom string, address string) error {
+	if err := k.RateLimits.Remove(ctx, collections.Join(denom, address)); err != nil {
+		return err
+	}
+	return k.RateLimitUsages.Remove(ctx, collections.Join(denom, address))
+}
+
+func (k *Keeper) GetRateLimit(ctx context.Context, denom string, address string) (types.RateLimit, bool) {
+	rateLimit, err := k.RateLimits.Get(ctx, collections.Join(denom, address))
+	return rateLimit, err == nil
+}
+
+func (k *Keeper) GetRateLimits(ctx context.Context) (rateLimits []types.RateLimit) {
+	_ = k.RateLimits.Walk(ctx, nil, func(_ collections.Pair[string, string], rateLimit types.RateLimit) (bool, error) {
+		rateLimits = append(rateLimits, rateLimit)
+		return false, nil
+	})
+	return
+}
+
+func (k *Keeper) GetRateLimitsByDenom(ctx context.Context, denom string) (rateLimits []types.RateLimit) {
+	_ = k.RateLimits.Walk(ctx, collections.NewPrefixedPairRange[string, string](denom), func(_ collections.Pair[string, string], rateLimit types.RateLimit) (bool, error) {
+		rateLimits = append(rateLimits, rateLimit)
+		return false, nil
+	})
+	return
+}
+
+// SetRateLimit stores a rate limit. The usage of an existing rate limit is
+// reset when its window changes, as its buckets can't be aged out otherwise.
+func (k *Keeper) SetRateLimit(ctx context.Context, rateLimit types.RateLimit) error {
+	existing, found := k.GetRateLimit(ctx, rateLimit.Denom, rateLimit.Address)
+	if found && (existing.WindowBlocks != rateLimit.WindowBlocks || existing.WindowSeconds != rateLimit.WindowSeconds) {
+		if err := k.RateLimitUsages.Remove(ctx, collections.Join(rateLimit.Denom, rateLimit.Address)); err != nil {
+			return err
+		}
+	}
+	return k.RateLimits.Set(ctx, collections.Join(rateLimit.Denom, rateLimit.Address), rateLimit)
+}
+
+//
+
+func (k *Keeper) GetRateLimitUsage(ctx context.Context, denom string, address string) (types.RateLimitUsage, bool) {
+	usage, err := k.RateLimitUsages.Get(ctx, collections.Join(denom, address))
+	return usage, err == nil
+}
+
+func (k *Keeper) GetRateLimitUsages(ctx context.Context) (usages []types.RateLimitUsage) {
+	_ = k.RateLimitUsages.Walk(ctx, nil, func(_ collections.Pair[string, string], usage types.RateLimitUsage) (bool, error) {
+		usages = append(usages, usage)
+		return false, nil
+	})
+	return
+}
+
+func (k *Keeper) SetRateLimitUsage(ctx context.Context, usage types.RateLimitUsage) error {
+	return k.RateLimitUsages.Set(ctx, collections.Join(usage.Denom, usage.Address), usage)
+}
+
+//
+
+func (k *Keeper) GetSupplyCap(ctx context.Context, denom string) (supplyCap math.Int) {
+	supplyCap = math.ZeroInt()
+	bz, err := k.SupplyCap.Get(ctx, denom)
+	if err != nil {
+		return
+	}
+	_ = supplyCap.Unmarshal(bz)
+	return
+}
+
+func (k *Keeper) GetSupplyCaps(ctx context.Context) (supplyCaps map[string]string) {
+	supplyCaps = make(map[string]string)
+	_ = k.SupplyCap.Walk(ctx, nil, func(key string, value []byte) (stop bool, err error) {
+		var supplyCap math.Int
+		if err := supplyCap.Unmarshal(value); err != nil {
+			panic(fmt.Errorf("invalid supply cap for denom %s: %w", key, err))
+		}
+
+		supplyCaps[key] = supplyCap.String()
+		return false, nil
+	})
+	return
+}
+
+func (k *Keeper) SetSupplyCap(ctx context.Context, denom string, supplyCap math.Int) error {
+	if supplyCap.IsZero() {
+		return k.SupplyCap.Remove(ctx, denom)
+	}
+
+	bz, _ := supplyCap.Marshal()
+	return k.SupplyCap.Set(ctx, denom, bz)
+}
+
+//
+
+func (k *Keeper) GetMintApprovalPolicy(ctx context.Context, denom string) (types.MintApprovalPolicy, bool) {
+	policy, err := k.MintApprovalPolicies.Get(ctx, denom)
+	return policy, err == nil
+}
+
+func (k *Keeper) GetMintApprovalPolicies(ctx context.Context) (policies []types.MintApprovalPolicy) {
+	_ = k.MintApprovalPolicies.Walk(ctx, nil, func(_ string, policy types.MintApprovalPolicy) (bool, error) {
+		policies = append(policies, policy)
+		return false, nil
+	})
+	return
+}
+
+func (k *Keeper) SetMintApprovalPolicy(ctx context.Context, policy types.MintApprovalPolicy) error {
+	if policy.Threshold.IsZero() {
+		return k.MintApprovalPolicies.Remove(ctx, policy.Denom)
+	}
+	return k.MintApprovalPolicies.Set(ctx, policy.Denom, policy)
+}
+
+//
+
+func (k *Keeper) DeletePendingMint(ctx context.Context, denom string, id uint64) error {
+	if pendingMint, found := k.GetPendingMint(ctx, denom, id); found {
+		if err := k.PendingMintExpiries.Remove(ctx, collections.Join3(pendingMint.ExpiryHeight, denom, id)); err != nil {
+			return err
+		}
+	}
+	return k.PendingMints.Remove(ctx, collections.Join(denom, id))
+}
+
+func (k *Keeper) GetPendingMint(ctx context.Context, denom string, id uint64) (types.PendingMint, bool) {
+	pendingMint, err := k.PendingMints.Get(ctx, collections.Join(denom, id))
+	return pendingMint, err == nil
+}
+
+func (k *Keeper) GetPendingMints(ctx context.Context) (pendingMints []types.PendingMint) {
+	_ = k.PendingMints.Walk(ctx, nil, func(_ collections.Pair[string, uint64], pendingMint types.PendingMint) (bool, error) {
+		pendingMints = append(pendingMints, pendingMint)
+		return false, nil
+	})
+	return
+}
+
+// GetExpiredPendingMints returns the pending mints whose expiry height is
+// below the given height, ranging only over the expired part of the index.
+func (k *Keeper) GetExpiredPendingMints(ctx context.Context, height int64) (pendingMints []types.PendingMint) {
+	_ = k.PendingMintExpiries.Walk(ctx, collections.NewPrefixUntilTripleRange[int64, string, uint64](height-1), func(key collections.Triple[int64, string, uint64]) (bool, error) {
+		if pendingMint, found := k.GetPendingMint(ctx, key.K2(), key.K3()); found {
+			pendingMints = append(pendingMints, pendingMint)
+		}
+		return false, nil
+	})
+	return
+}
+
+// SetPendingMint stores a pending mint, and indexes it by its expiry height.
+func (k *Keeper) SetPendingMint(ctx context.Context, pendingMint types.PendingMint) error {
+	if err := k.PendingMintExpiries.Set(ctx, collections.Join3(pendingMint.ExpiryHeight, pendingMint.Denom, pendingMint.Id)); err != nil {
+		return err
+	}
+	return k.PendingMints.Set(ctx, collections.Join(pendingMint.Denom, pendingMint.Id), pendingMint)
+}
+
+func (k *Keeper) GetNextPendingMintID(ctx context.Context) uint64 {
+	id, _ := k.NextPendingMintID.Peek(ctx)
+	return id
+}
+
+func (k *Keeper) SetNextPendingMintID(ctx context.Context, id uint64) error {
+	return k.NextPendingMintID.Set(ctx, id)
+}
+
+//
+
+func (k *Keeper) DeleteReference(ctx context.Context, denom string, reference string) error {
+	return k.References.Remove(ctx, collections.Join(denom, reference))
+}
+
+func (k *Keeper) GetReference(ctx context.Context, denom string, reference string) (types.Reference, bool) {
+	ref, err := k.References.Get(ctx, collections.Join(denom, reference))
+	return ref, err == nil
+}
+
+func (k *Keeper) GetReferences(ctx context.Context) (references []types.Reference) {
+	_ = k.References.Walk(ctx, nil, func(_ collections.Pair[string, string], ref types.Reference) (bool, error) {
+		references = append(references, ref)
+		return false, nil
+	})
+	return
+}
+
+func (k *Keeper) HasReference(ctx context.Context, denom string, reference string) bool {
+	has, _ := k.References.Has(ctx, collections.Join(denom, reference))
+	return has
+}
+
+func (k *Keeper) SetReference(ctx context.Context, ref types.Reference) error {
+	return k.References.Set(ctx, collections.Join(ref.Denom, ref.Reference), ref)
+}
+
+//
+
+func (k *Keeper) GetTransferPolicy(ctx context.Context, denom string) types.TransferPolicy {
+	policy, _ := k.TransferPolicies.Get(ctx, denom)
+	return types.TransferPolicy(policy)
+}
+
+func (k *Keeper) GetTransferPolicies(ctx context.Context) map[string]types.TransferPolicy {
+	policies := make(map[string]types.TransferPolicy)
+	_ = k.TransferPolicies.Walk(ctx, nil, func(key string, value int32) (bool, error) {
+		policies[key] = types.TransferPolicy(value)
+		return false, nil
+	})
+	return policies
+}
+
+func (k *Keeper) SetTransferPolicy(ctx context.Context, denom string, policy types.TransferPolicy) error {
+	return k.TransferPolicies.Set(ctx, denom, int32(policy))
+}
+
+//
+
+func (k *Keeper) DeleteAllowlistAdmin(ctx context.Context, denom string, admin string) error {
+	key, err := k.AccountKey(denom, admin)
+	if err != nil {
+		return err
+	}
+	return k.AllowlistAdmins.Remove(ctx, key)
+}
+
+func (k *Keeper) GetAllowlistAdminsByDenom(ctx context.Context, denom string) (admins []string) {
+	_ = k.AllowlistAdmins.Walk(ctx, collections.NewPrefixedPairRange[string, []byte](denom), func(key collections.Pair[string, []byte]) (bool, error) {
+		admin, err := k.addressCodec.BytesToString(key.K2())
+		if err != nil {
+			return false, nil
+		}
+		admins = append(admins, admin)
+		return false, nil
+	})
+	return
+}
+
+func (k *Keeper) GetAllowlistAdmins(ctx context.Context) (admins []types.Account) {
+	for _, allowedDenom := range k.GetAllowedDenoms(ctx) {
+		for _, admin := range k.GetAllowlistAdminsByDenom(ctx, allowedDenom) {
+			admins = append(admins, types.Account{
+				Denom:   allowedDenom,
+				Address: admin,
+			})
+		}
+	}
+
+	return
+}
+
+func (k *Keeper) IsAllowlistAdmin(ctx context.Context, denom string, admin string) bool {
+	key, err := k.AccountKey(denom, admin)
+	if err != nil {
+		return false
+	}
+	isAdmin, _ := k.AllowlistAdmins.Has(ctx, key)
+	return isAdmin
+}
+
+func (k *Keeper) SetAllowlistAdmin(ctx context.Context, denom string, admin string) error {
+	key, err := k.AccountKey(denom, admin)
+	if err != nil {
+		return err
+	}
+	return k.AllowlistAdmins.Set(ctx, key)
+}
+
+//
+
+func (k *Keeper) DeleteAllowlisted(ctx context.Context, denom string, account string) error {
+	key, err := k.AccountKey(denom, account)
+	if err != nil {
+		return err
+	}
+	return k.Allowlist.Remove(ctx, key)
+}
+
+func (k *Keeper) GetAllowlistByDenom(ctx context.Context, denom string) (accounts []string) {
+	_ = k.Allowlist.Walk(ctx, collections.NewPrefixedPairRange[string, []byte](denom), func(key collections.Pair[string, []byte]) (bool, error) {
+		account, err := k.addressCodec.BytesToString(key.K2())
+		if err != nil {
+			return false, nil
+		}
+		accounts = append(accounts, account)
+		return false, nil
+	})
+	return
+}
+
+func (k *Keeper) GetAllowlist(ctx context.Context) (accounts []types.Account) {
+	for _, allowedDenom := range k.GetAllowedDenoms(ctx) {
+		for _, account := range k.GetAllowlistByDenom(ctx, allowedDenom) {
+			accounts = append(accounts, types.Account{
+				Denom:   allowedDenom,
+				Address: account,
+			})
+		}
+	}
+
+	return
+}
+
+func (k *Keeper) IsAllowlisted(ctx context.Context, denom string, account string) bool {
+	key, err := k.AccountKey(denom, account)
+	if err != nil {
+		return false
+	}
+	allowlisted, _ := k.Allowlist.Has(ctx, key)
+	return allowlisted
+}
+
+func (k *Keeper) SetAllowlisted(ctx context.Context, denom string, account string) error {
+	key, err := k.AccountKey(denom, account)
+	if err != nil {
+		return err
+	}
+	return k.Allowlist.Set(ctx, key)
+}
+
+//
+
+func (k *Keeper) DeleteAccountLimit(ctx context.Context, denom string, address string) error {
+	return k.AccountLimits.Remove(ctx, collections.Join(denom, address))
+}
+
+func (k *Keeper) GetAccountLimit(ctx context.Context, denom string, address string) (types.AccountLimit, bool) {
+	limit, err := k.AccountLimits.Get(ctx, collections.Join(denom, address))
+	return limit, err == nil
+}
+
+func (k *Keeper) GetAccountLimits(ctx context.Context) (limits []types.AccountLimit) {
+	_ = k.AccountLimits.Walk(ctx, nil, func(_ collections.Pair[string, string], limit types.AccountLimit) (bool, error) {
+		limits = append(limits, limit)
+		return false, nil
+	})
+	return
+}
+
+func (k *Keeper) SetAccountLimit(ctx context.Context, limit types.AccountLimit) error {
+	return k.AccountLimits.Set(ctx, collections.Join(limit.Denom, limit.Address), limit)
+}
+
+//
+
+func (k *Keeper) GetAccountOutflow(ctx context.Context, denom string, address string) (types.AccountOutflow, bool) {
+	outflow, err := k.AccountOutflows.Get(ctx, collections.Join(denom, address))
+	return outflow, err == nil
+}
+
+func (k *Keeper) GetAccountOutflows(ctx context.Context) (outflows []types.AccountOutflow) {
+	_ = k.AccountOutflows.Walk(ctx, nil, func(_ collections.Pair[string, string], outflow types.AccountOutflow) (bool, error) {
+		outflows = append(outflows, outflow)
+		return false, nil
+	})
+	return
+}
+
+func (k *Keeper) SetAccountOutflow(ctx context.Context, outflow types.AccountOutflow) error {
+	return k.AccountOutflows.Set(ctx, collections.Join(outflow.Denom, outflow.Address), outflow)
+}
+
+//
+
+// AccountKey returns the key of a denom and address pair, as used by the
+// systems, admins, mint allowances and allowlist collections.
+func (k *Keeper) AccountKey(denom string, address string) (collections.Pair[string, []byte], error) {
+	bz, err := k.addressCodec.StringToBytes(address)
+	if err != nil {
+		return collections.Pair[string, []byte]{}, errors.Wrapf(err, "unable to decode address %s", address)
+	}
+	return collections.Join(denom, bz), nil
+}
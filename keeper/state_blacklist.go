@@ -14,7 +14,14 @@
 
 package keeper
 
-import "context"
+import (
+	"context"
+	"time"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/monerium/module-noble/v2/types/blacklist"
+)
 
 //
 
@@ -81,9 +88,189 @@ func (k *Keeper) GetAdversaries(ctx context.Context) (adversaries []string) {
 
 func (k *Keeper) IsAdversary(ctx context.Context, address string) bool {
 	isAdversary, _ := k.Adversaries.Has(ctx, address)
-	return isAdversary
+	return isAdversary && !k.IsBanExpired(ctx, "", address)
 }
 
 func (k *Keeper) SetAdversary(ctx context.Context, address string) error {
 	return k.Adversaries.Set(ctx, address)
 }
+
+//
+
+func (k *Keeper) DeleteDenomBlacklistAdmin(ctx context.Context, denom string, admin string) error {
+	return k.DenomBlacklistAdmins.Remove(ctx, collections.Join(denom, admin))
+}
+
+func (k *Keeper) GetDenomBlacklistAdmins(ctx context.Context, denom string) (admins []blacklist.Account) {
+	var rng collections.Ranger[collections.Pair[string, string]]
+	if denom != "" {
+		rng = collections.NewPrefixedPairRange[string, string](denom)
+	}
+
+	_ = k.DenomBlacklistAdmins.Walk(ctx, rng, func(key collections.Pair[string, string]) (bool, error) {
+		admins = append(admins, blacklist.Account{
+			Denom:   key.K1(),
+			Address: key.K2(),
+		})
+		return false, nil
+	})
+	return
+}
+
+func (k *Keeper) IsDenomBlacklistAdmin(ctx context.Context, denom string, admin string) bool {
+	isAdmin, _ := k.DenomBlacklistAdmins.Has(ctx, collections.Join(denom, admin))
+	return isAdmin
+}
+
+func (k *Keeper) SetDenomBlacklistAdmin(ctx context.Context, denom string, admin string) error {
+	return k.DenomBlacklistAdmins.Set(ctx, collections.Join(denom, admin))
+}
+
+//
+
+func (k *Keeper) DeleteDenomAdversary(ctx context.Context, denom string, address string) error {
+	return k.DenomAdversaries.Remove(ctx, collections.Join(denom, address))
+}
+
+func (k *Keeper) GetDenomAdversaries(ctx context.Context, denom string) (adversaries []blacklist.Account) {
+	var rng collections.Ranger[collections.Pair[string, string]]
+	if denom != "" {
+		rng = collections.NewPrefixedPairRange[string, string](denom)
+	}
+
+	_ = k.DenomAdversaries.Walk(ctx, rng, func(key collections.Pair[string, string]) (bool, error) {
+		adversaries = append(adversaries, blacklist.Account{
+			Denom:   key.K1(),
+			Address: key.K2(),
+		})
+		return false, nil
+	})
+	return
+}
+
+// HasDenomAdversaries returns true if any address is banned for a denom.
+func (k *Keeper) HasDenomAdversaries(ctx context.Context, denom string) (found bool) {
+	_ = k.DenomAdversaries.Walk(ctx, collections.NewPrefixedPairRange[string, string](denom), func(_ collections.Pair[string, string]) (bool, error) {
+		found = true
+		return true, nil
+	})
+	return
+}
+
+func (k *Keeper) IsDenomAdversary(ctx context.Context, denom string, address string) bool {
+	isAdversary, _ := k.DenomAdversaries.Has(ctx, collections.Join(denom, address))
+	return isAdversary && !k.IsBanExpired(ctx, denom, address)
+}
+
+func (k *Keeper) SetDenomAdversary(ctx context.Context, denom string, address string) error {
+	return k.DenomAdversaries.Set(ctx, collections.Join(denom, address))
+}
+
+//
+
+// DeleteBanRecord removes the ban record of an address, together with its
+// entries in the expiry indexes.
+func (k *Keeper) DeleteBanRecord(ctx context.Context, denom string, address string) error {
+	if record, found := k.GetBanRecord(ctx, denom, address); found {
+		if err := k.removeBanExpiries(ctx, record); err != nil {
+			return err
+		}
+	}
+	return k.BanRecords.Remove(ctx, collections.Join(denom, address))
+}
+
+func (k *Keeper) GetBanRecord(ctx context.Context, denom string, address string) (blacklist.BanRecord, bool) {
+	record, err := k.BanRecords.Get(ctx, collections.Join(denom, address))
+	return record, err == nil
+}
+
+func (k *Keeper) GetBanRecords(ctx context.Context) (records []blacklist.BanRecord) {
+	_ = k.BanRecords.Walk(ctx, nil, func(_ collections.Pair[string, string], record blacklist.BanRecord) (bool, error) {
+		records = append(records, record)
+		return false, nil
+	})
+	return
+}
+
+// IsBanExpired returns true if the ban of an address, globally if denom is
+// empty, has an expiry that has been reached in the current block.
+func (k *Keeper) IsBanExpired(ctx context.Context, denom string, address string) bool {
+	record, found := k.GetBanRecord(ctx, denom, address)
+	if !found {
+		return false
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	return record.IsExpired(sdkCtx.BlockHeight(), sdkCtx.BlockTime())
+}
+
+// IsPermanentlyBanned returns true if an address, globally if denom is empty,
+// is banned without an expiry.
+func (k *Keeper) IsPermanentlyBanned(ctx context.Context, denom string, address string) bool {
+	var banned bool
+	if denom == "" {
+		banned, _ = k.Adversaries.Has(ctx, address)
+	} else {
+		banned, _ = k.DenomAdversaries.Has(ctx, collections.Join(denom, address))
+	}
+
+	record, found := k.GetBanRecord(ctx, denom, address)
+	return banned && (!found || record.IsPermanent())
+}
+
+// GetExpiredBanRecords returns the ban records whose expiry height or time
+// has been reached, ranging only over the expired part of the indexes.
+func (k *Keeper) GetExpiredBanRecords(ctx context.Context, height int64, blockTime time.Time) (records []blacklist.BanRecord) {
+	seen := make(map[[2]string]bool)
+	collect := func(key collections.Triple[int64, string, string]) (bool, error) {
+		id := [2]string{key.K2(), key.K3()}
+		if seen[id] {
+			return false, nil
+		}
+		seen[id] = true
+
+		if record, found := k.GetBanRecord(ctx, key.K2(), key.K3()); found {
+			records = append(records, record)
+		}
+		return false, nil
+	}
+
+	_ = k.BanExpiryHeights.Walk(ctx, collections.NewPrefixUntilTripleRange[int64, string, string](height), collect)
+	_ = k.BanExpiryTimes.Walk(ctx, collections.NewPrefixUntilTripleRange[int64, string, string](blockTime.Unix()), collect)
+	return
+}
+
+// SetBanRecord stores the ban record of an address, and indexes it by its
+// expiry height and time. Bans without an expiry are not indexed.
+func (k *Keeper) SetBanRecord(ctx context.Context, record blacklist.BanRecord) error {
+	if existing, found := k.GetBanRecord(ctx, record.Denom, record.Address); found {
+		if err := k.removeBanExpiries(ctx, existing); err != nil {
+			return err
+		}
+	}
+	if record.ExpiryHeight > 0 {
+		if err := k.BanExpiryHeights.Set(ctx, collections.Join3(record.ExpiryHeight, record.Denom, record.Address)); err != nil {
+			return err
+		}
+	}
+	if record.ExpiryTime > 0 {
+		if err := k.BanExpiryTimes.Set(ctx, collections.Join3(record.ExpiryTime, record.Denom, record.Address)); err != nil {
+			return err
+		}
+	}
+	return k.BanRecords.Set(ctx, collections.Join(record.Denom, record.Address), record)
+}
+
+func (k *Keeper) removeBanExpiries(ctx context.Context, record blacklist.BanRecord) error {
+	if err := k.BanExpiryHeights.Remove(ctx, collections.Join3(record.ExpiryHeight, record.Denom, record.Address)); err != nil {
+		return err
+	}
+	return k.BanExpiryTimes.Remove(ctx, collections.Join3(record.ExpiryTime, record.Denom, record.Address))
+}
+
+//
+
+// IsBlocked returns true if an address is either globally or denom-specifically blacklisted.
+func (k *Keeper) IsBlocked(ctx context.Context, denom string, address string) bool {
+	return k.IsAdversary(ctx, address) || k.IsDenomAdversary(ctx, denom, address)
+}
@@ -16,8 +16,11 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
+	"cosmossdk.io/collections"
 	"github.com/monerium/module-noble/v2/types"
+	"github.com/monerium/module-noble/v2/types/blacklist"
 	"github.com/monerium/module-noble/v2/utils"
 	"github.com/monerium/module-noble/v2/utils/mocks"
 	"github.com/stretchr/testify/require"
@@ -55,7 +58,7 @@ func TestGetMintAllowances(t *testing.T) {
 	})
 
 	// ARRANGE: Set invalid mint allowance
-	key := types.MintAllowanceKey("ueure", "address")
+	key, _ := k.AccountKey("ueure", utils.TestAccount().Address)
 	_ = k.MintAllowance.Set(ctx, key, []byte("panic"))
 
 	// ACT: Attempt to get mint allowances.
@@ -74,3 +77,114 @@ func TestGetMintAllowances(t *testing.T) {
 		Allowance: One.MulRaw(2),
 	})
 }
+
+func TestGetMaxMintAllowancesWithCorruptValue(t *testing.T) {
+	k, ctx := mocks.FlorinKeeper()
+
+	// ARRANGE: Set a valid and a corrupt max mint allowance in state.
+	require.NoError(t, k.SetMaxMintAllowance(ctx, "ueure", One))
+	require.NoError(t, k.MaxMintAllowance.Set(ctx, "ugbpe", []byte("corrupt")))
+
+	// ACT & ASSERT: Getting the max mint allowances should panic.
+	require.Panics(t, func() { k.GetMaxMintAllowances(ctx) })
+}
+
+func TestGetExpiredBanRecords(t *testing.T) {
+	k, ctx := mocks.FlorinKeeper()
+	byHeight, byTime, byBoth, reasonOnly := utils.TestAccount(), utils.TestAccount(), utils.TestAccount(), utils.TestAccount()
+
+	// ARRANGE: Set ban records with different expiries in state.
+	require.NoError(t, k.SetBanRecord(ctx, blacklist.BanRecord{Address: byHeight.Address, ExpiryHeight: 10}))
+	require.NoError(t, k.SetBanRecord(ctx, blacklist.BanRecord{Denom: "ueure", Address: byTime.Address, ExpiryTime: 1_000}))
+	require.NoError(t, k.SetBanRecord(ctx, blacklist.BanRecord{Address: byBoth.Address, ExpiryHeight: 5, ExpiryTime: 500}))
+	require.NoError(t, k.SetBanRecord(ctx, blacklist.BanRecord{Address: reasonOnly.Address, Reason: blacklist.BanReasonSanctions}))
+
+	// ACT: Attempt to get the ban records expired at height 9 and time 999.
+	res := k.GetExpiredBanRecords(ctx, 9, time.Unix(999, 0))
+	// ASSERT: Only the ban record expired by both height and time should be returned, once.
+	require.Len(t, res, 1)
+	require.Equal(t, byBoth.Address, res[0].Address)
+
+	// ACT: Attempt to get the ban records expired at height 10 and time 1_000.
+	res = k.GetExpiredBanRecords(ctx, 10, time.Unix(1_000, 0))
+	// ASSERT: All time-bounded ban records should be returned, without the reason-only ban.
+	require.Len(t, res, 3)
+	for _, record := range res {
+		require.NotEqual(t, reasonOnly.Address, record.Address)
+	}
+
+	// ACT: Attempt to extend the ban of an address.
+	require.NoError(t, k.SetBanRecord(ctx, blacklist.BanRecord{Address: byHeight.Address, ExpiryHeight: 20}))
+	// ASSERT: The old expiry should've been removed from the index.
+	has, err := k.BanExpiryHeights.Has(ctx, collections.Join3(int64(10), "", byHeight.Address))
+	require.NoError(t, err)
+	require.False(t, has)
+	require.Len(t, k.GetExpiredBanRecords(ctx, 10, time.Unix(1_000, 0)), 2)
+
+	// ACT: Attempt to delete a ban record.
+	require.NoError(t, k.DeleteBanRecord(ctx, "ueure", byTime.Address))
+	// ASSERT: The ban record should've been removed from the index.
+	has, err = k.BanExpiryTimes.Has(ctx, collections.Join3(int64(1_000), "ueure", byTime.Address))
+	require.NoError(t, err)
+	require.False(t, has)
+	require.Len(t, k.GetExpiredBanRecords(ctx, 10, time.Unix(1_000, 0)), 1)
+}
+
+func TestGetExpiredPendingMints(t *testing.T) {
+	k, ctx := mocks.FlorinKeeper()
+
+	// ARRANGE: Set pending mints with different expiry heights in state.
+	for id, expiryHeight := range map[uint64]int64{1: 10, 2: 20, 3: 30} {
+		require.NoError(t, k.SetPendingMint(ctx, types.PendingMint{Id: id, Denom: "ueure", Amount: One, ExpiryHeight: expiryHeight}))
+	}
+
+	// ACT: Attempt to get the pending mints expired at height 21.
+	res := k.GetExpiredPendingMints(ctx, 21)
+	// ASSERT: Only the pending mints with an expiry below 21 should be returned.
+	require.Len(t, res, 2)
+	require.Equal(t, uint64(1), res[0].Id)
+	require.Equal(t, uint64(2), res[1].Id)
+
+	// ACT: Attempt to delete a pending mint.
+	require.NoError(t, k.DeletePendingMint(ctx, "ueure", 1))
+	// ASSERT: The pending mint should've been removed from the expiry index.
+	has, err := k.PendingMintExpiries.Has(ctx, collections.Join3(int64(10), "ueure", uint64(1)))
+	require.NoError(t, err)
+	require.False(t, has)
+	require.Len(t, k.GetExpiredPendingMints(ctx, 21), 1)
+}
+
+func TestGetSupplyCapsWithCorruptValue(t *testing.T) {
+	k, ctx := mocks.FlorinKeeper()
+
+	// ARRANGE: Set a valid and a corrupt supply cap in state.
+	require.NoError(t, k.SetSupplyCap(ctx, "ueure", One))
+	require.NoError(t, k.SupplyCap.Set(ctx, "ugbpe", []byte("corrupt")))
+
+	// ACT & ASSERT: Getting the supply caps should panic.
+	require.Panics(t, func() { k.GetSupplyCaps(ctx) })
+}
+
+func TestGetSystemsByDenomWithPrefixedDenom(t *testing.T) {
+	k, ctx := mocks.FlorinKeeper()
+
+	// ARRANGE: Allow a denom that is prefixed by an existing denom.
+	err := k.SetAllowedDenom(ctx, "ueurex")
+	require.NoError(t, err)
+
+	// ARRANGE: Set systems for both denoms in state.
+	system1, system2 := utils.TestAccount(), utils.TestAccount()
+	err = k.SetSystem(ctx, "ueure", system1.Address)
+	require.NoError(t, err)
+	err = k.SetSystem(ctx, "ueurex", system2.Address)
+	require.NoError(t, err)
+
+	// ACT: Attempt to get systems of both denoms.
+	systems := k.GetSystemsByDenom(ctx, "ueure")
+	prefixedSystems := k.GetSystemsByDenom(ctx, "ueurex")
+	// ASSERT: The action should've succeeded, without mixing up the denoms.
+	require.Equal(t, []string{system1.Address}, systems)
+	require.Equal(t, []string{system2.Address}, prefixedSystems)
+	require.True(t, k.IsSystem(ctx, "ueure", system1.Address))
+	require.False(t, k.IsSystem(ctx, "ueure", system2.Address))
+}
@@ -0,0 +1,51 @@
+// Copyright 2024 Monerium ehf.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/monerium/module-noble/v2/types"
+)
+
+// IsTransferBlocked returns true if an address can't send or receive a denom
+// under the transfer policy of that denom. Banned addresses are blocked under
+// every policy, as the open policy can't be combined with denom-specific bans.
+func (k *Keeper) IsTransferBlocked(ctx context.Context, denom string, address string) bool {
+	if k.IsBlocked(ctx, denom, address) {
+		return true
+	}
+	if k.GetTransferPolicy(ctx, denom) == types.TransferPolicyAllowlist {
+		return !k.IsAllowlisted(ctx, denom, address)
+	}
+	return false
+}
+
+// isAccountTransferBlocked is the equivalent of IsTransferBlocked used by the
+// send restriction. The module account is exempt from the allowlist, as it is
+// the counterparty of all mints, burns and redemptions.
+func (k *Keeper) isAccountTransferBlocked(ctx context.Context, policy types.TransferPolicy, denom string, address sdk.AccAddress) bool {
+	if k.IsBlocked(ctx, denom, address.String()) {
+		return true
+	}
+	if policy != types.TransferPolicyAllowlist || address.Equals(authtypes.NewModuleAddress(types.ModuleName)) {
+		return false
+	}
+	allowlisted, _ := k.Allowlist.Has(ctx, collections.Join(denom, address.Bytes()))
+	return !allowlisted
+}
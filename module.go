@@ -35,13 +35,14 @@ import (
 	modulev1 "github.com/monerium/module-noble/v2/api/module/v1"
 	"github.com/monerium/module-noble/v2/client/cli"
 	"github.com/monerium/module-noble/v2/keeper"
+	"github.com/monerium/module-noble/v2/keeper/migrations"
 	"github.com/monerium/module-noble/v2/types"
 	"github.com/monerium/module-noble/v2/types/blacklist"
 	"github.com/spf13/cobra"
 )
 
 // ConsensusVersion defines the current x/florin module consensus version.
-const ConsensusVersion = 1
+const ConsensusVersion = 2
 
 var (
 	_ module.AppModuleBasic      = AppModule{}
@@ -50,6 +51,7 @@ var (
 	_ module.HasGenesis          = AppModule{}
 	_ module.HasGenesisBasics    = AppModuleBasic{}
 	_ module.HasServices         = AppModule{}
+	_ appmodule.HasEndBlocker    = AppModule{}
 )
 
 //
@@ -134,6 +136,19 @@ func (m AppModule) RegisterServices(cfg module.Configurator) {
 
 	blacklist.RegisterMsgServer(cfg.MsgServer(), keeper.NewBlacklistMsgServer(m.keeper))
 	blacklist.RegisterQueryServer(cfg.QueryServer(), keeper.NewBlacklistQueryServer(m.keeper))
+
+	migrator := migrations.NewMigrator(m.keeper)
+	if err := cfg.RegisterMigration(types.ModuleName, 1, migrator.Migrate1to2); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 1 to 2: %v", types.ModuleName, err))
+	}
+}
+
+func (m AppModule) EndBlock(ctx context.Context) error {
+	if err := m.keeper.ExpirePendingMints(ctx); err != nil {
+		return err
+	}
+
+	return m.keeper.PruneExpiredBans(ctx)
 }
 
 //
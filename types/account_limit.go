@@ -0,0 +1,60 @@
+// Copyright 2024 Monerium ehf.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"time"
+
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+)
+
+// OutflowWindow is the length of the window in which the daily outflow of an
+// account is tracked.
+const OutflowWindow = 24 * time.Hour
+
+// Validate ensures that an account limit has a valid address, if any, and
+// that none of its amounts are negative. A zero amount disables that limit.
+func (al AccountLimit) Validate(cdc address.Codec) error {
+	if err := validateOptionalAddress(cdc, "account limit", al.Address); err != nil {
+		return err
+	}
+
+	for _, amount := range []math.Int{al.MaxBalance, al.MaxTransfer, al.MaxDailyOutflow} {
+		if amount.IsNil() || amount.IsNegative() {
+			return errors.Wrap(ErrInvalidAccountLimit, "amounts cannot be negative")
+		}
+	}
+
+	return nil
+}
+
+// NewAccountOutflow returns an empty outflow whose window starts at the given
+// time.
+func NewAccountOutflow(denom string, address string, blockTime time.Time) AccountOutflow {
+	return AccountOutflow{
+		Denom:           denom,
+		Address:         address,
+		Used:            math.ZeroInt(),
+		WindowStartTime: blockTime.Unix(),
+	}
+}
+
+// IsExpired returns true if the window of an outflow has elapsed at the given
+// time.
+func (o AccountOutflow) IsExpired(blockTime time.Time) bool {
+	return blockTime.Unix() >= o.WindowStartTime+int64(OutflowWindow.Seconds())
+}
@@ -0,0 +1,78 @@
+// Copyright 2024 Monerium ehf.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package blacklist
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// MaxCaseIDLength is the maximum length of a case identifier on a ban.
+const MaxCaseIDLength = 128
+
+// ParseBanReason parses a ban reason from either its full enum name or its
+// short form, e.g. "BAN_REASON_COURT_ORDER" or "court-order".
+func ParseBanReason(reason string) (BanReason, error) {
+	name := strings.ToUpper(strings.ReplaceAll(reason, "-", "_"))
+	if !strings.HasPrefix(name, "BAN_REASON_") {
+		name = "BAN_REASON_" + name
+	}
+
+	value, ok := BanReason_value[name]
+	if !ok {
+		return BanReasonUnspecified, fmt.Errorf("unknown ban reason %s", reason)
+	}
+
+	return BanReason(value), nil
+}
+
+// IsEmpty returns true if the ban record holds no details beyond the banned
+// address, in which case it doesn't need to be stored.
+func (r BanRecord) IsEmpty() bool {
+	return r.ExpiryHeight == 0 && r.ExpiryTime == 0 && r.Reason == BanReasonUnspecified && r.CaseId == ""
+}
+
+// Validate ensures that a ban record has a non-negative expiry, a known
+// reason, and a case identifier within bounds.
+func (r BanRecord) Validate() error {
+	if r.ExpiryHeight < 0 || r.ExpiryTime < 0 {
+		return fmt.Errorf("expiry height (%d) and time (%d) must not be negative", r.ExpiryHeight, r.ExpiryTime)
+	}
+	if _, ok := BanReason_name[int32(r.Reason)]; !ok {
+		return fmt.Errorf("unknown ban reason %d", r.Reason)
+	}
+	if len(r.CaseId) > MaxCaseIDLength {
+		return fmt.Errorf("case id must not exceed %d characters", MaxCaseIDLength)
+	}
+
+	return nil
+}
+
+// IsPermanent returns true if the ban has neither an expiry height nor an
+// expiry time.
+func (r BanRecord) IsPermanent() bool {
+	return r.ExpiryHeight == 0 && r.ExpiryTime == 0
+}
+
+// IsExpired returns true if the ban has reached either its expiry height or
+// its expiry time.
+func (r BanRecord) IsExpired(height int64, time time.Time) bool {
+	if r.ExpiryHeight > 0 && height >= r.ExpiryHeight {
+		return true
+	}
+
+	return r.ExpiryTime > 0 && time.Unix() >= r.ExpiryTime
+}
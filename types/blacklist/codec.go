@@ -25,18 +25,22 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgAcceptOwnership{}, "florin/blacklist/AcceptOwnership", nil)
 	cdc.RegisterConcrete(&MsgAddAdminAccount{}, "florin/blacklist/AddAdminAccount", nil)
 	cdc.RegisterConcrete(&MsgBan{}, "florin/blacklist/Ban", nil)
+	cdc.RegisterConcrete(&MsgBanBatch{}, "florin/blacklist/BanBatch", nil)
 	cdc.RegisterConcrete(&MsgRemoveAdminAccount{}, "florin/blacklist/RemoveAdminAccount", nil)
 	cdc.RegisterConcrete(&MsgTransferOwnership{}, "florin/blacklist/TransferOwnership", nil)
 	cdc.RegisterConcrete(&MsgUnban{}, "florin/blacklist/Unban", nil)
+	cdc.RegisterConcrete(&MsgUnbanBatch{}, "florin/blacklist/UnbanBatch", nil)
 }
 
 func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgAcceptOwnership{})
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgAddAdminAccount{})
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgBan{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgBanBatch{})
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgRemoveAdminAccount{})
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgTransferOwnership{})
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgUnban{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgUnbanBatch{})
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -25,4 +25,9 @@ var (
 	ErrNoPendingOwner      = errors.Register(Codespace, 4, "there is no blacklist pending owner")
 	ErrInvalidPendingOwner = errors.Register(Codespace, 5, "signer is not blacklist pending owner")
 	ErrInvalidAdmin        = errors.Register(Codespace, 6, "signer is not a blacklist admin")
+	ErrInvalidExpiry       = errors.Register(Codespace, 7, "invalid ban expiry")
+	ErrInvalidBanRecord    = errors.Register(Codespace, 8, "invalid ban record")
+	ErrInvalidBatch        = errors.Register(Codespace, 9, "invalid batch")
+	ErrInvalidAddress      = errors.Register(Codespace, 10, "invalid address")
+	ErrMalformedDenom      = errors.Register(Codespace, 11, "malformed denom")
 )
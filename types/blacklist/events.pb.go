@@ -36,6 +36,10 @@ type Decision struct {
 	Amount cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
 	// valid is true if transfer approved, false if rejected.
 	Valid bool `protobuf:"varint,4,opt,name=valid,proto3" json:"valid,omitempty"`
+	// sender_blocked is true if the sender is an adversary.
+	SenderBlocked bool `protobuf:"varint,5,opt,name=sender_blocked,json=senderBlocked,proto3" json:"sender_blocked,omitempty"`
+	// recipient_blocked is true if the recipient is an adversary.
+	RecipientBlocked bool `protobuf:"varint,6,opt,name=recipient_blocked,json=recipientBlocked,proto3" json:"recipient_blocked,omitempty"`
 }
 
 func (m *Decision) Reset()         { *m = Decision{} }
@@ -92,10 +96,34 @@ func (m *Decision) GetValid() bool {
 	return false
 }
 
+func (m *Decision) GetSenderBlocked() bool {
+	if m != nil {
+		return m.SenderBlocked
+	}
+	return false
+}
+
+func (m *Decision) GetRecipientBlocked() bool {
+	if m != nil {
+		return m.RecipientBlocked
+	}
+	return false
+}
+
 // Emitted when an address is added to the blacklist.
 type Ban struct {
 	// adversary is the address that was added.
 	Adversary string `protobuf:"bytes,1,opt,name=adversary,proto3" json:"adversary,omitempty"`
+	// denom is the denom the ban is scoped to, empty meaning global.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// expiry_height is the block height the ban expires at, zero meaning never.
+	ExpiryHeight int64 `protobuf:"varint,3,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty"`
+	// expiry_time is the unix time the ban expires at, zero meaning never.
+	ExpiryTime int64 `protobuf:"varint,4,opt,name=expiry_time,json=expiryTime,proto3" json:"expiry_time,omitempty"`
+	// reason is the reason the address was banned for.
+	Reason BanReason `protobuf:"varint,5,opt,name=reason,proto3,enum=florin.blacklist.v1.BanReason" json:"reason,omitempty"`
+	// case_id is the external case the ban relates to.
+	CaseId string `protobuf:"bytes,6,opt,name=case_id,json=caseId,proto3" json:"case_id,omitempty"`
 }
 
 func (m *Ban) Reset()         { *m = Ban{} }
@@ -138,17 +166,109 @@ func (m *Ban) GetAdversary() string {
 	return ""
 }
 
+func (m *Ban) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *Ban) GetExpiryHeight() int64 {
+	if m != nil {
+		return m.ExpiryHeight
+	}
+	return 0
+}
+
+func (m *Ban) GetExpiryTime() int64 {
+	if m != nil {
+		return m.ExpiryTime
+	}
+	return 0
+}
+
+func (m *Ban) GetReason() BanReason {
+	if m != nil {
+		return m.Reason
+	}
+	return BanReasonUnspecified
+}
+
+func (m *Ban) GetCaseId() string {
+	if m != nil {
+		return m.CaseId
+	}
+	return ""
+}
+
+// Emitted when a time-bounded ban expires and is pruned.
+type BanExpired struct {
+	// adversary is the address whose ban expired.
+	Adversary string `protobuf:"bytes,1,opt,name=adversary,proto3" json:"adversary,omitempty"`
+	// denom is the denom the ban was scoped to, empty meaning global.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *BanExpired) Reset()         { *m = BanExpired{} }
+func (m *BanExpired) String() string { return proto.CompactTextString(m) }
+func (*BanExpired) ProtoMessage()    {}
+func (*BanExpired) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d94ec933c59aa91, []int{2}
+}
+func (m *BanExpired) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BanExpired) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BanExpired.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BanExpired) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BanExpired.Merge(m, src)
+}
+func (m *BanExpired) XXX_Size() int {
+	return m.Size()
+}
+func (m *BanExpired) XXX_DiscardUnknown() {
+	xxx_messageInfo_BanExpired.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BanExpired proto.InternalMessageInfo
+
+func (m *BanExpired) GetAdversary() string {
+	if m != nil {
+		return m.Adversary
+	}
+	return ""
+}
+
+func (m *BanExpired) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 // Emitted when an address is removed from the blacklist.
 type Unban struct {
 	// friend is the address that was removed.
 	Friend string `protobuf:"bytes,1,opt,name=friend,proto3" json:"friend,omitempty"`
+	// denom is the denom the ban is scoped to, empty meaning global.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
 func (m *Unban) Reset()         { *m = Unban{} }
 func (m *Unban) String() string { return proto.CompactTextString(m) }
 func (*Unban) ProtoMessage()    {}
 func (*Unban) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0d94ec933c59aa91, []int{2}
+	return fileDescriptor_0d94ec933c59aa91, []int{3}
 }
 func (m *Unban) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -184,17 +304,26 @@ func (m *Unban) GetFriend() string {
 	return ""
 }
 
+func (m *Unban) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 // Emitted when admin account is added.
 type AdminAccountAdded struct {
 	// account is the address that was added.
 	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// denom is the denom the admin is scoped to, empty meaning global.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
 func (m *AdminAccountAdded) Reset()         { *m = AdminAccountAdded{} }
 func (m *AdminAccountAdded) String() string { return proto.CompactTextString(m) }
 func (*AdminAccountAdded) ProtoMessage()    {}
 func (*AdminAccountAdded) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0d94ec933c59aa91, []int{3}
+	return fileDescriptor_0d94ec933c59aa91, []int{4}
 }
 func (m *AdminAccountAdded) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -230,17 +359,26 @@ func (m *AdminAccountAdded) GetAccount() string {
 	return ""
 }
 
+func (m *AdminAccountAdded) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 // Emitted when admin account is removed.
 type AdminAccountRemoved struct {
 	// account is the address that was removed.
 	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// denom is the denom the admin is scoped to, empty meaning global.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
 func (m *AdminAccountRemoved) Reset()         { *m = AdminAccountRemoved{} }
 func (m *AdminAccountRemoved) String() string { return proto.CompactTextString(m) }
 func (*AdminAccountRemoved) ProtoMessage()    {}
 func (*AdminAccountRemoved) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0d94ec933c59aa91, []int{4}
+	return fileDescriptor_0d94ec933c59aa91, []int{5}
 }
 func (m *AdminAccountRemoved) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -276,6 +414,13 @@ func (m *AdminAccountRemoved) GetAccount() string {
 	return ""
 }
 
+func (m *AdminAccountRemoved) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 // Emitted when an ownership transfer is started.
 type OwnershipTransferStarted struct {
 	// previous_owner is the address of the previous owner.
@@ -288,7 +433,7 @@ func (m *OwnershipTransferStarted) Reset()         { *m = OwnershipTransferStart
 func (m *OwnershipTransferStarted) String() string { return proto.CompactTextString(m) }
 func (*OwnershipTransferStarted) ProtoMessage()    {}
 func (*OwnershipTransferStarted) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0d94ec933c59aa91, []int{5}
+	return fileDescriptor_0d94ec933c59aa91, []int{6}
 }
 func (m *OwnershipTransferStarted) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -343,7 +488,7 @@ func (m *OwnershipTransferred) Reset()         { *m = OwnershipTransferred{} }
 func (m *OwnershipTransferred) String() string { return proto.CompactTextString(m) }
 func (*OwnershipTransferred) ProtoMessage()    {}
 func (*OwnershipTransferred) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0d94ec933c59aa91, []int{6}
+	return fileDescriptor_0d94ec933c59aa91, []int{7}
 }
 func (m *OwnershipTransferred) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -389,6 +534,7 @@ func (m *OwnershipTransferred) GetNewOwner() string {
 func init() {
 	proto.RegisterType((*Decision)(nil), "florin.blacklist.v1.Decision")
 	proto.RegisterType((*Ban)(nil), "florin.blacklist.v1.Ban")
+	proto.RegisterType((*BanExpired)(nil), "florin.blacklist.v1.BanExpired")
 	proto.RegisterType((*Unban)(nil), "florin.blacklist.v1.Unban")
 	proto.RegisterType((*AdminAccountAdded)(nil), "florin.blacklist.v1.AdminAccountAdded")
 	proto.RegisterType((*AdminAccountRemoved)(nil), "florin.blacklist.v1.AdminAccountRemoved")
@@ -399,35 +545,45 @@ func init() {
 func init() { proto.RegisterFile("florin/blacklist/v1/events.proto", fileDescriptor_0d94ec933c59aa91) }
 
 var fileDescriptor_0d94ec933c59aa91 = []byte{
-	// 445 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x92, 0xcf, 0x6a, 0x13, 0x51,
-	0x14, 0xc6, 0x33, 0x69, 0x1b, 0x93, 0x03, 0x16, 0x7a, 0x1b, 0x65, 0xac, 0x32, 0x09, 0x23, 0x42,
-	0x11, 0x32, 0xd7, 0xda, 0x27, 0x48, 0x70, 0x61, 0x17, 0x22, 0x44, 0xdd, 0x74, 0x61, 0xb9, 0x33,
-	0xf7, 0x26, 0xb9, 0x74, 0xe6, 0x9c, 0x70, 0xef, 0x9d, 0x09, 0x7d, 0x09, 0xf1, 0x31, 0x5c, 0xba,
-	0xf0, 0x21, 0xba, 0x2c, 0xae, 0xc4, 0x45, 0x91, 0x64, 0xe1, 0x6b, 0xc8, 0xfc, 0xa9, 0x15, 0x04,
-	0x57, 0x6e, 0x86, 0xf3, 0x7d, 0xf7, 0xf7, 0x9d, 0x73, 0x18, 0x0e, 0x0c, 0x67, 0x29, 0x19, 0x8d,
-	0x3c, 0x4e, 0x45, 0x72, 0x9e, 0x6a, 0xeb, 0x78, 0x71, 0xc4, 0x55, 0xa1, 0xd0, 0xd9, 0x68, 0x69,
-	0xc8, 0x11, 0xdb, 0xaf, 0x89, 0xe8, 0x37, 0x11, 0x15, 0x47, 0x07, 0x7b, 0x22, 0xd3, 0x48, 0xbc,
-	0xfa, 0xd6, 0xdc, 0xc1, 0x83, 0x84, 0x6c, 0x46, 0xf6, 0xac, 0x52, 0xbc, 0x16, 0xcd, 0x53, 0x7f,
-	0x4e, 0x73, 0xaa, 0xfd, 0xb2, 0xaa, 0xdd, 0xf0, 0x83, 0x07, 0xdd, 0x17, 0x2a, 0xd1, 0x56, 0x13,
-	0x32, 0x06, 0xdb, 0x33, 0x43, 0x99, 0xef, 0x0d, 0xbd, 0xc3, 0xde, 0xb4, 0xaa, 0xd9, 0x2e, 0xb4,
-	0x1d, 0xf9, 0xed, 0xca, 0x69, 0x3b, 0x62, 0x2f, 0xa1, 0x23, 0x32, 0xca, 0xd1, 0xf9, 0x5b, 0xa5,
-	0x37, 0x79, 0x76, 0x79, 0x3d, 0x68, 0x7d, 0xbf, 0x1e, 0xdc, 0xab, 0x87, 0x59, 0x79, 0x1e, 0x69,
-	0xe2, 0x99, 0x70, 0x8b, 0xe8, 0x04, 0xdd, 0xd7, 0x2f, 0x23, 0x68, 0xb6, 0x38, 0x41, 0xf7, 0xe9,
-	0xe7, 0xe7, 0xa7, 0xde, 0xb4, 0xc9, 0xb3, 0x3e, 0xec, 0x14, 0x22, 0xd5, 0xd2, 0xdf, 0x1e, 0x7a,
-	0x87, 0xdd, 0x69, 0x2d, 0xc2, 0xc7, 0xb0, 0x35, 0x11, 0xc8, 0x1e, 0x41, 0x4f, 0xc8, 0x42, 0x19,
-	0x2b, 0xcc, 0x45, 0xb3, 0xcf, 0xad, 0x11, 0x0e, 0x60, 0xe7, 0x1d, 0xc6, 0x02, 0xd9, 0x7d, 0xe8,
-	0xcc, 0x8c, 0x56, 0x28, 0x1b, 0xa6, 0x51, 0xe1, 0x08, 0xf6, 0xc6, 0x32, 0xd3, 0x38, 0x4e, 0x92,
-	0x72, 0xd6, 0x58, 0x4a, 0x25, 0x99, 0x0f, 0x77, 0x44, 0xad, 0x1b, 0xfa, 0x46, 0x86, 0x1c, 0xf6,
-	0xff, 0xc4, 0xa7, 0x2a, 0xa3, 0xe2, 0x9f, 0x81, 0xf7, 0xe0, 0xbf, 0x5e, 0xa1, 0x32, 0x76, 0xa1,
-	0x97, 0x6f, 0x8d, 0x40, 0x3b, 0x53, 0xe6, 0x8d, 0x13, 0xc6, 0x29, 0xc9, 0x9e, 0xc0, 0xee, 0xd2,
-	0xa8, 0x42, 0x53, 0x6e, 0xcf, 0xa8, 0x84, 0x9a, 0xf0, 0xdd, 0x1b, 0xb7, 0x4a, 0xb2, 0x87, 0xd0,
-	0x43, 0xb5, 0x6a, 0x88, 0xfa, 0xff, 0x76, 0x51, 0xad, 0xaa, 0xc7, 0xf0, 0x14, 0xfa, 0x7f, 0xf5,
-	0x37, 0xff, 0xa7, 0xf7, 0xe4, 0xd5, 0xe5, 0x3a, 0xf0, 0xae, 0xd6, 0x81, 0xf7, 0x63, 0x1d, 0x78,
-	0x1f, 0x37, 0x41, 0xeb, 0x6a, 0x13, 0xb4, 0xbe, 0x6d, 0x82, 0xd6, 0xe9, 0xf1, 0x5c, 0xbb, 0x45,
-	0x1e, 0x47, 0x09, 0x65, 0x3c, 0x23, 0x54, 0x46, 0xe7, 0x65, 0x21, 0xf3, 0x54, 0x8d, 0x90, 0xe2,
-	0x54, 0xf1, 0xe2, 0x39, 0x77, 0x17, 0x4b, 0x65, 0x6f, 0x4f, 0x35, 0xee, 0x54, 0x87, 0x74, 0xfc,
-	0x2b, 0x00, 0x00, 0xff, 0xff, 0xd4, 0x2a, 0x03, 0xab, 0xc5, 0x02, 0x00, 0x00,
+	// 595 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x53, 0x41, 0x4f, 0x14, 0x4d,
+	0x10, 0xdd, 0x61, 0x61, 0x61, 0xeb, 0xfb, 0x20, 0xd2, 0xa0, 0x8e, 0x68, 0x06, 0x5c, 0x63, 0x42,
+	0x34, 0xcc, 0x08, 0x44, 0xcf, 0xb2, 0x4a, 0x02, 0x07, 0x63, 0x32, 0xe2, 0x85, 0x83, 0x9b, 0xde,
+	0xe9, 0xda, 0xdd, 0x0e, 0x33, 0xdd, 0x9b, 0xee, 0xde, 0x41, 0xfe, 0x85, 0x3f, 0xc3, 0xa3, 0x07,
+	0x7f, 0x04, 0x47, 0xe2, 0xc9, 0x78, 0x20, 0x66, 0x39, 0xf8, 0x37, 0xcc, 0x74, 0x37, 0x60, 0x22,
+	0x5c, 0x88, 0x97, 0x49, 0xbd, 0x57, 0xaf, 0x5e, 0xd7, 0x4b, 0x4f, 0xc3, 0x4a, 0x2f, 0x97, 0x8a,
+	0x8b, 0xa4, 0x9b, 0xd3, 0xec, 0x20, 0xe7, 0xda, 0x24, 0xe5, 0x7a, 0x82, 0x25, 0x0a, 0xa3, 0xe3,
+	0xa1, 0x92, 0x46, 0x92, 0x05, 0xa7, 0x88, 0x2f, 0x14, 0x71, 0xb9, 0xbe, 0x34, 0x4f, 0x0b, 0x2e,
+	0x64, 0x62, 0xbf, 0x4e, 0xb7, 0x74, 0x2f, 0x93, 0xba, 0x90, 0xba, 0x63, 0x51, 0xe2, 0x80, 0x6f,
+	0x3d, 0xbc, 0xea, 0x90, 0x3e, 0x0a, 0xd4, 0xfc, 0x5c, 0xb2, 0xd8, 0x97, 0x7d, 0xe9, 0x46, 0xab,
+	0xca, 0xb1, 0xad, 0x71, 0x00, 0x33, 0xaf, 0x31, 0xe3, 0x9a, 0x4b, 0x41, 0x08, 0x4c, 0xf6, 0x94,
+	0x2c, 0xc2, 0x60, 0x25, 0x58, 0x6d, 0xa6, 0xb6, 0x26, 0x73, 0x30, 0x61, 0x64, 0x38, 0x61, 0x99,
+	0x09, 0x23, 0xc9, 0x0e, 0x34, 0x68, 0x21, 0x47, 0xc2, 0x84, 0xf5, 0x8a, 0x6b, 0x3f, 0x3b, 0x3e,
+	0x5d, 0xae, 0xfd, 0x38, 0x5d, 0xbe, 0xed, 0xf6, 0xd1, 0xec, 0x20, 0xe6, 0x32, 0x29, 0xa8, 0x19,
+	0xc4, 0xbb, 0xc2, 0x7c, 0xfb, 0xba, 0x06, 0x7e, 0xd1, 0x5d, 0x61, 0x3e, 0xff, 0xfa, 0xf2, 0x24,
+	0x48, 0xfd, 0x3c, 0x59, 0x84, 0xa9, 0x92, 0xe6, 0x9c, 0x85, 0x93, 0x2b, 0xc1, 0xea, 0x4c, 0xea,
+	0x00, 0x79, 0x0c, 0x73, 0x1a, 0x05, 0x43, 0xd5, 0xe9, 0xe6, 0x32, 0x3b, 0x40, 0x16, 0x4e, 0xd9,
+	0xf6, 0xac, 0x63, 0xdb, 0x8e, 0x24, 0x4f, 0x61, 0x5e, 0x61, 0xc6, 0x87, 0x1c, 0x85, 0xb9, 0x50,
+	0x36, 0xac, 0xf2, 0xd6, 0x45, 0xc3, 0x8b, 0x5b, 0x27, 0x01, 0xd4, 0xdb, 0x54, 0x90, 0x07, 0xd0,
+	0xa4, 0xac, 0x44, 0xa5, 0xa9, 0x3a, 0xf2, 0x21, 0x2f, 0x89, 0x6a, 0x1f, 0x86, 0x42, 0x16, 0x3e,
+	0xac, 0x03, 0xe4, 0x11, 0xcc, 0xe2, 0xc7, 0x21, 0x57, 0x47, 0x9d, 0x01, 0xf2, 0xfe, 0xc0, 0xc5,
+	0xae, 0xa7, 0xff, 0x3b, 0x72, 0xc7, 0x72, 0x64, 0x19, 0xfe, 0xf3, 0x22, 0xc3, 0x0b, 0xb4, 0x81,
+	0xea, 0x29, 0x38, 0x6a, 0x8f, 0x17, 0x48, 0x5e, 0x40, 0x43, 0x21, 0xd5, 0x52, 0xd8, 0x34, 0x73,
+	0x1b, 0x51, 0x7c, 0xc5, 0x9d, 0xc7, 0x6d, 0x2a, 0x52, 0xab, 0x4a, 0xbd, 0x9a, 0xdc, 0x85, 0xe9,
+	0x8c, 0x6a, 0xec, 0x70, 0x17, 0xae, 0x99, 0x36, 0x2a, 0xb8, 0xcb, 0x5a, 0x2f, 0x01, 0xda, 0x54,
+	0x6c, 0x57, 0x27, 0x20, 0xbb, 0x49, 0xb0, 0xd6, 0x73, 0x98, 0x7a, 0x2f, 0xba, 0x54, 0x90, 0x3b,
+	0xd0, 0xe8, 0x29, 0x8e, 0x82, 0xf9, 0x49, 0x8f, 0xae, 0x19, 0x7b, 0x05, 0xf3, 0x5b, 0xac, 0xe0,
+	0x62, 0x2b, 0xcb, 0xaa, 0x5b, 0xdc, 0x62, 0x0c, 0x19, 0x09, 0x61, 0x9a, 0x3a, 0xec, 0x3d, 0xce,
+	0xe1, 0x35, 0x26, 0xdb, 0xb0, 0xf0, 0xa7, 0x49, 0x8a, 0x85, 0x2c, 0x6f, 0x60, 0xf3, 0x01, 0xc2,
+	0xb7, 0x87, 0x02, 0x95, 0x1e, 0xf0, 0xe1, 0x9e, 0xa2, 0x42, 0xf7, 0x50, 0xbd, 0x33, 0x54, 0x19,
+	0xb4, 0xff, 0xd1, 0x50, 0x61, 0xc9, 0xe5, 0x48, 0x77, 0x64, 0x25, 0xf2, 0x96, 0xb3, 0xe7, 0xac,
+	0x9d, 0x24, 0xf7, 0xa1, 0x29, 0xf0, 0xd0, 0x2b, 0x9c, 0xf9, 0x8c, 0xc0, 0x43, 0xdb, 0x6c, 0xed,
+	0xc3, 0xe2, 0x5f, 0xfe, 0xea, 0xdf, 0x78, 0xb7, 0xdf, 0x1c, 0x8f, 0xa3, 0xe0, 0x64, 0x1c, 0x05,
+	0x3f, 0xc7, 0x51, 0xf0, 0xe9, 0x2c, 0xaa, 0x9d, 0x9c, 0x45, 0xb5, 0xef, 0x67, 0x51, 0x6d, 0x7f,
+	0xb3, 0xcf, 0xcd, 0x60, 0xd4, 0x8d, 0x33, 0x59, 0x24, 0x85, 0x14, 0xa8, 0xf8, 0xa8, 0x2a, 0xd8,
+	0x28, 0xc7, 0x35, 0x21, 0xbb, 0x39, 0x26, 0xe5, 0x46, 0x62, 0x8e, 0x86, 0xa8, 0x2f, 0x9f, 0x7b,
+	0xb7, 0x61, 0x9f, 0xf3, 0xe6, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0x6a, 0xd1, 0x96, 0x8d, 0x6e,
+	0x04, 0x00, 0x00,
 }
 
 func (m *Decision) Marshal() (dAtA []byte, err error) {
@@ -450,6 +606,26 @@ func (m *Decision) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RecipientBlocked {
+		i--
+		if m.RecipientBlocked {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.SenderBlocked {
+		i--
+		if m.SenderBlocked {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.Valid {
 		i--
 		if m.Valid {
@@ -507,6 +683,72 @@ func (m *Ban) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.CaseId) > 0 {
+		i -= len(m.CaseId)
+		copy(dAtA[i:], m.CaseId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.CaseId)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Reason != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Reason))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ExpiryTime != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ExpiryTime))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Adversary) > 0 {
+		i -= len(m.Adversary)
+		copy(dAtA[i:], m.Adversary)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Adversary)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BanExpired) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BanExpired) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BanExpired) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Adversary) > 0 {
 		i -= len(m.Adversary)
 		copy(dAtA[i:], m.Adversary)
@@ -537,6 +779,13 @@ func (m *Unban) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Friend) > 0 {
 		i -= len(m.Friend)
 		copy(dAtA[i:], m.Friend)
@@ -567,6 +816,13 @@ func (m *AdminAccountAdded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Account) > 0 {
 		i -= len(m.Account)
 		copy(dAtA[i:], m.Account)
@@ -597,6 +853,13 @@ func (m *AdminAccountRemoved) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Account) > 0 {
 		i -= len(m.Account)
 		copy(dAtA[i:], m.Account)
@@ -711,6 +974,12 @@ func (m *Decision) Size() (n int) {
 	if m.Valid {
 		n += 2
 	}
+	if m.SenderBlocked {
+		n += 2
+	}
+	if m.RecipientBlocked {
+		n += 2
+	}
 	return n
 }
 
@@ -724,6 +993,40 @@ func (m *Ban) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovEvents(uint64(m.ExpiryHeight))
+	}
+	if m.ExpiryTime != 0 {
+		n += 1 + sovEvents(uint64(m.ExpiryTime))
+	}
+	if m.Reason != 0 {
+		n += 1 + sovEvents(uint64(m.Reason))
+	}
+	l = len(m.CaseId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *BanExpired) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Adversary)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
 	return n
 }
 
@@ -737,6 +1040,10 @@ func (m *Unban) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
 	return n
 }
 
@@ -750,6 +1057,10 @@ func (m *AdminAccountAdded) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
 	return n
 }
 
@@ -763,6 +1074,10 @@ func (m *AdminAccountRemoved) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
 	return n
 }
 
@@ -953,6 +1268,46 @@ func (m *Decision) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Valid = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SenderBlocked", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SenderBlocked = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipientBlocked", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RecipientBlocked = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -1035,19 +1390,254 @@ func (m *Ban) Unmarshal(dAtA []byte) error {
 			}
 			m.Adversary = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvents(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEvents
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
 			}
-			iNdEx += skippy
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryTime", wireType)
+			}
+			m.ExpiryTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			m.Reason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reason |= BanReason(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CaseId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CaseId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BanExpired) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BanExpired: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BanExpired: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Adversary", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Adversary = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
 
@@ -1117,6 +1707,38 @@ func (m *Unban) Unmarshal(dAtA []byte) error {
 			}
 			m.Friend = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -1199,6 +1821,38 @@ func (m *AdminAccountAdded) Unmarshal(dAtA []byte) error {
 			}
 			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -1281,6 +1935,38 @@ func (m *AdminAccountRemoved) Unmarshal(dAtA []byte) error {
 			}
 			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -16,6 +16,7 @@ package blacklist
 
 import (
 	"fmt"
+	"slices"
 
 	"cosmossdk.io/core/address"
 )
@@ -49,5 +50,35 @@ func (gs *GenesisState) Validate(cdc address.Codec) error {
 		}
 	}
 
+	for _, admin := range gs.DenomAdmins {
+		if admin.Denom == "" {
+			return fmt.Errorf("missing denom for admin address (%s)", admin.Address)
+		}
+		if _, err := cdc.StringToBytes(admin.Address); err != nil {
+			return fmt.Errorf("invalid admin address (%s) for denom %s: %s", admin.Address, admin.Denom, err)
+		}
+	}
+
+	for _, adversary := range gs.DenomAdversaries {
+		if adversary.Denom == "" {
+			return fmt.Errorf("missing denom for adversary address (%s)", adversary.Address)
+		}
+		if _, err := cdc.StringToBytes(adversary.Address); err != nil {
+			return fmt.Errorf("invalid adversary address (%s) for denom %s: %s", adversary.Address, adversary.Denom, err)
+		}
+	}
+
+	for _, record := range gs.BanRecords {
+		if record.Denom == "" && !slices.Contains(gs.Adversaries, record.Address) {
+			return fmt.Errorf("found a ban record for a non adversary address (%s)", record.Address)
+		}
+		if record.Denom != "" && !slices.Contains(gs.DenomAdversaries, Account{Denom: record.Denom, Address: record.Address}) {
+			return fmt.Errorf("found a ban record for a non adversary address (%s) for denom %s", record.Address, record.Denom)
+		}
+		if err := record.Validate(); err != nil {
+			return fmt.Errorf("invalid ban record for address (%s): %s", record.Address, err)
+		}
+	}
+
 	return nil
 }
@@ -5,6 +5,7 @@ package blacklist
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -22,11 +23,49 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// BanReason enumerates why an address was banned.
+type BanReason int32
+
+const (
+	BanReasonUnspecified BanReason = 0
+	BanReasonSanctions   BanReason = 1
+	BanReasonCourtOrder  BanReason = 2
+	BanReasonFraud       BanReason = 3
+	BanReasonOther       BanReason = 4
+)
+
+var BanReason_name = map[int32]string{
+	0: "BAN_REASON_UNSPECIFIED",
+	1: "BAN_REASON_SANCTIONS",
+	2: "BAN_REASON_COURT_ORDER",
+	3: "BAN_REASON_FRAUD",
+	4: "BAN_REASON_OTHER",
+}
+
+var BanReason_value = map[string]int32{
+	"BAN_REASON_UNSPECIFIED": 0,
+	"BAN_REASON_SANCTIONS":   1,
+	"BAN_REASON_COURT_ORDER": 2,
+	"BAN_REASON_FRAUD":       3,
+	"BAN_REASON_OTHER":       4,
+}
+
+func (x BanReason) String() string {
+	return proto.EnumName(BanReason_name, int32(x))
+}
+
+func (BanReason) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f721fa633e11416a, []int{0}
+}
+
 type GenesisState struct {
-	Owner        string   `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
-	PendingOwner string   `protobuf:"bytes,2,opt,name=pending_owner,json=pendingOwner,proto3" json:"pending_owner,omitempty"`
-	Admins       []string `protobuf:"bytes,3,rep,name=admins,proto3" json:"admins,omitempty"`
-	Adversaries  []string `protobuf:"bytes,4,rep,name=adversaries,proto3" json:"adversaries,omitempty"`
+	Owner            string      `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	PendingOwner     string      `protobuf:"bytes,2,opt,name=pending_owner,json=pendingOwner,proto3" json:"pending_owner,omitempty"`
+	Admins           []string    `protobuf:"bytes,3,rep,name=admins,proto3" json:"admins,omitempty"`
+	Adversaries      []string    `protobuf:"bytes,4,rep,name=adversaries,proto3" json:"adversaries,omitempty"`
+	DenomAdmins      []Account   `protobuf:"bytes,5,rep,name=denom_admins,json=denomAdmins,proto3" json:"denom_admins"`
+	DenomAdversaries []Account   `protobuf:"bytes,6,rep,name=denom_adversaries,json=denomAdversaries,proto3" json:"denom_adversaries"`
+	BanRecords       []BanRecord `protobuf:"bytes,7,rep,name=ban_records,json=banRecords,proto3" json:"ban_records"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -90,29 +129,215 @@ func (m *GenesisState) GetAdversaries() []string {
 	return nil
 }
 
+func (m *GenesisState) GetDenomAdmins() []Account {
+	if m != nil {
+		return m.DenomAdmins
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDenomAdversaries() []Account {
+	if m != nil {
+		return m.DenomAdversaries
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBanRecords() []BanRecord {
+	if m != nil {
+		return m.BanRecords
+	}
+	return nil
+}
+
+type Account struct {
+	Denom   string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *Account) Reset()         { *m = Account{} }
+func (m *Account) String() string { return proto.CompactTextString(m) }
+func (*Account) ProtoMessage()    {}
+func (*Account) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f721fa633e11416a, []int{1}
+}
+func (m *Account) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Account) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Account.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Account) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Account.Merge(m, src)
+}
+func (m *Account) XXX_Size() int {
+	return m.Size()
+}
+func (m *Account) XXX_DiscardUnknown() {
+	xxx_messageInfo_Account.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Account proto.InternalMessageInfo
+
+func (m *Account) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *Account) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// BanRecord holds the optional details of a ban, such as the point at which
+// a time-bounded ban is lifted and why it was imposed. The denom is empty for
+// global bans.
+type BanRecord struct {
+	Denom        string    `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Address      string    `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	ExpiryHeight int64     `protobuf:"varint,3,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty"`
+	ExpiryTime   int64     `protobuf:"varint,4,opt,name=expiry_time,json=expiryTime,proto3" json:"expiry_time,omitempty"`
+	Reason       BanReason `protobuf:"varint,5,opt,name=reason,proto3,enum=florin.blacklist.v1.BanReason" json:"reason,omitempty"`
+	CaseId       string    `protobuf:"bytes,6,opt,name=case_id,json=caseId,proto3" json:"case_id,omitempty"`
+}
+
+func (m *BanRecord) Reset()         { *m = BanRecord{} }
+func (m *BanRecord) String() string { return proto.CompactTextString(m) }
+func (*BanRecord) ProtoMessage()    {}
+func (*BanRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f721fa633e11416a, []int{2}
+}
+func (m *BanRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BanRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BanRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BanRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BanRecord.Merge(m, src)
+}
+func (m *BanRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *BanRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_BanRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BanRecord proto.InternalMessageInfo
+
+func (m *BanRecord) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *BanRecord) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *BanRecord) GetExpiryHeight() int64 {
+	if m != nil {
+		return m.ExpiryHeight
+	}
+	return 0
+}
+
+func (m *BanRecord) GetExpiryTime() int64 {
+	if m != nil {
+		return m.ExpiryTime
+	}
+	return 0
+}
+
+func (m *BanRecord) GetReason() BanReason {
+	if m != nil {
+		return m.Reason
+	}
+	return BanReasonUnspecified
+}
+
+func (m *BanRecord) GetCaseId() string {
+	if m != nil {
+		return m.CaseId
+	}
+	return ""
+}
+
 func init() {
+	proto.RegisterEnum("florin.blacklist.v1.BanReason", BanReason_name, BanReason_value)
 	proto.RegisterType((*GenesisState)(nil), "florin.blacklist.v1.GenesisState")
+	proto.RegisterType((*Account)(nil), "florin.blacklist.v1.Account")
+	proto.RegisterType((*BanRecord)(nil), "florin.blacklist.v1.BanRecord")
 }
 
 func init() { proto.RegisterFile("florin/blacklist/v1/genesis.proto", fileDescriptor_f721fa633e11416a) }
 
 var fileDescriptor_f721fa633e11416a = []byte{
-	// 237 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4c, 0xcb, 0xc9, 0x2f,
-	0xca, 0xcc, 0xd3, 0x4f, 0xca, 0x49, 0x4c, 0xce, 0xce, 0xc9, 0x2c, 0x2e, 0xd1, 0x2f, 0x33, 0xd4,
-	0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x86,
-	0x28, 0xd1, 0x83, 0x2b, 0xd1, 0x2b, 0x33, 0x54, 0x6a, 0x66, 0xe4, 0xe2, 0x71, 0x87, 0x28, 0x0b,
-	0x2e, 0x49, 0x2c, 0x49, 0x15, 0x12, 0xe1, 0x62, 0xcd, 0x2f, 0xcf, 0x4b, 0x2d, 0x92, 0x60, 0x54,
-	0x60, 0xd4, 0xe0, 0x0c, 0x82, 0x70, 0x84, 0x94, 0xb9, 0x78, 0x0b, 0x52, 0xf3, 0x52, 0x32, 0xf3,
-	0xd2, 0xe3, 0x21, 0xb2, 0x4c, 0x60, 0x59, 0x1e, 0xa8, 0xa0, 0x3f, 0x58, 0x91, 0x18, 0x17, 0x5b,
-	0x62, 0x4a, 0x6e, 0x66, 0x5e, 0xb1, 0x04, 0xb3, 0x02, 0xb3, 0x06, 0x67, 0x10, 0x94, 0x27, 0xa4,
-	0xc0, 0xc5, 0x9d, 0x98, 0x52, 0x96, 0x5a, 0x54, 0x9c, 0x58, 0x94, 0x99, 0x5a, 0x2c, 0xc1, 0x02,
-	0x96, 0x44, 0x16, 0x72, 0xf2, 0x3d, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f,
-	0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28,
-	0xe3, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0xfd, 0xdc, 0xfc, 0xbc, 0xd4,
-	0xa2, 0xcc, 0x52, 0x10, 0x23, 0xa5, 0x34, 0x27, 0x55, 0x37, 0x2f, 0x3f, 0x29, 0x27, 0x55, 0xbf,
-	0xcc, 0x48, 0xbf, 0xa4, 0xb2, 0x20, 0xb5, 0x18, 0xe1, 0xf5, 0x24, 0x36, 0xb0, 0x87, 0x8d, 0x01,
-	0x01, 0x00, 0x00, 0xff, 0xff, 0xff, 0x83, 0x7e, 0x68, 0x15, 0x01, 0x00, 0x00,
+	// 605 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xc1, 0x6e, 0xda, 0x4c,
+	0x14, 0x85, 0x31, 0x10, 0x50, 0x06, 0xfe, 0xc8, 0xff, 0x04, 0x25, 0x16, 0xaa, 0x1c, 0x37, 0xdd,
+	0xa0, 0x4a, 0xc5, 0x4d, 0x52, 0x55, 0xea, 0x12, 0x08, 0x69, 0x58, 0x14, 0x57, 0x06, 0x36, 0xdd,
+	0x58, 0xc6, 0x73, 0x03, 0xa3, 0xc2, 0x0c, 0x9a, 0x31, 0xb4, 0xd9, 0x76, 0x55, 0xb1, 0xea, 0x0b,
+	0xb0, 0xea, 0xcb, 0x64, 0x19, 0x75, 0xd5, 0x55, 0x55, 0xc1, 0x8b, 0x54, 0x8c, 0x8d, 0x43, 0xa3,
+	0xa8, 0x6a, 0x77, 0x73, 0xcf, 0xfd, 0xce, 0xb9, 0x33, 0x17, 0x61, 0xf4, 0xf8, 0x6a, 0xc4, 0x05,
+	0x65, 0x76, 0x7f, 0xe4, 0x07, 0xef, 0x47, 0x54, 0x86, 0xf6, 0xec, 0xc4, 0x1e, 0x00, 0x03, 0x49,
+	0x65, 0x75, 0x22, 0x78, 0xc8, 0xf1, 0x7e, 0x84, 0x54, 0x13, 0xa4, 0x3a, 0x3b, 0x29, 0x97, 0x06,
+	0x7c, 0xc0, 0x55, 0xdf, 0x5e, 0x9f, 0x22, 0xf4, 0x78, 0x99, 0x46, 0xc5, 0xd7, 0x91, 0xb9, 0x13,
+	0xfa, 0x21, 0xe0, 0x12, 0xda, 0xe1, 0x1f, 0x18, 0x08, 0x43, 0xb3, 0xb4, 0xca, 0xae, 0x1b, 0x15,
+	0xf8, 0x09, 0xfa, 0x6f, 0x02, 0x8c, 0x50, 0x36, 0xf0, 0xa2, 0x6e, 0x5a, 0x75, 0x8b, 0xb1, 0xe8,
+	0x28, 0xe8, 0x00, 0xe5, 0x7c, 0x32, 0xa6, 0x4c, 0x1a, 0x19, 0x2b, 0x53, 0xd9, 0x75, 0xe3, 0x0a,
+	0x5b, 0xa8, 0xe0, 0x93, 0x19, 0x08, 0xe9, 0x0b, 0x0a, 0xd2, 0xc8, 0xaa, 0xe6, 0xb6, 0x84, 0x9b,
+	0xa8, 0x48, 0x80, 0xf1, 0xb1, 0x17, 0xfb, 0x77, 0xac, 0x4c, 0xa5, 0x70, 0xfa, 0xa8, 0xfa, 0xc0,
+	0x3b, 0xaa, 0xb5, 0x20, 0xe0, 0x53, 0x16, 0xd6, 0xb3, 0x37, 0x3f, 0x8e, 0x52, 0x6e, 0x41, 0xf9,
+	0x6a, 0xd1, 0x20, 0x07, 0xfd, 0xbf, 0x89, 0xb9, 0x1b, 0x97, 0xfb, 0xeb, 0x2c, 0x3d, 0xce, 0xda,
+	0xbe, 0x57, 0xa1, 0xef, 0x33, 0x4f, 0x40, 0xc0, 0x05, 0x91, 0x46, 0x5e, 0x45, 0x99, 0x0f, 0x46,
+	0xd5, 0x7d, 0xe6, 0x2a, 0x2c, 0x0e, 0x43, 0xfd, 0x8d, 0x20, 0x8f, 0x5f, 0xa1, 0x7c, 0x3c, 0x69,
+	0xbd, 0x5e, 0x35, 0x65, 0xb3, 0x5e, 0x55, 0x60, 0x03, 0xe5, 0x7d, 0x42, 0x04, 0x48, 0x19, 0x2f,
+	0x76, 0x53, 0x1e, 0x7f, 0xd3, 0xd0, 0x6e, 0x12, 0xfd, 0xaf, 0xee, 0xf5, 0xcf, 0x06, 0x1f, 0x27,
+	0x54, 0x5c, 0x7b, 0x43, 0xa0, 0x83, 0x61, 0x68, 0x64, 0x2c, 0xad, 0x92, 0x71, 0x8b, 0x91, 0x78,
+	0xa9, 0x34, 0x7c, 0x84, 0x0a, 0x31, 0x14, 0xd2, 0x31, 0x18, 0x59, 0x85, 0xa0, 0x48, 0xea, 0xd2,
+	0x31, 0xe0, 0x97, 0x28, 0x27, 0xc0, 0x97, 0x9c, 0x19, 0x3b, 0x96, 0x56, 0xd9, 0xfb, 0xd3, 0x02,
+	0xd6, 0x94, 0x1b, 0xd3, 0xf8, 0x10, 0xe5, 0x03, 0x5f, 0x82, 0x47, 0x89, 0x91, 0x53, 0xf7, 0xca,
+	0xad, 0xcb, 0x16, 0x79, 0xfa, 0x29, 0x1d, 0x3f, 0x4a, 0x61, 0x2f, 0xd0, 0x41, 0xbd, 0xd6, 0xf6,
+	0xdc, 0x66, 0xad, 0xe3, 0xb4, 0xbd, 0x5e, 0xbb, 0xf3, 0xb6, 0xd9, 0x68, 0x5d, 0xb4, 0x9a, 0xe7,
+	0x7a, 0xaa, 0x6c, 0xcc, 0x17, 0x56, 0x29, 0x41, 0x7b, 0x4c, 0x4e, 0x20, 0xa0, 0x57, 0x14, 0x08,
+	0x7e, 0x8e, 0x4a, 0x5b, 0xae, 0x4e, 0xad, 0xdd, 0xe8, 0xb6, 0x9c, 0x76, 0x47, 0xd7, 0xca, 0x07,
+	0xf3, 0x85, 0x85, 0x13, 0x4f, 0xc7, 0x67, 0x41, 0x48, 0x39, 0x93, 0xf8, 0xec, 0xb7, 0x39, 0x0d,
+	0xa7, 0xe7, 0x76, 0x3d, 0xc7, 0x3d, 0x6f, 0xba, 0x7a, 0xba, 0x7c, 0x38, 0x5f, 0x58, 0xfb, 0x89,
+	0xa7, 0xc1, 0xa7, 0x22, 0x74, 0x04, 0x01, 0x81, 0x2b, 0x48, 0xdf, 0x32, 0x5d, 0xb8, 0xb5, 0xde,
+	0xb9, 0x9e, 0x29, 0xe3, 0xf9, 0xc2, 0xda, 0x4b, 0xf0, 0x0b, 0xe1, 0x4f, 0xc9, 0x3d, 0xd2, 0xe9,
+	0x5e, 0x36, 0x5d, 0x3d, 0x7b, 0x8f, 0x74, 0xc2, 0x21, 0x88, 0x72, 0xf6, 0xf3, 0x57, 0x33, 0x55,
+	0x7f, 0x73, 0xb3, 0x34, 0xb5, 0xdb, 0xa5, 0xa9, 0xfd, 0x5c, 0x9a, 0xda, 0x97, 0x95, 0x99, 0xba,
+	0x5d, 0x99, 0xa9, 0xef, 0x2b, 0x33, 0xf5, 0xee, 0x6c, 0x40, 0xc3, 0xe1, 0xb4, 0x5f, 0x0d, 0xf8,
+	0xd8, 0x1e, 0x73, 0x06, 0x82, 0x4e, 0xd7, 0x07, 0x32, 0x1d, 0xc1, 0x33, 0xc6, 0xfb, 0x23, 0xb0,
+	0x67, 0xa7, 0x76, 0x78, 0x3d, 0x01, 0x79, 0xf7, 0x11, 0xe8, 0xe7, 0xd4, 0xff, 0xf9, 0xec, 0x57,
+	0x00, 0x00, 0x00, 0xff, 0xff, 0x8d, 0x87, 0xb6, 0x5f, 0x1f, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -135,6 +360,48 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.BanRecords) > 0 {
+		for iNdEx := len(m.BanRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BanRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.DenomAdversaries) > 0 {
+		for iNdEx := len(m.DenomAdversaries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomAdversaries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.DenomAdmins) > 0 {
+		for iNdEx := len(m.DenomAdmins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomAdmins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.Adversaries) > 0 {
 		for iNdEx := len(m.Adversaries) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Adversaries[iNdEx])
@@ -170,6 +437,102 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Account) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Account) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Account) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BanRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BanRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BanRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CaseId) > 0 {
+		i -= len(m.CaseId)
+		copy(dAtA[i:], m.CaseId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.CaseId)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Reason != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Reason))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ExpiryTime != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ExpiryTime))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -207,6 +570,71 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.DenomAdmins) > 0 {
+		for _, e := range m.DenomAdmins {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DenomAdversaries) > 0 {
+		for _, e := range m.DenomAdversaries {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BanRecords) > 0 {
+		for _, e := range m.BanRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Account) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *BanRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.ExpiryHeight))
+	}
+	if m.ExpiryTime != 0 {
+		n += 1 + sovGenesis(uint64(m.ExpiryTime))
+	}
+	if m.Reason != 0 {
+		n += 1 + sovGenesis(uint64(m.Reason))
+	}
+	l = len(m.CaseId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -373,6 +801,425 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			}
 			m.Adversaries = append(m.Adversaries, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomAdmins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomAdmins = append(m.DenomAdmins, Account{})
+			if err := m.DenomAdmins[len(m.DenomAdmins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomAdversaries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomAdversaries = append(m.DenomAdversaries, Account{})
+			if err := m.DenomAdversaries[len(m.DenomAdversaries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BanRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BanRecords = append(m.BanRecords, BanRecord{})
+			if err := m.BanRecords[len(m.BanRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Account) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Account: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Account: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BanRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BanRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BanRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryTime", wireType)
+			}
+			m.ExpiryTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			m.Reason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reason |= BanReason(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CaseId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CaseId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
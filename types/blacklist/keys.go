@@ -21,6 +21,12 @@ var (
 	PendingOwnerKey = []byte("blacklist/pending_owner")
 	AdminPrefix     = []byte("blacklist/admin/")
 	AdversaryPrefix = []byte("blacklist/adversary/")
+
+	DenomAdminPrefix      = []byte("blacklist/denom_admin/")
+	DenomAdversaryPrefix  = []byte("blacklist/denom_adversary/")
+	BanRecordPrefix       = []byte("blacklist/ban_record/")
+	BanExpiryHeightPrefix = []byte("blacklist/ban_expiry_height/")
+	BanExpiryTimePrefix   = []byte("blacklist/ban_expiry_time/")
 )
 
 func AdminKey(address string) []byte {
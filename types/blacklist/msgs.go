@@ -0,0 +1,176 @@
+// Copyright 2024 Monerium ehf.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package blacklist
+
+import (
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/errors"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	_ sdk.HasValidateBasic = &MsgAcceptOwnership{}
+	_ sdk.HasValidateBasic = &MsgAddAdminAccount{}
+	_ sdk.HasValidateBasic = &MsgBan{}
+	_ sdk.HasValidateBasic = &MsgBanBatch{}
+	_ sdk.HasValidateBasic = &MsgRemoveAdminAccount{}
+	_ sdk.HasValidateBasic = &MsgTransferOwnership{}
+	_ sdk.HasValidateBasic = &MsgUnban{}
+	_ sdk.HasValidateBasic = &MsgUnbanBatch{}
+)
+
+// The Validate methods below perform the stateless validation of messages.
+// They are run as part of ValidateBasic, using the account address prefix of
+// the chain, and again by the message server using the keeper's address codec.
+
+func (msg *MsgAcceptOwnership) Validate(cdc address.Codec) error {
+	return validateAddress(cdc, "signer", msg.Signer)
+}
+
+func (msg *MsgAddAdminAccount) Validate(cdc address.Codec) error {
+	if err := validateDenomAndSigner(cdc, msg.Denom, msg.Signer); err != nil {
+		return err
+	}
+	return validateAddress(cdc, "account", msg.Account)
+}
+
+func (msg *MsgBan) Validate(cdc address.Codec) error {
+	if err := validateDenomAndSigner(cdc, msg.Denom, msg.Signer); err != nil {
+		return err
+	}
+	if err := validateAddress(cdc, "adversary", msg.Adversary); err != nil {
+		return err
+	}
+	return validateBan(msg.ExpiryHeight, msg.ExpiryTime, msg.Reason, msg.CaseId)
+}
+
+func (msg *MsgBanBatch) Validate(cdc address.Codec) error {
+	if err := validateDenomAndSigner(cdc, msg.Denom, msg.Signer); err != nil {
+		return err
+	}
+	if len(msg.Adversaries) == 0 {
+		return errors.Wrap(ErrInvalidBatch, "no adversaries")
+	}
+	adversaries := make(map[string]bool)
+	for _, adversary := range msg.Adversaries {
+		if adversaries[adversary] {
+			return errors.Wrapf(ErrInvalidBatch, "duplicate adversary %s", adversary)
+		}
+		adversaries[adversary] = true
+
+		if err := validateAddress(cdc, "adversary", adversary); err != nil {
+			return err
+		}
+	}
+	return validateBan(msg.ExpiryHeight, msg.ExpiryTime, msg.Reason, msg.CaseId)
+}
+
+func (msg *MsgRemoveAdminAccount) Validate(cdc address.Codec) error {
+	if err := validateDenomAndSigner(cdc, msg.Denom, msg.Signer); err != nil {
+		return err
+	}
+	return validateAddress(cdc, "account", msg.Account)
+}
+
+func (msg *MsgTransferOwnership) Validate(cdc address.Codec) error {
+	if err := validateAddress(cdc, "signer", msg.Signer); err != nil {
+		return err
+	}
+	return validateAddress(cdc, "new owner", msg.NewOwner)
+}
+
+func (msg *MsgUnban) Validate(cdc address.Codec) error {
+	if err := validateDenomAndSigner(cdc, msg.Denom, msg.Signer); err != nil {
+		return err
+	}
+	return validateAddress(cdc, "friend", msg.Friend)
+}
+
+func (msg *MsgUnbanBatch) Validate(cdc address.Codec) error {
+	if err := validateDenomAndSigner(cdc, msg.Denom, msg.Signer); err != nil {
+		return err
+	}
+	if len(msg.Friends) == 0 {
+		return errors.Wrap(ErrInvalidBatch, "no friends")
+	}
+	friends := make(map[string]bool)
+	for _, friend := range msg.Friends {
+		if friends[friend] {
+			return errors.Wrapf(ErrInvalidBatch, "duplicate friend %s", friend)
+		}
+		friends[friend] = true
+
+		if err := validateAddress(cdc, "friend", friend); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//
+
+func (msg *MsgAcceptOwnership) ValidateBasic() error { return msg.Validate(accountAddressCodec()) }
+
+func (msg *MsgAddAdminAccount) ValidateBasic() error { return msg.Validate(accountAddressCodec()) }
+
+func (msg *MsgBan) ValidateBasic() error { return msg.Validate(accountAddressCodec()) }
+
+func (msg *MsgBanBatch) ValidateBasic() error { return msg.Validate(accountAddressCodec()) }
+
+func (msg *MsgRemoveAdminAccount) ValidateBasic() error { return msg.Validate(accountAddressCodec()) }
+
+func (msg *MsgTransferOwnership) ValidateBasic() error { return msg.Validate(accountAddressCodec()) }
+
+func (msg *MsgUnban) ValidateBasic() error { return msg.Validate(accountAddressCodec()) }
+
+func (msg *MsgUnbanBatch) ValidateBasic() error { return msg.Validate(accountAddressCodec()) }
+
+//
+
+// accountAddressCodec returns the address codec of the chain's account
+// addresses, as ValidateBasic has no access to the app's address codec.
+func accountAddressCodec() address.Codec {
+	return addresscodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+}
+
+func validateAddress(cdc address.Codec, name string, address string) error {
+	if _, err := cdc.StringToBytes(address); err != nil {
+		return errors.Wrapf(ErrInvalidAddress, "invalid %s address (%s): %s", name, address, err)
+	}
+	return nil
+}
+
+// validateDenomAndSigner validates the signer and, as an empty denom scopes
+// an action to the global blacklist, the denom only if provided.
+func validateDenomAndSigner(cdc address.Codec, denom string, signer string) error {
+	if denom != "" {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return errors.Wrap(ErrMalformedDenom, err.Error())
+		}
+	}
+	return validateAddress(cdc, "signer", signer)
+}
+
+func validateBan(expiryHeight int64, expiryTime int64, reason BanReason, caseID string) error {
+	if expiryHeight < 0 || expiryTime < 0 {
+		return errors.Wrap(ErrInvalidExpiry, "expiry cannot be negative")
+	}
+	record := BanRecord{ExpiryHeight: expiryHeight, ExpiryTime: expiryTime, Reason: reason, CaseId: caseID}
+	if err := record.Validate(); err != nil {
+		return errors.Wrap(ErrInvalidBanRecord, err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,126 @@
+// Copyright 2024 Monerium ehf.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package blacklist_test
+
+import (
+	"strings"
+	"testing"
+
+	"cosmossdk.io/core/address"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/monerium/module-noble/v2/types/blacklist"
+	"github.com/monerium/module-noble/v2/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgValidate(t *testing.T) {
+	cdc := addresscodec.NewBech32Codec("noble")
+	account := utils.TestAccount()
+	valid, invalid := account.Address, account.Invalid
+	other := utils.TestAccount().Address
+
+	testCases := []struct {
+		name string
+		msg  interface{ Validate(address.Codec) error }
+		err  error
+	}{
+		{"AcceptOwnership", &blacklist.MsgAcceptOwnership{Signer: valid}, nil},
+		{"AcceptOwnership with invalid signer", &blacklist.MsgAcceptOwnership{Signer: invalid}, blacklist.ErrInvalidAddress},
+
+		{"AddAdminAccount", &blacklist.MsgAddAdminAccount{Signer: valid, Account: valid}, nil},
+		{"AddAdminAccount for denom", &blacklist.MsgAddAdminAccount{Signer: valid, Account: valid, Denom: "ueure"}, nil},
+		{"AddAdminAccount with malformed denom", &blacklist.MsgAddAdminAccount{Signer: valid, Account: valid, Denom: "!"}, blacklist.ErrMalformedDenom},
+		{"AddAdminAccount with invalid account", &blacklist.MsgAddAdminAccount{Signer: valid, Account: invalid}, blacklist.ErrInvalidAddress},
+
+		{"Ban", &blacklist.MsgBan{Signer: valid, Adversary: valid}, nil},
+		{"Ban with details", &blacklist.MsgBan{Signer: valid, Adversary: valid, Denom: "ueure", ExpiryHeight: 1, Reason: blacklist.BanReasonFraud, CaseId: "case-1"}, nil},
+		{"Ban with invalid signer", &blacklist.MsgBan{Signer: invalid, Adversary: valid}, blacklist.ErrInvalidAddress},
+		{"Ban with invalid adversary", &blacklist.MsgBan{Signer: valid, Adversary: "adversary"}, blacklist.ErrInvalidAddress},
+		{"Ban with malformed denom", &blacklist.MsgBan{Signer: valid, Adversary: valid, Denom: "u"}, blacklist.ErrMalformedDenom},
+		{"Ban with negative expiry", &blacklist.MsgBan{Signer: valid, Adversary: valid, ExpiryTime: -1}, blacklist.ErrInvalidExpiry},
+		{"Ban with unknown reason", &blacklist.MsgBan{Signer: valid, Adversary: valid, Reason: blacklist.BanReason(99)}, blacklist.ErrInvalidBanRecord},
+		{"Ban with too long case id", &blacklist.MsgBan{Signer: valid, Adversary: valid, CaseId: strings.Repeat("a", blacklist.MaxCaseIDLength+1)}, blacklist.ErrInvalidBanRecord},
+
+		{"BanBatch", &blacklist.MsgBanBatch{Signer: valid, Adversaries: []string{valid, other}}, nil},
+		{"BanBatch without adversaries", &blacklist.MsgBanBatch{Signer: valid}, blacklist.ErrInvalidBatch},
+		{"BanBatch with duplicate adversary", &blacklist.MsgBanBatch{Signer: valid, Adversaries: []string{valid, valid}}, blacklist.ErrInvalidBatch},
+		{"BanBatch with invalid adversary", &blacklist.MsgBanBatch{Signer: valid, Adversaries: []string{valid, invalid}}, blacklist.ErrInvalidAddress},
+		{"BanBatch with negative expiry", &blacklist.MsgBanBatch{Signer: valid, Adversaries: []string{valid}, ExpiryHeight: -1}, blacklist.ErrInvalidExpiry},
+
+		{"RemoveAdminAccount", &blacklist.MsgRemoveAdminAccount{Signer: valid, Account: valid}, nil},
+		{"RemoveAdminAccount with invalid account", &blacklist.MsgRemoveAdminAccount{Signer: valid, Account: invalid}, blacklist.ErrInvalidAddress},
+
+		{"TransferOwnership", &blacklist.MsgTransferOwnership{Signer: valid, NewOwner: other}, nil},
+		{"TransferOwnership with invalid new owner", &blacklist.MsgTransferOwnership{Signer: valid, NewOwner: invalid}, blacklist.ErrInvalidAddress},
+
+		{"Unban", &blacklist.MsgUnban{Signer: valid, Friend: valid}, nil},
+		{"Unban with invalid friend", &blacklist.MsgUnban{Signer: valid, Friend: invalid}, blacklist.ErrInvalidAddress},
+		{"Unban with malformed denom", &blacklist.MsgUnban{Signer: valid, Friend: valid, Denom: "1eure"}, blacklist.ErrMalformedDenom},
+
+		{"UnbanBatch", &blacklist.MsgUnbanBatch{Signer: valid, Friends: []string{valid, other}}, nil},
+		{"UnbanBatch without friends", &blacklist.MsgUnbanBatch{Signer: valid}, blacklist.ErrInvalidBatch},
+		{"UnbanBatch with duplicate friend", &blacklist.MsgUnbanBatch{Signer: valid, Friends: []string{valid, valid}}, blacklist.ErrInvalidBatch},
+		{"UnbanBatch with invalid friend", &blacklist.MsgUnbanBatch{Signer: valid, Friends: []string{invalid}}, blacklist.ErrInvalidAddress},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.Validate(cdc)
+			if tc.err == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.err)
+			}
+		})
+	}
+}
+
+// TestMsgValidateBasic checks that ValidateBasic validates addresses against
+// the account address prefix of the chain.
+func TestMsgValidateBasic(t *testing.T) {
+	bytes := utils.TestAccount().Bytes
+	valid := sdk.AccAddress(bytes).String()
+	invalid, err := sdk.Bech32ifyAddressBytes("other", bytes)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name string
+		msg  sdk.HasValidateBasic
+		err  error
+	}{
+		{"AcceptOwnership", &blacklist.MsgAcceptOwnership{Signer: valid}, nil},
+		{"AcceptOwnership with other prefix", &blacklist.MsgAcceptOwnership{Signer: invalid}, blacklist.ErrInvalidAddress},
+		{"Ban", &blacklist.MsgBan{Signer: valid, Adversary: valid}, nil},
+		{"Ban with other prefix", &blacklist.MsgBan{Signer: valid, Adversary: invalid}, blacklist.ErrInvalidAddress},
+		{"BanBatch", &blacklist.MsgBanBatch{Signer: valid, Adversaries: []string{valid}}, nil},
+		{"BanBatch with other prefix", &blacklist.MsgBanBatch{Signer: valid, Adversaries: []string{invalid}}, blacklist.ErrInvalidAddress},
+		{"Unban", &blacklist.MsgUnban{Signer: valid, Friend: valid}, nil},
+		{"Unban with other prefix", &blacklist.MsgUnban{Signer: valid, Friend: invalid}, blacklist.ErrInvalidAddress},
+		{"UnbanBatch", &blacklist.MsgUnbanBatch{Signer: valid, Friends: []string{valid}}, nil},
+		{"UnbanBatch with other prefix", &blacklist.MsgUnbanBatch{Signer: valid, Friends: []string{invalid}}, blacklist.ErrInvalidAddress},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.err == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.err)
+			}
+		})
+	}
+}
@@ -6,7 +6,8 @@ package blacklist
 import (
 	context "context"
 	fmt "fmt"
-	_ "github.com/cosmos/cosmos-sdk/types/query"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -118,6 +119,7 @@ func (m *QueryOwnerResponse) GetPendingOwner() string {
 }
 
 type QueryAdmins struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
 func (m *QueryAdmins) Reset()         { *m = QueryAdmins{} }
@@ -153,8 +155,16 @@ func (m *QueryAdmins) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryAdmins proto.InternalMessageInfo
 
+func (m *QueryAdmins) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 type QueryAdminsResponse struct {
-	Admins []string `protobuf:"bytes,1,rep,name=admins,proto3" json:"admins,omitempty"`
+	Admins      []string  `protobuf:"bytes,1,rep,name=admins,proto3" json:"admins,omitempty"`
+	DenomAdmins []Account `protobuf:"bytes,2,rep,name=denom_admins,json=denomAdmins,proto3" json:"denom_admins"`
 }
 
 func (m *QueryAdminsResponse) Reset()         { *m = QueryAdminsResponse{} }
@@ -197,7 +207,17 @@ func (m *QueryAdminsResponse) GetAdmins() []string {
 	return nil
 }
 
+func (m *QueryAdminsResponse) GetDenomAdmins() []Account {
+	if m != nil {
+		return m.DenomAdmins
+	}
+	return nil
+}
+
 type QueryAdversaries struct {
+	Denom           string             `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Pagination      *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	DenomPagination *query.PageRequest `protobuf:"bytes,3,opt,name=denom_pagination,json=denomPagination,proto3" json:"denom_pagination,omitempty"`
 }
 
 func (m *QueryAdversaries) Reset()         { *m = QueryAdversaries{} }
@@ -233,8 +253,33 @@ func (m *QueryAdversaries) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryAdversaries proto.InternalMessageInfo
 
+func (m *QueryAdversaries) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *QueryAdversaries) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func (m *QueryAdversaries) GetDenomPagination() *query.PageRequest {
+	if m != nil {
+		return m.DenomPagination
+	}
+	return nil
+}
+
 type QueryAdversariesResponse struct {
-	Adversaries []string `protobuf:"bytes,1,rep,name=adversaries,proto3" json:"adversaries,omitempty"`
+	Adversaries      []string            `protobuf:"bytes,1,rep,name=adversaries,proto3" json:"adversaries,omitempty"`
+	DenomAdversaries []Account           `protobuf:"bytes,2,rep,name=denom_adversaries,json=denomAdversaries,proto3" json:"denom_adversaries"`
+	Pagination       *query.PageResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	DenomPagination  *query.PageResponse `protobuf:"bytes,4,opt,name=denom_pagination,json=denomPagination,proto3" json:"denom_pagination,omitempty"`
+	BanRecords       []BanRecord         `protobuf:"bytes,5,rep,name=ban_records,json=banRecords,proto3" json:"ban_records"`
 }
 
 func (m *QueryAdversariesResponse) Reset()         { *m = QueryAdversariesResponse{} }
@@ -277,6 +322,122 @@ func (m *QueryAdversariesResponse) GetAdversaries() []string {
 	return nil
 }
 
+func (m *QueryAdversariesResponse) GetDenomAdversaries() []Account {
+	if m != nil {
+		return m.DenomAdversaries
+	}
+	return nil
+}
+
+func (m *QueryAdversariesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func (m *QueryAdversariesResponse) GetDenomPagination() *query.PageResponse {
+	if m != nil {
+		return m.DenomPagination
+	}
+	return nil
+}
+
+func (m *QueryAdversariesResponse) GetBanRecords() []BanRecord {
+	if m != nil {
+		return m.BanRecords
+	}
+	return nil
+}
+
+type QueryAdversary struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryAdversary) Reset()         { *m = QueryAdversary{} }
+func (m *QueryAdversary) String() string { return proto.CompactTextString(m) }
+func (*QueryAdversary) ProtoMessage()    {}
+func (*QueryAdversary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e01a1b9b84da2938, []int{6}
+}
+func (m *QueryAdversary) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAdversary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAdversary.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAdversary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAdversary.Merge(m, src)
+}
+func (m *QueryAdversary) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAdversary) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAdversary.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAdversary proto.InternalMessageInfo
+
+func (m *QueryAdversary) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+type QueryAdversaryResponse struct {
+	Bans []BanRecord `protobuf:"bytes,1,rep,name=bans,proto3" json:"bans"`
+}
+
+func (m *QueryAdversaryResponse) Reset()         { *m = QueryAdversaryResponse{} }
+func (m *QueryAdversaryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAdversaryResponse) ProtoMessage()    {}
+func (*QueryAdversaryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e01a1b9b84da2938, []int{7}
+}
+func (m *QueryAdversaryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAdversaryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAdversaryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAdversaryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAdversaryResponse.Merge(m, src)
+}
+func (m *QueryAdversaryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAdversaryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAdversaryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAdversaryResponse proto.InternalMessageInfo
+
+func (m *QueryAdversaryResponse) GetBans() []BanRecord {
+	if m != nil {
+		return m.Bans
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryOwner)(nil), "florin.blacklist.v1.QueryOwner")
 	proto.RegisterType((*QueryOwnerResponse)(nil), "florin.blacklist.v1.QueryOwnerResponse")
@@ -284,38 +445,58 @@ func init() {
 	proto.RegisterType((*QueryAdminsResponse)(nil), "florin.blacklist.v1.QueryAdminsResponse")
 	proto.RegisterType((*QueryAdversaries)(nil), "florin.blacklist.v1.QueryAdversaries")
 	proto.RegisterType((*QueryAdversariesResponse)(nil), "florin.blacklist.v1.QueryAdversariesResponse")
+	proto.RegisterType((*QueryAdversary)(nil), "florin.blacklist.v1.QueryAdversary")
+	proto.RegisterType((*QueryAdversaryResponse)(nil), "florin.blacklist.v1.QueryAdversaryResponse")
 }
 
 func init() { proto.RegisterFile("florin/blacklist/v1/query.proto", fileDescriptor_e01a1b9b84da2938) }
 
 var fileDescriptor_e01a1b9b84da2938 = []byte{
-	// 412 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x31, 0xef, 0xd2, 0x40,
-	0x18, 0xc6, 0x39, 0x08, 0x24, 0xbc, 0x40, 0x62, 0x0e, 0x63, 0x48, 0xc1, 0xd2, 0xd4, 0x18, 0x1a,
-	0x93, 0xf6, 0x02, 0xac, 0x2e, 0xba, 0x1b, 0x22, 0xa3, 0x8b, 0x69, 0xe1, 0xac, 0x17, 0xdb, 0xbb,
-	0xda, 0x6b, 0x6b, 0x70, 0x70, 0x70, 0x72, 0x34, 0xba, 0xf8, 0x31, 0xfc, 0x18, 0x8e, 0x24, 0x2e,
-	0x8e, 0x06, 0x4c, 0xfc, 0x1a, 0x86, 0x5e, 0x6d, 0x09, 0x81, 0x3f, 0xff, 0x8d, 0xf7, 0xb9, 0xdf,
-	0xfb, 0x3c, 0x6f, 0x1e, 0x0a, 0xe3, 0x57, 0x81, 0x88, 0x19, 0x27, 0x5e, 0xe0, 0xae, 0xde, 0x04,
-	0x4c, 0x26, 0x24, 0x9b, 0x92, 0xb7, 0x29, 0x8d, 0x37, 0x4e, 0x14, 0x8b, 0x44, 0xe0, 0xbe, 0x02,
-	0x9c, 0x12, 0x70, 0xb2, 0xa9, 0x36, 0x5c, 0x09, 0x19, 0x0a, 0xa9, 0xc0, 0x93, 0x0d, 0x6d, 0xe4,
-	0x0b, 0xe1, 0x07, 0x94, 0xb8, 0x11, 0x23, 0x2e, 0xe7, 0x22, 0x71, 0x13, 0x26, 0xb8, 0x54, 0xaf,
-	0x66, 0x17, 0xe0, 0xf9, 0x01, 0x5e, 0xbc, 0xe3, 0x34, 0x36, 0x17, 0x80, 0xab, 0x69, 0x49, 0x65,
-	0x24, 0xb8, 0xa4, 0xf8, 0x2e, 0x34, 0xc5, 0x41, 0x18, 0x20, 0x03, 0x59, 0xed, 0xa5, 0x1a, 0xf0,
-	0x03, 0xe8, 0x45, 0x94, 0xaf, 0x19, 0xf7, 0x5f, 0xaa, 0xd7, 0x7a, 0xfe, 0xda, 0x2d, 0x44, 0x65,
-	0xd8, 0x83, 0x4e, 0x6e, 0xf8, 0x64, 0x1d, 0x32, 0x2e, 0x4d, 0x1b, 0xfa, 0x47, 0x63, 0x19, 0x70,
-	0x0f, 0x5a, 0x6e, 0xae, 0x0c, 0x90, 0xd1, 0xb0, 0xda, 0xcb, 0x62, 0x32, 0x31, 0xdc, 0x29, 0xf0,
-	0x8c, 0xc6, 0xd2, 0x8d, 0x19, 0x95, 0xe6, 0x63, 0x18, 0x9c, 0x6a, 0xa5, 0x8f, 0x01, 0x1d, 0xb7,
-	0x92, 0x0b, 0xb3, 0x63, 0x69, 0xf6, 0xad, 0x01, 0xcd, 0x7c, 0x1d, 0xbf, 0x87, 0x66, 0x7e, 0x22,
-	0x1e, 0x3b, 0x67, 0x2a, 0x75, 0xaa, 0x1a, 0xb4, 0xc9, 0x15, 0xe0, 0x7f, 0xbc, 0x39, 0xf9, 0xf4,
-	0xf7, 0xfb, 0x23, 0xf4, 0xf1, 0xe7, 0x9f, 0xaf, 0xf5, 0x11, 0xd6, 0xc8, 0xb9, 0xbf, 0x52, 0x55,
-	0xf7, 0x01, 0x5a, 0xaa, 0x01, 0x6c, 0x5c, 0xf6, 0x56, 0x84, 0x66, 0x5d, 0x23, 0xca, 0x78, 0xab,
-	0x8a, 0xbf, 0x8f, 0x87, 0x67, 0xe3, 0x55, 0xaf, 0xf8, 0x0b, 0x82, 0xce, 0x51, 0x7f, 0xf8, 0xe1,
-	0x4d, 0x19, 0x25, 0xa6, 0xd9, 0xb7, 0xc2, 0xca, 0x7b, 0xec, 0xea, 0x1e, 0x13, 0x1b, 0x17, 0xee,
-	0x29, 0xd7, 0x9e, 0x3e, 0xfb, 0xb1, 0xd3, 0xd1, 0x76, 0xa7, 0xa3, 0xdf, 0x3b, 0x1d, 0x7d, 0xde,
-	0xeb, 0xb5, 0xed, 0x5e, 0xaf, 0xfd, 0xda, 0xeb, 0xb5, 0x17, 0x73, 0x9f, 0x25, 0xaf, 0x53, 0xcf,
-	0x59, 0x89, 0x90, 0x84, 0x82, 0xd3, 0x98, 0xa5, 0x87, 0x1f, 0xeb, 0x34, 0xa0, 0x36, 0x17, 0x5e,
-	0x40, 0x49, 0x36, 0x23, 0xc9, 0x26, 0xa2, 0xb2, 0x72, 0xf7, 0x5a, 0xf9, 0xf7, 0x3d, 0xff, 0x17,
-	0x00, 0x00, 0xff, 0xff, 0x9e, 0x90, 0x85, 0xb1, 0x52, 0x03, 0x00, 0x00,
+	// 696 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0xc1, 0x6e, 0xd3, 0x4a,
+	0x14, 0x8d, 0xd3, 0xa4, 0x4f, 0xbd, 0xee, 0x7b, 0xaf, 0x6f, 0x5a, 0x55, 0x91, 0xdb, 0xe7, 0x06,
+	0x57, 0xd0, 0x28, 0xa8, 0x1e, 0x25, 0x15, 0x12, 0xdb, 0x56, 0x2a, 0xac, 0x50, 0x5b, 0x2f, 0xd9,
+	0x54, 0xe3, 0x78, 0x30, 0x16, 0xc9, 0x4c, 0xea, 0x71, 0x82, 0x02, 0x82, 0x05, 0x2b, 0x96, 0x08,
+	0xf8, 0x0f, 0xf8, 0x07, 0x36, 0x5d, 0x56, 0x62, 0xc3, 0x0a, 0xa1, 0x16, 0x89, 0x35, 0x7f, 0x80,
+	0x32, 0x33, 0xb1, 0x93, 0xca, 0x6d, 0xd2, 0xdd, 0xcc, 0x9d, 0x73, 0xcf, 0x39, 0xf7, 0xde, 0x19,
+	0x1b, 0x36, 0x9e, 0xb4, 0x79, 0x1c, 0x31, 0xec, 0xb7, 0x49, 0xeb, 0x59, 0x3b, 0x12, 0x09, 0xee,
+	0x37, 0xf0, 0x49, 0x8f, 0xc6, 0x03, 0xb7, 0x1b, 0xf3, 0x84, 0xa3, 0x65, 0x05, 0x70, 0x53, 0x80,
+	0xdb, 0x6f, 0x58, 0xf5, 0x16, 0x17, 0x1d, 0x2e, 0xb0, 0x4f, 0x04, 0x55, 0x68, 0xdc, 0x6f, 0xf8,
+	0x34, 0x21, 0x0d, 0xdc, 0x25, 0x61, 0xc4, 0x48, 0x12, 0x71, 0xa6, 0x08, 0xac, 0x35, 0x8d, 0x1d,
+	0xc1, 0xc6, 0xd9, 0xad, 0x5b, 0x79, 0xf2, 0x21, 0x65, 0x54, 0x44, 0x42, 0x43, 0x56, 0x42, 0x1e,
+	0x72, 0xb9, 0xc4, 0xc3, 0x95, 0x8e, 0xae, 0x87, 0x9c, 0x87, 0x6d, 0x8a, 0x49, 0x37, 0xc2, 0x84,
+	0x31, 0x9e, 0x48, 0x49, 0x9d, 0xe3, 0x2c, 0x02, 0x1c, 0x0d, 0x55, 0x0e, 0x9e, 0x33, 0x1a, 0x3b,
+	0x07, 0x80, 0xb2, 0x9d, 0x47, 0x45, 0x97, 0x33, 0x41, 0xd1, 0x0a, 0x94, 0xf9, 0x30, 0x50, 0x31,
+	0xaa, 0x46, 0x6d, 0xc1, 0x53, 0x1b, 0xb4, 0x09, 0x7f, 0x77, 0x29, 0x0b, 0x22, 0x16, 0x1e, 0xab,
+	0xd3, 0xa2, 0x3c, 0x5d, 0xd4, 0x41, 0x45, 0xb8, 0x09, 0xa6, 0x24, 0xdc, 0x0d, 0x3a, 0x11, 0x13,
+	0x43, 0xa6, 0x80, 0x32, 0xde, 0x19, 0x31, 0xc9, 0x8d, 0x93, 0xc0, 0xf2, 0x18, 0x28, 0x95, 0x5d,
+	0x85, 0x79, 0x22, 0x23, 0x15, 0xa3, 0x3a, 0x57, 0x5b, 0xf0, 0xf4, 0x0e, 0xed, 0xc3, 0xa2, 0xcc,
+	0x3b, 0xd6, 0xa7, 0xc5, 0xea, 0x5c, 0xcd, 0x6c, 0xae, 0xbb, 0x39, 0xed, 0x77, 0x77, 0x5b, 0x2d,
+	0xde, 0x63, 0xc9, 0x5e, 0xe9, 0xf4, 0xfb, 0x46, 0xc1, 0x33, 0x65, 0x9e, 0x92, 0x71, 0xbe, 0x18,
+	0xb0, 0xa4, 0x65, 0xfb, 0x34, 0x16, 0x24, 0x8e, 0xe8, 0x15, 0x06, 0xd1, 0x03, 0x80, 0x6c, 0x58,
+	0xb2, 0x4e, 0xb3, 0x79, 0xc7, 0x55, 0xd3, 0x72, 0x87, 0x93, 0x75, 0xd5, 0xa4, 0xf4, 0x64, 0xdd,
+	0x43, 0x12, 0x52, 0x8f, 0x9e, 0xf4, 0xa8, 0x48, 0xbc, 0xb1, 0x4c, 0x74, 0x04, 0x4b, 0xca, 0xf9,
+	0x18, 0xdb, 0xdc, 0x8d, 0xd8, 0xfe, 0x95, 0xf9, 0x87, 0x69, 0xba, 0xf3, 0xbb, 0x08, 0x95, 0xcb,
+	0x55, 0xa4, 0x1d, 0xac, 0x82, 0x49, 0xb2, 0xb0, 0x6e, 0xe3, 0x78, 0x08, 0x1d, 0xc0, 0x7f, 0xa3,
+	0x5e, 0x66, 0xb8, 0xd9, 0x1b, 0xba, 0xa4, 0x1b, 0x9a, 0x11, 0x3e, 0x9c, 0x68, 0x95, 0x2a, 0x6e,
+	0x6b, 0x6a, 0x71, 0xca, 0xef, 0x44, 0xaf, 0xbc, 0x9c, 0x5e, 0x95, 0x6e, 0x46, 0x77, 0xb9, 0x59,
+	0x68, 0x1f, 0x4c, 0x9f, 0xb0, 0xe3, 0x98, 0xb6, 0x78, 0x1c, 0x88, 0x4a, 0x59, 0xd6, 0x69, 0xe7,
+	0xd6, 0xb9, 0x47, 0x98, 0x27, 0x61, 0xba, 0x52, 0xf0, 0x47, 0x01, 0xe1, 0xd4, 0xe1, 0x9f, 0x89,
+	0x96, 0x0f, 0x50, 0x05, 0xfe, 0x22, 0x41, 0x10, 0x53, 0x21, 0xf4, 0xc5, 0x19, 0x6d, 0x1d, 0x0f,
+	0x56, 0x27, 0xb1, 0xe9, 0x70, 0xee, 0x43, 0xc9, 0x27, 0xfa, 0x72, 0xcf, 0xea, 0x42, 0x66, 0x34,
+	0x3f, 0x95, 0xa0, 0x2c, 0x49, 0xd1, 0x0b, 0x28, 0xcb, 0x77, 0x86, 0x36, 0x72, 0xd3, 0xb3, 0xb7,
+	0x6c, 0x6d, 0x4d, 0x01, 0x8c, 0x6c, 0x39, 0x5b, 0x6f, 0x7f, 0x7d, 0xae, 0x1b, 0x6f, 0xbe, 0xfe,
+	0xfc, 0x50, 0x5c, 0x47, 0x16, 0xce, 0xfb, 0xea, 0xa8, 0xf7, 0xff, 0x1a, 0xe6, 0xf5, 0xab, 0xae,
+	0x5e, 0xcd, 0xad, 0x10, 0x56, 0x6d, 0x1a, 0x22, 0x95, 0xaf, 0x65, 0xf2, 0xff, 0xa3, 0xb5, 0x5c,
+	0x79, 0xfd, 0x19, 0x78, 0x6f, 0x80, 0x39, 0x7e, 0xf3, 0x6e, 0x5f, 0xa7, 0x91, 0xc2, 0xac, 0xed,
+	0x99, 0x60, 0xa9, 0x9f, 0xed, 0xcc, 0x8f, 0x83, 0xaa, 0x57, 0xf8, 0xc9, 0x4c, 0x7c, 0x34, 0x60,
+	0x21, 0xbb, 0x16, 0x9b, 0xd3, 0xb5, 0x06, 0xd6, 0xdd, 0x19, 0x40, 0xa9, 0x9d, 0x7b, 0x99, 0x9d,
+	0x3a, 0xaa, 0x5d, 0x6b, 0x67, 0x80, 0x5f, 0xea, 0x4b, 0xf8, 0x6a, 0xef, 0xd1, 0xe9, 0xb9, 0x6d,
+	0x9c, 0x9d, 0xdb, 0xc6, 0x8f, 0x73, 0xdb, 0x78, 0x77, 0x61, 0x17, 0xce, 0x2e, 0xec, 0xc2, 0xb7,
+	0x0b, 0xbb, 0xf0, 0x78, 0x27, 0x8c, 0x92, 0xa7, 0x3d, 0xdf, 0x6d, 0xf1, 0x0e, 0xee, 0x70, 0x46,
+	0xe3, 0xa8, 0x37, 0x5c, 0x04, 0xbd, 0x36, 0xdd, 0x66, 0xdc, 0x6f, 0x53, 0xdc, 0x6f, 0xe2, 0x64,
+	0xd0, 0xa5, 0x22, 0x53, 0xf1, 0xe7, 0xe5, 0xbf, 0x63, 0xe7, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff,
+	0x83, 0x18, 0xef, 0x6e, 0x13, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -333,6 +514,7 @@ type QueryClient interface {
 	Owner(ctx context.Context, in *QueryOwner, opts ...grpc.CallOption) (*QueryOwnerResponse, error)
 	Admins(ctx context.Context, in *QueryAdmins, opts ...grpc.CallOption) (*QueryAdminsResponse, error)
 	Adversaries(ctx context.Context, in *QueryAdversaries, opts ...grpc.CallOption) (*QueryAdversariesResponse, error)
+	Adversary(ctx context.Context, in *QueryAdversary, opts ...grpc.CallOption) (*QueryAdversaryResponse, error)
 }
 
 type queryClient struct {
@@ -370,11 +552,21 @@ func (c *queryClient) Adversaries(ctx context.Context, in *QueryAdversaries, opt
 	return out, nil
 }
 
+func (c *queryClient) Adversary(ctx context.Context, in *QueryAdversary, opts ...grpc.CallOption) (*QueryAdversaryResponse, error) {
+	out := new(QueryAdversaryResponse)
+	err := c.cc.Invoke(ctx, "/florin.blacklist.v1.Query/Adversary", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	Owner(context.Context, *QueryOwner) (*QueryOwnerResponse, error)
 	Admins(context.Context, *QueryAdmins) (*QueryAdminsResponse, error)
 	Adversaries(context.Context, *QueryAdversaries) (*QueryAdversariesResponse, error)
+	Adversary(context.Context, *QueryAdversary) (*QueryAdversaryResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -390,6 +582,9 @@ func (*UnimplementedQueryServer) Admins(ctx context.Context, req *QueryAdmins) (
 func (*UnimplementedQueryServer) Adversaries(ctx context.Context, req *QueryAdversaries) (*QueryAdversariesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Adversaries not implemented")
 }
+func (*UnimplementedQueryServer) Adversary(ctx context.Context, req *QueryAdversary) (*QueryAdversaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Adversary not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -449,6 +644,24 @@ func _Query_Adversaries_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Adversary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAdversary)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Adversary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/florin.blacklist.v1.Query/Adversary",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Adversary(ctx, req.(*QueryAdversary))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "florin.blacklist.v1.Query",
@@ -466,6 +679,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Adversaries",
 			Handler:    _Query_Adversaries_Handler,
 		},
+		{
+			MethodName: "Adversary",
+			Handler:    _Query_Adversary_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "florin/blacklist/v1/query.proto",
@@ -551,6 +768,13 @@ func (m *QueryAdmins) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -574,6 +798,20 @@ func (m *QueryAdminsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DenomAdmins) > 0 {
+		for iNdEx := len(m.DenomAdmins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomAdmins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.Admins) > 0 {
 		for iNdEx := len(m.Admins) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Admins[iNdEx])
@@ -606,6 +844,37 @@ func (m *QueryAdversaries) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DenomPagination != nil {
+		{
+			size, err := m.DenomPagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -629,6 +898,58 @@ func (m *QueryAdversariesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	_ = i
 	var l int
 	_ = l
+	if len(m.BanRecords) > 0 {
+		for iNdEx := len(m.BanRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BanRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.DenomPagination != nil {
+		{
+			size, err := m.DenomPagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.DenomAdversaries) > 0 {
+		for iNdEx := len(m.DenomAdversaries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomAdversaries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.Adversaries) > 0 {
 		for iNdEx := len(m.Adversaries) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Adversaries[iNdEx])
@@ -641,6 +962,73 @@ func (m *QueryAdversariesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryAdversary) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAdversary) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAdversary) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAdversaryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAdversaryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAdversaryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Bans) > 0 {
+		for iNdEx := len(m.Bans) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bans[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -684,6 +1072,10 @@ func (m *QueryAdmins) Size() (n int) {
 	}
 	var l int
 	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -699,6 +1091,12 @@ func (m *QueryAdminsResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
+	if len(m.DenomAdmins) > 0 {
+		for _, e := range m.DenomAdmins {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -708,6 +1106,18 @@ func (m *QueryAdversaries) Size() (n int) {
 	}
 	var l int
 	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.DenomPagination != nil {
+		l = m.DenomPagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -723,20 +1133,68 @@ func (m *QueryAdversariesResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
+	if len(m.DenomAdversaries) > 0 {
+		for _, e := range m.DenomAdversaries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.DenomPagination != nil {
+		l = m.DenomPagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.BanRecords) > 0 {
+		for _, e := range m.BanRecords {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *QueryAdversary) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
 }
-func (m *QueryOwner) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
+
+func (m *QueryAdversaryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Bans) > 0 {
+		for _, e := range m.Bans {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryOwner) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
@@ -925,6 +1383,38 @@ func (m *QueryAdmins) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: QueryAdmins: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1007,6 +1497,40 @@ func (m *QueryAdminsResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.Admins = append(m.Admins, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomAdmins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomAdmins = append(m.DenomAdmins, Account{})
+			if err := m.DenomAdmins[len(m.DenomAdmins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1057,6 +1581,110 @@ func (m *QueryAdversaries) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: QueryAdversaries: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomPagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DenomPagination == nil {
+				m.DenomPagination = &query.PageRequest{}
+			}
+			if err := m.DenomPagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1139,6 +1767,312 @@ func (m *QueryAdversariesResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.Adversaries = append(m.Adversaries, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomAdversaries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomAdversaries = append(m.DenomAdversaries, Account{})
+			if err := m.DenomAdversaries[len(m.DenomAdversaries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomPagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DenomPagination == nil {
+				m.DenomPagination = &query.PageResponse{}
+			}
+			if err := m.DenomPagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BanRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BanRecords = append(m.BanRecords, BanRecord{})
+			if err := m.BanRecords[len(m.BanRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAdversary) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAdversary: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAdversary: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAdversaryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAdversaryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAdversaryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bans", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bans = append(m.Bans, BanRecord{})
+			if err := m.Bans[len(m.Bans)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
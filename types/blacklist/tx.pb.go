@@ -110,6 +110,7 @@ var xxx_messageInfo_MsgAcceptOwnershipResponse proto.InternalMessageInfo
 type MsgAddAdminAccount struct {
 	Signer  string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
 	Account string `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	Denom   string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
 func (m *MsgAddAdminAccount) Reset()         { *m = MsgAddAdminAccount{} }
@@ -184,8 +185,13 @@ var xxx_messageInfo_MsgAddAdminAccountResponse proto.InternalMessageInfo
 
 // MsgBan implements the ban (0x97c3ccd8) method.
 type MsgBan struct {
-	Signer    string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
-	Adversary string `protobuf:"bytes,2,opt,name=adversary,proto3" json:"adversary,omitempty"`
+	Signer       string    `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Adversary    string    `protobuf:"bytes,2,opt,name=adversary,proto3" json:"adversary,omitempty"`
+	Denom        string    `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	ExpiryHeight int64     `protobuf:"varint,4,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty"`
+	ExpiryTime   int64     `protobuf:"varint,5,opt,name=expiry_time,json=expiryTime,proto3" json:"expiry_time,omitempty"`
+	Reason       BanReason `protobuf:"varint,6,opt,name=reason,proto3,enum=florin.blacklist.v1.BanReason" json:"reason,omitempty"`
+	CaseId       string    `protobuf:"bytes,7,opt,name=case_id,json=caseId,proto3" json:"case_id,omitempty"`
 }
 
 func (m *MsgBan) Reset()         { *m = MsgBan{} }
@@ -258,17 +264,99 @@ func (m *MsgBanResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgBanResponse proto.InternalMessageInfo
 
+// MsgBanBatch is the request of the BanBatch action.
+type MsgBanBatch struct {
+	Signer       string    `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Adversaries  []string  `protobuf:"bytes,2,rep,name=adversaries,proto3" json:"adversaries,omitempty"`
+	Denom        string    `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	ExpiryHeight int64     `protobuf:"varint,4,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty"`
+	ExpiryTime   int64     `protobuf:"varint,5,opt,name=expiry_time,json=expiryTime,proto3" json:"expiry_time,omitempty"`
+	Reason       BanReason `protobuf:"varint,6,opt,name=reason,proto3,enum=florin.blacklist.v1.BanReason" json:"reason,omitempty"`
+	CaseId       string    `protobuf:"bytes,7,opt,name=case_id,json=caseId,proto3" json:"case_id,omitempty"`
+}
+
+func (m *MsgBanBatch) Reset()         { *m = MsgBanBatch{} }
+func (m *MsgBanBatch) String() string { return proto.CompactTextString(m) }
+func (*MsgBanBatch) ProtoMessage()    {}
+func (*MsgBanBatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_213e66f15459b69a, []int{6}
+}
+func (m *MsgBanBatch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBanBatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBanBatch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBanBatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBanBatch.Merge(m, src)
+}
+func (m *MsgBanBatch) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBanBatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBanBatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBanBatch proto.InternalMessageInfo
+
+// MsgBanBatchResponse is the response of the BanBatch action.
+type MsgBanBatchResponse struct {
+}
+
+func (m *MsgBanBatchResponse) Reset()         { *m = MsgBanBatchResponse{} }
+func (m *MsgBanBatchResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBanBatchResponse) ProtoMessage()    {}
+func (*MsgBanBatchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_213e66f15459b69a, []int{7}
+}
+func (m *MsgBanBatchResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBanBatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBanBatchResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBanBatchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBanBatchResponse.Merge(m, src)
+}
+func (m *MsgBanBatchResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBanBatchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBanBatchResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBanBatchResponse proto.InternalMessageInfo
+
 // MsgRemoveAdminAccount implements the removeAdminAccount (0x67a89a72) method.
 type MsgRemoveAdminAccount struct {
 	Signer  string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
 	Account string `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	Denom   string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
 func (m *MsgRemoveAdminAccount) Reset()         { *m = MsgRemoveAdminAccount{} }
 func (m *MsgRemoveAdminAccount) String() string { return proto.CompactTextString(m) }
 func (*MsgRemoveAdminAccount) ProtoMessage()    {}
 func (*MsgRemoveAdminAccount) Descriptor() ([]byte, []int) {
-	return fileDescriptor_213e66f15459b69a, []int{6}
+	return fileDescriptor_213e66f15459b69a, []int{8}
 }
 func (m *MsgRemoveAdminAccount) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -305,7 +393,7 @@ func (m *MsgRemoveAdminAccountResponse) Reset()         { *m = MsgRemoveAdminAcc
 func (m *MsgRemoveAdminAccountResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgRemoveAdminAccountResponse) ProtoMessage()    {}
 func (*MsgRemoveAdminAccountResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_213e66f15459b69a, []int{7}
+	return fileDescriptor_213e66f15459b69a, []int{9}
 }
 func (m *MsgRemoveAdminAccountResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -344,7 +432,7 @@ func (m *MsgTransferOwnership) Reset()         { *m = MsgTransferOwnership{} }
 func (m *MsgTransferOwnership) String() string { return proto.CompactTextString(m) }
 func (*MsgTransferOwnership) ProtoMessage()    {}
 func (*MsgTransferOwnership) Descriptor() ([]byte, []int) {
-	return fileDescriptor_213e66f15459b69a, []int{8}
+	return fileDescriptor_213e66f15459b69a, []int{10}
 }
 func (m *MsgTransferOwnership) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -381,7 +469,7 @@ func (m *MsgTransferOwnershipResponse) Reset()         { *m = MsgTransferOwnersh
 func (m *MsgTransferOwnershipResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgTransferOwnershipResponse) ProtoMessage()    {}
 func (*MsgTransferOwnershipResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_213e66f15459b69a, []int{9}
+	return fileDescriptor_213e66f15459b69a, []int{11}
 }
 func (m *MsgTransferOwnershipResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -414,13 +502,14 @@ var xxx_messageInfo_MsgTransferOwnershipResponse proto.InternalMessageInfo
 type MsgUnban struct {
 	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
 	Friend string `protobuf:"bytes,2,opt,name=friend,proto3" json:"friend,omitempty"`
+	Denom  string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
 func (m *MsgUnban) Reset()         { *m = MsgUnban{} }
 func (m *MsgUnban) String() string { return proto.CompactTextString(m) }
 func (*MsgUnban) ProtoMessage()    {}
 func (*MsgUnban) Descriptor() ([]byte, []int) {
-	return fileDescriptor_213e66f15459b69a, []int{10}
+	return fileDescriptor_213e66f15459b69a, []int{12}
 }
 func (m *MsgUnban) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -457,7 +546,7 @@ func (m *MsgUnbanResponse) Reset()         { *m = MsgUnbanResponse{} }
 func (m *MsgUnbanResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUnbanResponse) ProtoMessage()    {}
 func (*MsgUnbanResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_213e66f15459b69a, []int{11}
+	return fileDescriptor_213e66f15459b69a, []int{13}
 }
 func (m *MsgUnbanResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -486,6 +575,83 @@ func (m *MsgUnbanResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUnbanResponse proto.InternalMessageInfo
 
+// MsgUnbanBatch is the request of the UnbanBatch action.
+type MsgUnbanBatch struct {
+	Signer  string   `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Friends []string `protobuf:"bytes,2,rep,name=friends,proto3" json:"friends,omitempty"`
+	Denom   string   `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *MsgUnbanBatch) Reset()         { *m = MsgUnbanBatch{} }
+func (m *MsgUnbanBatch) String() string { return proto.CompactTextString(m) }
+func (*MsgUnbanBatch) ProtoMessage()    {}
+func (*MsgUnbanBatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_213e66f15459b69a, []int{14}
+}
+func (m *MsgUnbanBatch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnbanBatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnbanBatch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnbanBatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnbanBatch.Merge(m, src)
+}
+func (m *MsgUnbanBatch) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnbanBatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnbanBatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnbanBatch proto.InternalMessageInfo
+
+// MsgUnbanBatchResponse is the response of the UnbanBatch action.
+type MsgUnbanBatchResponse struct {
+}
+
+func (m *MsgUnbanBatchResponse) Reset()         { *m = MsgUnbanBatchResponse{} }
+func (m *MsgUnbanBatchResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnbanBatchResponse) ProtoMessage()    {}
+func (*MsgUnbanBatchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_213e66f15459b69a, []int{15}
+}
+func (m *MsgUnbanBatchResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnbanBatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnbanBatchResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnbanBatchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnbanBatchResponse.Merge(m, src)
+}
+func (m *MsgUnbanBatchResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnbanBatchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnbanBatchResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnbanBatchResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgAcceptOwnership)(nil), "florin.blacklist.v1.MsgAcceptOwnership")
 	proto.RegisterType((*MsgAcceptOwnershipResponse)(nil), "florin.blacklist.v1.MsgAcceptOwnershipResponse")
@@ -493,58 +659,77 @@ func init() {
 	proto.RegisterType((*MsgAddAdminAccountResponse)(nil), "florin.blacklist.v1.MsgAddAdminAccountResponse")
 	proto.RegisterType((*MsgBan)(nil), "florin.blacklist.v1.MsgBan")
 	proto.RegisterType((*MsgBanResponse)(nil), "florin.blacklist.v1.MsgBanResponse")
+	proto.RegisterType((*MsgBanBatch)(nil), "florin.blacklist.v1.MsgBanBatch")
+	proto.RegisterType((*MsgBanBatchResponse)(nil), "florin.blacklist.v1.MsgBanBatchResponse")
 	proto.RegisterType((*MsgRemoveAdminAccount)(nil), "florin.blacklist.v1.MsgRemoveAdminAccount")
 	proto.RegisterType((*MsgRemoveAdminAccountResponse)(nil), "florin.blacklist.v1.MsgRemoveAdminAccountResponse")
 	proto.RegisterType((*MsgTransferOwnership)(nil), "florin.blacklist.v1.MsgTransferOwnership")
 	proto.RegisterType((*MsgTransferOwnershipResponse)(nil), "florin.blacklist.v1.MsgTransferOwnershipResponse")
 	proto.RegisterType((*MsgUnban)(nil), "florin.blacklist.v1.MsgUnban")
 	proto.RegisterType((*MsgUnbanResponse)(nil), "florin.blacklist.v1.MsgUnbanResponse")
+	proto.RegisterType((*MsgUnbanBatch)(nil), "florin.blacklist.v1.MsgUnbanBatch")
+	proto.RegisterType((*MsgUnbanBatchResponse)(nil), "florin.blacklist.v1.MsgUnbanBatchResponse")
 }
 
 func init() { proto.RegisterFile("florin/blacklist/v1/tx.proto", fileDescriptor_213e66f15459b69a) }
 
 var fileDescriptor_213e66f15459b69a = []byte{
-	// 635 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x95, 0x3f, 0x6f, 0xd3, 0x4e,
-	0x18, 0xc7, 0x73, 0xbf, 0xfe, 0x1a, 0xda, 0x1b, 0xa0, 0x35, 0x01, 0x8a, 0x49, 0x9d, 0xca, 0x15,
-	0x02, 0x22, 0x6a, 0x37, 0xa9, 0xf8, 0x23, 0x33, 0x25, 0x0b, 0x93, 0x85, 0x14, 0x60, 0x61, 0xa9,
-	0x1c, 0xfb, 0x72, 0xb5, 0x1a, 0xdf, 0x85, 0x3b, 0x27, 0xa1, 0x5b, 0xc5, 0x84, 0x98, 0x78, 0x05,
-	0x28, 0x2f, 0x21, 0x12, 0x4c, 0xf0, 0x06, 0x18, 0x2b, 0x26, 0x46, 0x94, 0x0c, 0xe1, 0x65, 0xa0,
-	0xf8, 0x5f, 0xd4, 0x5c, 0x9c, 0x84, 0x32, 0xb0, 0x44, 0x77, 0xf7, 0x7c, 0x9f, 0xe7, 0xf9, 0x7e,
-	0x94, 0xbb, 0xc7, 0x30, 0xdf, 0x68, 0x52, 0xe6, 0x12, 0xbd, 0xde, 0xb4, 0xec, 0xe3, 0xa6, 0xcb,
-	0x7d, 0xbd, 0x53, 0xd2, 0xfd, 0x37, 0x5a, 0x8b, 0x51, 0x9f, 0x4a, 0x57, 0xc3, 0xa8, 0x96, 0x44,
-	0xb5, 0x4e, 0x49, 0xde, 0xb4, 0x3c, 0x97, 0x50, 0x3d, 0xf8, 0x0d, 0x75, 0xf2, 0x0d, 0x9b, 0x72,
-	0x8f, 0x72, 0xdd, 0xe3, 0x78, 0x9c, 0xef, 0x71, 0x1c, 0x05, 0x6e, 0x86, 0x81, 0xc3, 0x60, 0xa7,
-	0x87, 0x9b, 0x28, 0x94, 0xc3, 0x14, 0xd3, 0xf0, 0x7c, 0xbc, 0x0a, 0x4f, 0xd5, 0x53, 0x00, 0x25,
-	0x93, 0xe3, 0x8a, 0x6d, 0xa3, 0x96, 0xff, 0xac, 0x4b, 0x10, 0xe3, 0x47, 0x6e, 0x4b, 0xda, 0x87,
-	0x59, 0xee, 0x62, 0x82, 0xd8, 0x16, 0xd8, 0x01, 0x77, 0xd7, 0xab, 0x5b, 0xdf, 0x3f, 0xef, 0xe5,
-	0xa2, 0x72, 0x15, 0xc7, 0x61, 0x88, 0xf3, 0xe7, 0x3e, 0x73, 0x09, 0xae, 0x45, 0x3a, 0xe3, 0xf1,
-	0xbb, 0x5e, 0x21, 0xf3, 0xab, 0x57, 0xc8, 0xbc, 0x1d, 0xf5, 0x8b, 0xd1, 0xe1, 0xfb, 0x51, 0xbf,
-	0xb8, 0x23, 0x00, 0x4f, 0xf5, 0x52, 0xf3, 0x50, 0x16, 0x1d, 0xd4, 0x10, 0x6f, 0x51, 0xc2, 0x91,
-	0xfa, 0x29, 0x32, 0xe8, 0x38, 0x15, 0xc7, 0x73, 0x49, 0xc5, 0xb6, 0x69, 0x9b, 0xf8, 0x7f, 0x6e,
-	0x50, 0x2a, 0xc3, 0x4b, 0x56, 0x98, 0xbc, 0xf5, 0xdf, 0x82, 0x94, 0x58, 0x68, 0x3c, 0x4a, 0x81,
-	0x2a, 0x88, 0x50, 0x8e, 0x53, 0x99, 0xd8, 0x8b, 0x99, 0xce, 0x9b, 0x4e, 0x98, 0x7a, 0x00, 0x66,
-	0x4d, 0x8e, 0xab, 0x16, 0xb9, 0x00, 0xc7, 0x43, 0xb8, 0x6e, 0x39, 0x1d, 0xc4, 0xb8, 0xc5, 0x4e,
-	0x16, 0x92, 0x4c, 0xa4, 0xc6, 0xfd, 0x14, 0x96, 0x9c, 0xc0, 0x52, 0xb5, 0x88, 0xba, 0x01, 0x2f,
-	0x87, 0x0e, 0x13, 0xd3, 0x5f, 0x00, 0xbc, 0x66, 0x72, 0x5c, 0x43, 0x1e, 0xed, 0xa0, 0x7f, 0xf0,
-	0x5f, 0x3c, 0x49, 0xf1, 0xbf, 0x2b, 0xf8, 0x17, 0x2d, 0xaa, 0x05, 0xb8, 0x3d, 0xd3, 0x7b, 0x42,
-	0xf7, 0x15, 0xc0, 0x9c, 0xc9, 0xf1, 0x0b, 0x66, 0x11, 0xde, 0x40, 0xec, 0x2f, 0x5e, 0x82, 0xf4,
-	0x00, 0xae, 0x13, 0xd4, 0x3d, 0xa4, 0xe3, 0x12, 0x0b, 0xf1, 0xd6, 0x08, 0xea, 0x06, 0xcd, 0x0c,
-	0x23, 0x85, 0x4f, 0x15, 0xf8, 0x04, 0x93, 0xaa, 0x02, 0xf3, 0xb3, 0xcc, 0x27, 0x74, 0x1f, 0x01,
-	0x5c, 0x33, 0x39, 0x7e, 0x49, 0xea, 0x17, 0xba, 0x72, 0xfb, 0x30, 0xdb, 0x60, 0x2e, 0x22, 0xce,
-	0x42, 0x9c, 0x48, 0x67, 0x68, 0x29, 0x30, 0xd7, 0x05, 0x98, 0xc0, 0x93, 0x2a, 0xc1, 0x8d, 0xd8,
-	0x5f, 0x6c, 0xba, 0x3c, 0xfa, 0x1f, 0xae, 0x98, 0x1c, 0x4b, 0xc7, 0xf0, 0xca, 0xf4, 0x78, 0xba,
-	0xa3, 0xcd, 0x18, 0x94, 0x9a, 0x38, 0x45, 0x64, 0x7d, 0x49, 0x61, 0xdc, 0x34, 0x68, 0x36, 0x35,
-	0x6a, 0xd2, 0x9b, 0x9d, 0x17, 0xce, 0x69, 0x36, 0x7b, 0x0e, 0x48, 0x4f, 0xe1, 0xca, 0x78, 0x06,
-	0xdc, 0x4a, 0xcb, 0xab, 0x5a, 0x44, 0xde, 0x9d, 0x13, 0x4c, 0x0a, 0xf9, 0x50, 0x9a, 0xf1, 0x2e,
-	0x8b, 0x69, 0xa9, 0xa2, 0x56, 0x2e, 0x2f, 0xaf, 0x4d, 0xba, 0xbe, 0x86, 0x9b, 0xe2, 0x7b, 0xb9,
-	0x97, 0x56, 0x48, 0x90, 0xca, 0xa5, 0xa5, 0xa5, 0x49, 0x4b, 0x13, 0xae, 0x86, 0x97, 0x78, 0x3b,
-	0x2d, 0x37, 0x08, 0xcb, 0xb7, 0xe7, 0x86, 0xe3, 0x72, 0xf2, 0xea, 0xe9, 0xa8, 0x5f, 0x04, 0x55,
-	0xf3, 0xdb, 0x40, 0x01, 0x67, 0x03, 0x05, 0xfc, 0x1c, 0x28, 0xe0, 0xc3, 0x50, 0xc9, 0x9c, 0x0d,
-	0x95, 0xcc, 0x8f, 0xa1, 0x92, 0x79, 0x75, 0x80, 0x5d, 0xff, 0xa8, 0x5d, 0xd7, 0x6c, 0xea, 0xe9,
-	0x1e, 0x25, 0x88, 0xb9, 0xed, 0xf1, 0xc2, 0x69, 0x37, 0xd1, 0x1e, 0xa1, 0xf5, 0x26, 0xd2, 0x3b,
-	0x65, 0xdd, 0x3f, 0x69, 0x21, 0x3e, 0xb9, 0xd2, 0xf5, 0x6c, 0xf0, 0x69, 0x3d, 0xf8, 0x1d, 0x00,
-	0x00, 0xff, 0xff, 0xf6, 0x37, 0x91, 0xab, 0xec, 0x07, 0x00, 0x00,
+	// 869 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x96, 0x41, 0x6f, 0xe3, 0x44,
+	0x14, 0xc7, 0xe3, 0x84, 0x38, 0xed, 0x2b, 0xbb, 0xec, 0x7a, 0xb3, 0xd4, 0xeb, 0xed, 0x3a, 0xc1,
+	0x15, 0x22, 0x44, 0x6c, 0xbc, 0x49, 0xc5, 0x82, 0xc2, 0x29, 0xb9, 0x00, 0x07, 0x0b, 0x29, 0x14,
+	0x0e, 0x08, 0x29, 0x72, 0xec, 0xa9, 0x33, 0x6a, 0x3c, 0x13, 0x3c, 0x4e, 0xda, 0xde, 0x2a, 0x4e,
+	0x88, 0x13, 0x5f, 0x00, 0xa9, 0x1f, 0xa1, 0x87, 0xde, 0xb8, 0x70, 0x84, 0x03, 0x52, 0xc5, 0x09,
+	0x89, 0x0b, 0x6a, 0x0f, 0xe5, 0x63, 0xa0, 0x78, 0x6c, 0xa7, 0xad, 0xed, 0xa4, 0x84, 0x0b, 0x7b,
+	0xa9, 0x32, 0xef, 0xfd, 0xdf, 0xbc, 0xf7, 0x7b, 0xd6, 0xbc, 0x57, 0xd8, 0xda, 0x1b, 0x51, 0x0f,
+	0x13, 0x7d, 0x30, 0x32, 0xad, 0xfd, 0x11, 0x66, 0xbe, 0x3e, 0x6d, 0xea, 0xfe, 0x61, 0x63, 0xec,
+	0x51, 0x9f, 0x4a, 0x8f, 0xb8, 0xb7, 0x11, 0x7b, 0x1b, 0xd3, 0xa6, 0xf2, 0xd0, 0x74, 0x31, 0xa1,
+	0x7a, 0xf0, 0x97, 0xeb, 0x94, 0x4d, 0x8b, 0x32, 0x97, 0x32, 0xdd, 0x65, 0xce, 0x2c, 0xde, 0x65,
+	0x4e, 0xe8, 0x78, 0xc2, 0x1d, 0xfd, 0xe0, 0xa4, 0xf3, 0x43, 0xe8, 0x7a, 0x2b, 0x2d, 0xb3, 0x83,
+	0x08, 0x62, 0x38, 0x92, 0x94, 0x1d, 0xea, 0x50, 0x1e, 0x3a, 0xfb, 0xc5, 0xad, 0xda, 0xb1, 0x00,
+	0x92, 0xc1, 0x9c, 0x8e, 0x65, 0xa1, 0xb1, 0xff, 0xd9, 0x01, 0x41, 0x1e, 0x1b, 0xe2, 0xb1, 0xf4,
+	0x02, 0x44, 0x86, 0x1d, 0x82, 0x3c, 0x59, 0xa8, 0x0a, 0xb5, 0xf5, 0xae, 0xfc, 0xfb, 0xd9, 0xf3,
+	0x72, 0x98, 0xb1, 0x63, 0xdb, 0x1e, 0x62, 0xec, 0x73, 0xdf, 0xc3, 0xc4, 0xe9, 0x85, 0xba, 0xf6,
+	0x87, 0xdf, 0x9d, 0x54, 0x72, 0x7f, 0x9f, 0x54, 0x72, 0xdf, 0x5e, 0x9d, 0xd6, 0x43, 0xe3, 0xf7,
+	0x57, 0xa7, 0xf5, 0x6a, 0xa2, 0xb2, 0x5b, 0xb9, 0xb4, 0x2d, 0x50, 0x92, 0x15, 0xf4, 0x10, 0x1b,
+	0x53, 0xc2, 0x90, 0xf6, 0x6b, 0x58, 0xa0, 0x6d, 0x77, 0x6c, 0x17, 0x93, 0x8e, 0x65, 0xd1, 0x09,
+	0xf1, 0xff, 0x7d, 0x81, 0x52, 0x0b, 0x4a, 0x26, 0x0f, 0x96, 0xf3, 0x4b, 0x42, 0x22, 0xa1, 0x54,
+	0x86, 0xa2, 0x8d, 0x08, 0x75, 0xe5, 0xc2, 0x2c, 0xa2, 0xc7, 0x0f, 0xed, 0x0f, 0x32, 0x50, 0x2b,
+	0x49, 0x54, 0xdb, 0xee, 0xcc, 0x8b, 0x8e, 0x48, 0x6f, 0xa2, 0xc4, 0xa4, 0xbf, 0xe5, 0x41, 0x34,
+	0x98, 0xd3, 0x35, 0xc9, 0x0a, 0x74, 0x2f, 0x61, 0xdd, 0xb4, 0xa7, 0xc8, 0x63, 0xa6, 0x77, 0xb4,
+	0x94, 0x6f, 0x2e, 0x4d, 0x27, 0x94, 0xb6, 0xe1, 0x1e, 0x3a, 0x1c, 0x63, 0xef, 0xa8, 0x3f, 0x44,
+	0xd8, 0x19, 0xfa, 0xf2, 0x6b, 0x55, 0xa1, 0x56, 0xe8, 0xbd, 0xce, 0x8d, 0x9f, 0x04, 0x36, 0xa9,
+	0x02, 0x1b, 0xa1, 0xc8, 0xc7, 0x2e, 0x92, 0x8b, 0x81, 0x04, 0xb8, 0x69, 0x17, 0xbb, 0x48, 0x7a,
+	0x09, 0xa2, 0x87, 0x4c, 0x46, 0x89, 0x2c, 0x56, 0x85, 0xda, 0xfd, 0x96, 0xda, 0x48, 0x79, 0x01,
+	0x8d, 0xae, 0x49, 0x7a, 0x81, 0xaa, 0x17, 0xaa, 0xa5, 0x4d, 0x28, 0x59, 0x26, 0x43, 0x7d, 0x6c,
+	0xcb, 0xa5, 0xa0, 0x2a, 0x71, 0x76, 0xfc, 0xd4, 0x6e, 0xbf, 0x97, 0xd1, 0xf8, 0x72, 0xa2, 0xf1,
+	0x5d, 0x93, 0x68, 0x0f, 0xe0, 0x3e, 0x6f, 0x67, 0xdc, 0xe1, 0x3f, 0xf3, 0xb0, 0xc1, 0x4d, 0x5d,
+	0xd3, 0xb7, 0x86, 0x2b, 0xb4, 0xb9, 0x0d, 0x1b, 0x51, 0xef, 0x30, 0x62, 0x72, 0xbe, 0x5a, 0x58,
+	0x18, 0x76, 0x5d, 0xfc, 0x6a, 0xb5, 0xba, 0x99, 0xd1, 0xea, 0x27, 0x69, 0xad, 0x0e, 0xba, 0xa9,
+	0x3d, 0x86, 0x47, 0xd7, 0x9a, 0x1b, 0x37, 0xfd, 0x5c, 0x80, 0xc7, 0x06, 0x73, 0x7a, 0xc8, 0xa5,
+	0x53, 0xf4, 0xbf, 0x79, 0xc3, 0x1f, 0x65, 0xf0, 0x6d, 0x27, 0xf8, 0x92, 0x85, 0x6b, 0x15, 0x78,
+	0x96, 0x4a, 0x14, 0x33, 0xff, 0x24, 0x40, 0xd9, 0x60, 0xce, 0xae, 0x67, 0x12, 0xb6, 0x87, 0xbc,
+	0xff, 0x30, 0x57, 0xa5, 0xf7, 0x61, 0x9d, 0xa0, 0x83, 0x3e, 0x9d, 0x5d, 0xb1, 0x14, 0x7a, 0x8d,
+	0xa0, 0x83, 0x20, 0x59, 0xbb, 0x9d, 0xc1, 0xa7, 0x25, 0xf8, 0x12, 0x45, 0x6a, 0x2a, 0x6c, 0xa5,
+	0x15, 0x1f, 0xd3, 0x9d, 0x09, 0xb0, 0x66, 0x30, 0xe7, 0x0b, 0x32, 0x58, 0x69, 0x54, 0xbd, 0x00,
+	0x71, 0xcf, 0xc3, 0x88, 0xd8, 0x4b, 0x71, 0x42, 0x5d, 0xc6, 0x27, 0x6c, 0x64, 0x20, 0xbe, 0x99,
+	0x40, 0x0c, 0x2a, 0xd5, 0x24, 0x78, 0x10, 0x55, 0x1d, 0xa3, 0xfc, 0x2c, 0xc0, 0xbd, 0xc8, 0xb8,
+	0xea, 0x4c, 0x68, 0x41, 0x89, 0xd7, 0xb9, 0x7c, 0x1e, 0x44, 0xc2, 0x0c, 0xa2, 0x9d, 0x0c, 0xa2,
+	0xa7, 0xe9, 0x44, 0xfc, 0xd9, 0x6d, 0x06, 0xcf, 0x6b, 0x6e, 0x88, 0xd8, 0x5a, 0x3f, 0x8a, 0x50,
+	0x30, 0x98, 0x23, 0xed, 0xc3, 0x1b, 0xb7, 0xd7, 0xfb, 0x3b, 0xa9, 0xe3, 0x21, 0xb9, 0x85, 0x15,
+	0xfd, 0x8e, 0xc2, 0x28, 0x69, 0x90, 0xec, 0xd6, 0xaa, 0xce, 0x4e, 0x76, 0x53, 0xb8, 0x20, 0x59,
+	0xfa, 0xc6, 0x94, 0x3e, 0x86, 0xc2, 0x6c, 0x5b, 0x3e, 0xcd, 0x8a, 0xeb, 0x9a, 0x44, 0xd9, 0x5e,
+	0xe0, 0x8c, 0x2f, 0xfa, 0x12, 0xd6, 0xe2, 0xa5, 0x50, 0x5d, 0x10, 0x10, 0x28, 0x94, 0xda, 0x32,
+	0x45, 0x7c, 0xaf, 0x0f, 0x52, 0xca, 0xdc, 0xab, 0x67, 0xc5, 0x27, 0xb5, 0x4a, 0xeb, 0xee, 0xda,
+	0x38, 0xeb, 0x37, 0xf0, 0x30, 0x39, 0x79, 0xde, 0xcd, 0xba, 0x28, 0x21, 0x55, 0x9a, 0x77, 0x96,
+	0xc6, 0x29, 0x0d, 0x28, 0xf2, 0x71, 0xf0, 0x2c, 0x2b, 0x36, 0x70, 0x2b, 0x6f, 0x2f, 0x74, 0xc7,
+	0xd7, 0x7d, 0x0d, 0x70, 0xed, 0x49, 0x6a, 0x0b, 0x83, 0xf8, 0x37, 0xa9, 0x2f, 0xd7, 0x44, 0xb7,
+	0x2b, 0xc5, 0xe3, 0xab, 0xd3, 0xba, 0xd0, 0x35, 0x7e, 0xb9, 0x50, 0x85, 0xf3, 0x0b, 0x55, 0xf8,
+	0xeb, 0x42, 0x15, 0x7e, 0xb8, 0x54, 0x73, 0xe7, 0x97, 0x6a, 0xee, 0x8f, 0x4b, 0x35, 0xf7, 0xd5,
+	0x8e, 0x83, 0xfd, 0xe1, 0x64, 0xd0, 0xb0, 0xa8, 0xab, 0xbb, 0x94, 0x20, 0x0f, 0x4f, 0x66, 0x3f,
+	0xec, 0xc9, 0x08, 0x3d, 0x27, 0x74, 0x30, 0x42, 0xfa, 0xb4, 0xa5, 0xfb, 0x47, 0x63, 0xc4, 0xe6,
+	0x4f, 0x72, 0x20, 0x06, 0xff, 0x50, 0xef, 0xfc, 0x13, 0x00, 0x00, 0xff, 0xff, 0x1e, 0x4c, 0xf5,
+	0x7e, 0x05, 0x0c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -562,9 +747,11 @@ type MsgClient interface {
 	AcceptOwnership(ctx context.Context, in *MsgAcceptOwnership, opts ...grpc.CallOption) (*MsgAcceptOwnershipResponse, error)
 	AddAdminAccount(ctx context.Context, in *MsgAddAdminAccount, opts ...grpc.CallOption) (*MsgAddAdminAccountResponse, error)
 	Ban(ctx context.Context, in *MsgBan, opts ...grpc.CallOption) (*MsgBanResponse, error)
+	BanBatch(ctx context.Context, in *MsgBanBatch, opts ...grpc.CallOption) (*MsgBanBatchResponse, error)
 	RemoveAdminAccount(ctx context.Context, in *MsgRemoveAdminAccount, opts ...grpc.CallOption) (*MsgRemoveAdminAccountResponse, error)
 	TransferOwnership(ctx context.Context, in *MsgTransferOwnership, opts ...grpc.CallOption) (*MsgTransferOwnershipResponse, error)
 	Unban(ctx context.Context, in *MsgUnban, opts ...grpc.CallOption) (*MsgUnbanResponse, error)
+	UnbanBatch(ctx context.Context, in *MsgUnbanBatch, opts ...grpc.CallOption) (*MsgUnbanBatchResponse, error)
 }
 
 type msgClient struct {
@@ -602,6 +789,15 @@ func (c *msgClient) Ban(ctx context.Context, in *MsgBan, opts ...grpc.CallOption
 	return out, nil
 }
 
+func (c *msgClient) BanBatch(ctx context.Context, in *MsgBanBatch, opts ...grpc.CallOption) (*MsgBanBatchResponse, error) {
+	out := new(MsgBanBatchResponse)
+	err := c.cc.Invoke(ctx, "/florin.blacklist.v1.Msg/BanBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) RemoveAdminAccount(ctx context.Context, in *MsgRemoveAdminAccount, opts ...grpc.CallOption) (*MsgRemoveAdminAccountResponse, error) {
 	out := new(MsgRemoveAdminAccountResponse)
 	err := c.cc.Invoke(ctx, "/florin.blacklist.v1.Msg/RemoveAdminAccount", in, out, opts...)
@@ -629,14 +825,25 @@ func (c *msgClient) Unban(ctx context.Context, in *MsgUnban, opts ...grpc.CallOp
 	return out, nil
 }
 
+func (c *msgClient) UnbanBatch(ctx context.Context, in *MsgUnbanBatch, opts ...grpc.CallOption) (*MsgUnbanBatchResponse, error) {
+	out := new(MsgUnbanBatchResponse)
+	err := c.cc.Invoke(ctx, "/florin.blacklist.v1.Msg/UnbanBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	AcceptOwnership(context.Context, *MsgAcceptOwnership) (*MsgAcceptOwnershipResponse, error)
 	AddAdminAccount(context.Context, *MsgAddAdminAccount) (*MsgAddAdminAccountResponse, error)
 	Ban(context.Context, *MsgBan) (*MsgBanResponse, error)
+	BanBatch(context.Context, *MsgBanBatch) (*MsgBanBatchResponse, error)
 	RemoveAdminAccount(context.Context, *MsgRemoveAdminAccount) (*MsgRemoveAdminAccountResponse, error)
 	TransferOwnership(context.Context, *MsgTransferOwnership) (*MsgTransferOwnershipResponse, error)
 	Unban(context.Context, *MsgUnban) (*MsgUnbanResponse, error)
+	UnbanBatch(context.Context, *MsgUnbanBatch) (*MsgUnbanBatchResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -652,6 +859,9 @@ func (*UnimplementedMsgServer) AddAdminAccount(ctx context.Context, req *MsgAddA
 func (*UnimplementedMsgServer) Ban(ctx context.Context, req *MsgBan) (*MsgBanResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Ban not implemented")
 }
+func (*UnimplementedMsgServer) BanBatch(ctx context.Context, req *MsgBanBatch) (*MsgBanBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BanBatch not implemented")
+}
 func (*UnimplementedMsgServer) RemoveAdminAccount(ctx context.Context, req *MsgRemoveAdminAccount) (*MsgRemoveAdminAccountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveAdminAccount not implemented")
 }
@@ -661,6 +871,9 @@ func (*UnimplementedMsgServer) TransferOwnership(ctx context.Context, req *MsgTr
 func (*UnimplementedMsgServer) Unban(ctx context.Context, req *MsgUnban) (*MsgUnbanResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Unban not implemented")
 }
+func (*UnimplementedMsgServer) UnbanBatch(ctx context.Context, req *MsgUnbanBatch) (*MsgUnbanBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnbanBatch not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -720,6 +933,24 @@ func _Msg_Ban_Handler(srv interface{}, ctx context.Context, dec func(interface{}
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_BanBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBanBatch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BanBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/florin.blacklist.v1.Msg/BanBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BanBatch(ctx, req.(*MsgBanBatch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_RemoveAdminAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgRemoveAdminAccount)
 	if err := dec(in); err != nil {
@@ -774,6 +1005,24 @@ func _Msg_Unban_Handler(srv interface{}, ctx context.Context, dec func(interface
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UnbanBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnbanBatch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnbanBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/florin.blacklist.v1.Msg/UnbanBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnbanBatch(ctx, req.(*MsgUnbanBatch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "florin.blacklist.v1.Msg",
@@ -791,6 +1040,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Ban",
 			Handler:    _Msg_Ban_Handler,
 		},
+		{
+			MethodName: "BanBatch",
+			Handler:    _Msg_BanBatch_Handler,
+		},
 		{
 			MethodName: "RemoveAdminAccount",
 			Handler:    _Msg_RemoveAdminAccount_Handler,
@@ -803,6 +1056,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Unban",
 			Handler:    _Msg_Unban_Handler,
 		},
+		{
+			MethodName: "UnbanBatch",
+			Handler:    _Msg_UnbanBatch_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "florin/blacklist/v1/tx.proto",
@@ -881,6 +1138,13 @@ func (m *MsgAddAdminAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Account) > 0 {
 		i -= len(m.Account)
 		copy(dAtA[i:], m.Account)
@@ -941,6 +1205,35 @@ func (m *MsgBan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.CaseId) > 0 {
+		i -= len(m.CaseId)
+		copy(dAtA[i:], m.CaseId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CaseId)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Reason != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Reason))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ExpiryTime != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExpiryTime))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Adversary) > 0 {
 		i -= len(m.Adversary)
 		copy(dAtA[i:], m.Adversary)
@@ -981,6 +1274,97 @@ func (m *MsgBanResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgBanBatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBanBatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBanBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CaseId) > 0 {
+		i -= len(m.CaseId)
+		copy(dAtA[i:], m.CaseId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CaseId)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Reason != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Reason))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ExpiryTime != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExpiryTime))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Adversaries) > 0 {
+		for iNdEx := len(m.Adversaries) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Adversaries[iNdEx])
+			copy(dAtA[i:], m.Adversaries[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Adversaries[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBanBatchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBanBatchResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBanBatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgRemoveAdminAccount) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1001,6 +1385,13 @@ func (m *MsgRemoveAdminAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Account) > 0 {
 		i -= len(m.Account)
 		copy(dAtA[i:], m.Account)
@@ -1121,6 +1512,13 @@ func (m *MsgUnban) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Friend) > 0 {
 		i -= len(m.Friend)
 		copy(dAtA[i:], m.Friend)
@@ -1161,46 +1559,115 @@ func (m *MsgUnbanResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *MsgAcceptOwnership) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgUnbanBatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgAcceptOwnershipResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgUnbanBatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgAddAdminAccount) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgUnbanBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Signer)
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Friends) > 0 {
+		for iNdEx := len(m.Friends) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Friends[iNdEx])
+			copy(dAtA[i:], m.Friends[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Friends[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnbanBatchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnbanBatchResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnbanBatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgAcceptOwnership) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAcceptOwnershipResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddAdminAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
@@ -1208,6 +1675,10 @@ func (m *MsgAddAdminAccount) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -1234,6 +1705,23 @@ func (m *MsgBan) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovTx(uint64(m.ExpiryHeight))
+	}
+	if m.ExpiryTime != 0 {
+		n += 1 + sovTx(uint64(m.ExpiryTime))
+	}
+	if m.Reason != 0 {
+		n += 1 + sovTx(uint64(m.Reason))
+	}
+	l = len(m.CaseId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -1246,6 +1734,51 @@ func (m *MsgBanResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgBanBatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Adversaries) > 0 {
+		for _, s := range m.Adversaries {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovTx(uint64(m.ExpiryHeight))
+	}
+	if m.ExpiryTime != 0 {
+		n += 1 + sovTx(uint64(m.ExpiryTime))
+	}
+	if m.Reason != 0 {
+		n += 1 + sovTx(uint64(m.Reason))
+	}
+	l = len(m.CaseId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgBanBatchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgRemoveAdminAccount) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1260,6 +1793,10 @@ func (m *MsgRemoveAdminAccount) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -1312,6 +1849,10 @@ func (m *MsgUnban) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -1324,6 +1865,38 @@ func (m *MsgUnbanResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgUnbanBatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Friends) > 0 {
+		for _, s := range m.Friends {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUnbanBatchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1485,10 +2058,491 @@ func (m *MsgAddAdminAccount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddAdminAccount: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddAdminAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddAdminAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddAdminAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddAdminAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddAdminAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBan) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBan: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBan: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Adversary", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Adversary = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryTime", wireType)
+			}
+			m.ExpiryTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			m.Reason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reason |= BanReason(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CaseId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CaseId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBanResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBanResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBanResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBanBatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBanBatch: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddAdminAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBanBatch: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1525,7 +2579,7 @@ func (m *MsgAddAdminAccount) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Adversaries", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1553,7 +2607,128 @@ func (m *MsgAddAdminAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Account = string(dAtA[iNdEx:postIndex])
+			m.Adversaries = append(m.Adversaries, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryTime", wireType)
+			}
+			m.ExpiryTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			m.Reason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reason |= BanReason(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CaseId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CaseId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1576,7 +2751,7 @@ func (m *MsgAddAdminAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddAdminAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgBanBatchResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1599,10 +2774,10 @@ func (m *MsgAddAdminAccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddAdminAccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBanBatchResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddAdminAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBanBatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1626,7 +2801,7 @@ func (m *MsgAddAdminAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBan) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveAdminAccount) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1649,10 +2824,10 @@ func (m *MsgBan) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBan: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveAdminAccount: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBan: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveAdminAccount: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1689,7 +2864,7 @@ func (m *MsgBan) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Adversary", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1717,7 +2892,39 @@ func (m *MsgBan) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Adversary = string(dAtA[iNdEx:postIndex])
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1740,7 +2947,7 @@ func (m *MsgBan) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBanResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveAdminAccountResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1763,10 +2970,10 @@ func (m *MsgBanResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBanResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveAdminAccountResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBanResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveAdminAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1790,7 +2997,7 @@ func (m *MsgBanResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveAdminAccount) Unmarshal(dAtA []byte) error {
+func (m *MsgTransferOwnership) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1813,10 +3020,10 @@ func (m *MsgRemoveAdminAccount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveAdminAccount: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTransferOwnership: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveAdminAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTransferOwnership: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1853,7 +3060,7 @@ func (m *MsgRemoveAdminAccount) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1881,7 +3088,7 @@ func (m *MsgRemoveAdminAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Account = string(dAtA[iNdEx:postIndex])
+			m.NewOwner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1904,7 +3111,7 @@ func (m *MsgRemoveAdminAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveAdminAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgTransferOwnershipResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1927,10 +3134,10 @@ func (m *MsgRemoveAdminAccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveAdminAccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTransferOwnershipResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveAdminAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTransferOwnershipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1954,7 +3161,7 @@ func (m *MsgRemoveAdminAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgTransferOwnership) Unmarshal(dAtA []byte) error {
+func (m *MsgUnban) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1977,10 +3184,10 @@ func (m *MsgTransferOwnership) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTransferOwnership: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnban: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTransferOwnership: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnban: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2017,7 +3224,7 @@ func (m *MsgTransferOwnership) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Friend", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2045,7 +3252,39 @@ func (m *MsgTransferOwnership) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewOwner = string(dAtA[iNdEx:postIndex])
+			m.Friend = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2068,7 +3307,7 @@ func (m *MsgTransferOwnership) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgTransferOwnershipResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUnbanResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2091,10 +3330,10 @@ func (m *MsgTransferOwnershipResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTransferOwnershipResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnbanResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTransferOwnershipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnbanResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2118,7 +3357,7 @@ func (m *MsgTransferOwnershipResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUnban) Unmarshal(dAtA []byte) error {
+func (m *MsgUnbanBatch) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2141,10 +3380,10 @@ func (m *MsgUnban) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUnban: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnbanBatch: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUnban: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnbanBatch: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2181,7 +3420,7 @@ func (m *MsgUnban) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Friend", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Friends", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2209,7 +3448,39 @@ func (m *MsgUnban) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Friend = string(dAtA[iNdEx:postIndex])
+			m.Friends = append(m.Friends, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2232,7 +3503,7 @@ func (m *MsgUnban) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUnbanResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUnbanBatchResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2255,10 +3526,10 @@ func (m *MsgUnbanResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUnbanResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnbanBatchResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUnbanResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnbanBatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -27,16 +27,38 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 
 	cdc.RegisterConcrete(&MsgAcceptOwnership{}, "florin/AcceptOwnership", nil)
 	cdc.RegisterConcrete(&MsgAddAdminAccount{}, "florin/AddAdminAccount", nil)
+	cdc.RegisterConcrete(&MsgAddAllowlistAdmin{}, "florin/AddAllowlistAdmin", nil)
 	cdc.RegisterConcrete(&MsgAddSystemAccount{}, "florin/AddSystemAccount", nil)
+	cdc.RegisterConcrete(&MsgAddToAllowlist{}, "florin/AddToAllowlist", nil)
 	cdc.RegisterConcrete(&MsgAllowDenom{}, "florin/AllowDenom", nil)
+	cdc.RegisterConcrete(&MsgApproveMint{}, "florin/ApproveMint", nil)
 	cdc.RegisterConcrete(&MsgBurn{}, "florin/Burn", nil)
+	cdc.RegisterConcrete(&MsgCancelMint{}, "florin/CancelMint", nil)
+	cdc.RegisterConcrete(&MsgDeprecateLegacySignatures{}, "florin/DeprecateLegacySignatures", nil)
 	cdc.RegisterConcrete(&MsgMint{}, "florin/Mint", nil)
+	cdc.RegisterConcrete(&MsgMintBatch{}, "florin/MintBatch", nil)
+	cdc.RegisterConcrete(&MsgPause{}, "florin/Pause", nil)
 	cdc.RegisterConcrete(&MsgRecover{}, "florin/Recover", nil)
+	cdc.RegisterConcrete(&MsgRedeem{}, "florin/Redeem", nil)
+	cdc.RegisterConcrete(&MsgRemoveAccountLimit{}, "florin/RemoveAccountLimit", nil)
 	cdc.RegisterConcrete(&MsgRemoveAdminAccount{}, "florin/RemoveAdminAccount", nil)
+	cdc.RegisterConcrete(&MsgRemoveAllowlistAdmin{}, "florin/RemoveAllowlistAdmin", nil)
+	cdc.RegisterConcrete(&MsgRemoveFromAllowlist{}, "florin/RemoveFromAllowlist", nil)
+	cdc.RegisterConcrete(&MsgRemoveRateLimit{}, "florin/RemoveRateLimit", nil)
 	cdc.RegisterConcrete(&MsgRemoveSystemAccount{}, "florin/RemoveSystemAccount", nil)
+	cdc.RegisterConcrete(&MsgSeize{}, "florin/Seize", nil)
+	cdc.RegisterConcrete(&MsgSetAccountLimit{}, "florin/SetAccountLimit", nil)
 	cdc.RegisterConcrete(&MsgSetMaxMintAllowance{}, "florin/SetMaxMintAllowance", nil)
 	cdc.RegisterConcrete(&MsgSetMintAllowance{}, "florin/SetMintAllowance", nil)
+	cdc.RegisterConcrete(&MsgSetMintApprovalPolicy{}, "florin/SetMintApprovalPolicy", nil)
+	cdc.RegisterConcrete(&MsgSetPauser{}, "florin/SetPauser", nil)
+	cdc.RegisterConcrete(&MsgSetRateLimit{}, "florin/SetRateLimit", nil)
+	cdc.RegisterConcrete(&MsgSetSeizer{}, "florin/SetSeizer", nil)
+	cdc.RegisterConcrete(&MsgSetSupplyCap{}, "florin/SetSupplyCap", nil)
+	cdc.RegisterConcrete(&MsgSetTransferPolicy{}, "florin/SetTransferPolicy", nil)
 	cdc.RegisterConcrete(&MsgTransferOwnership{}, "florin/TransferOwnership", nil)
+	cdc.RegisterConcrete(&MsgUnpause{}, "florin/Unpause", nil)
+	cdc.RegisterConcrete(&MsgWipe{}, "florin/Wipe", nil)
 }
 
 func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
@@ -44,16 +66,38 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgAcceptOwnership{})
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgAddAdminAccount{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgAddAllowlistAdmin{})
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgAddSystemAccount{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgAddToAllowlist{})
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgAllowDenom{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgApproveMint{})
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgBurn{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgCancelMint{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgDeprecateLegacySignatures{})
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgMint{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgMintBatch{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgPause{})
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgRecover{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgRedeem{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgRemoveAccountLimit{})
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgRemoveAdminAccount{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgRemoveAllowlistAdmin{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgRemoveFromAllowlist{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgRemoveRateLimit{})
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgRemoveSystemAccount{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgSeize{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgSetAccountLimit{})
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgSetMaxMintAllowance{})
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgSetMintAllowance{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgSetMintApprovalPolicy{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgSetPauser{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgSetRateLimit{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgSetSeizer{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgSetSupplyCap{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgSetTransferPolicy{})
 	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgTransferOwnership{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgUnpause{})
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgWipe{})
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -31,4 +31,34 @@ var (
 	ErrInsufficientAllowance = errors.Register(ModuleName, 12, "insufficient allowance")
 	ErrInvalidPubKey         = errors.Register(ModuleName, 13, "invalid public key")
 	ErrInvalidSignature      = errors.Register(ModuleName, 14, "invalid signature")
+	ErrInvalidPauser         = errors.Register(ModuleName, 15, "signer is not owner or pauser")
+	ErrPaused                = errors.Register(ModuleName, 16, "denom is paused")
+	ErrNotPaused             = errors.Register(ModuleName, 17, "denom is not paused")
+	ErrSignatureExpired      = errors.Register(ModuleName, 18, "signature has expired")
+	ErrLegacySignature       = errors.Register(ModuleName, 19, "legacy signatures are deprecated")
+	ErrInvalidRateLimit      = errors.Register(ModuleName, 20, "invalid rate limit")
+	ErrNoRateLimit           = errors.Register(ModuleName, 21, "there is no rate limit")
+	ErrRateLimitExceeded     = errors.Register(ModuleName, 22, "mint rate limit exceeded")
+	ErrInvalidSupplyCap      = errors.Register(ModuleName, 23, "supply cap cannot be negative")
+	ErrSupplyCapExceeded     = errors.Register(ModuleName, 24, "supply cap exceeded")
+	ErrInvalidApprovalPolicy = errors.Register(ModuleName, 25, "invalid mint approval policy")
+	ErrNoPendingMint         = errors.Register(ModuleName, 26, "there is no pending mint")
+	ErrPendingMintExpired    = errors.Register(ModuleName, 27, "pending mint has expired")
+	ErrInvalidApprover       = errors.Register(ModuleName, 28, "signer cannot approve this mint")
+	ErrInvalidCanceller      = errors.Register(ModuleName, 29, "signer is not the minting system or owner")
+	ErrInvalidReference      = errors.Register(ModuleName, 30, "invalid reference")
+	ErrDuplicateReference    = errors.Register(ModuleName, 31, "reference has already been used")
+	ErrInvalidBatch          = errors.Register(ModuleName, 32, "invalid batch")
+	ErrInvalidAmount         = errors.Register(ModuleName, 33, "amount must be positive")
+	ErrInvalidSeizer         = errors.Register(ModuleName, 34, "signer is not seizer")
+	ErrNotBanned             = errors.Register(ModuleName, 35, "address is not banned")
+	ErrInvalidAddress        = errors.Register(ModuleName, 36, "invalid address")
+	ErrMalformedDenom        = errors.Register(ModuleName, 37, "malformed denom")
+	ErrInvalidRecipient      = errors.Register(ModuleName, 38, "invalid recipient")
+	ErrComplianceOverride    = errors.Register(ModuleName, 39, "compliance override is required")
+	ErrInvalidAllowlistAdmin = errors.Register(ModuleName, 40, "signer is not an allowlist admin")
+	ErrInvalidTransferPolicy = errors.Register(ModuleName, 41, "invalid transfer policy")
+	ErrInvalidAccountLimit   = errors.Register(ModuleName, 42, "invalid account limit")
+	ErrNoAccountLimit        = errors.Register(ModuleName, 43, "there is no account limit")
+	ErrAccountLimitExceeded  = errors.Register(ModuleName, 44, "account limit exceeded")
 )
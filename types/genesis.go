@@ -37,6 +37,16 @@ func (gs *GenesisState) Validate(cdc address.Codec) error {
 	if err := gs.BlacklistState.Validate(cdc); err != nil {
 		return err
 	}
+	for _, admin := range gs.BlacklistState.DenomAdmins {
+		if !slices.Contains(gs.AllowedDenoms, admin.Denom) {
+			return fmt.Errorf("found a blacklist admin account (%s) for a not allowed denom %s", admin.Address, admin.Denom)
+		}
+	}
+	for _, adversary := range gs.BlacklistState.DenomAdversaries {
+		if !slices.Contains(gs.AllowedDenoms, adversary.Denom) {
+			return fmt.Errorf("found an adversary (%s) for a not allowed denom %s", adversary.Address, adversary.Denom)
+		}
+	}
 
 	for denom, owner := range gs.Owners {
 		if !slices.Contains(gs.AllowedDenoms, denom) {
@@ -98,5 +108,194 @@ func (gs *GenesisState) Validate(cdc address.Codec) error {
 		}
 	}
 
+	for _, denom := range gs.PausedDenoms {
+		if !slices.Contains(gs.AllowedDenoms, denom) {
+			return fmt.Errorf("found a paused denom %s that is not allowed", denom)
+		}
+	}
+
+	for denom, pauser := range gs.Pausers {
+		if !slices.Contains(gs.AllowedDenoms, denom) {
+			return fmt.Errorf("found a pauser (%s) for a not allowed denom %s", pauser, denom)
+		}
+
+		if _, err := cdc.StringToBytes(pauser); err != nil {
+			return fmt.Errorf("invalid pauser address (%s) for denom %s: %s", pauser, denom, err)
+		}
+	}
+
+	for denom, seizer := range gs.Seizers {
+		if !slices.Contains(gs.AllowedDenoms, denom) {
+			return fmt.Errorf("found a seizer (%s) for a not allowed denom %s", seizer, denom)
+		}
+
+		if _, err := cdc.StringToBytes(seizer); err != nil {
+			return fmt.Errorf("invalid seizer address (%s) for denom %s: %s", seizer, denom, err)
+		}
+	}
+
+	for address := range gs.Nonces {
+		if _, err := cdc.StringToBytes(address); err != nil {
+			return fmt.Errorf("invalid nonce address (%s): %s", address, err)
+		}
+	}
+
+	rateLimits := make(map[string]bool)
+	for _, rateLimit := range gs.RateLimits {
+		if !slices.Contains(gs.AllowedDenoms, rateLimit.Denom) {
+			return fmt.Errorf("found a rate limit for a not allowed denom %s", rateLimit.Denom)
+		}
+
+		if err := rateLimit.Validate(cdc); err != nil {
+			return fmt.Errorf("invalid rate limit for denom %s: %s", rateLimit.Denom, err)
+		}
+
+		rateLimits[rateLimit.Denom+"/"+rateLimit.Address] = true
+	}
+
+	for _, usage := range gs.RateLimitUsages {
+		if !rateLimits[usage.Denom+"/"+usage.Address] {
+			return fmt.Errorf("found a rate limit usage without a rate limit for denom %s", usage.Denom)
+		}
+
+		if usage.Used.IsNil() || usage.Used.IsNegative() {
+			return fmt.Errorf("invalid rate limit usage (%s) for denom %s", usage.Used, usage.Denom)
+		}
+
+		for _, bucket := range usage.Buckets {
+			if bucket.Amount.IsNil() || !bucket.Amount.IsPositive() {
+				return fmt.Errorf("invalid rate limit bucket (%s) for denom %s", bucket.Amount, usage.Denom)
+			}
+		}
+	}
+
+	for denom, supplyCap := range gs.SupplyCaps {
+		if !slices.Contains(gs.AllowedDenoms, denom) {
+			return fmt.Errorf("found a supply cap (%s) for a not allowed denom %s", supplyCap, denom)
+		}
+
+		if amount, ok := math.NewIntFromString(supplyCap); !ok || amount.IsNegative() {
+			return fmt.Errorf("invalid supply cap (%s) for denom %s", supplyCap, denom)
+		}
+	}
+
+	for _, policy := range gs.MintApprovalPolicies {
+		if !slices.Contains(gs.AllowedDenoms, policy.Denom) {
+			return fmt.Errorf("found a mint approval policy for a not allowed denom %s", policy.Denom)
+		}
+
+		if err := policy.Validate(); err != nil {
+			return fmt.Errorf("invalid mint approval policy for denom %s: %s", policy.Denom, err)
+		}
+	}
+
+	for _, pendingMint := range gs.PendingMints {
+		if !slices.Contains(gs.AllowedDenoms, pendingMint.Denom) {
+			return fmt.Errorf("found a pending mint (%d) for a not allowed denom %s", pendingMint.Id, pendingMint.Denom)
+		}
+
+		if pendingMint.Id == 0 || pendingMint.Id >= gs.NextPendingMintId {
+			return fmt.Errorf("invalid pending mint id (%d), next id is %d", pendingMint.Id, gs.NextPendingMintId)
+		}
+
+		if _, err := cdc.StringToBytes(pendingMint.System); err != nil {
+			return fmt.Errorf("invalid pending mint system address (%s): %s", pendingMint.System, err)
+		}
+
+		if _, err := cdc.StringToBytes(pendingMint.To); err != nil {
+			return fmt.Errorf("invalid pending mint recipient address (%s): %s", pendingMint.To, err)
+		}
+
+		if pendingMint.Amount.IsNil() || !pendingMint.Amount.IsPositive() {
+			return fmt.Errorf("invalid pending mint amount (%s) for id %d", pendingMint.Amount, pendingMint.Id)
+		}
+	}
+
+	references := make(map[[2]string]bool)
+	for _, reference := range gs.References {
+		if !slices.Contains(gs.AllowedDenoms, reference.Denom) {
+			return fmt.Errorf("found a reference (%s) for a not allowed denom %s", reference.Reference, reference.Denom)
+		}
+
+		if err := ValidateReference(reference.Reference); err != nil {
+			return fmt.Errorf("invalid reference (%s) for denom %s: %s", reference.Reference, reference.Denom, err)
+		}
+
+		key := [2]string{reference.Denom, reference.Reference}
+		if references[key] {
+			return fmt.Errorf("found a duplicate reference (%s) for denom %s", reference.Reference, reference.Denom)
+		}
+		references[key] = true
+
+		if _, err := cdc.StringToBytes(reference.System); err != nil {
+			return fmt.Errorf("invalid reference system address (%s): %s", reference.System, err)
+		}
+
+		if _, err := cdc.StringToBytes(reference.Account); err != nil {
+			return fmt.Errorf("invalid reference account address (%s): %s", reference.Account, err)
+		}
+	}
+
+	for denom, policy := range gs.TransferPolicies {
+		if !slices.Contains(gs.AllowedDenoms, denom) {
+			return fmt.Errorf("found a transfer policy for a not allowed denom %s", denom)
+		}
+
+		if err := policy.Validate(); err != nil {
+			return fmt.Errorf("invalid transfer policy for denom %s: %s", denom, err)
+		}
+	}
+
+	for _, admin := range gs.AllowlistAdmins {
+		if !slices.Contains(gs.AllowedDenoms, admin.Denom) {
+			return fmt.Errorf("found an allowlist admin account (%s) for a not allowed denom %s", admin.Address, admin.Denom)
+		}
+
+		if _, err := cdc.StringToBytes(admin.Address); err != nil {
+			return fmt.Errorf("invalid allowlist admin address (%s) for denom %s: %s", admin.Address, admin.Denom, err)
+		}
+	}
+
+	for _, account := range gs.Allowlist {
+		if !slices.Contains(gs.AllowedDenoms, account.Denom) {
+			return fmt.Errorf("found an allowlisted account (%s) for a not allowed denom %s", account.Address, account.Denom)
+		}
+
+		if _, err := cdc.StringToBytes(account.Address); err != nil {
+			return fmt.Errorf("invalid allowlisted address (%s) for denom %s: %s", account.Address, account.Denom, err)
+		}
+	}
+
+	accountLimits := make(map[[2]string]bool)
+	for _, limit := range gs.AccountLimits {
+		if !slices.Contains(gs.AllowedDenoms, limit.Denom) {
+			return fmt.Errorf("found an account limit for a not allowed denom %s", limit.Denom)
+		}
+
+		if err := limit.Validate(cdc); err != nil {
+			return fmt.Errorf("invalid account limit for denom %s: %s", limit.Denom, err)
+		}
+
+		key := [2]string{limit.Denom, limit.Address}
+		if accountLimits[key] {
+			return fmt.Errorf("found a duplicate account limit (%s) for denom %s", limit.Address, limit.Denom)
+		}
+		accountLimits[key] = true
+	}
+
+	for _, outflow := range gs.AccountOutflows {
+		if !slices.Contains(gs.AllowedDenoms, outflow.Denom) {
+			return fmt.Errorf("found an account outflow (%s) for a not allowed denom %s", outflow.Address, outflow.Denom)
+		}
+
+		if _, err := cdc.StringToBytes(outflow.Address); err != nil {
+			return fmt.Errorf("invalid account outflow address (%s) for denom %s: %s", outflow.Address, outflow.Denom, err)
+		}
+
+		if outflow.Used.IsNil() || outflow.Used.IsNegative() {
+			return fmt.Errorf("invalid account outflow (%s) for denom %s", outflow.Used, outflow.Denom)
+		}
+	}
+
 	return nil
 }
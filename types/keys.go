@@ -14,6 +14,8 @@
 
 package types
 
+import "cosmossdk.io/collections"
+
 const ModuleName = "florin"
 
 var (
@@ -24,16 +26,31 @@ var (
 	AdminPrefix            = []byte("admin/")
 	MintAllowancePrefix    = []byte("mint_allowance/")
 	MaxMintAllowancePrefix = []byte("max_mint_allowance/")
-)
+	PausedPrefix           = []byte("paused/")
+	PauserPrefix           = []byte("pauser/")
+	SeizerPrefix           = []byte("seizer/")
+	NoncePrefix            = []byte("nonce/")
+	RateLimitPrefix        = []byte("rate_limit/")
+	RateLimitUsagePrefix   = []byte("rate_limit_usage/")
+	SupplyCapPrefix        = []byte("supply_cap/")
+
+	MintApprovalPolicyPrefix = []byte("mint_approval_policy/")
+	PendingMintPrefix        = []byte("pending_mint/")
+	PendingMintExpiryPrefix  = []byte("pending_mint_expiry/")
+	NextPendingMintIDKey     = []byte("next_pending_mint_id")
+	ReferencePrefix          = []byte("reference/")
 
-func SystemKey(denom string, address string) []byte {
-	return append([]byte(denom), []byte(address)...)
-}
+	TransferPolicyPrefix = []byte("transfer_policy/")
+	AllowlistAdminPrefix = []byte("allowlist_admin/")
+	AllowlistPrefix      = []byte("allowlist/")
 
-func AdminKey(denom string, address string) []byte {
-	return append([]byte(denom), []byte(address)...)
-}
+	AccountLimitPrefix   = []byte("account_limit/")
+	AccountOutflowPrefix = []byte("account_outflow/")
+
+	LegacySignaturesDeprecatedKey = []byte("legacy_signatures_deprecated")
+)
 
-func MintAllowanceKey(denom string, address string) []byte {
-	return append([]byte(denom), []byte(address)...)
-}
+// AccountKeyCodec encodes the denom and address bytes of systems, admins and
+// mint allowances. The denom is delimited, so that a denom that is a prefix of
+// another denom can't be confused with it when iterating.
+var AccountKeyCodec = collections.PairKeyCodec(collections.StringKey, collections.BytesKey)
@@ -0,0 +1,490 @@
+// Copyright 2024 Monerium ehf.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	_ sdk.HasValidateBasic = &MsgAcceptOwnership{}
+	_ sdk.HasValidateBasic = &MsgAddAdminAccount{}
+	_ sdk.HasValidateBasic = &MsgAddAllowlistAdmin{}
+	_ sdk.HasValidateBasic = &MsgAddSystemAccount{}
+	_ sdk.HasValidateBasic = &MsgAddToAllowlist{}
+	_ sdk.HasValidateBasic = &MsgAllowDenom{}
+	_ sdk.HasValidateBasic = &MsgApproveMint{}
+	_ sdk.HasValidateBasic = &MsgBurn{}
+	_ sdk.HasValidateBasic = &MsgCancelMint{}
+	_ sdk.HasValidateBasic = &MsgDeprecateLegacySignatures{}
+	_ sdk.HasValidateBasic = &MsgMint{}
+	_ sdk.HasValidateBasic = &MsgMintBatch{}
+	_ sdk.HasValidateBasic = &MsgPause{}
+	_ sdk.HasValidateBasic = &MsgRecover{}
+	_ sdk.HasValidateBasic = &MsgRedeem{}
+	_ sdk.HasValidateBasic = &MsgRemoveAccountLimit{}
+	_ sdk.HasValidateBasic = &MsgRemoveAdminAccount{}
+	_ sdk.HasValidateBasic = &MsgRemoveAllowlistAdmin{}
+	_ sdk.HasValidateBasic = &MsgRemoveFromAllowlist{}
+	_ sdk.HasValidateBasic = &MsgRemoveRateLimit{}
+	_ sdk.HasValidateBasic = &MsgRemoveSystemAccount{}
+	_ sdk.HasValidateBasic = &MsgSeize{}
+	_ sdk.HasValidateBasic = &MsgSetAccountLimit{}
+	_ sdk.HasValidateBasic = &MsgSetMaxMintAllowance{}
+	_ sdk.HasValidateBasic = &MsgSetMintAllowance{}
+	_ sdk.HasValidateBasic = &MsgSetMintApprovalPolicy{}
+	_ sdk.HasValidateBasic = &MsgSetPauser{}
+	_ sdk.HasValidateBasic = &MsgSetRateLimit{}
+	_ sdk.HasValidateBasic = &MsgSetSeizer{}
+	_ sdk.HasValidateBasic = &MsgSetSupplyCap{}
+	_ sdk.HasValidateBasic = &MsgSetTransferPolicy{}
+	_ sdk.HasValidateBasic = &MsgTransferOwnership{}
+	_ sdk.HasValidateBasic = &MsgUnpause{}
+	_ sdk.HasValidateBasic = &MsgWipe{}
+)
+
+// The Validate methods below perform the stateless validation of messages.
+// They are run as part of ValidateBasic, using the account address prefix of
+// the chain, and again by the message server using the keeper's address codec.
+
+func (msg *MsgAcceptOwnership) Validate(cdc address.Codec) error {
+	return validateDenomAndSigner(cdc, msg.Denom, msg.Signer)
+}
+
+func (msg *MsgAddAdminAccount) Validate(cdc address.Codec) error {
+	if err := validateDenomAndSigner(cdc, msg.Denom, msg.Signer); err != nil {
+		return err
+	}
+	return validateAddress(cdc, "account", msg.Account)
+}
+
+func (msg *MsgAddAllowlistAdmin) Validate(cdc address.Codec) error {
+	if err := validateDenomAndSigner(cdc, msg.Denom, msg.Signer); err != nil {
+		return err
+	}
+	return validateAddress(cdc, "account", msg.Account)
+}
+
+func (msg *MsgAddSystemAccount) Validate(cdc address.Codec) error {
+	if err := validateDenomAndSigner(cdc, msg.Denom, msg.Signer); err != nil {
+		return err
+	}
+	return validateAddress(cdc, "account", msg.Account)
+}
+
+func (msg *MsgAddToAllowlist) Validate(cdc address.Codec) error {
+	if err := validateDenomAndSigner(cdc, msg.Denom, msg.Signer); err != nil {
+		return err
+	}
+	return validateAddress(cdc, "account", msg.Account)
+}
+
+func (msg *MsgAllowDenom) Validate(cdc address.Codec) error {
+	if err := validateDenomAndSigner(cdc, msg.Denom, msg.Signer); err != nil {
+		return err
+	}
+	return validateAddress(cdc, "owner", msg.Owner)
+}
+
+func (msg *MsgApproveMint) Validate(cdc address.Codec) error {
+	return validateDenomAndSigner(cdc, msg.Denom, msg.Signer)
+}
+
+func (msg *MsgBurn) Validate(cdc address.Codec) error {
+	if err := validateDenomAndSigner(cdc, msg.Denom, msg.Signer); err != nil {
+		return err
+	}
+	if err := validateAddress(cdc, "from", msg.From); err != nil {
+		return err
+	}
+	if err := validateAmount(msg.Amount); err != nil {
+		return err
+	}
+	if err := validateAuthorization(msg.Signature, msg.PubKey != nil, msg.ExpiryHeight); err != nil {
+		return err
+	}
+	return validateOptionalReference(msg.Reference)
+}
+
+func (msg *MsgCancelMint) Validate(cdc address.Codec) error {
+	return validateDenomAndSigner(cdc, msg.Denom, msg.Signer)
+}
+
+func (msg *MsgDeprecateLegacySignatures) Validate(cdc address.Codec) error {
+	return validateAddress(cdc, "signer", msg.Signer)
+}
+
+func (msg *MsgMint) Validate(cdc address.Codec) error {
+	if err := validateDenomAndSigner(cdc, msg.Denom, msg.Signer); err != nil {
+		return err
+	}
+	if err := validateAddress(cdc, "to", msg.To); err != nil {
+		return err
+	}
+	if err := validateAmount(msg.Amount); err != nil {
+		return err
+	}
+	return validateOptionalReference(msg.Reference)
+}
+
+func (msg *MsgMintBatch) Validate(cdc address.Codec) error {
+	if err := validateDenomAndSigner(cdc, msg.Denom, msg.Signer); err != nil {
+		return err
+	}
+	if len(msg.Recipients) == 0 {
+		return errors.Wrap(ErrInvalidBatch, "no recipients")
+	}
+	for i, recipient := range msg.Recipients {
+		if err := validateAddress(cdc, "recipient", recipient.To); err != nil {
+			return errors.Wrapf(err, "recipient %d", i)
+		}
+		if err := validateAmount(recipient.Amount); err != nil {
+			return errors.Wrapf(err, "recipient %d", i)
+		}
+		if err := validateOptionalReference(recipient.Reference); err != nil {
+			return errors.Wrapf(err, "recipient %d", i)
+		}
+	}
+	return nil
+}
+
+func (msg *MsgPause) Validate(cdc address.Codec) error {
+	return validateDenomAndSigner(cdc, msg.Denom, msg.Signer)
+}
+
+func (msg *MsgRecover) Validate(cdc address.Codec) error {
+	if err := validateDenomAndSigner(cdc, msg.Denom, msg.Signer); err != nil {
+		return err
+	}
+	if err := validateAddress(cdc, "from", msg.From); err != nil {
+		return err
+	}
+	if err := validateAddress(cdc, "to", msg.To); err != nil {
+		return err
+	}
+	if msg.From == msg.To {
+		return errors.Wrap(ErrInvalidRecipient, "cannot recover to the same address")
+	}
+	if !msg.Amount.IsNil() && msg.Amount.IsNegative() {
+		return errors.Wrapf(ErrInvalidAmount, "cannot recover %s", msg.Amount)
+	}
+	return validateAuthorization(msg.Signature, msg.PubKey != nil, msg.ExpiryHeight)
+}
+
+func (msg *MsgRedeem) Validate(cdc address.Codec) error {
+	if err := validateDenomAndSigner(cdc, msg.Denom, msg.Signer); err != nil {
+		return err
+	}
+	if err := validateAmount(msg.Amount); err != nil {
+		return err
+	}
+	return validateOptionalReference(msg.Reference)
+}
+
+func (msg *MsgRemoveAccountLimit) Validate(cdc address.Codec) error {
+	if err := validateDenomAndSigner(cdc, msg.Denom, msg.Signer); err != nil {
+		return err
+	}
+	return validateOptionalAddress(cdc, "account limit", msg.Address)
+}
+
+func (msg *MsgRemoveAdminAccount) Validate(cdc address.Codec) error {
+	if err := validateDenomAndSigner(cdc, msg.Denom, msg.Signer); err != nil {
+		return err
+	}
+	return validateAddress(cdc, "account", msg.Account)
+}
+
+func (msg *MsgRemoveAllowlistAdmin) Validate(cdc address.Codec) error {
+	if err := validateDenomAndSigner(cdc, msg.Denom, msg.Signer); err != nil {
+		return err
+	}
+	return validateAddress(cdc, "account", msg.Account)
+}
+
+func (msg *MsgRemoveFromAllowlist) Validate(cdc address.Codec) error {
+	if err := validateDenomAndSigner(cdc, msg.Denom, msg.Signer); err != nil {
+		return err
+	}
+	return validateAddress(cdc, "account", msg.Account)
+}
+
+func (msg *MsgRemoveRateLimit) Validate(cdc address.Codec) error {
+	if err := validateDenomAndSigner(cdc, msg.Denom, msg.Signer); err != nil {
+		return err
+	}
+	return validateOptionalAddress(cdc, "rate limit", msg.Address)
+}
+
+func (msg *MsgRemoveSystemAccount) Validate(cdc address.Codec) error {
+	if err := validateDenomAndSigner(cdc, msg.Denom, msg.Signer); err != nil {
+		return err
+	}
+	return validateAddress(cdc, "account", msg.Account)
+}
+
+func (msg *MsgSeize) Validate(cdc address.Codec) error {
+	if err := validateDenomAndSigner(cdc, msg.Denom, msg.Signer); err != nil {
+		return err
+	}
+	if err := validateAddress(cdc, "from", msg.From); err != nil {
+		return err
+	}
+	return validateAddress(cdc, "to", msg.To)
+}
+
+func (msg *MsgSetAccountLimit) Validate(cdc address.Codec) error {
+	if err := validateDenomAndSigner(cdc, msg.Denom, msg.Signer); err != nil {
+		return err
+	}
+	return AccountLimit{
+		Denom:           msg.Denom,
+		Address:         msg.Address,
+		MaxBalance:      msg.MaxBalance,
+		MaxTransfer:     msg.MaxTransfer,
+		MaxDailyOutflow: msg.MaxDailyOutflow,
+	}.Validate(cdc)
+}
+
+func (msg *MsgSetMaxMintAllowance) Validate(cdc address.Codec) error {
+	if err := validateDenomAndSigner(cdc, msg.Denom, msg.Signer); err != nil {
+		return err
+	}
+	if msg.Amount.IsNil() || msg.Amount.IsNegative() {
+		return ErrInvalidAllowance
+	}
+	return nil
+}
+
+func (msg *MsgSetMintAllowance) Validate(cdc address.Codec) error {
+	if err := validateDenomAndSigner(cdc, msg.Denom, msg.Signer); err != nil {
+		return err
+	}
+	if err := validateAddress(cdc, "account", msg.Account); err != nil {
+		return err
+	}
+	if msg.Amount.IsNil() || msg.Amount.IsNegative() {
+		return ErrInvalidAllowance
+	}
+	return nil
+}
+
+func (msg *MsgSetMintApprovalPolicy) Validate(cdc address.Codec) error {
+	if err := validateDenomAndSigner(cdc, msg.Denom, msg.Signer); err != nil {
+		return err
+	}
+	return MintApprovalPolicy{
+		Denom:        msg.Denom,
+		Threshold:    msg.Threshold,
+		Quorum:       msg.Quorum,
+		ExpiryBlocks: msg.ExpiryBlocks,
+	}.Validate()
+}
+
+func (msg *MsgSetPauser) Validate(cdc address.Codec) error {
+	if err := validateDenomAndSigner(cdc, msg.Denom, msg.Signer); err != nil {
+		return err
+	}
+	return validateOptionalAddress(cdc, "pauser", msg.Pauser)
+}
+
+func (msg *MsgSetRateLimit) Validate(cdc address.Codec) error {
+	if err := validateDenomAndSigner(cdc, msg.Denom, msg.Signer); err != nil {
+		return err
+	}
+	return RateLimit{
+		Denom:         msg.Denom,
+		Address:       msg.Address,
+		Amount:        msg.Amount,
+		WindowBlocks:  msg.WindowBlocks,
+		WindowSeconds: msg.WindowSeconds,
+	}.Validate(cdc)
+}
+
+func (msg *MsgSetSeizer) Validate(cdc address.Codec) error {
+	if err := validateDenomAndSigner(cdc, msg.Denom, msg.Signer); err != nil {
+		return err
+	}
+	return validateOptionalAddress(cdc, "seizer", msg.Seizer)
+}
+
+func (msg *MsgSetSupplyCap) Validate(cdc address.Codec) error {
+	if err := validateDenomAndSigner(cdc, msg.Denom, msg.Signer); err != nil {
+		return err
+	}
+	if msg.Amount.IsNil() || msg.Amount.IsNegative() {
+		return ErrInvalidSupplyCap
+	}
+	return nil
+}
+
+func (msg *MsgSetTransferPolicy) Validate(cdc address.Codec) error {
+	if err := validateDenomAndSigner(cdc, msg.Denom, msg.Signer); err != nil {
+		return err
+	}
+	return msg.Policy.Validate()
+}
+
+func (msg *MsgTransferOwnership) Validate(cdc address.Codec) error {
+	if err := validateDenomAndSigner(cdc, msg.Denom, msg.Signer); err != nil {
+		return err
+	}
+	return validateAddress(cdc, "new owner", msg.NewOwner)
+}
+
+func (msg *MsgUnpause) Validate(cdc address.Codec) error {
+	return validateDenomAndSigner(cdc, msg.Denom, msg.Signer)
+}
+
+func (msg *MsgWipe) Validate(cdc address.Codec) error {
+	if err := validateDenomAndSigner(cdc, msg.Denom, msg.Signer); err != nil {
+		return err
+	}
+	return validateAddress(cdc, "from", msg.From)
+}
+
+//
+
+func (msg *MsgAcceptOwnership) ValidateBasic() error { return msg.Validate(accountAddressCodec()) }
+
+func (msg *MsgAddAdminAccount) ValidateBasic() error { return msg.Validate(accountAddressCodec()) }
+
+func (msg *MsgAddAllowlistAdmin) ValidateBasic() error { return msg.Validate(accountAddressCodec()) }
+
+func (msg *MsgAddSystemAccount) ValidateBasic() error { return msg.Validate(accountAddressCodec()) }
+
+func (msg *MsgAddToAllowlist) ValidateBasic() error { return msg.Validate(accountAddressCodec()) }
+
+func (msg *MsgAllowDenom) ValidateBasic() error { return msg.Validate(accountAddressCodec()) }
+
+func (msg *MsgApproveMint) ValidateBasic() error { return msg.Validate(accountAddressCodec()) }
+
+func (msg *MsgBurn) ValidateBasic() error { return msg.Validate(accountAddressCodec()) }
+
+func (msg *MsgCancelMint) ValidateBasic() error { return msg.Validate(accountAddressCodec()) }
+
+func (msg *MsgDeprecateLegacySignatures) ValidateBasic() error {
+	return msg.Validate(accountAddressCodec())
+}
+
+func (msg *MsgMint) ValidateBasic() error { return msg.Validate(accountAddressCodec()) }
+
+func (msg *MsgMintBatch) ValidateBasic() error { return msg.Validate(accountAddressCodec()) }
+
+func (msg *MsgPause) ValidateBasic() error { return msg.Validate(accountAddressCodec()) }
+
+func (msg *MsgRecover) ValidateBasic() error { return msg.Validate(accountAddressCodec()) }
+
+func (msg *MsgRedeem) ValidateBasic() error { return msg.Validate(accountAddressCodec()) }
+
+func (msg *MsgRemoveAccountLimit) ValidateBasic() error { return msg.Validate(accountAddressCodec()) }
+
+func (msg *MsgRemoveAdminAccount) ValidateBasic() error { return msg.Validate(accountAddressCodec()) }
+
+func (msg *MsgRemoveAllowlistAdmin) ValidateBasic() error { return msg.Validate(accountAddressCodec()) }
+
+func (msg *MsgRemoveFromAllowlist) ValidateBasic() error { return msg.Validate(accountAddressCodec()) }
+
+func (msg *MsgRemoveRateLimit) ValidateBasic() error { return msg.Validate(accountAddressCodec()) }
+
+func (msg *MsgRemoveSystemAccount) ValidateBasic() error { return msg.Validate(accountAddressCodec()) }
+
+func (msg *MsgSeize) ValidateBasic() error { return msg.Validate(accountAddressCodec()) }
+
+func (msg *MsgSetAccountLimit) ValidateBasic() error { return msg.Validate(accountAddressCodec()) }
+
+func (msg *MsgSetMaxMintAllowance) ValidateBasic() error { return msg.Validate(accountAddressCodec()) }
+
+func (msg *MsgSetMintAllowance) ValidateBasic() error { return msg.Validate(accountAddressCodec()) }
+
+func (msg *MsgSetMintApprovalPolicy) ValidateBasic() error {
+	return msg.Validate(accountAddressCodec())
+}
+
+func (msg *MsgSetPauser) ValidateBasic() error { return msg.Validate(accountAddressCodec()) }
+
+func (msg *MsgSetRateLimit) ValidateBasic() error { return msg.Validate(accountAddressCodec()) }
+
+func (msg *MsgSetSeizer) ValidateBasic() error { return msg.Validate(accountAddressCodec()) }
+
+func (msg *MsgSetSupplyCap) ValidateBasic() error { return msg.Validate(accountAddressCodec()) }
+
+func (msg *MsgSetTransferPolicy) ValidateBasic() error { return msg.Validate(accountAddressCodec()) }
+
+func (msg *MsgTransferOwnership) ValidateBasic() error { return msg.Validate(accountAddressCodec()) }
+
+func (msg *MsgUnpause) ValidateBasic() error { return msg.Validate(accountAddressCodec()) }
+
+func (msg *MsgWipe) ValidateBasic() error { return msg.Validate(accountAddressCodec()) }
+
+//
+
+// accountAddressCodec returns the address codec of the chain's account
+// addresses, as ValidateBasic has no access to the app's address codec.
+func accountAddressCodec() address.Codec {
+	return addresscodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+}
+
+func validateAddress(cdc address.Codec, name string, address string) error {
+	if _, err := cdc.StringToBytes(address); err != nil {
+		return errors.Wrapf(ErrInvalidAddress, "invalid %s address (%s): %s", name, address, err)
+	}
+	return nil
+}
+
+func validateOptionalAddress(cdc address.Codec, name string, address string) error {
+	if address == "" {
+		return nil
+	}
+	return validateAddress(cdc, name, address)
+}
+
+func validateDenomAndSigner(cdc address.Codec, denom string, signer string) error {
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return errors.Wrap(ErrMalformedDenom, err.Error())
+	}
+	return validateAddress(cdc, "signer", signer)
+}
+
+func validateAmount(amount math.Int) error {
+	if amount.IsNil() || !amount.IsPositive() {
+		return ErrInvalidAmount
+	}
+	return nil
+}
+
+func validateAuthorization(signature []byte, hasPubKey bool, expiryHeight int64) error {
+	if len(signature) == 0 {
+		return errors.Wrap(ErrInvalidSignature, "signature is required")
+	}
+	if !hasPubKey {
+		return ErrInvalidPubKey
+	}
+	if expiryHeight < 0 {
+		return errors.Wrap(ErrInvalidSignature, "expiry height cannot be negative")
+	}
+	return nil
+}
+
+func validateOptionalReference(reference string) error {
+	if reference == "" {
+		return nil
+	}
+	return ValidateReference(reference)
+}
@@ -0,0 +1,232 @@
+// Copyright 2024 Monerium ehf.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/math"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/monerium/module-noble/v2/types"
+	"github.com/monerium/module-noble/v2/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgValidate(t *testing.T) {
+	cdc := addresscodec.NewBech32Codec("noble")
+	account := utils.TestAccount()
+	valid, invalid := account.Address, account.Invalid
+	recipient := utils.TestAccount().Address
+	pubKey, err := codectypes.NewAnyWithValue(secp256k1.GenPrivKey().PubKey())
+	require.NoError(t, err)
+	signature := []byte("signature")
+	one := math.OneInt()
+	longReference := strings.Repeat("a", types.MaxReferenceLength+1)
+
+	testCases := []struct {
+		name string
+		msg  interface{ Validate(address.Codec) error }
+		err  error
+	}{
+		{"AcceptOwnership", &types.MsgAcceptOwnership{Denom: "ueure", Signer: valid}, nil},
+		{"AcceptOwnership with malformed denom", &types.MsgAcceptOwnership{Denom: "!", Signer: valid}, types.ErrMalformedDenom},
+		{"AcceptOwnership with invalid signer", &types.MsgAcceptOwnership{Denom: "ueure", Signer: invalid}, types.ErrInvalidAddress},
+
+		{"AddAdminAccount", &types.MsgAddAdminAccount{Denom: "ueure", Signer: valid, Account: valid}, nil},
+		{"AddAdminAccount with empty denom", &types.MsgAddAdminAccount{Signer: valid, Account: valid}, types.ErrMalformedDenom},
+		{"AddAdminAccount with invalid account", &types.MsgAddAdminAccount{Denom: "ueure", Signer: valid, Account: invalid}, types.ErrInvalidAddress},
+
+		{"AddAllowlistAdmin", &types.MsgAddAllowlistAdmin{Denom: "ugbpe", Signer: valid, Account: valid}, nil},
+		{"AddAllowlistAdmin with invalid account", &types.MsgAddAllowlistAdmin{Denom: "ugbpe", Signer: valid, Account: invalid}, types.ErrInvalidAddress},
+
+		{"AddSystemAccount", &types.MsgAddSystemAccount{Denom: "ueure", Signer: valid, Account: valid}, nil},
+		{"AddSystemAccount with invalid signer", &types.MsgAddSystemAccount{Denom: "ueure", Account: valid}, types.ErrInvalidAddress},
+		{"AddSystemAccount with invalid account", &types.MsgAddSystemAccount{Denom: "ueure", Signer: valid, Account: "account"}, types.ErrInvalidAddress},
+
+		{"AddToAllowlist", &types.MsgAddToAllowlist{Denom: "ugbpe", Signer: valid, Account: valid}, nil},
+		{"AddToAllowlist with malformed denom", &types.MsgAddToAllowlist{Denom: "!", Signer: valid, Account: valid}, types.ErrMalformedDenom},
+		{"AddToAllowlist with invalid account", &types.MsgAddToAllowlist{Denom: "ugbpe", Signer: valid, Account: invalid}, types.ErrInvalidAddress},
+
+		{"AllowDenom", &types.MsgAllowDenom{Signer: valid, Denom: "ueure", Owner: valid}, nil},
+		{"AllowDenom with malformed denom", &types.MsgAllowDenom{Signer: valid, Denom: "u", Owner: valid}, types.ErrMalformedDenom},
+		{"AllowDenom with invalid owner", &types.MsgAllowDenom{Signer: valid, Denom: "ueure", Owner: invalid}, types.ErrInvalidAddress},
+
+		{"ApproveMint", &types.MsgApproveMint{Denom: "ueure", Signer: valid, Id: 1}, nil},
+		{"ApproveMint with invalid signer", &types.MsgApproveMint{Denom: "ueure", Signer: invalid, Id: 1}, types.ErrInvalidAddress},
+
+		{"Burn", &types.MsgBurn{Denom: "ueure", Signer: valid, From: valid, Amount: one, Signature: signature, PubKey: pubKey}, nil},
+		{"Burn with invalid from", &types.MsgBurn{Denom: "ueure", Signer: valid, From: invalid, Amount: one, Signature: signature, PubKey: pubKey}, types.ErrInvalidAddress},
+		{"Burn with nil amount", &types.MsgBurn{Denom: "ueure", Signer: valid, From: valid, Signature: signature, PubKey: pubKey}, types.ErrInvalidAmount},
+		{"Burn with zero amount", &types.MsgBurn{Denom: "ueure", Signer: valid, From: valid, Amount: math.ZeroInt(), Signature: signature, PubKey: pubKey}, types.ErrInvalidAmount},
+		{"Burn with negative amount", &types.MsgBurn{Denom: "ueure", Signer: valid, From: valid, Amount: math.NewInt(-1), Signature: signature, PubKey: pubKey}, types.ErrInvalidAmount},
+		{"Burn without signature", &types.MsgBurn{Denom: "ueure", Signer: valid, From: valid, Amount: one, PubKey: pubKey}, types.ErrInvalidSignature},
+		{"Burn without pubkey", &types.MsgBurn{Denom: "ueure", Signer: valid, From: valid, Amount: one, Signature: signature}, types.ErrInvalidPubKey},
+		{"Burn with negative expiry height", &types.MsgBurn{Denom: "ueure", Signer: valid, From: valid, Amount: one, Signature: signature, PubKey: pubKey, ExpiryHeight: -1}, types.ErrInvalidSignature},
+		{"Burn with too long reference", &types.MsgBurn{Denom: "ueure", Signer: valid, From: valid, Amount: one, Signature: signature, PubKey: pubKey, Reference: longReference}, types.ErrInvalidReference},
+
+		{"CancelMint", &types.MsgCancelMint{Denom: "ueure", Signer: valid, Id: 1}, nil},
+		{"CancelMint with malformed denom", &types.MsgCancelMint{Denom: "ueure!", Signer: valid, Id: 1}, types.ErrMalformedDenom},
+
+		{"DeprecateLegacySignatures", &types.MsgDeprecateLegacySignatures{Signer: valid, Deprecated: true}, nil},
+		{"DeprecateLegacySignatures with invalid signer", &types.MsgDeprecateLegacySignatures{Signer: invalid}, types.ErrInvalidAddress},
+
+		{"Mint", &types.MsgMint{Denom: "ueure", Signer: valid, To: valid, Amount: one, Reference: "ref"}, nil},
+		{"Mint with invalid to", &types.MsgMint{Denom: "ueure", Signer: valid, To: invalid, Amount: one}, types.ErrInvalidAddress},
+		{"Mint with zero amount", &types.MsgMint{Denom: "ueure", Signer: valid, To: valid, Amount: math.ZeroInt()}, types.ErrInvalidAmount},
+		{"Mint with negative amount", &types.MsgMint{Denom: "ueure", Signer: valid, To: valid, Amount: math.NewInt(-1)}, types.ErrInvalidAmount},
+		{"Mint with too long reference", &types.MsgMint{Denom: "ueure", Signer: valid, To: valid, Amount: one, Reference: longReference}, types.ErrInvalidReference},
+
+		{"MintBatch", &types.MsgMintBatch{Denom: "ueure", Signer: valid, Recipients: []types.MintRecipient{{To: valid, Amount: one}}}, nil},
+		{"MintBatch without recipients", &types.MsgMintBatch{Denom: "ueure", Signer: valid}, types.ErrInvalidBatch},
+		{"MintBatch with invalid recipient", &types.MsgMintBatch{Denom: "ueure", Signer: valid, Recipients: []types.MintRecipient{{To: invalid, Amount: one}}}, types.ErrInvalidAddress},
+		{"MintBatch with zero amount", &types.MsgMintBatch{Denom: "ueure", Signer: valid, Recipients: []types.MintRecipient{{To: valid, Amount: math.ZeroInt()}}}, types.ErrInvalidAmount},
+		{"MintBatch with too long reference", &types.MsgMintBatch{Denom: "ueure", Signer: valid, Recipients: []types.MintRecipient{{To: valid, Amount: one, Reference: longReference}}}, types.ErrInvalidReference},
+
+		{"Pause", &types.MsgPause{Denom: "ueure", Signer: valid}, nil},
+		{"Pause with invalid signer", &types.MsgPause{Denom: "ueure", Signer: invalid}, types.ErrInvalidAddress},
+
+		{"Recover", &types.MsgRecover{Denom: "ueure", Signer: valid, From: valid, To: recipient, Signature: signature, PubKey: pubKey}, nil},
+		{"Recover with invalid from", &types.MsgRecover{Denom: "ueure", Signer: valid, From: invalid, To: recipient, Signature: signature, PubKey: pubKey}, types.ErrInvalidAddress},
+		{"Recover with invalid to", &types.MsgRecover{Denom: "ueure", Signer: valid, From: valid, To: invalid, Signature: signature, PubKey: pubKey}, types.ErrInvalidAddress},
+		{"Recover without signature", &types.MsgRecover{Denom: "ueure", Signer: valid, From: valid, To: recipient, PubKey: pubKey}, types.ErrInvalidSignature},
+		{"Recover with partial amount", &types.MsgRecover{Denom: "ueure", Signer: valid, From: valid, To: recipient, Signature: signature, PubKey: pubKey, Amount: one}, nil},
+		{"Recover to the same address", &types.MsgRecover{Denom: "ueure", Signer: valid, From: valid, To: valid, Signature: signature, PubKey: pubKey}, types.ErrInvalidRecipient},
+		{"Recover with negative amount", &types.MsgRecover{Denom: "ueure", Signer: valid, From: valid, To: recipient, Signature: signature, PubKey: pubKey, Amount: math.NewInt(-1)}, types.ErrInvalidAmount},
+		{"Recover without pubkey", &types.MsgRecover{Denom: "ueure", Signer: valid, From: valid, To: recipient, Signature: signature}, types.ErrInvalidPubKey},
+
+		{"Redeem", &types.MsgRedeem{Denom: "ueure", Signer: valid, Amount: one}, nil},
+		{"Redeem with zero amount", &types.MsgRedeem{Denom: "ueure", Signer: valid, Amount: math.ZeroInt()}, types.ErrInvalidAmount},
+		{"Redeem with too long reference", &types.MsgRedeem{Denom: "ueure", Signer: valid, Amount: one, Reference: longReference}, types.ErrInvalidReference},
+
+		{"RemoveAccountLimit", &types.MsgRemoveAccountLimit{Denom: "ueure", Signer: valid}, nil},
+		{"RemoveAccountLimit with invalid address", &types.MsgRemoveAccountLimit{Denom: "ueure", Signer: valid, Address: invalid}, types.ErrInvalidAddress},
+
+		{"RemoveAdminAccount", &types.MsgRemoveAdminAccount{Denom: "ueure", Signer: valid, Account: valid}, nil},
+		{"RemoveAdminAccount with invalid account", &types.MsgRemoveAdminAccount{Denom: "ueure", Signer: valid, Account: invalid}, types.ErrInvalidAddress},
+
+		{"RemoveAllowlistAdmin", &types.MsgRemoveAllowlistAdmin{Denom: "ugbpe", Signer: valid, Account: valid}, nil},
+		{"RemoveAllowlistAdmin with invalid signer", &types.MsgRemoveAllowlistAdmin{Denom: "ugbpe", Signer: invalid, Account: valid}, types.ErrInvalidAddress},
+
+		{"RemoveFromAllowlist", &types.MsgRemoveFromAllowlist{Denom: "ugbpe", Signer: valid, Account: valid}, nil},
+		{"RemoveFromAllowlist with invalid account", &types.MsgRemoveFromAllowlist{Denom: "ugbpe", Signer: valid, Account: invalid}, types.ErrInvalidAddress},
+
+		{"RemoveRateLimit", &types.MsgRemoveRateLimit{Denom: "ueure", Signer: valid}, nil},
+		{"RemoveRateLimit for an address", &types.MsgRemoveRateLimit{Denom: "ueure", Signer: valid, Address: valid}, nil},
+		{"RemoveRateLimit with invalid address", &types.MsgRemoveRateLimit{Denom: "ueure", Signer: valid, Address: invalid}, types.ErrInvalidAddress},
+
+		{"RemoveSystemAccount", &types.MsgRemoveSystemAccount{Denom: "ueure", Signer: valid, Account: valid}, nil},
+		{"RemoveSystemAccount with invalid account", &types.MsgRemoveSystemAccount{Denom: "ueure", Signer: valid, Account: invalid}, types.ErrInvalidAddress},
+
+		{"Seize", &types.MsgSeize{Denom: "ueure", Signer: valid, From: valid, To: valid}, nil},
+		{"Seize with invalid from", &types.MsgSeize{Denom: "ueure", Signer: valid, From: invalid, To: valid}, types.ErrInvalidAddress},
+		{"Seize with invalid to", &types.MsgSeize{Denom: "ueure", Signer: valid, From: valid, To: invalid}, types.ErrInvalidAddress},
+
+		{"SetAccountLimit", &types.MsgSetAccountLimit{Denom: "ueure", Signer: valid, Address: valid, MaxBalance: math.OneInt(), MaxTransfer: math.ZeroInt(), MaxDailyOutflow: math.ZeroInt()}, nil},
+		{"SetAccountLimit with invalid address", &types.MsgSetAccountLimit{Denom: "ueure", Signer: valid, Address: invalid, MaxBalance: math.OneInt(), MaxTransfer: math.ZeroInt(), MaxDailyOutflow: math.ZeroInt()}, types.ErrInvalidAddress},
+		{"SetAccountLimit with negative amount", &types.MsgSetAccountLimit{Denom: "ueure", Signer: valid, MaxBalance: math.ZeroInt(), MaxTransfer: math.NewInt(-1), MaxDailyOutflow: math.ZeroInt()}, types.ErrInvalidAccountLimit},
+
+		{"SetMaxMintAllowance", &types.MsgSetMaxMintAllowance{Denom: "ueure", Signer: valid, Amount: math.ZeroInt()}, nil},
+		{"SetMaxMintAllowance with negative amount", &types.MsgSetMaxMintAllowance{Denom: "ueure", Signer: valid, Amount: math.NewInt(-1)}, types.ErrInvalidAllowance},
+
+		{"SetMintAllowance", &types.MsgSetMintAllowance{Denom: "ueure", Signer: valid, Account: valid, Amount: one}, nil},
+		{"SetMintAllowance with invalid account", &types.MsgSetMintAllowance{Denom: "ueure", Signer: valid, Account: invalid, Amount: one}, types.ErrInvalidAddress},
+		{"SetMintAllowance with nil amount", &types.MsgSetMintAllowance{Denom: "ueure", Signer: valid, Account: valid}, types.ErrInvalidAllowance},
+
+		{"SetMintApprovalPolicy", &types.MsgSetMintApprovalPolicy{Denom: "ueure", Signer: valid, Threshold: one, Quorum: 1, ExpiryBlocks: 1}, nil},
+		{"SetMintApprovalPolicy disabled", &types.MsgSetMintApprovalPolicy{Denom: "ueure", Signer: valid, Threshold: math.ZeroInt()}, nil},
+		{"SetMintApprovalPolicy without quorum", &types.MsgSetMintApprovalPolicy{Denom: "ueure", Signer: valid, Threshold: one, ExpiryBlocks: 1}, types.ErrInvalidApprovalPolicy},
+
+		{"SetPauser", &types.MsgSetPauser{Denom: "ueure", Signer: valid, Pauser: valid}, nil},
+		{"SetPauser to none", &types.MsgSetPauser{Denom: "ueure", Signer: valid}, nil},
+		{"SetPauser with invalid pauser", &types.MsgSetPauser{Denom: "ueure", Signer: valid, Pauser: invalid}, types.ErrInvalidAddress},
+
+		{"SetRateLimit", &types.MsgSetRateLimit{Denom: "ueure", Signer: valid, Amount: one, WindowBlocks: 1}, nil},
+		{"SetRateLimit with invalid address", &types.MsgSetRateLimit{Denom: "ueure", Signer: valid, Address: invalid, Amount: one, WindowBlocks: 1}, types.ErrInvalidAddress},
+		{"SetRateLimit with zero amount", &types.MsgSetRateLimit{Denom: "ueure", Signer: valid, Amount: math.ZeroInt(), WindowBlocks: 1}, types.ErrInvalidRateLimit},
+		{"SetRateLimit without window", &types.MsgSetRateLimit{Denom: "ueure", Signer: valid, Amount: one}, types.ErrInvalidRateLimit},
+
+		{"SetSeizer", &types.MsgSetSeizer{Denom: "ueure", Signer: valid, Seizer: valid}, nil},
+		{"SetSeizer to none", &types.MsgSetSeizer{Denom: "ueure", Signer: valid}, nil},
+		{"SetSeizer with invalid seizer", &types.MsgSetSeizer{Denom: "ueure", Signer: valid, Seizer: invalid}, types.ErrInvalidAddress},
+
+		{"SetSupplyCap", &types.MsgSetSupplyCap{Denom: "ueure", Signer: valid, Amount: math.ZeroInt()}, nil},
+		{"SetSupplyCap with negative amount", &types.MsgSetSupplyCap{Denom: "ueure", Signer: valid, Amount: math.NewInt(-1)}, types.ErrInvalidSupplyCap},
+
+		{"SetTransferPolicy", &types.MsgSetTransferPolicy{Denom: "ugbpe", Signer: valid, Policy: types.TransferPolicyAllowlist}, nil},
+		{"SetTransferPolicy with unknown policy", &types.MsgSetTransferPolicy{Denom: "ugbpe", Signer: valid, Policy: types.TransferPolicy(99)}, types.ErrInvalidTransferPolicy},
+
+		{"TransferOwnership", &types.MsgTransferOwnership{Denom: "ueure", Signer: valid, NewOwner: valid}, nil},
+		{"TransferOwnership with invalid new owner", &types.MsgTransferOwnership{Denom: "ueure", Signer: valid, NewOwner: invalid}, types.ErrInvalidAddress},
+
+		{"Unpause", &types.MsgUnpause{Denom: "ueure", Signer: valid}, nil},
+		{"Unpause with empty denom", &types.MsgUnpause{Signer: valid}, types.ErrMalformedDenom},
+
+		{"Wipe", &types.MsgWipe{Denom: "ueure", Signer: valid, From: valid}, nil},
+		{"Wipe with invalid from", &types.MsgWipe{Denom: "ueure", Signer: valid, From: invalid}, types.ErrInvalidAddress},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.Validate(cdc)
+			if tc.err == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.err)
+			}
+		})
+	}
+}
+
+// TestMsgValidateBasic checks that ValidateBasic validates addresses against
+// the account address prefix of the chain.
+func TestMsgValidateBasic(t *testing.T) {
+	bytes := utils.TestAccount().Bytes
+	valid := sdk.AccAddress(bytes).String()
+	invalid, err := sdk.Bech32ifyAddressBytes("other", bytes)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name string
+		msg  sdk.HasValidateBasic
+		err  error
+	}{
+		{"AcceptOwnership", &types.MsgAcceptOwnership{Denom: "ueure", Signer: valid}, nil},
+		{"AcceptOwnership with other prefix", &types.MsgAcceptOwnership{Denom: "ueure", Signer: invalid}, types.ErrInvalidAddress},
+		{"AddSystemAccount", &types.MsgAddSystemAccount{Denom: "ueure", Signer: valid, Account: valid}, nil},
+		{"AddSystemAccount with other prefix", &types.MsgAddSystemAccount{Denom: "ueure", Signer: valid, Account: invalid}, types.ErrInvalidAddress},
+		{"Mint", &types.MsgMint{Denom: "ueure", Signer: valid, To: valid, Amount: math.OneInt()}, nil},
+		{"Mint with other prefix", &types.MsgMint{Denom: "ueure", Signer: valid, To: invalid, Amount: math.OneInt()}, types.ErrInvalidAddress},
+		{"Seize", &types.MsgSeize{Denom: "ueure", Signer: valid, From: valid, To: valid}, nil},
+		{"Seize with other prefix", &types.MsgSeize{Denom: "ueure", Signer: valid, From: valid, To: invalid}, types.ErrInvalidAddress},
+		{"Wipe", &types.MsgWipe{Denom: "ueure", Signer: valid, From: valid}, nil},
+		{"Wipe with other prefix", &types.MsgWipe{Denom: "ueure", Signer: valid, From: invalid}, types.ErrInvalidAddress},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.err == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.err)
+			}
+		})
+	}
+}
@@ -0,0 +1,58 @@
+// Copyright 2024 Monerium ehf.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"slices"
+
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+)
+
+// Validate ensures that an enabled mint approval policy requires at least one
+// approval and lets pending mints expire.
+func (p MintApprovalPolicy) Validate() error {
+	if p.Threshold.IsNil() || p.Threshold.IsNegative() {
+		return errors.Wrap(ErrInvalidApprovalPolicy, "threshold cannot be negative")
+	}
+	if p.Threshold.IsZero() {
+		return nil
+	}
+
+	if p.Quorum == 0 {
+		return errors.Wrap(ErrInvalidApprovalPolicy, "quorum must be positive")
+	}
+	if p.ExpiryBlocks <= 0 {
+		return errors.Wrap(ErrInvalidApprovalPolicy, "expiry blocks must be positive")
+	}
+
+	return nil
+}
+
+// RequiresApproval returns true if a mint of amount must be approved under
+// this policy. A zero threshold disables the policy.
+func (p MintApprovalPolicy) RequiresApproval(amount math.Int) bool {
+	return !p.Threshold.IsNil() && p.Threshold.IsPositive() && amount.GT(p.Threshold)
+}
+
+// IsApprovedBy returns true if address has already approved the pending mint.
+func (pm PendingMint) IsApprovedBy(address string) bool {
+	return slices.Contains(pm.Approvals, address)
+}
+
+// HasQuorum returns true if the pending mint has received enough approvals.
+func (pm PendingMint) HasQuorum() bool {
+	return uint32(len(pm.Approvals)) >= pm.Quorum
+}
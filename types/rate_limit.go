@@ -0,0 +1,111 @@
+// Copyright 2024 Monerium ehf.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"time"
+
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+)
+
+// Validate ensures that a rate limit has a positive amount and exactly one
+// positive window, either in blocks or in seconds.
+func (rl RateLimit) Validate(cdc address.Codec) error {
+	if err := validateOptionalAddress(cdc, "rate limit", rl.Address); err != nil {
+		return err
+	}
+
+	if rl.Amount.IsNil() || !rl.Amount.IsPositive() {
+		return errors.Wrap(ErrInvalidRateLimit, "amount must be positive")
+	}
+	if rl.WindowBlocks < 0 || rl.WindowSeconds < 0 {
+		return errors.Wrap(ErrInvalidRateLimit, "window cannot be negative")
+	}
+	if (rl.WindowBlocks == 0) == (rl.WindowSeconds == 0) {
+		return errors.Wrap(ErrInvalidRateLimit, "exactly one of window blocks or window seconds must be set")
+	}
+
+	return nil
+}
+
+// RateLimitBuckets is the number of buckets the window of a rate limit is
+// divided into. A bucket counts towards the limit for as long as any part of
+// it is within the window, so no window of the configured length can exceed
+// the limit, at the cost of releasing capacity up to one bucket late.
+const RateLimitBuckets = 10
+
+// NewRateLimitUsage returns an empty usage of a rate limit.
+func NewRateLimitUsage(denom string, address string) RateLimitUsage {
+	return RateLimitUsage{
+		Denom:   denom,
+		Address: address,
+		Used:    math.ZeroInt(),
+	}
+}
+
+// Prune drops the buckets of a usage that have left the rolling window at the
+// given height and time, and recomputes the amount used.
+func (rl RateLimit) Prune(usage RateLimitUsage, height int64, blockTime time.Time) RateLimitUsage {
+	position, window, size := rl.position(height, blockTime), rl.window(), rl.bucketSize()
+
+	var buckets []RateLimitBucket
+	usage.Used = math.ZeroInt()
+	for _, bucket := range usage.Buckets {
+		if bucket.Start+size > position-window+1 {
+			buckets = append(buckets, bucket)
+			usage.Used = usage.Used.Add(bucket.Amount)
+		}
+	}
+	usage.Buckets = buckets
+
+	return usage
+}
+
+// Record adds a minted amount to the bucket of the given height and time.
+func (rl RateLimit) Record(usage RateLimitUsage, amount math.Int, height int64, blockTime time.Time) RateLimitUsage {
+	position, size := rl.position(height, blockTime), rl.bucketSize()
+	start := position - position%size
+
+	if n := len(usage.Buckets); n > 0 && usage.Buckets[n-1].Start == start {
+		usage.Buckets[n-1].Amount = usage.Buckets[n-1].Amount.Add(amount)
+	} else {
+		usage.Buckets = append(usage.Buckets, RateLimitBucket{Start: start, Amount: amount})
+	}
+	usage.Used = usage.Used.Add(amount)
+
+	return usage
+}
+
+// position returns the block height or unix timestamp that the window of a
+// rate limit is measured in.
+func (rl RateLimit) position(height int64, blockTime time.Time) int64 {
+	if rl.WindowBlocks > 0 {
+		return height
+	}
+	return blockTime.Unix()
+}
+
+func (rl RateLimit) window() int64 {
+	if rl.WindowBlocks > 0 {
+		return rl.WindowBlocks
+	}
+	return rl.WindowSeconds
+}
+
+func (rl RateLimit) bucketSize() int64 {
+	return (rl.window() + RateLimitBuckets - 1) / RateLimitBuckets
+}
@@ -0,0 +1,35 @@
+// Copyright 2024 Monerium ehf.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"cosmossdk.io/errors"
+)
+
+// MaxReferenceLength is the max length of an external reference.
+const MaxReferenceLength = 128
+
+// ValidateReference ensures that an external reference is non-empty and not
+// longer than MaxReferenceLength.
+func ValidateReference(reference string) error {
+	if reference == "" {
+		return errors.Wrap(ErrInvalidReference, "reference cannot be empty")
+	}
+	if len(reference) > MaxReferenceLength {
+		return errors.Wrapf(ErrInvalidReference, "reference cannot be longer than %d characters", MaxReferenceLength)
+	}
+
+	return nil
+}
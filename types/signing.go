@@ -0,0 +1,98 @@
+// Copyright 2024 Monerium ehf.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// LegacySignMessage is the constant message users signed before structured
+// sign-docs were introduced. It is only accepted until legacy signatures are
+// deprecated.
+const LegacySignMessage = "I hereby declare that I am the address owner."
+
+const (
+	SignActionBurn    = "burn"
+	SignActionRecover = "recover"
+)
+
+// SignDoc is the structured message a user signs, using ADR-36, to authorize
+// a single burn or recover of their tokens.
+type SignDoc struct {
+	ChainID            string `json:"chain_id"`
+	Action             string `json:"action"`
+	Denom              string `json:"denom"`
+	Amount             string `json:"amount"`
+	Destination        string `json:"destination"`
+	Nonce              uint64 `json:"nonce,string"`
+	ExpiryHeight       int64  `json:"expiry_height,string"`
+	ComplianceOverride bool   `json:"compliance_override,omitempty"`
+}
+
+// NewBurnSignDoc returns the sign-doc authorizing a system account to burn
+// amount of denom from the signer.
+func NewBurnSignDoc(chainID string, denom string, amount string, system string, nonce uint64, expiryHeight int64) SignDoc {
+	return SignDoc{
+		ChainID:      chainID,
+		Action:       SignActionBurn,
+		Denom:        denom,
+		Amount:       amount,
+		Destination:  system,
+		Nonce:        nonce,
+		ExpiryHeight: expiryHeight,
+	}
+}
+
+// NewRecoverSignDoc returns the sign-doc authorizing the recovery of amount
+// of denom to the destination address. A nil or zero amount authorizes the
+// recovery of the signer's entire balance, and is signed as an empty amount.
+func NewRecoverSignDoc(chainID string, denom string, amount math.Int, to string, nonce uint64, expiryHeight int64, complianceOverride bool) SignDoc {
+	var rawAmount string
+	if !amount.IsNil() && !amount.IsZero() {
+		rawAmount = amount.String()
+	}
+
+	return SignDoc{
+		ChainID:            chainID,
+		Action:             SignActionRecover,
+		Denom:              denom,
+		Amount:             rawAmount,
+		Destination:        to,
+		Nonce:              nonce,
+		ExpiryHeight:       expiryHeight,
+		ComplianceOverride: complianceOverride,
+	}
+}
+
+// Bytes returns the canonical, key-sorted JSON encoding of the sign-doc.
+func (doc SignDoc) Bytes() []byte {
+	bz, _ := json.Marshal(doc)
+	return sdk.MustSortJSON(bz)
+}
+
+// ADR36SignBytes returns the ADR-36 bytes an address signs to sign arbitrary
+// data, matching the amino JSON sign-doc produced by Keplr's signArbitrary
+// function.
+func ADR36SignBytes(signer string, data []byte) []byte {
+	return []byte(fmt.Sprintf(
+		`{"account_number":"0","chain_id":"","fee":{"amount":[],"gas":"0"},"memo":"","msgs":[{"type":"sign/MsgSignData","value":{"data":"%s","signer":"%s"}}],"sequence":"0"}`,
+		base64.StdEncoding.EncodeToString(data), signer,
+	))
+}
@@ -0,0 +1,46 @@
+// Copyright 2024 Monerium ehf.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	"cosmossdk.io/errors"
+)
+
+// ParseTransferPolicy parses a transfer policy from either its full enum name
+// or its short form, e.g. "TRANSFER_POLICY_ALLOWLIST" or "allowlist".
+func ParseTransferPolicy(policy string) (TransferPolicy, error) {
+	name := strings.ToUpper(strings.ReplaceAll(policy, "-", "_"))
+	if !strings.HasPrefix(name, "TRANSFER_POLICY_") {
+		name = "TRANSFER_POLICY_" + name
+	}
+
+	value, ok := TransferPolicy_value[name]
+	if !ok {
+		return TransferPolicyBlacklist, fmt.Errorf("unknown transfer policy %s", policy)
+	}
+
+	return TransferPolicy(value), nil
+}
+
+// Validate ensures that a transfer policy is known.
+func (p TransferPolicy) Validate() error {
+	if _, ok := TransferPolicy_name[int32(p)]; !ok {
+		return errors.Wrapf(ErrInvalidTransferPolicy, "unknown transfer policy %d", p)
+	}
+	return nil
+}
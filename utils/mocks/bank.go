@@ -29,6 +29,7 @@ var _ types.BankKeeper = BankKeeper{}
 
 type BankKeeper struct {
 	Balances    map[string]sdk.Coins
+	Locked      map[string]sdk.Coins
 	Restriction SendRestrictionFn
 }
 
@@ -55,7 +56,12 @@ func (k BankKeeper) GetSupply(_ context.Context, denom string) sdk.Coin {
 		return sdk.NewCoin(denom, math.NewIntFromUint64(1_000_000))
 	}
 
-	return sdk.NewCoin(denom, math.ZeroInt())
+	supply := math.ZeroInt()
+	for _, balance := range k.Balances {
+		supply = supply.Add(balance.AmountOf(denom))
+	}
+
+	return sdk.NewCoin(denom, supply)
 }
 
 func (k BankKeeper) MintCoins(_ context.Context, moduleName string, amt sdk.Coins) error {
@@ -77,6 +83,15 @@ func (k BankKeeper) SendCoinsFromModuleToAccount(ctx context.Context, senderModu
 	return k.SendCoins(ctx, senderAddr, recipientAddr, amt)
 }
 
+func (k BankKeeper) SpendableCoin(_ context.Context, addr sdk.AccAddress, denom string) sdk.Coin {
+	spendable := k.Balances[addr.String()].AmountOf(denom).Sub(k.Locked[addr.String()].AmountOf(denom))
+	if spendable.IsNegative() {
+		spendable = math.ZeroInt()
+	}
+
+	return sdk.NewCoin(denom, spendable)
+}
+
 //
 
 type SendRestrictionFn func(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (newToAddr sdk.AccAddress, err error)
@@ -109,6 +124,10 @@ func (k BankKeeper) SendCoins(ctx context.Context, fromAddr sdk.AccAddress, toAd
 		return sdkerrors.Wrapf(errors.ErrInsufficientFunds, "%s is smaller than %s", balance, amt)
 	}
 
+	if _, negative := newBalance.SafeSub(k.Locked[fromAddr.String()]...); negative {
+		return sdkerrors.Wrapf(errors.ErrInsufficientFunds, "spendable balance is smaller than %s", amt)
+	}
+
 	k.Balances[fromAddr.String()] = newBalance
 	k.Balances[toAddr.String()] = k.Balances[toAddr.String()].Add(amt...)
 
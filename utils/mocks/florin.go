@@ -27,6 +27,9 @@ import (
 	"github.com/monerium/module-noble/v2/types"
 )
 
+// Authority is the address of the governance module on Noble.
+const Authority = "noble10d07y265gmmuvt4z0w9aw880jnsr700jjpxdwa"
+
 func FlorinKeeper() (*keeper.Keeper, sdk.Context) {
 	return FlorinWithKeepers(BankKeeper{})
 }
@@ -40,7 +43,7 @@ func FlorinWithKeepers(bank BankKeeper) (*keeper.Keeper, sdk.Context) {
 	cdc := codec.NewProtoCodec(reg)
 
 	k := keeper.NewKeeper(
-		"authority",
+		Authority,
 		runtime.NewKVStoreService(key),
 		runtime.ProvideEventService(),
 		cdc,
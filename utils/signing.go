@@ -0,0 +1,48 @@
+// Copyright 2024 Monerium ehf.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/monerium/module-noble/v2/types"
+)
+
+type Signer struct {
+	Account
+	PrivKey *secp256k1.PrivKey
+}
+
+func TestSigner() Signer {
+	privKey := secp256k1.GenPrivKey()
+	bytes := privKey.PubKey().Address().Bytes()
+	address, _ := sdk.Bech32ifyAddressBytes("noble", bytes)
+	invalid, _ := sdk.Bech32ifyAddressBytes("cosmos", bytes)
+
+	return Signer{
+		Account: Account{
+			Address: address,
+			Invalid: invalid,
+			Bytes:   bytes,
+		},
+		PrivKey: privKey,
+	}
+}
+
+// SignArbitrary signs data following ADR-36, mirroring Keplr's signArbitrary function.
+func (s Signer) SignArbitrary(data []byte) []byte {
+	signature, _ := s.PrivKey.Sign(types.ADR36SignBytes(s.Address, data))
+	return signature
+}